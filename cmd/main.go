@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
 	"github.com/labstack/echo/v4"
@@ -11,10 +13,17 @@ import (
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/auth"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/blobstore"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventbus"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/service"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/webhooksender"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -30,6 +39,11 @@ func main() {
 		log.Fatal("Failed to initialize database:", err)
 	}
 
+	blobStore, err := blobstore.New(cfg.Storage)
+	if err != nil {
+		log.Fatal("Failed to initialize blob store:", err)
+	}
+
 	// Initialize Echo router
 	router := echo.New()
 
@@ -48,13 +62,65 @@ func main() {
 	router.Use(handler.CORSMiddleware(*cfg))
 
 	taskRepo := repository.NewTaskDB(db)
-	taskController := controller.NewTask(taskRepo)
+	tagRepo := repository.NewTagDB(db)
+	projectRepo := repository.NewProjectDB(db)
+	workspaceRepo := repository.NewWorkspaceDB(db)
+	commentRepo := repository.NewCommentDB(db)
+	attachmentRepo := repository.NewAttachmentDB(db)
+	webhookRepo := repository.NewWebhookDB(db)
+
+	// Deliver the events of saved tasks to in-process subscribers
+	eventBus := eventbus.New()
+
+	taskController := controller.NewTask(taskRepo, tagRepo, projectRepo, eventBus)
+	tagController := controller.NewTag(tagRepo)
+	projectController := controller.NewProject(projectRepo, taskRepo)
+	workspaceController := controller.NewWorkspace(workspaceRepo)
+	commentController := controller.NewComment(commentRepo, taskRepo)
+	attachmentController := controller.NewAttachment(attachmentRepo, taskRepo, blobStore, attachment.Limits{
+		MaxFileSize: cfg.Storage.AttachmentMaxSize,
+		UserQuota:   cfg.Storage.UserQuota,
+	})
+	webhookController := controller.NewWebhook(webhookRepo)
+
+	// Permanently remove tasks that outlived the trash retention, checked hourly
+	service.NewTrashPurgeService(taskRepo, cfg.Trash.RetentionDuration(), time.Hour).Start(context.Background())
+
+	// Spread out task ranks that grew too long from repeated moves, checked hourly
+	service.NewRankRebalanceService(taskRepo, task.RebalanceRankLength, time.Hour).Start(context.Background())
+
+	// Remove attachments of permanently deleted tasks and their content, checked hourly
+	service.NewAttachmentCleanupService(attachmentRepo, blobStore, time.Hour).Start(context.Background())
+
+	// Deliver task events from the webhook outbox to the endpoints of their
+	// owners, retrying failed deliveries with exponential backoff
+	webhookPolicy := webhook.RetryPolicy{
+		MaxAttempts:  cfg.Webhook.MaxAttempts,
+		BaseDelay:    cfg.Webhook.RetryBaseDelayDuration(),
+		MaxDelay:     cfg.Webhook.RetryMaxDelayDuration(),
+		DisableAfter: cfg.Webhook.DisableAfter,
+	}
+	webhookSender := webhooksender.NewHTTPSender(cfg.Webhook.TimeoutDuration(), cfg.Webhook.AllowedNetworkPrefixes())
+	service.NewWebhookDeliveryService(webhookRepo, webhookSender, webhookPolicy, cfg.Webhook.TimeoutDuration(), cfg.Webhook.PollIntervalDuration()).
+		Start(context.Background())
+
+	// Stream the changes to the tasks of a user to their open event streams,
+	// keeping the latest changes so that interrupted streams can be resumed
+	taskEvents := eventstream.NewBroker(cfg.EventStream.LogSize, cfg.EventStream.BufferSize, cfg.EventStream.HeartbeatIntervalDuration())
+	eventbus.SubscribeAsync(eventBus, handler.NewTaskStreamPublisher(*taskController, taskEvents).Handle)
 
 	// Initialize health service
 	healthService := service.NewHealthService(db)
 
 	apiServer := handler.NewAPIServer(
 		*taskController,
+		*tagController,
+		*projectController,
+		*workspaceController,
+		*commentController,
+		*attachmentController,
+		*webhookController,
+		taskEvents,
 		healthService,
 	)
 
@@ -68,6 +134,14 @@ func main() {
 	authMiddleware := handler.NewAuthenticationMiddleware(authService)
 	authMiddlewareFunc := authMiddleware.MiddlewareFunc()
 
+	// Create workspace middleware, which checks membership of the authenticated user
+	workspaceMiddlewareFunc := handler.NewWorkspaceMiddleware(*workspaceController).MiddlewareFunc()
+
+	// Create Idempotency-Key middleware for task creation and clean up expired keys hourly
+	idempotencyRepo := repository.NewIdempotencyDB(db)
+	idempotencyMiddleware := handler.NewIdempotencyMiddleware(idempotencyRepo, cfg.Idempotency)
+	service.NewIdempotencyCleanupService(idempotencyRepo, time.Hour).Start(context.Background())
+
 	// Create wrapper for generated handlers
 	wrapper := generated.ServerInterfaceWrapper{
 		Handler: apiServer,
@@ -78,14 +152,107 @@ func main() {
 
 	// Create a group for protected task endpoints
 	taskGroup := router.Group("/tasks")
-	taskGroup.Use(authMiddlewareFunc)
+	taskGroup.Use(authMiddlewareFunc, workspaceMiddlewareFunc)
 
 	// Register task endpoints with authentication middleware
 	taskGroup.GET("", wrapper.TaskGetAllTasks)
-	taskGroup.POST("", wrapper.TaskCreateTask)
+	taskGroup.POST("", wrapper.TaskCreateTask, idempotencyMiddleware.MiddlewareFunc())
+	taskGroup.GET("/events", wrapper.TaskStreamEvents)
+	taskGroup.GET("/search", wrapper.TaskSearchTasks)
+	taskGroup.GET("/trash", wrapper.TaskGetTrash)
+	taskGroup.DELETE("/trash", wrapper.TaskEmptyTrash)
 	taskGroup.GET("/:taskId", wrapper.TaskGetTask)
 	taskGroup.PUT("/:taskId", wrapper.TaskUpdateTask)
+	taskGroup.PATCH("/:taskId", wrapper.TaskPatchTask)
 	taskGroup.DELETE("/:taskId", wrapper.TaskDeleteTask)
+	taskGroup.POST("/:taskId/restore", wrapper.TaskRestoreTask)
+	taskGroup.GET("/:taskId/subtasks", wrapper.TaskGetSubtasks)
+	taskGroup.GET("/:taskId/collaborators", wrapper.TaskGetCollaborators)
+	taskGroup.PUT("/:taskId/collaborators/:userId", wrapper.TaskAddCollaborator)
+	taskGroup.DELETE("/:taskId/collaborators/:userId", wrapper.TaskRemoveCollaborator)
+	taskGroup.PUT("/:taskId/assignee", wrapper.TaskAssignTask)
+	taskGroup.GET("/:taskId/assignments", wrapper.TaskGetAssignments)
+	taskGroup.GET("/:taskId/history", wrapper.TaskGetHistory)
+	taskGroup.GET("/:taskId/comments", wrapper.CommentGetComments)
+	taskGroup.POST("/:taskId/comments", wrapper.CommentCreateComment)
+	taskGroup.GET("/:taskId/attachments", wrapper.AttachmentGetAttachments)
+	taskGroup.POST("/:taskId/attachments", wrapper.AttachmentUploadAttachment)
+	taskGroup.GET("/:taskId/dependencies", wrapper.TaskGetDependencies)
+	taskGroup.PUT("/:taskId/dependencies/:blockerId", wrapper.TaskAddDependency)
+	taskGroup.DELETE("/:taskId/dependencies/:blockerId", wrapper.TaskRemoveDependency)
+	taskGroup.GET("/:taskId/occurrences", wrapper.TaskGetOccurrences)
+	taskGroup.POST("/:taskId/move", wrapper.TaskMoveTask)
+	taskGroup.PUT("/:taskId/series", wrapper.TaskUpdateSeries)
+
+	// Create a group for protected comment endpoints. Comments on a task are
+	// listed and created under /tasks/:taskId/comments
+	commentGroup := router.Group("/comments")
+	commentGroup.Use(authMiddlewareFunc)
+
+	// Register comment endpoints with authentication middleware
+	commentGroup.PATCH("/:commentId", wrapper.CommentUpdateComment)
+	commentGroup.DELETE("/:commentId", wrapper.CommentDeleteComment)
+
+	// Create a group for protected attachment endpoints. Attachments of a task
+	// are listed and uploaded under /tasks/:taskId/attachments
+	attachmentGroup := router.Group("/attachments")
+	attachmentGroup.Use(authMiddlewareFunc)
+
+	// Register attachment endpoints with authentication middleware
+	attachmentGroup.GET("/:attachmentId", wrapper.AttachmentDownloadAttachment)
+	attachmentGroup.DELETE("/:attachmentId", wrapper.AttachmentDeleteAttachment)
+
+	// Create a group for protected tag endpoints
+	tagGroup := router.Group("/tags")
+	tagGroup.Use(authMiddlewareFunc)
+
+	// Register tag endpoints with authentication middleware
+	tagGroup.GET("", wrapper.TagGetAllTags)
+	tagGroup.POST("", wrapper.TagCreateTag)
+	tagGroup.GET("/:tagId", wrapper.TagGetTag)
+	tagGroup.PUT("/:tagId", wrapper.TagUpdateTag)
+	tagGroup.DELETE("/:tagId", wrapper.TagDeleteTag)
+
+	// Create a group for protected project endpoints
+	projectGroup := router.Group("/projects")
+	projectGroup.Use(authMiddlewareFunc)
+
+	// Register project endpoints with authentication middleware
+	projectGroup.GET("", wrapper.ProjectGetAllProjects)
+	projectGroup.POST("", wrapper.ProjectCreateProject)
+	projectGroup.GET("/:projectId", wrapper.ProjectGetProject)
+	projectGroup.PUT("/:projectId", wrapper.ProjectUpdateProject)
+	projectGroup.DELETE("/:projectId", wrapper.ProjectDeleteProject)
+	projectGroup.GET("/:projectId/tasks", wrapper.ProjectGetProjectTasks)
+
+	// Create a group for protected webhook endpoints
+	webhookGroup := router.Group("/webhooks")
+	webhookGroup.Use(authMiddlewareFunc)
+
+	// Register webhook endpoints with authentication middleware
+	webhookGroup.GET("", wrapper.WebhookGetAllWebhooks)
+	webhookGroup.POST("", wrapper.WebhookCreateWebhook)
+	webhookGroup.GET("/:webhookId", wrapper.WebhookGetWebhook)
+	webhookGroup.PATCH("/:webhookId", wrapper.WebhookUpdateWebhook)
+	webhookGroup.DELETE("/:webhookId", wrapper.WebhookDeleteWebhook)
+
+	// Create a group for protected workspace endpoints
+	workspaceGroup := router.Group("/workspaces")
+	workspaceGroup.Use(authMiddlewareFunc)
+
+	// Register workspace endpoints with authentication middleware
+	workspaceGroup.GET("", wrapper.WorkspaceGetAllWorkspaces)
+	workspaceGroup.POST("", wrapper.WorkspaceCreateWorkspace)
+
+	// Endpoints of a single workspace also check the membership of the user.
+	memberGroup := workspaceGroup.Group("/:workspaceId", workspaceMiddlewareFunc)
+	memberGroup.GET("/members", wrapper.WorkspaceGetMembers)
+	memberGroup.PUT("/members/:userId", wrapper.WorkspaceSetMember)
+	memberGroup.DELETE("/members/:userId", wrapper.WorkspaceRemoveMember)
+
+	// Register the realtime task sync socket, which authenticates like the REST endpoints
+	taskSocketHandler := handler.NewTaskSocketHandler(*taskController, taskEvents, cfg.WebSocket)
+	router.GET("/ws", taskSocketHandler.Serve, authMiddlewareFunc)
 
 	if err := router.Start(":" + cfg.Port); err != nil {
 		log.Fatal("Failed to start server:", err.Error())
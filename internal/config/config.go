@@ -3,9 +3,11 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var (
@@ -23,6 +25,35 @@ var (
 	ErrAuthMethodRequired     = errors.New("at least one authentication method must be configured: JWT_SECRET, JWKS_ENDPOINT_URL, or JWT_PRIVATE_KEY_FILE")
 	ErrPrivateKeyFileNotFound = errors.New("private key file does not exist")
 
+	ErrIdempotencyKeyTTLNotPositive = errors.New("idempotency key TTL must be positive")
+	ErrTrashRetentionNotPositive    = errors.New("trash retention must be positive")
+
+	ErrStorageBackendInvalid         = errors.New("storage backend must be local or s3")
+	ErrStorageLocalDirRequired       = errors.New("storage directory is required for the local backend")
+	ErrS3EndpointRequired            = errors.New("S3 endpoint is required for the s3 backend")
+	ErrS3RegionRequired              = errors.New("S3 region is required for the s3 backend")
+	ErrS3BucketRequired              = errors.New("S3 bucket is required for the s3 backend")
+	ErrS3CredentialsRequired         = errors.New("S3 access key ID and secret access key are required for the s3 backend")
+	ErrAttachmentMaxSizeNotPositive  = errors.New("attachment max size must be positive")
+	ErrStorageQuotaNotPositive       = errors.New("storage quota must be positive")
+	ErrAttachmentMaxSizeExceedsQuota = errors.New("attachment max size must not exceed the storage quota")
+
+	ErrWebhookTimeoutNotPositive      = errors.New("webhook timeout must be positive")
+	ErrWebhookPollIntervalNotPositive = errors.New("webhook poll interval must be positive")
+	ErrWebhookMaxAttemptsNotPositive  = errors.New("webhook max attempts must be positive")
+	ErrWebhookRetryDelayNotPositive   = errors.New("webhook retry delays must be positive")
+	ErrWebhookRetryMaxDelayTooSmall   = errors.New("webhook retry max delay must not be less than the base delay")
+	ErrWebhookDisableAfterNotPositive = errors.New("webhook disable threshold must be positive")
+	ErrWebhookAllowedNetworkInvalid   = errors.New("webhook allowed network must be a CIDR prefix")
+
+	ErrEventStreamHeartbeatNotPositive  = errors.New("event stream heartbeat interval must be positive")
+	ErrEventStreamLogSizeNotPositive    = errors.New("event stream log size must be positive")
+	ErrEventStreamBufferSizeNotPositive = errors.New("event stream buffer size must be positive")
+
+	ErrWebSocketPingIntervalNotPositive   = errors.New("websocket ping interval must be positive")
+	ErrWebSocketPongTimeoutTooSmall       = errors.New("websocket pong timeout must be greater than the ping interval")
+	ErrWebSocketMaxMessageSizeNotPositive = errors.New("websocket max message size must be positive")
+
 	ErrServiceNameRequired = errors.New("service name is required")
 	ErrAllowOriginEmpty    = errors.New("allowed origin is empty")
 
@@ -123,10 +154,274 @@ func (ac AuthConfig) Validate() error {
 	return nil
 }
 
+// IdempotencyConfig holds configuration for Idempotency-Key handling.
+type IdempotencyConfig struct {
+	KeyTTL int // seconds
+}
+
+// Validate validates the idempotency configuration
+func (ic IdempotencyConfig) Validate() error {
+	if ic.KeyTTL <= 0 {
+		return ErrIdempotencyKeyTTLNotPositive
+	}
+
+	return nil
+}
+
+// KeyTTLDuration returns how long an idempotency key is remembered.
+func (ic IdempotencyConfig) KeyTTLDuration() time.Duration {
+	return time.Duration(ic.KeyTTL) * time.Second
+}
+
+// TrashConfig holds configuration for deleted tasks.
+type TrashConfig struct {
+	Retention int // seconds
+}
+
+// Validate validates the trash configuration
+func (tc TrashConfig) Validate() error {
+	if tc.Retention <= 0 {
+		return ErrTrashRetentionNotPositive
+	}
+
+	return nil
+}
+
+// RetentionDuration returns how long a deleted task stays in the trash before it is purged.
+func (tc TrashConfig) RetentionDuration() time.Duration {
+	return time.Duration(tc.Retention) * time.Second
+}
+
+// Storage backends for attachment content.
+const (
+	StorageBackendLocal = "local"
+	StorageBackendS3    = "s3"
+)
+
+// S3Config holds the connection settings of an S3-compatible object store.
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// Validate validates the S3 configuration
+func (sc S3Config) Validate() error {
+	if sc.Endpoint == "" {
+		return ErrS3EndpointRequired
+	}
+
+	if sc.Region == "" {
+		return ErrS3RegionRequired
+	}
+
+	if sc.Bucket == "" {
+		return ErrS3BucketRequired
+	}
+
+	if sc.AccessKeyID == "" || sc.SecretAccessKey == "" {
+		return ErrS3CredentialsRequired
+	}
+
+	return nil
+}
+
+// StorageConfig holds configuration for attachment storage and the per-user quota.
+type StorageConfig struct {
+	Backend           string
+	LocalDir          string
+	S3                S3Config
+	AttachmentMaxSize int64 // bytes
+	UserQuota         int64 // bytes
+}
+
+// Validate validates the storage configuration
+func (sc StorageConfig) Validate() error {
+	switch sc.Backend {
+	case StorageBackendLocal:
+		if sc.LocalDir == "" {
+			return ErrStorageLocalDirRequired
+		}
+	case StorageBackendS3:
+		if err := sc.S3.Validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrStorageBackendInvalid, sc.Backend)
+	}
+
+	if sc.AttachmentMaxSize <= 0 {
+		return ErrAttachmentMaxSizeNotPositive
+	}
+
+	if sc.UserQuota <= 0 {
+		return ErrStorageQuotaNotPositive
+	}
+
+	if sc.AttachmentMaxSize > sc.UserQuota {
+		return ErrAttachmentMaxSizeExceedsQuota
+	}
+
+	return nil
+}
+
+// WebhookConfig holds configuration for delivering webhook events.
+type WebhookConfig struct {
+	Timeout        int // seconds
+	PollInterval   int // seconds
+	MaxAttempts    int
+	RetryBaseDelay int // seconds
+	RetryMaxDelay  int // seconds
+	DisableAfter   int // failed attempts in a row
+	// AllowedNetworks are CIDR prefixes endpoints may be in even though they are
+	// loopback, private or link-local, such as a network of internal receivers.
+	AllowedNetworks []string
+}
+
+// Validate validates the webhook configuration
+func (wc WebhookConfig) Validate() error {
+	if wc.Timeout <= 0 {
+		return ErrWebhookTimeoutNotPositive
+	}
+
+	if wc.PollInterval <= 0 {
+		return ErrWebhookPollIntervalNotPositive
+	}
+
+	if wc.MaxAttempts <= 0 {
+		return ErrWebhookMaxAttemptsNotPositive
+	}
+
+	if wc.RetryBaseDelay <= 0 || wc.RetryMaxDelay <= 0 {
+		return ErrWebhookRetryDelayNotPositive
+	}
+
+	if wc.RetryMaxDelay < wc.RetryBaseDelay {
+		return ErrWebhookRetryMaxDelayTooSmall
+	}
+
+	if wc.DisableAfter <= 0 {
+		return ErrWebhookDisableAfterNotPositive
+	}
+
+	for i, network := range wc.AllowedNetworks {
+		if _, err := netip.ParsePrefix(network); err != nil {
+			return fmt.Errorf("%w at index %d", ErrWebhookAllowedNetworkInvalid, i)
+		}
+	}
+
+	return nil
+}
+
+// TimeoutDuration returns how long a delivery attempt may take.
+func (wc WebhookConfig) TimeoutDuration() time.Duration {
+	return time.Duration(wc.Timeout) * time.Second
+}
+
+// AllowedNetworkPrefixes returns the parsed AllowedNetworks. Entries that are
+// not valid prefixes are left out; Validate reports them.
+func (wc WebhookConfig) AllowedNetworkPrefixes() []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(wc.AllowedNetworks))
+
+	for _, network := range wc.AllowedNetworks {
+		if prefix, err := netip.ParsePrefix(network); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// PollIntervalDuration returns how often pending events and deliveries are looked for.
+func (wc WebhookConfig) PollIntervalDuration() time.Duration {
+	return time.Duration(wc.PollInterval) * time.Second
+}
+
+// RetryBaseDelayDuration returns the wait after the first failed delivery attempt.
+func (wc WebhookConfig) RetryBaseDelayDuration() time.Duration {
+	return time.Duration(wc.RetryBaseDelay) * time.Second
+}
+
+// RetryMaxDelayDuration returns the longest wait between two delivery attempts.
+func (wc WebhookConfig) RetryMaxDelayDuration() time.Duration {
+	return time.Duration(wc.RetryMaxDelay) * time.Second
+}
+
+// EventStreamConfig holds configuration for streaming task changes to clients.
+type EventStreamConfig struct {
+	HeartbeatInterval int // seconds
+	LogSize           int // changes kept for resuming streams
+	BufferSize        int // changes queued for a slow client before it is disconnected
+}
+
+// Validate validates the event stream configuration
+func (ec EventStreamConfig) Validate() error {
+	if ec.HeartbeatInterval <= 0 {
+		return ErrEventStreamHeartbeatNotPositive
+	}
+
+	if ec.LogSize <= 0 {
+		return ErrEventStreamLogSizeNotPositive
+	}
+
+	if ec.BufferSize <= 0 {
+		return ErrEventStreamBufferSizeNotPositive
+	}
+
+	return nil
+}
+
+// HeartbeatIntervalDuration returns how often an idle stream is sent a heartbeat.
+func (ec EventStreamConfig) HeartbeatIntervalDuration() time.Duration {
+	return time.Duration(ec.HeartbeatInterval) * time.Second
+}
+
+// WebSocketConfig holds configuration for the realtime task sync over WebSocket.
+type WebSocketConfig struct {
+	PingInterval   int // seconds
+	PongTimeout    int // seconds without any message from the client before it is disconnected
+	MaxMessageSize int // bytes
+}
+
+// Validate validates the WebSocket configuration
+func (wc WebSocketConfig) Validate() error {
+	if wc.PingInterval <= 0 {
+		return ErrWebSocketPingIntervalNotPositive
+	}
+
+	if wc.PongTimeout <= wc.PingInterval {
+		return ErrWebSocketPongTimeoutTooSmall
+	}
+
+	if wc.MaxMessageSize <= 0 {
+		return ErrWebSocketMaxMessageSizeNotPositive
+	}
+
+	return nil
+}
+
+// PingIntervalDuration returns how often clients are pinged.
+func (wc WebSocketConfig) PingIntervalDuration() time.Duration {
+	return time.Duration(wc.PingInterval) * time.Second
+}
+
+// PongTimeoutDuration returns how long a client may stay silent, pongs included.
+func (wc WebSocketConfig) PongTimeoutDuration() time.Duration {
+	return time.Duration(wc.PongTimeout) * time.Second
+}
+
 // Config represents the application configuration loaded from environment variables.
 type Config struct {
 	Database     DatabaseConfig
 	Auth         AuthConfig
+	Idempotency  IdempotencyConfig
+	Trash        TrashConfig
+	Storage      StorageConfig
+	Webhook      WebhookConfig
+	EventStream  EventStreamConfig
+	WebSocket    WebSocketConfig
 	AllowOrigins []string
 	ServiceName  string
 	Port         string
@@ -145,6 +440,30 @@ func (c Config) Validate() error {
 		return err
 	}
 
+	if err := c.Idempotency.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Trash.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Storage.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Webhook.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.EventStream.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.WebSocket.Validate(); err != nil {
+		return err
+	}
+
 	if c.ServiceName == "" {
 		return ErrServiceNameRequired
 	}
@@ -177,6 +496,44 @@ func Load() (*Config, error) {
 			},
 			PrivateKeyFilePath: getEnv("JWT_PRIVATE_KEY_FILE", ""),
 		},
+		Idempotency: IdempotencyConfig{
+			KeyTTL: getIntEnv("IDEMPOTENCY_KEY_TTL", 86400), // 24 hours
+		},
+		Trash: TrashConfig{
+			Retention: getIntEnv("TRASH_RETENTION", 2592000), // 30 days
+		},
+		Storage: StorageConfig{
+			Backend:  getEnv("STORAGE_BACKEND", StorageBackendLocal),
+			LocalDir: getEnv("STORAGE_LOCAL_DIR", "data/attachments"),
+			S3: S3Config{
+				Endpoint:        getEnv("S3_ENDPOINT", ""),
+				Region:          getEnv("S3_REGION", "us-east-1"),
+				Bucket:          getEnv("S3_BUCKET", ""),
+				AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+				SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+			},
+			AttachmentMaxSize: int64(getIntEnv("ATTACHMENT_MAX_SIZE", 10485760)), // 10 MiB
+			UserQuota:         int64(getIntEnv("STORAGE_USER_QUOTA", 104857600)), // 100 MiB
+		},
+		Webhook: WebhookConfig{
+			Timeout:         getIntEnv("WEBHOOK_TIMEOUT", 10),
+			PollInterval:    getIntEnv("WEBHOOK_POLL_INTERVAL", 5),
+			MaxAttempts:     getIntEnv("WEBHOOK_MAX_ATTEMPTS", 8),
+			RetryBaseDelay:  getIntEnv("WEBHOOK_RETRY_BASE_DELAY", 30),
+			RetryMaxDelay:   getIntEnv("WEBHOOK_RETRY_MAX_DELAY", 3600), // 1 hour
+			DisableAfter:    getIntEnv("WEBHOOK_DISABLE_AFTER", 20),
+			AllowedNetworks: getListEnv("WEBHOOK_ALLOWED_NETWORKS"),
+		},
+		EventStream: EventStreamConfig{
+			HeartbeatInterval: getIntEnv("EVENT_STREAM_HEARTBEAT_INTERVAL", 15),
+			LogSize:           getIntEnv("EVENT_STREAM_LOG_SIZE", 1000),
+			BufferSize:        getIntEnv("EVENT_STREAM_BUFFER_SIZE", 64),
+		},
+		WebSocket: WebSocketConfig{
+			PingInterval:   getIntEnv("WEBSOCKET_PING_INTERVAL", 30),
+			PongTimeout:    getIntEnv("WEBSOCKET_PONG_TIMEOUT", 60),
+			MaxMessageSize: getIntEnv("WEBSOCKET_MAX_MESSAGE_SIZE", 65536), // 64 KiB
+		},
 		AllowOrigins: strings.Split(getEnv("ALLOW_ORIGINS", "http://localhost:5173,http://localhost:3000"), ","),
 		ServiceName:  getEnv("SERVICE_NAME", "todo-server"),
 		Port:         getEnv("PORT", "8080"),
@@ -198,6 +555,17 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getListEnv returns the comma-separated values of the variable, or nil if it
+// is not set.
+func getListEnv(key string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
+}
+
 func getIntEnv(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
 		if intValue, err := strconv.Atoi(value); err == nil {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestDatabaseConfig_Validate(t *testing.T) {
@@ -365,6 +366,295 @@ func TestAuthConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestIdempotencyConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  IdempotencyConfig
+		wantErr error
+	}{
+		{
+			name:    "valid TTL",
+			config:  IdempotencyConfig{KeyTTL: 86400},
+			wantErr: nil,
+		},
+		{
+			name:    "zero TTL",
+			config:  IdempotencyConfig{KeyTTL: 0},
+			wantErr: ErrIdempotencyKeyTTLNotPositive,
+		},
+		{
+			name:    "negative TTL",
+			config:  IdempotencyConfig{KeyTTL: -1},
+			wantErr: ErrIdempotencyKeyTTLNotPositive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.config.Validate()
+
+			// Assert
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("IdempotencyConfig.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got := (IdempotencyConfig{KeyTTL: 90}).KeyTTLDuration(); got != 90*time.Second {
+		t.Errorf("IdempotencyConfig.KeyTTLDuration() = %v, want %v", got, 90*time.Second)
+	}
+}
+
+func TestTrashConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  TrashConfig
+		wantErr error
+	}{
+		{
+			name:    "valid retention",
+			config:  TrashConfig{Retention: 2592000},
+			wantErr: nil,
+		},
+		{
+			name:    "zero retention",
+			config:  TrashConfig{Retention: 0},
+			wantErr: ErrTrashRetentionNotPositive,
+		},
+		{
+			name:    "negative retention",
+			config:  TrashConfig{Retention: -1},
+			wantErr: ErrTrashRetentionNotPositive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.config.Validate()
+
+			// Assert
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("TrashConfig.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got := (TrashConfig{Retention: 3600}).RetentionDuration(); got != time.Hour {
+		t.Errorf("TrashConfig.RetentionDuration() = %v, want %v", got, time.Hour)
+	}
+}
+
+func TestStorageConfig_Validate(t *testing.T) {
+	validS3Config := S3Config{
+		Endpoint:        "http://localhost:9000",
+		Region:          "us-east-1",
+		Bucket:          "attachments",
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+	}
+
+	tests := []struct {
+		name    string
+		config  StorageConfig
+		wantErr error
+	}{
+		{
+			name:    "valid local storage",
+			config:  StorageConfig{Backend: StorageBackendLocal, LocalDir: "data/attachments", AttachmentMaxSize: 1024, UserQuota: 4096},
+			wantErr: nil,
+		},
+		{
+			name:    "valid s3 storage",
+			config:  StorageConfig{Backend: StorageBackendS3, S3: validS3Config, AttachmentMaxSize: 1024, UserQuota: 4096},
+			wantErr: nil,
+		},
+		{
+			name:    "unknown backend",
+			config:  StorageConfig{Backend: "ftp", LocalDir: "data/attachments", AttachmentMaxSize: 1024, UserQuota: 4096},
+			wantErr: ErrStorageBackendInvalid,
+		},
+		{
+			name:    "local storage without directory",
+			config:  StorageConfig{Backend: StorageBackendLocal, AttachmentMaxSize: 1024, UserQuota: 4096},
+			wantErr: ErrStorageLocalDirRequired,
+		},
+		{
+			name: "s3 storage without bucket",
+			config: StorageConfig{
+				Backend: StorageBackendS3,
+				S3: S3Config{
+					Endpoint:        "http://localhost:9000",
+					Region:          "us-east-1",
+					AccessKeyID:     "access-key",
+					SecretAccessKey: "secret-key",
+				},
+				AttachmentMaxSize: 1024,
+				UserQuota:         4096,
+			},
+			wantErr: ErrS3BucketRequired,
+		},
+		{
+			name: "s3 storage without secret access key",
+			config: StorageConfig{
+				Backend: StorageBackendS3,
+				S3: S3Config{
+					Endpoint:    "http://localhost:9000",
+					Region:      "us-east-1",
+					Bucket:      "attachments",
+					AccessKeyID: "access-key",
+				},
+				AttachmentMaxSize: 1024,
+				UserQuota:         4096,
+			},
+			wantErr: ErrS3CredentialsRequired,
+		},
+		{
+			name:    "zero attachment max size",
+			config:  StorageConfig{Backend: StorageBackendLocal, LocalDir: "data/attachments", AttachmentMaxSize: 0, UserQuota: 4096},
+			wantErr: ErrAttachmentMaxSizeNotPositive,
+		},
+		{
+			name:    "negative quota",
+			config:  StorageConfig{Backend: StorageBackendLocal, LocalDir: "data/attachments", AttachmentMaxSize: 1024, UserQuota: -1},
+			wantErr: ErrStorageQuotaNotPositive,
+		},
+		{
+			name:    "attachment max size above quota",
+			config:  StorageConfig{Backend: StorageBackendLocal, LocalDir: "data/attachments", AttachmentMaxSize: 8192, UserQuota: 4096},
+			wantErr: ErrAttachmentMaxSizeExceedsQuota,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := tt.config.Validate()
+
+			// Assert
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StorageConfig.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookConfig_Validate(t *testing.T) {
+	valid := WebhookConfig{Timeout: 10, PollInterval: 5, MaxAttempts: 8, RetryBaseDelay: 30, RetryMaxDelay: 3600, DisableAfter: 20}
+
+	tests := []struct {
+		name    string
+		modify  func(*WebhookConfig)
+		wantErr error
+	}{
+		{name: "valid config", modify: func(*WebhookConfig) {}, wantErr: nil},
+		{name: "zero timeout", modify: func(c *WebhookConfig) { c.Timeout = 0 }, wantErr: ErrWebhookTimeoutNotPositive},
+		{name: "zero poll interval", modify: func(c *WebhookConfig) { c.PollInterval = 0 }, wantErr: ErrWebhookPollIntervalNotPositive},
+		{name: "zero max attempts", modify: func(c *WebhookConfig) { c.MaxAttempts = 0 }, wantErr: ErrWebhookMaxAttemptsNotPositive},
+		{name: "negative base delay", modify: func(c *WebhookConfig) { c.RetryBaseDelay = -1 }, wantErr: ErrWebhookRetryDelayNotPositive},
+		{name: "zero max delay", modify: func(c *WebhookConfig) { c.RetryMaxDelay = 0 }, wantErr: ErrWebhookRetryDelayNotPositive},
+		{name: "max delay below base delay", modify: func(c *WebhookConfig) { c.RetryMaxDelay = 10 }, wantErr: ErrWebhookRetryMaxDelayTooSmall},
+		{name: "zero disable threshold", modify: func(c *WebhookConfig) { c.DisableAfter = 0 }, wantErr: ErrWebhookDisableAfterNotPositive},
+		{name: "allowed networks", modify: func(c *WebhookConfig) { c.AllowedNetworks = []string{"10.0.0.0/8", "::1/128"} }, wantErr: nil},
+		{name: "allowed network without prefix length", modify: func(c *WebhookConfig) { c.AllowedNetworks = []string{"10.0.0.1"} }, wantErr: ErrWebhookAllowedNetworkInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			config := valid
+			tt.modify(&config)
+
+			// Act
+			err := config.Validate()
+
+			// Assert
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("WebhookConfig.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got := valid.RetryMaxDelayDuration(); got != time.Hour {
+		t.Errorf("WebhookConfig.RetryMaxDelayDuration() = %v, want %v", got, time.Hour)
+	}
+}
+
+func TestEventStreamConfig_Validate(t *testing.T) {
+	valid := EventStreamConfig{HeartbeatInterval: 15, LogSize: 1000, BufferSize: 64}
+
+	tests := []struct {
+		name    string
+		modify  func(*EventStreamConfig)
+		wantErr error
+	}{
+		{name: "valid config", modify: func(*EventStreamConfig) {}, wantErr: nil},
+		{name: "zero heartbeat interval", modify: func(c *EventStreamConfig) { c.HeartbeatInterval = 0 }, wantErr: ErrEventStreamHeartbeatNotPositive},
+		{name: "zero log size", modify: func(c *EventStreamConfig) { c.LogSize = 0 }, wantErr: ErrEventStreamLogSizeNotPositive},
+		{name: "negative buffer size", modify: func(c *EventStreamConfig) { c.BufferSize = -1 }, wantErr: ErrEventStreamBufferSizeNotPositive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			config := valid
+			tt.modify(&config)
+
+			// Act
+			err := config.Validate()
+
+			// Assert
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("EventStreamConfig.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got := valid.HeartbeatIntervalDuration(); got != 15*time.Second {
+		t.Errorf("EventStreamConfig.HeartbeatIntervalDuration() = %v, want %v", got, 15*time.Second)
+	}
+}
+
+func TestWebSocketConfig_Validate(t *testing.T) {
+	valid := WebSocketConfig{PingInterval: 30, PongTimeout: 60, MaxMessageSize: 65536}
+
+	tests := []struct {
+		name    string
+		modify  func(*WebSocketConfig)
+		wantErr error
+	}{
+		{name: "valid config", modify: func(*WebSocketConfig) {}, wantErr: nil},
+		{name: "zero ping interval", modify: func(c *WebSocketConfig) { c.PingInterval = 0 }, wantErr: ErrWebSocketPingIntervalNotPositive},
+		{name: "pong timeout equal to ping interval", modify: func(c *WebSocketConfig) { c.PongTimeout = 30 }, wantErr: ErrWebSocketPongTimeoutTooSmall},
+		{name: "negative max message size", modify: func(c *WebSocketConfig) { c.MaxMessageSize = -1 }, wantErr: ErrWebSocketMaxMessageSizeNotPositive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			config := valid
+			tt.modify(&config)
+
+			// Act
+			err := config.Validate()
+
+			// Assert
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("WebSocketConfig.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got := valid.PingIntervalDuration(); got != 30*time.Second {
+		t.Errorf("WebSocketConfig.PingIntervalDuration() = %v, want %v", got, 30*time.Second)
+	}
+
+	if got := valid.PongTimeoutDuration(); got != time.Minute {
+		t.Errorf("WebSocketConfig.PongTimeoutDuration() = %v, want %v", got, time.Minute)
+	}
+}
+
 func TestConfig_Validate(t *testing.T) {
 	tmpFile, err := os.CreateTemp("", "test_private_key_*.pem")
 	if err != nil {
@@ -392,6 +682,25 @@ func TestConfig_Validate(t *testing.T) {
 		PrivateKeyFilePath: "",
 	}
 
+	validIdempotencyConfig := IdempotencyConfig{KeyTTL: 86400}
+	validTrashConfig := TrashConfig{Retention: 2592000}
+	validStorageConfig := StorageConfig{
+		Backend:           StorageBackendLocal,
+		LocalDir:          "data/attachments",
+		AttachmentMaxSize: 10485760,
+		UserQuota:         104857600,
+	}
+	validWebhookConfig := WebhookConfig{
+		Timeout:        10,
+		PollInterval:   5,
+		MaxAttempts:    8,
+		RetryBaseDelay: 30,
+		RetryMaxDelay:  3600,
+		DisableAfter:   20,
+	}
+	validEventStreamConfig := EventStreamConfig{HeartbeatInterval: 15, LogSize: 1000, BufferSize: 64}
+	validWebSocketConfig := WebSocketConfig{PingInterval: 30, PongTimeout: 60, MaxMessageSize: 65536}
+
 	tests := []struct {
 		name    string
 		config  Config
@@ -403,6 +712,12 @@ func TestConfig_Validate(t *testing.T) {
 			config: Config{
 				Database:     validDatabaseConfig,
 				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
 				AllowOrigins: []string{"http://localhost:3000", "http://localhost:5173"},
 				ServiceName:  "todo-server",
 			},
@@ -413,6 +728,12 @@ func TestConfig_Validate(t *testing.T) {
 			config: Config{
 				Database:     validDatabaseConfig,
 				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
 				AllowOrigins: []string{},
 				ServiceName:  "todo-server",
 			},
@@ -429,6 +750,12 @@ func TestConfig_Validate(t *testing.T) {
 					Name:     "dbname",
 				},
 				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
 				AllowOrigins: []string{"http://localhost:3000"},
 				ServiceName:  "todo-server",
 			},
@@ -448,17 +775,131 @@ func TestConfig_Validate(t *testing.T) {
 					},
 					PrivateKeyFilePath: "",
 				},
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
 				AllowOrigins: []string{"http://localhost:3000"},
 				ServiceName:  "todo-server",
 			},
 			wantErr: true,
 			errMsg:  "at least one authentication method must be configured: JWT_SECRET, JWKS_ENDPOINT_URL, or JWT_PRIVATE_KEY_FILE",
 		},
+		{
+			name: "invalid idempotency config",
+			config: Config{
+				Database:     validDatabaseConfig,
+				Auth:         validAuthConfig,
+				Idempotency:  IdempotencyConfig{KeyTTL: 0},
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
+				AllowOrigins: []string{"http://localhost:3000"},
+				ServiceName:  "todo-server",
+			},
+			wantErr: true,
+			errMsg:  "idempotency key TTL must be positive",
+		},
+		{
+			name: "invalid trash config",
+			config: Config{
+				Database:     validDatabaseConfig,
+				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        TrashConfig{Retention: 0},
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
+				AllowOrigins: []string{"http://localhost:3000"},
+				ServiceName:  "todo-server",
+			},
+			wantErr: true,
+			errMsg:  "trash retention must be positive",
+		},
+		{
+			name: "invalid storage config",
+			config: Config{
+				Database:     validDatabaseConfig,
+				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      StorageConfig{Backend: StorageBackendLocal, LocalDir: "", AttachmentMaxSize: 1024, UserQuota: 4096},
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
+				AllowOrigins: []string{"http://localhost:3000"},
+				ServiceName:  "todo-server",
+			},
+			wantErr: true,
+			errMsg:  "storage directory is required for the local backend",
+		},
+		{
+			name: "invalid webhook config",
+			config: Config{
+				Database:     validDatabaseConfig,
+				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      WebhookConfig{Timeout: 0, PollInterval: 5, MaxAttempts: 8, RetryBaseDelay: 30, RetryMaxDelay: 3600, DisableAfter: 20},
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
+				AllowOrigins: []string{"http://localhost:3000"},
+				ServiceName:  "todo-server",
+			},
+			wantErr: true,
+			errMsg:  "webhook timeout must be positive",
+		},
+		{
+			name: "invalid event stream config",
+			config: Config{
+				Database:     validDatabaseConfig,
+				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  EventStreamConfig{HeartbeatInterval: 15, LogSize: 0, BufferSize: 64},
+				WebSocket:    validWebSocketConfig,
+				AllowOrigins: []string{"http://localhost:3000"},
+				ServiceName:  "todo-server",
+			},
+			wantErr: true,
+			errMsg:  "event stream log size must be positive",
+		},
+		{
+			name: "invalid websocket config",
+			config: Config{
+				Database:     validDatabaseConfig,
+				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    WebSocketConfig{PingInterval: 30, PongTimeout: 10, MaxMessageSize: 65536},
+				AllowOrigins: []string{"http://localhost:3000"},
+				ServiceName:  "todo-server",
+			},
+			wantErr: true,
+			errMsg:  "websocket pong timeout must be greater than the ping interval",
+		},
 		{
 			name: "empty service name",
 			config: Config{
 				Database:     validDatabaseConfig,
 				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
 				AllowOrigins: []string{"http://localhost:3000"},
 				ServiceName:  "",
 			},
@@ -470,6 +911,12 @@ func TestConfig_Validate(t *testing.T) {
 			config: Config{
 				Database:     validDatabaseConfig,
 				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
 				AllowOrigins: []string{"http://localhost:3000", "", "http://localhost:5173"},
 				ServiceName:  "todo-server",
 			},
@@ -481,6 +928,12 @@ func TestConfig_Validate(t *testing.T) {
 			config: Config{
 				Database:     validDatabaseConfig,
 				Auth:         validAuthConfig,
+				Idempotency:  validIdempotencyConfig,
+				Trash:        validTrashConfig,
+				Storage:      validStorageConfig,
+				Webhook:      validWebhookConfig,
+				EventStream:  validEventStreamConfig,
+				WebSocket:    validWebSocketConfig,
 				AllowOrigins: []string{"http://localhost:3000", "   ", "http://localhost:5173"},
 				ServiceName:  "todo-server",
 			},
@@ -524,20 +977,30 @@ func TestLoad(t *testing.T) {
 		{
 			name: "default values",
 			envVars: map[string]string{
-				"DB_HOST":              "",
-				"DB_PORT":              "",
-				"DB_USER":              "",
-				"DB_PASSWORD":          "",
-				"DB_NAME":              "",
-				"JWT_SECRET":           "default-secret",
-				"JWKS_ENDPOINT_URL":    "",
-				"JWKS_CACHE_DURATION":  "",
-				"JWKS_REFRESH_PADDING": "",
-				"JWT_PRIVATE_KEY_FILE": "",
-				"ALLOW_ORIGINS":        "",
-				"SERVICE_NAME":         "",
-				"PORT":                 "",
-				"METRICS_PORT":         "",
+				"DB_HOST":                 "",
+				"DB_PORT":                 "",
+				"DB_USER":                 "",
+				"DB_PASSWORD":             "",
+				"DB_NAME":                 "",
+				"JWT_SECRET":              "default-secret",
+				"JWKS_ENDPOINT_URL":       "",
+				"JWKS_CACHE_DURATION":     "",
+				"JWKS_REFRESH_PADDING":    "",
+				"JWT_PRIVATE_KEY_FILE":    "",
+				"IDEMPOTENCY_KEY_TTL":     "",
+				"TRASH_RETENTION":         "",
+				"STORAGE_BACKEND":         "",
+				"STORAGE_LOCAL_DIR":       "",
+				"ATTACHMENT_MAX_SIZE":     "",
+				"STORAGE_USER_QUOTA":      "",
+				"WEBHOOK_TIMEOUT":         "",
+				"WEBHOOK_MAX_ATTEMPTS":    "",
+				"EVENT_STREAM_LOG_SIZE":   "",
+				"WEBSOCKET_PING_INTERVAL": "",
+				"ALLOW_ORIGINS":           "",
+				"SERVICE_NAME":            "",
+				"PORT":                    "",
+				"METRICS_PORT":            "",
 			},
 			wantErr: false,
 		},
@@ -607,6 +1070,124 @@ func TestLoad(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "negative idempotency key TTL",
+			envVars: map[string]string{
+				"DB_HOST":             "localhost",
+				"DB_PORT":             "5432",
+				"DB_USER":             "user",
+				"DB_PASSWORD":         "password",
+				"DB_NAME":             "dbname",
+				"JWT_SECRET":          "secret",
+				"IDEMPOTENCY_KEY_TTL": "-1",
+				"SERVICE_NAME":        "todo-server",
+				"PORT":                "8080",
+				"METRICS_PORT":        "8081",
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero trash retention",
+			envVars: map[string]string{
+				"DB_HOST":         "localhost",
+				"DB_PORT":         "5432",
+				"DB_USER":         "user",
+				"DB_PASSWORD":     "password",
+				"DB_NAME":         "dbname",
+				"JWT_SECRET":      "secret",
+				"TRASH_RETENTION": "0",
+				"SERVICE_NAME":    "todo-server",
+				"PORT":            "8080",
+				"METRICS_PORT":    "8081",
+			},
+			wantErr: true,
+		},
+		{
+			name: "s3 storage without bucket",
+			envVars: map[string]string{
+				"DB_HOST":              "localhost",
+				"DB_PORT":              "5432",
+				"DB_USER":              "user",
+				"DB_PASSWORD":          "password",
+				"DB_NAME":              "dbname",
+				"JWT_SECRET":           "secret",
+				"STORAGE_BACKEND":      "s3",
+				"S3_ENDPOINT":          "http://localhost:9000",
+				"S3_BUCKET":            "",
+				"S3_ACCESS_KEY_ID":     "access-key",
+				"S3_SECRET_ACCESS_KEY": "secret-key",
+				"SERVICE_NAME":         "todo-server",
+				"PORT":                 "8080",
+				"METRICS_PORT":         "8081",
+			},
+			wantErr: true,
+		},
+		{
+			name: "webhook retry max delay below base delay",
+			envVars: map[string]string{
+				"DB_HOST":                  "localhost",
+				"DB_PORT":                  "5432",
+				"DB_USER":                  "user",
+				"DB_PASSWORD":              "password",
+				"DB_NAME":                  "dbname",
+				"JWT_SECRET":               "secret",
+				"WEBHOOK_RETRY_BASE_DELAY": "60",
+				"WEBHOOK_RETRY_MAX_DELAY":  "30",
+				"SERVICE_NAME":             "todo-server",
+				"PORT":                     "8080",
+				"METRICS_PORT":             "8081",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid webhook allowed network",
+			envVars: map[string]string{
+				"DB_HOST":                  "localhost",
+				"DB_PORT":                  "5432",
+				"DB_USER":                  "user",
+				"DB_PASSWORD":              "password",
+				"DB_NAME":                  "dbname",
+				"JWT_SECRET":               "secret",
+				"WEBHOOK_ALLOWED_NETWORKS": "10.0.0.0/8,internal",
+				"SERVICE_NAME":             "todo-server",
+				"PORT":                     "8080",
+				"METRICS_PORT":             "8081",
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero event stream heartbeat interval",
+			envVars: map[string]string{
+				"DB_HOST":                         "localhost",
+				"DB_PORT":                         "5432",
+				"DB_USER":                         "user",
+				"DB_PASSWORD":                     "password",
+				"DB_NAME":                         "dbname",
+				"JWT_SECRET":                      "secret",
+				"EVENT_STREAM_HEARTBEAT_INTERVAL": "0",
+				"SERVICE_NAME":                    "todo-server",
+				"PORT":                            "8080",
+				"METRICS_PORT":                    "8081",
+			},
+			wantErr: true,
+		},
+		{
+			name: "websocket pong timeout shorter than ping interval",
+			envVars: map[string]string{
+				"DB_HOST":                 "localhost",
+				"DB_PORT":                 "5432",
+				"DB_USER":                 "user",
+				"DB_PASSWORD":             "password",
+				"DB_NAME":                 "dbname",
+				"JWT_SECRET":              "secret",
+				"WEBSOCKET_PING_INTERVAL": "60",
+				"WEBSOCKET_PONG_TIMEOUT":  "30",
+				"SERVICE_NAME":            "todo-server",
+				"PORT":                    "8080",
+				"METRICS_PORT":            "8081",
+			},
+			wantErr: true,
+		},
 		{
 			name: "nonexistent private key file",
 			envVars: map[string]string{
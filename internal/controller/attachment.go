@@ -0,0 +1,232 @@
+package controller
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"golang.org/x/net/context"
+)
+
+// Attachment represents the attachment controller that handles business logic for attachment operations.
+// Anyone who may view a task may download its attachments; attaching files
+// requires the permission to edit the task.
+type Attachment struct {
+	attachmentRepo attachment.AttachmentRepository
+	taskRepo       task.TaskRepository
+	blobStore      attachment.BlobStore
+	limits         attachment.Limits
+}
+
+// NewAttachment creates a new Attachment controller with the provided repositories,
+// the store for the content of attachments and the limits on their size.
+func NewAttachment(attachmentRepo attachment.AttachmentRepository, taskRepo task.TaskRepository, blobStore attachment.BlobStore, limits attachment.Limits) *Attachment {
+	return &Attachment{
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		blobStore:      blobStore,
+		limits:         limits,
+	}
+}
+
+// MaxFileSize returns the size in bytes of the largest file that may be attached.
+func (c *Attachment) MaxFileSize() int64 {
+	return c.limits.MaxFileSize
+}
+
+// GetAttachments retrieves the attachments of a task, oldest first.
+// It returns task.ErrTaskNotFound if the user has no access to the task.
+func (c *Attachment) GetAttachments(ctx context.Context, userID user.UserID, taskID task.TaskID) ([]*attachment.Attachment, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if taskID.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	if _, err := c.authorizeTask(ctx, userID, taskID, task.PermissionView); err != nil {
+		return nil, err
+	}
+
+	attachments, err := c.attachmentRepo.FindAllByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// UploadAttachment attaches a file of the given size to a task on behalf of the user.
+// The content is streamed to the blob store while its checksum is computed, and
+// its type is detected from the first bytes rather than taken from the client.
+// It returns task.ErrTaskNotFound if the user has no access to the task,
+// attachment.ErrFileTooLarge or attachment.ErrQuotaExceeded if the file does
+// not fit within the limits, and attachment.ErrUnsupportedContentType if it
+// is neither an image nor a PDF document.
+func (c *Attachment) UploadAttachment(ctx context.Context, userID user.UserID, taskID task.TaskID, fileName string, size int64, content io.Reader) (*attachment.Attachment, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if taskID.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	if err := c.limits.CheckSize(size); err != nil {
+		return nil, err
+	}
+
+	fileName, err := attachment.NormalizeFileName(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.authorizeTask(ctx, userID, taskID, task.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	// Checked again when the attachment is stored; this only avoids uploading
+	// content that cannot be kept.
+	used, err := c.attachmentRepo.UsageByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.limits.CheckQuota(used, size); err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, attachment.SniffLength)
+
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	contentType, err := attachment.DetectContentType(head[:n])
+	if err != nil {
+		return nil, err
+	}
+
+	id := attachment.GenerateAttachmentID()
+	hash := sha256.New()
+	body := io.TeeReader(io.MultiReader(bytes.NewReader(head[:n]), content), hash)
+
+	if err := c.blobStore.Put(ctx, attachment.KeyFor(id), body, size, contentType); err != nil {
+		return nil, err
+	}
+
+	attachmentEntity, err := attachment.NewAttachment(id, taskID, userID, fileName, contentType, size, hex.EncodeToString(hash.Sum(nil)), time.Now())
+	if err == nil {
+		attachmentEntity, err = c.attachmentRepo.CreateWithinQuota(ctx, attachmentEntity, c.limits.UserQuota)
+	}
+
+	if err != nil {
+		// The content is useless without its metadata. A failure to remove it
+		// only leaves an unreferenced blob behind, so the original error wins.
+		_ = c.blobStore.Delete(ctx, attachment.KeyFor(id))
+
+		return nil, err
+	}
+
+	return attachmentEntity, nil
+}
+
+// DownloadAttachment opens the content of an attachment for reading. The caller must close it.
+// It returns attachment.ErrAttachmentNotFound if the user has no access to the
+// task of the attachment, and attachment.ErrBlobNotFound if its content is missing.
+func (c *Attachment) DownloadAttachment(ctx context.Context, userID user.UserID, id attachment.AttachmentID) (*attachment.Attachment, io.ReadCloser, error) {
+	if userID.IsEmpty() {
+		return nil, nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, nil, attachment.ErrAttachmentIDEmpty
+	}
+
+	attachmentEntity, _, err := c.authorize(ctx, userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := c.blobStore.Get(ctx, attachmentEntity.Key())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachmentEntity, content, nil
+}
+
+// DeleteAttachment removes an attachment and its content, which frees the
+// storage it took from the quota of its uploader.
+// The uploader of the file and the creator of its task may delete it.
+// It returns attachment.ErrAttachmentNotFound if the user has no access to the
+// task of the attachment, and attachment.ErrForbidden if they may not delete it.
+func (c *Attachment) DeleteAttachment(ctx context.Context, userID user.UserID, id attachment.AttachmentID) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return attachment.ErrAttachmentIDEmpty
+	}
+
+	attachmentEntity, taskEntity, err := c.authorize(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if !attachmentEntity.IsUploadedBy(userID) && taskEntity.UserID() != userID {
+		return attachment.ErrForbidden
+	}
+
+	// The content goes first: if removing the metadata fails afterwards, the
+	// attachment can still be deleted again instead of leaking its content.
+	if err := c.blobStore.Delete(ctx, attachmentEntity.Key()); err != nil {
+		return err
+	}
+
+	return c.attachmentRepo.Delete(ctx, id)
+}
+
+// authorizeTask reads the task for the user and checks that they have the permission on it.
+func (c *Attachment) authorizeTask(ctx context.Context, userID user.UserID, taskID task.TaskID, permission task.Permission) (*task.Task, error) {
+	taskEntity, err := c.taskRepo.FindAccessible(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := taskEntity.Authorize(userID, permission); err != nil {
+		return nil, err
+	}
+
+	return taskEntity, nil
+}
+
+// authorize reads the attachment and its task for the user and checks that
+// they may view the task. An attachment of a task the user has no access to is
+// reported as attachment.ErrAttachmentNotFound, so that it stays hidden from them.
+func (c *Attachment) authorize(ctx context.Context, userID user.UserID, id attachment.AttachmentID) (*attachment.Attachment, *task.Task, error) {
+	attachmentEntity, err := c.attachmentRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	taskEntity, err := c.authorizeTask(ctx, userID, attachmentEntity.TaskID(), task.PermissionView)
+	if err != nil {
+		if errors.Is(err, task.ErrTaskNotFound) {
+			return nil, nil, attachment.ErrAttachmentNotFound
+		}
+
+		return nil, nil, err
+	}
+
+	return attachmentEntity, taskEntity, nil
+}
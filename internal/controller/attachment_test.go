@@ -0,0 +1,472 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAttachmentRepository implements attachment.AttachmentRepository for testing
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentRepository) FindById(ctx context.Context, id attachment.AttachmentID) (*attachment.Attachment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*attachment.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) FindAllByTaskID(ctx context.Context, taskID task.TaskID) ([]*attachment.Attachment, error) {
+	args := m.Called(ctx, taskID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*attachment.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) FindDetached(ctx context.Context, limit int) ([]*attachment.Attachment, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*attachment.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) UsageByUserID(ctx context.Context, userID user.UserID) (int64, error) {
+	args := m.Called(ctx, userID)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) CreateWithinQuota(ctx context.Context, attachmentEntity *attachment.Attachment, quota int64) (*attachment.Attachment, error) {
+	args := m.Called(ctx, attachmentEntity, quota)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*attachment.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) Delete(ctx context.Context, id attachment.AttachmentID) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+// MockBlobStore implements attachment.BlobStore for testing. Put drains the
+// content so that the checksum computed while streaming is complete.
+type MockBlobStore struct {
+	mock.Mock
+}
+
+func (m *MockBlobStore) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	if _, err := io.Copy(io.Discard, content); err != nil {
+		return err
+	}
+
+	args := m.Called(ctx, key, size, contentType)
+
+	return args.Error(0)
+}
+
+func (m *MockBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+
+	return args.Error(0)
+}
+
+// pngContent is the start of a PNG image, enough for its type to be detected.
+var pngContent = []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR\x00\x00\x00\x01\x00\x00\x00\x01")
+
+func TestNewAttachment(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	mockAttachmentRepo := &MockAttachmentRepository{}
+	mockTaskRepo := &MockTaskRepository{}
+	mockBlobStore := &MockBlobStore{}
+	limits := attachment.Limits{MaxFileSize: 1024, UserQuota: 4096}
+
+	// Act
+	controller := NewAttachment(mockAttachmentRepo, mockTaskRepo, mockBlobStore, limits)
+
+	// Assert
+	assert.NotNil(t, controller)
+	assert.Equal(t, mockAttachmentRepo, controller.attachmentRepo)
+	assert.Equal(t, mockTaskRepo, controller.taskRepo)
+	assert.Equal(t, mockBlobStore, controller.blobStore)
+	assert.Equal(t, int64(1024), controller.MaxFileSize())
+}
+
+func TestAttachmentController_GetAttachments(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	viewerID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	viewerRole := task.RoleViewer
+
+	attachments := []*attachment.Attachment{
+		attachment.NewAttachmentWithoutValidation(attachment.GenerateAttachmentID(), taskID, creatorID,
+			"screenshot.png", "image/png", int64(len(pngContent)), "checksum", time.Now()),
+	}
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		accessible    *task.Task
+		accessError   error
+		expectedError error
+	}{
+		{
+			name:          "viewer lists the attachments",
+			userID:        viewerID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&viewerRole)),
+			accessError:   nil,
+			expectedError: nil,
+		},
+		{
+			name:          "task not accessible",
+			userID:        viewerID,
+			accessible:    nil,
+			accessError:   task.ErrTaskNotFound,
+			expectedError: task.ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockAttachmentRepo := &MockAttachmentRepository{}
+			mockTaskRepo := &MockTaskRepository{}
+			controller := NewAttachment(mockAttachmentRepo, mockTaskRepo, &MockBlobStore{}, attachment.Limits{MaxFileSize: 1024, UserQuota: 4096})
+			ctx := context.Background()
+
+			mockTaskRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.accessible, tt.accessError)
+
+			if tt.expectedError == nil {
+				mockAttachmentRepo.On("FindAllByTaskID", ctx, taskID).Return(attachments, nil)
+			}
+
+			// Act
+			result, err := controller.GetAttachments(ctx, tt.userID, taskID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, attachments, result)
+			}
+
+			mockTaskRepo.AssertExpectations(t)
+			mockAttachmentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAttachmentController_UploadAttachment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	viewerID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	viewerRole := task.RoleViewer
+	size := int64(len(pngContent))
+	checksum := sha256.Sum256(pngContent)
+	limits := attachment.Limits{MaxFileSize: 1024, UserQuota: 4096}
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		content       []byte
+		size          int64
+		setupMocks    func(*MockAttachmentRepository, *MockTaskRepository, *MockBlobStore)
+		expectedError error
+	}{
+		{
+			name:    "creator attaches an image",
+			userID:  creatorID,
+			content: pngContent,
+			size:    size,
+			setupMocks: func(attachmentRepo *MockAttachmentRepository, taskRepo *MockTaskRepository, blobStore *MockBlobStore) {
+				taskRepo.On("FindAccessible", mock.Anything, creatorID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				attachmentRepo.On("UsageByUserID", mock.Anything, creatorID).Return(int64(1000), nil)
+				blobStore.On("Put", mock.Anything, mock.AnythingOfType("string"), size, "image/png").Return(nil)
+				attachmentRepo.On("CreateWithinQuota", mock.Anything, mock.AnythingOfType("*attachment.Attachment"), int64(4096)).
+					Return(attachment.NewAttachmentWithoutValidation(attachment.GenerateAttachmentID(), taskID, creatorID,
+						"screenshot.png", "image/png", size, hex.EncodeToString(checksum[:]), time.Now()), nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "viewer may not attach files",
+			userID:  viewerID,
+			content: pngContent,
+			size:    size,
+			setupMocks: func(_ *MockAttachmentRepository, taskRepo *MockTaskRepository, _ *MockBlobStore) {
+				taskRepo.On("FindAccessible", mock.Anything, viewerID, taskID).
+					Return(task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&viewerRole)), nil)
+			},
+			expectedError: task.ErrForbidden,
+		},
+		{
+			name:          "file too large",
+			userID:        creatorID,
+			content:       pngContent,
+			size:          2048,
+			setupMocks:    func(_ *MockAttachmentRepository, _ *MockTaskRepository, _ *MockBlobStore) {},
+			expectedError: attachment.ErrFileTooLarge,
+		},
+		{
+			name:    "quota exceeded before uploading",
+			userID:  creatorID,
+			content: pngContent,
+			size:    size,
+			setupMocks: func(attachmentRepo *MockAttachmentRepository, taskRepo *MockTaskRepository, _ *MockBlobStore) {
+				taskRepo.On("FindAccessible", mock.Anything, creatorID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				attachmentRepo.On("UsageByUserID", mock.Anything, creatorID).Return(int64(4090), nil)
+			},
+			expectedError: attachment.ErrQuotaExceeded,
+		},
+		{
+			name:    "unsupported content type",
+			userID:  creatorID,
+			content: []byte("<html><script>alert(1)</script></html>"),
+			size:    38,
+			setupMocks: func(attachmentRepo *MockAttachmentRepository, taskRepo *MockTaskRepository, _ *MockBlobStore) {
+				taskRepo.On("FindAccessible", mock.Anything, creatorID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				attachmentRepo.On("UsageByUserID", mock.Anything, creatorID).Return(int64(0), nil)
+			},
+			expectedError: attachment.ErrUnsupportedContentType,
+		},
+		{
+			name:    "quota exceeded by a concurrent upload removes the content",
+			userID:  creatorID,
+			content: pngContent,
+			size:    size,
+			setupMocks: func(attachmentRepo *MockAttachmentRepository, taskRepo *MockTaskRepository, blobStore *MockBlobStore) {
+				taskRepo.On("FindAccessible", mock.Anything, creatorID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				attachmentRepo.On("UsageByUserID", mock.Anything, creatorID).Return(int64(1000), nil)
+				blobStore.On("Put", mock.Anything, mock.AnythingOfType("string"), size, "image/png").Return(nil)
+				attachmentRepo.On("CreateWithinQuota", mock.Anything, mock.AnythingOfType("*attachment.Attachment"), int64(4096)).
+					Return(nil, attachment.ErrQuotaExceeded)
+				blobStore.On("Delete", mock.Anything, mock.AnythingOfType("string")).Return(nil)
+			},
+			expectedError: attachment.ErrQuotaExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockAttachmentRepo := &MockAttachmentRepository{}
+			mockTaskRepo := &MockTaskRepository{}
+			mockBlobStore := &MockBlobStore{}
+			controller := NewAttachment(mockAttachmentRepo, mockTaskRepo, mockBlobStore, limits)
+
+			tt.setupMocks(mockAttachmentRepo, mockTaskRepo, mockBlobStore)
+
+			// Act
+			result, err := controller.UploadAttachment(context.Background(), tt.userID, taskID, "screenshot.png", tt.size, bytes.NewReader(tt.content))
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "image/png", result.ContentType())
+
+				created := mockAttachmentRepo.Calls[1].Arguments.Get(1).(*attachment.Attachment)
+				assert.Equal(t, hex.EncodeToString(checksum[:]), created.Checksum())
+				assert.Equal(t, "screenshot.png", created.FileName())
+				assert.Equal(t, size, created.Size())
+				mockBlobStore.AssertCalled(t, "Put", mock.Anything, created.Key(), size, "image/png")
+			}
+
+			mockTaskRepo.AssertExpectations(t)
+			mockAttachmentRepo.AssertExpectations(t)
+			mockBlobStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAttachmentController_DownloadAttachment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	strangerID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	attachmentEntity := attachment.NewAttachmentWithoutValidation(attachment.GenerateAttachmentID(), taskID, creatorID,
+		"screenshot.png", "image/png", int64(len(pngContent)), "checksum", time.Now())
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		accessible    *task.Task
+		accessError   error
+		expectedError error
+	}{
+		{
+			name:          "creator downloads the content",
+			userID:        creatorID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID),
+			accessError:   nil,
+			expectedError: nil,
+		},
+		{
+			name:          "attachment of an inaccessible task is hidden",
+			userID:        strangerID,
+			accessible:    nil,
+			accessError:   task.ErrTaskNotFound,
+			expectedError: attachment.ErrAttachmentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockAttachmentRepo := &MockAttachmentRepository{}
+			mockTaskRepo := &MockTaskRepository{}
+			mockBlobStore := &MockBlobStore{}
+			controller := NewAttachment(mockAttachmentRepo, mockTaskRepo, mockBlobStore, attachment.Limits{MaxFileSize: 1024, UserQuota: 4096})
+			ctx := context.Background()
+
+			mockAttachmentRepo.On("FindById", ctx, attachmentEntity.ID()).Return(attachmentEntity, nil)
+			mockTaskRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.accessible, tt.accessError)
+
+			if tt.expectedError == nil {
+				mockBlobStore.On("Get", ctx, attachmentEntity.Key()).Return(io.NopCloser(bytes.NewReader(pngContent)), nil)
+			}
+
+			// Act
+			result, content, err := controller.DownloadAttachment(ctx, tt.userID, attachmentEntity.ID())
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				assert.Nil(t, content)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, attachmentEntity, result)
+
+				data, err := io.ReadAll(content)
+				require.NoError(t, err)
+				assert.Equal(t, pngContent, data)
+			}
+
+			mockAttachmentRepo.AssertExpectations(t)
+			mockTaskRepo.AssertExpectations(t)
+			mockBlobStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAttachmentController_DeleteAttachment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	uploaderID := user.GenerateUserID()
+	editorID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	editorRole := task.RoleEditor
+	attachmentEntity := attachment.NewAttachmentWithoutValidation(attachment.GenerateAttachmentID(), taskID, uploaderID,
+		"invoice.pdf", "application/pdf", 2048, strings.Repeat("0", 64), time.Now())
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		accessible    *task.Task
+		expectedError error
+	}{
+		{
+			name:          "uploader deletes the attachment",
+			userID:        uploaderID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&editorRole)),
+			expectedError: nil,
+		},
+		{
+			name:          "task creator deletes the attachment",
+			userID:        creatorID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID),
+			expectedError: nil,
+		},
+		{
+			name:          "another editor may not delete it",
+			userID:        editorID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&editorRole)),
+			expectedError: attachment.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockAttachmentRepo := &MockAttachmentRepository{}
+			mockTaskRepo := &MockTaskRepository{}
+			mockBlobStore := &MockBlobStore{}
+			controller := NewAttachment(mockAttachmentRepo, mockTaskRepo, mockBlobStore, attachment.Limits{MaxFileSize: 1024, UserQuota: 4096})
+			ctx := context.Background()
+
+			mockAttachmentRepo.On("FindById", ctx, attachmentEntity.ID()).Return(attachmentEntity, nil)
+			mockTaskRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.accessible, nil)
+
+			if tt.expectedError == nil {
+				mockBlobStore.On("Delete", ctx, attachmentEntity.Key()).Return(nil)
+				mockAttachmentRepo.On("Delete", ctx, attachmentEntity.ID()).Return(nil)
+			}
+
+			// Act
+			err := controller.DeleteAttachment(ctx, tt.userID, attachmentEntity.ID())
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mockAttachmentRepo.AssertExpectations(t)
+			mockTaskRepo.AssertExpectations(t)
+			mockBlobStore.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"errors"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/comment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"golang.org/x/net/context"
+)
+
+// Comment represents the comment controller that handles business logic for comment operations.
+// Access to comments follows access to their task: anyone who may view a task
+// may read and write comments on it.
+type Comment struct {
+	commentRepo comment.CommentRepository
+	taskRepo    task.TaskRepository
+}
+
+// NewComment creates a new Comment controller with the provided repositories.
+func NewComment(commentRepo comment.CommentRepository, taskRepo task.TaskRepository) *Comment {
+	return &Comment{
+		commentRepo: commentRepo,
+		taskRepo:    taskRepo,
+	}
+}
+
+// GetComments retrieves a page of the comments on a task, oldest first.
+// Deleted comments are included without their body.
+// It returns task.ErrTaskNotFound if the user has no access to the task.
+func (c *Comment) GetComments(ctx context.Context, userID user.UserID, taskID task.TaskID, page comment.PageRequest) (comment.Page, error) {
+	if userID.IsEmpty() {
+		return comment.Page{}, user.ErrUserIDEmpty
+	}
+
+	if taskID.IsEmpty() {
+		return comment.Page{}, task.ErrTaskIDEmpty
+	}
+
+	if _, err := c.authorizeTask(ctx, userID, taskID); err != nil {
+		return comment.Page{}, err
+	}
+
+	commentPage, err := c.commentRepo.FindPageByTaskID(ctx, taskID, page)
+	if err != nil {
+		return comment.Page{}, err
+	}
+
+	return commentPage, nil
+}
+
+// CreateComment writes a comment by the given user on a task.
+// It returns task.ErrTaskNotFound if the user has no access to the task.
+func (c *Comment) CreateComment(ctx context.Context, userID user.UserID, taskID task.TaskID, body string) (*comment.Comment, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if taskID.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	commentEntity, err := comment.NewComment(comment.GenerateCommentID(), taskID, userID, body, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.authorizeTask(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+
+	commentItem, err := c.commentRepo.Create(ctx, commentEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return commentItem, nil
+}
+
+// UpdateComment replaces the body of a comment. Only its author may edit it.
+// It returns comment.ErrCommentNotFound if the user has no access to the task
+// of the comment, and comment.ErrForbidden if they did not write it.
+func (c *Comment) UpdateComment(ctx context.Context, userID user.UserID, id comment.CommentID, body string) (*comment.Comment, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, comment.ErrCommentIDEmpty
+	}
+
+	commentEntity, _, err := c.authorize(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !commentEntity.IsWrittenBy(userID) {
+		return nil, comment.ErrForbidden
+	}
+
+	if err := commentEntity.Edit(body, time.Now()); err != nil {
+		return nil, err
+	}
+
+	commentItem, err := c.commentRepo.Update(ctx, commentEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return commentItem, nil
+}
+
+// DeleteComment removes the body of a comment and marks it as deleted.
+// The author of the comment and the creator of its task may delete it.
+// It returns comment.ErrCommentNotFound if the user has no access to the task
+// of the comment, and comment.ErrForbidden if they may not delete it.
+func (c *Comment) DeleteComment(ctx context.Context, userID user.UserID, id comment.CommentID) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return comment.ErrCommentIDEmpty
+	}
+
+	commentEntity, taskEntity, err := c.authorize(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if !commentEntity.IsWrittenBy(userID) && taskEntity.UserID() != userID {
+		return comment.ErrForbidden
+	}
+
+	if err := commentEntity.Delete(time.Now()); err != nil {
+		return err
+	}
+
+	if _, err := c.commentRepo.Update(ctx, commentEntity); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// authorizeTask reads the task for the user and checks that they may view it.
+func (c *Comment) authorizeTask(ctx context.Context, userID user.UserID, taskID task.TaskID) (*task.Task, error) {
+	taskEntity, err := c.taskRepo.FindAccessible(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := taskEntity.Authorize(userID, task.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return taskEntity, nil
+}
+
+// authorize reads the comment and its task for the user and checks that they
+// may view the task. A comment on a task the user has no access to is
+// reported as comment.ErrCommentNotFound, so that it stays hidden from them.
+func (c *Comment) authorize(ctx context.Context, userID user.UserID, id comment.CommentID) (*comment.Comment, *task.Task, error) {
+	commentEntity, err := c.commentRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	taskEntity, err := c.authorizeTask(ctx, userID, commentEntity.TaskID())
+	if err != nil {
+		if errors.Is(err, task.ErrTaskNotFound) {
+			return nil, nil, comment.ErrCommentNotFound
+		}
+
+		return nil, nil, err
+	}
+
+	return commentEntity, taskEntity, nil
+}
@@ -0,0 +1,417 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/comment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCommentRepository implements comment.CommentRepository for testing
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+func (m *MockCommentRepository) FindById(ctx context.Context, id comment.CommentID) (*comment.Comment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*comment.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindPageByTaskID(ctx context.Context, taskID task.TaskID, page comment.PageRequest) (comment.Page, error) {
+	args := m.Called(ctx, taskID, page)
+
+	return args.Get(0).(comment.Page), args.Error(1)
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, commentEntity *comment.Comment) (*comment.Comment, error) {
+	args := m.Called(ctx, commentEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*comment.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) Update(ctx context.Context, commentEntity *comment.Comment) (*comment.Comment, error) {
+	args := m.Called(ctx, commentEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*comment.Comment), args.Error(1)
+}
+
+func TestNewComment(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	mockCommentRepo := &MockCommentRepository{}
+	mockTaskRepo := &MockTaskRepository{}
+
+	// Act
+	controller := NewComment(mockCommentRepo, mockTaskRepo)
+
+	// Assert
+	assert.NotNil(t, controller)
+	assert.Equal(t, mockCommentRepo, controller.commentRepo)
+	assert.Equal(t, mockTaskRepo, controller.taskRepo)
+}
+
+func TestCommentController_GetComments(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	viewerID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	viewerRole := task.RoleViewer
+
+	pageRequest, err := comment.NewPageRequest(2, "")
+	require.NoError(t, err)
+
+	commentPage := comment.Page{
+		Comments: []*comment.Comment{
+			comment.NewCommentWithoutValidation(comment.GenerateCommentID(), taskID, creatorID, "First", time.Now()),
+		},
+		NextCursor: "next",
+	}
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		accessible    *task.Task
+		accessError   error
+		expectedError error
+	}{
+		{
+			name:          "creator reads the comments",
+			userID:        creatorID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID),
+			accessError:   nil,
+			expectedError: nil,
+		},
+		{
+			name:          "viewer reads the comments",
+			userID:        viewerID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&viewerRole)),
+			accessError:   nil,
+			expectedError: nil,
+		},
+		{
+			name:          "task not accessible",
+			userID:        viewerID,
+			accessible:    nil,
+			accessError:   task.ErrTaskNotFound,
+			expectedError: task.ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockCommentRepo := &MockCommentRepository{}
+			mockTaskRepo := &MockTaskRepository{}
+			controller := NewComment(mockCommentRepo, mockTaskRepo)
+			ctx := context.Background()
+
+			mockTaskRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.accessible, tt.accessError)
+
+			if tt.expectedError == nil {
+				mockCommentRepo.On("FindPageByTaskID", ctx, taskID, pageRequest).Return(commentPage, nil)
+			}
+
+			// Act
+			result, err := controller.GetComments(ctx, tt.userID, taskID, pageRequest)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, commentPage, result)
+			}
+
+			mockTaskRepo.AssertExpectations(t)
+			mockCommentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentController_CreateComment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	viewerID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	viewerRole := task.RoleViewer
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		body          string
+		accessible    *task.Task
+		accessError   error
+		expectedError error
+	}{
+		{
+			name:          "viewer comments on a shared task",
+			userID:        viewerID,
+			body:          " Looks good ",
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&viewerRole)),
+			accessError:   nil,
+			expectedError: nil,
+		},
+		{
+			name:          "empty body should fail validation",
+			userID:        creatorID,
+			body:          " ",
+			accessible:    nil,
+			accessError:   nil,
+			expectedError: comment.ErrBodyEmpty,
+		},
+		{
+			name:          "task not accessible",
+			userID:        viewerID,
+			body:          "Looks good",
+			accessible:    nil,
+			accessError:   task.ErrTaskNotFound,
+			expectedError: task.ErrTaskNotFound,
+		},
+		{
+			name:          "empty user ID",
+			userID:        user.UserID{},
+			body:          "Looks good",
+			accessible:    nil,
+			accessError:   nil,
+			expectedError: user.ErrUserIDEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockCommentRepo := &MockCommentRepository{}
+			mockTaskRepo := &MockTaskRepository{}
+			controller := NewComment(mockCommentRepo, mockTaskRepo)
+			ctx := context.Background()
+
+			if tt.accessible != nil || tt.accessError != nil {
+				mockTaskRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.accessible, tt.accessError)
+			}
+
+			if tt.expectedError == nil {
+				mockCommentRepo.On("Create", ctx, mock.MatchedBy(func(commentEntity *comment.Comment) bool {
+					return commentEntity.TaskID() == taskID && commentEntity.IsWrittenBy(tt.userID) && commentEntity.Body() == "Looks good"
+				})).Return(comment.NewCommentWithoutValidation(comment.GenerateCommentID(), taskID, tt.userID, "Looks good", time.Now()), nil)
+			}
+
+			// Act
+			result, err := controller.CreateComment(ctx, tt.userID, taskID, tt.body)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "Looks good", result.Body())
+			}
+
+			mockTaskRepo.AssertExpectations(t)
+			mockCommentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentController_UpdateComment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	authorID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	commentID := comment.GenerateCommentID()
+	viewerRole := task.RoleViewer
+	deletedAt := time.Now()
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		deleted       bool
+		accessible    *task.Task
+		accessError   error
+		expectedError error
+	}{
+		{
+			name:          "author edits the comment",
+			userID:        authorID,
+			deleted:       false,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&viewerRole)),
+			accessError:   nil,
+			expectedError: nil,
+		},
+		{
+			name:          "task creator cannot edit another user's comment",
+			userID:        creatorID,
+			deleted:       false,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID),
+			accessError:   nil,
+			expectedError: comment.ErrForbidden,
+		},
+		{
+			name:          "deleted comment cannot be edited",
+			userID:        authorID,
+			deleted:       true,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&viewerRole)),
+			accessError:   nil,
+			expectedError: comment.ErrCommentDeleted,
+		},
+		{
+			name:          "author who lost access to the task",
+			userID:        authorID,
+			deleted:       false,
+			accessible:    nil,
+			accessError:   task.ErrTaskNotFound,
+			expectedError: comment.ErrCommentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockCommentRepo := &MockCommentRepository{}
+			mockTaskRepo := &MockTaskRepository{}
+			controller := NewComment(mockCommentRepo, mockTaskRepo)
+			ctx := context.Background()
+
+			var opts []comment.RestoreOption
+			if tt.deleted {
+				opts = append(opts, comment.WithDeletedAt(&deletedAt))
+			}
+
+			stored := comment.NewCommentWithoutValidation(commentID, taskID, authorID, "Done", time.Now(), opts...)
+			mockCommentRepo.On("FindById", ctx, commentID).Return(stored, nil)
+			mockTaskRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.accessible, tt.accessError)
+
+			if tt.expectedError == nil {
+				mockCommentRepo.On("Update", ctx, mock.MatchedBy(func(commentEntity *comment.Comment) bool {
+					return commentEntity.Body() == "Done now" && commentEntity.IsEdited()
+				})).Return(stored, nil)
+			}
+
+			// Act
+			result, err := controller.UpdateComment(ctx, tt.userID, commentID, "Done now")
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, result.IsEdited())
+			}
+
+			mockTaskRepo.AssertExpectations(t)
+			mockCommentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentController_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	authorID := user.GenerateUserID()
+	otherID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	commentID := comment.GenerateCommentID()
+	editorRole := task.RoleEditor
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		accessible    *task.Task
+		repoError     error
+		expectedError error
+	}{
+		{
+			name:          "author deletes the comment",
+			userID:        authorID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&editorRole)),
+			repoError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "task creator deletes another user's comment",
+			userID:        creatorID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID),
+			repoError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "other collaborator cannot delete the comment",
+			userID:        otherID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&editorRole)),
+			repoError:     nil,
+			expectedError: comment.ErrForbidden,
+		},
+		{
+			name:          "repository error",
+			userID:        authorID,
+			accessible:    task.NewTaskWithoutValidation(taskID, "Report", creatorID, task.WithSharedRole(&editorRole)),
+			repoError:     errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockCommentRepo := &MockCommentRepository{}
+			mockTaskRepo := &MockTaskRepository{}
+			controller := NewComment(mockCommentRepo, mockTaskRepo)
+			ctx := context.Background()
+
+			stored := comment.NewCommentWithoutValidation(commentID, taskID, authorID, "Done", time.Now())
+			mockCommentRepo.On("FindById", ctx, commentID).Return(stored, nil)
+			mockTaskRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.accessible, nil)
+
+			if !errors.Is(tt.expectedError, comment.ErrForbidden) {
+				mockCommentRepo.On("Update", ctx, mock.MatchedBy(func(commentEntity *comment.Comment) bool {
+					return commentEntity.IsDeleted() && commentEntity.Body() == ""
+				})).Return(stored, tt.repoError)
+			}
+
+			// Act
+			err := controller.DeleteComment(ctx, tt.userID, commentID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockTaskRepo.AssertExpectations(t)
+			mockCommentRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"golang.org/x/net/context"
+)
+
+// Project represents the project controller that handles business logic for project operations.
+type Project struct {
+	projectRepo project.ProjectRepository
+	taskRepo    task.TaskRepository
+}
+
+// NewProject creates a new Project controller with the provided repositories.
+func NewProject(projectRepo project.ProjectRepository, taskRepo task.TaskRepository) *Project {
+	return &Project{
+		projectRepo: projectRepo,
+		taskRepo:    taskRepo,
+	}
+}
+
+// GetAllProjects retrieves all projects of the given user ordered by name.
+// It returns an empty slice if the user has no projects.
+func (p *Project) GetAllProjects(ctx context.Context, userID user.UserID) ([]*project.Project, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	projects, err := p.projectRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// GetProjectById retrieves a specific project by its ID for the given user.
+func (p *Project) GetProjectById(ctx context.Context, userID user.UserID, id project.ProjectID) (*project.Project, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, project.ErrProjectIDEmpty
+	}
+
+	projectItem, err := p.projectRepo.FindById(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectItem, nil
+}
+
+// GetProjectTasks retrieves the tasks of a project of the given user.
+// It returns project.ErrProjectNotFound if the user has no such project.
+func (p *Project) GetProjectTasks(ctx context.Context, userID user.UserID, id project.ProjectID) ([]*task.Task, error) {
+	if _, err := p.GetProjectById(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	tasks, err := p.taskRepo.FindAllByUserID(ctx, userID, task.Filter{ProjectID: &id})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// CreateProject creates a new project with the provided name for the given user.
+func (p *Project) CreateProject(ctx context.Context, userID user.UserID, name string) (*project.Project, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	projectEntity, err := project.NewProject(project.GenerateProjectID(), name, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectItem, err := p.projectRepo.Create(ctx, projectEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectItem, nil
+}
+
+// RenameProject changes the name of a project of the given user.
+func (p *Project) RenameProject(ctx context.Context, userID user.UserID, id project.ProjectID, name string) (*project.Project, error) {
+	projectEntity, err := p.GetProjectById(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := projectEntity.Rename(name); err != nil {
+		return nil, err
+	}
+
+	projectItem, err := p.projectRepo.Update(ctx, projectEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectItem, nil
+}
+
+// DeleteProject removes a project of the given user. Its tasks are moved to
+// the inbox or, with project.DeleteTasks, to the trash together with their subtasks.
+func (p *Project) DeleteProject(ctx context.Context, userID user.UserID, id project.ProjectID, disposal project.TaskDisposal) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return project.ErrProjectIDEmpty
+	}
+
+	return p.projectRepo.Delete(ctx, userID, id, disposal)
+}
@@ -0,0 +1,412 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProjectRepository implements project.ProjectRepository for testing
+type MockProjectRepository struct {
+	mock.Mock
+}
+
+func (m *MockProjectRepository) FindById(ctx context.Context, ownerID user.UserID, id project.ProjectID) (*project.Project, error) {
+	args := m.Called(ctx, ownerID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*project.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*project.Project, error) {
+	args := m.Called(ctx, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*project.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) Create(ctx context.Context, projectEntity *project.Project) (*project.Project, error) {
+	args := m.Called(ctx, projectEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*project.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) Update(ctx context.Context, projectEntity *project.Project) (*project.Project, error) {
+	args := m.Called(ctx, projectEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*project.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) Delete(ctx context.Context, ownerID user.UserID, id project.ProjectID, disposal project.TaskDisposal) error {
+	args := m.Called(ctx, ownerID, id, disposal)
+
+	return args.Error(0)
+}
+
+func TestNewProject(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	mockRepo := &MockProjectRepository{}
+	mockTaskRepo := &MockTaskRepository{}
+
+	// Act
+	controller := NewProject(mockRepo, mockTaskRepo)
+
+	// Assert
+	assert.NotNil(t, controller)
+	assert.Equal(t, mockRepo, controller.projectRepo)
+	assert.Equal(t, mockTaskRepo, controller.taskRepo)
+}
+
+func TestProjectController_GetAllProjects(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	projects := []*project.Project{
+		project.NewProjectWithoutValidation(project.GenerateProjectID(), "Home", testUserID),
+		project.NewProjectWithoutValidation(project.GenerateProjectID(), "Work", testUserID),
+	}
+
+	tests := []struct {
+		name             string
+		userID           user.UserID
+		mockReturn       []*project.Project
+		mockError        error
+		expectedProjects []*project.Project
+		expectedError    error
+	}{
+		{
+			name:             "successful retrieval",
+			userID:           testUserID,
+			mockReturn:       projects,
+			mockError:        nil,
+			expectedProjects: projects,
+			expectedError:    nil,
+		},
+		{
+			name:             "empty user ID",
+			userID:           user.UserID{},
+			mockReturn:       nil,
+			mockError:        nil,
+			expectedProjects: nil,
+			expectedError:    user.ErrUserIDEmpty,
+		},
+		{
+			name:             "repository error",
+			userID:           testUserID,
+			mockReturn:       nil,
+			mockError:        errors.New("database error"),
+			expectedProjects: nil,
+			expectedError:    errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockProjectRepository{}
+			controller := NewProject(mockRepo, &MockTaskRepository{})
+			ctx := context.Background()
+
+			if !tt.userID.IsEmpty() {
+				mockRepo.On("FindAllByUserID", ctx, tt.userID).Return(tt.mockReturn, tt.mockError)
+			}
+
+			// Act
+			result, err := controller.GetAllProjects(ctx, tt.userID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedProjects, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectController_CreateProject(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+
+	tests := []struct {
+		name          string
+		projectName   string
+		callsRepo     bool
+		expectedName  string
+		expectedError error
+	}{
+		{
+			name:          "successful creation normalizes the name",
+			projectName:   "  Work ",
+			callsRepo:     true,
+			expectedName:  "Work",
+			expectedError: nil,
+		},
+		{
+			name:          "empty name should fail validation",
+			projectName:   " ",
+			callsRepo:     false,
+			expectedName:  "",
+			expectedError: project.ErrNameEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockProjectRepository{}
+			controller := NewProject(mockRepo, &MockTaskRepository{})
+			ctx := context.Background()
+
+			if tt.callsRepo {
+				mockRepo.On("Create", ctx, mock.MatchedBy(func(projectEntity *project.Project) bool {
+					return projectEntity.UserID() == testUserID && projectEntity.Name() == tt.expectedName
+				})).Return(project.NewProjectWithoutValidation(project.GenerateProjectID(), tt.expectedName, testUserID), nil)
+			}
+
+			// Act
+			result, err := controller.CreateProject(ctx, testUserID, tt.projectName)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedName, result.Name())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectController_RenameProject(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testProjectID := project.GenerateProjectID()
+
+	tests := []struct {
+		name          string
+		projectName   string
+		findError     error
+		callsUpdate   bool
+		expectedError error
+	}{
+		{
+			name:          "successful rename",
+			projectName:   "Office",
+			findError:     nil,
+			callsUpdate:   true,
+			expectedError: nil,
+		},
+		{
+			name:          "project not found",
+			projectName:   "Office",
+			findError:     project.ErrProjectNotFound,
+			callsUpdate:   false,
+			expectedError: project.ErrProjectNotFound,
+		},
+		{
+			name:          "empty name should fail validation",
+			projectName:   "",
+			findError:     nil,
+			callsUpdate:   false,
+			expectedError: project.ErrNameEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockProjectRepository{}
+			controller := NewProject(mockRepo, &MockTaskRepository{})
+			ctx := context.Background()
+
+			if tt.findError != nil {
+				mockRepo.On("FindById", ctx, testUserID, testProjectID).Return(nil, tt.findError)
+			} else {
+				mockRepo.On("FindById", ctx, testUserID, testProjectID).
+					Return(project.NewProjectWithoutValidation(testProjectID, "Work", testUserID), nil)
+			}
+
+			if tt.callsUpdate {
+				mockRepo.On("Update", ctx, mock.MatchedBy(func(projectEntity *project.Project) bool {
+					return projectEntity.Name() == tt.projectName
+				})).Return(project.NewProjectWithoutValidation(testProjectID, tt.projectName, testUserID), nil)
+			}
+
+			// Act
+			result, err := controller.RenameProject(ctx, testUserID, testProjectID, tt.projectName)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.projectName, result.Name())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectController_GetProjectTasks(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testProjectID := project.GenerateProjectID()
+
+	t.Run("tasks of the project are listed", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockProjectRepository{}
+		mockTaskRepo := &MockTaskRepository{}
+		controller := NewProject(mockRepo, mockTaskRepo)
+		ctx := context.Background()
+
+		tasks := []*task.Task{task.NewTaskWithoutValidation(task.GenerateTaskID(), "Task", testUserID)}
+
+		mockRepo.On("FindById", ctx, testUserID, testProjectID).
+			Return(project.NewProjectWithoutValidation(testProjectID, "Work", testUserID), nil)
+		mockTaskRepo.On("FindAllByUserID", ctx, testUserID, mock.MatchedBy(func(filter task.Filter) bool {
+			return filter.ProjectID != nil && *filter.ProjectID == testProjectID
+		})).Return(tasks, nil)
+
+		// Act
+		result, err := controller.GetProjectTasks(ctx, testUserID, testProjectID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, tasks, result)
+		mockRepo.AssertExpectations(t)
+		mockTaskRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown project should fail", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockProjectRepository{}
+		mockTaskRepo := &MockTaskRepository{}
+		controller := NewProject(mockRepo, mockTaskRepo)
+		ctx := context.Background()
+
+		mockRepo.On("FindById", ctx, testUserID, testProjectID).Return(nil, project.ErrProjectNotFound)
+
+		// Act
+		result, err := controller.GetProjectTasks(ctx, testUserID, testProjectID)
+
+		// Assert
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+		assert.Nil(t, result)
+		mockTaskRepo.AssertNotCalled(t, "FindAllByUserID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestProjectController_DeleteProject(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testProjectID := project.GenerateProjectID()
+
+	tests := []struct {
+		name          string
+		projectID     project.ProjectID
+		disposal      project.TaskDisposal
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:          "tasks moved to the inbox",
+			projectID:     testProjectID,
+			disposal:      project.MoveTasksToInbox,
+			mockError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "tasks deleted",
+			projectID:     testProjectID,
+			disposal:      project.DeleteTasks,
+			mockError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "project not found",
+			projectID:     testProjectID,
+			disposal:      project.MoveTasksToInbox,
+			mockError:     project.ErrProjectNotFound,
+			expectedError: project.ErrProjectNotFound,
+		},
+		{
+			name:          "empty project ID",
+			projectID:     project.ProjectID{},
+			disposal:      project.MoveTasksToInbox,
+			mockError:     nil,
+			expectedError: project.ErrProjectIDEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockProjectRepository{}
+			controller := NewProject(mockRepo, &MockTaskRepository{})
+			ctx := context.Background()
+
+			if !tt.projectID.IsEmpty() {
+				mockRepo.On("Delete", ctx, testUserID, tt.projectID, tt.disposal).Return(tt.mockError)
+			}
+
+			// Act
+			err := controller.DeleteProject(ctx, testUserID, tt.projectID, tt.disposal)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
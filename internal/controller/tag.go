@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"golang.org/x/net/context"
+)
+
+// Tag represents the tag controller that handles business logic for tag operations.
+type Tag struct {
+	tagRepo tag.TagRepository
+}
+
+// NewTag creates a new Tag controller with the provided repository.
+func NewTag(tagRepo tag.TagRepository) *Tag {
+	return &Tag{
+		tagRepo: tagRepo,
+	}
+}
+
+// GetAllTags retrieves all tags of the given user ordered by name.
+// It returns an empty slice if the user has no tags.
+func (t *Tag) GetAllTags(ctx context.Context, userID user.UserID) ([]*tag.Tag, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	tags, err := t.tagRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// GetTagById retrieves a specific tag by its ID for the given user.
+func (t *Tag) GetTagById(ctx context.Context, userID user.UserID, id tag.TagID) (*tag.Tag, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, tag.ErrTagIDEmpty
+	}
+
+	tagItem, err := t.tagRepo.FindById(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return tagItem, nil
+}
+
+// CreateTag creates a new tag with the provided name for the given user.
+// It returns tag.ErrNameTaken if the user already has a tag with that name.
+func (t *Tag) CreateTag(ctx context.Context, userID user.UserID, name string) (*tag.Tag, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	tagEntity, err := tag.NewTag(tag.GenerateTagID(), name, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tagItem, err := t.tagRepo.Create(ctx, tagEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return tagItem, nil
+}
+
+// RenameTag changes the name of a tag of the given user.
+// It returns tag.ErrNameTaken if the user already has another tag with that name.
+func (t *Tag) RenameTag(ctx context.Context, userID user.UserID, id tag.TagID, name string) (*tag.Tag, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, tag.ErrTagIDEmpty
+	}
+
+	tagEntity, err := t.tagRepo.FindById(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tagEntity.Rename(name); err != nil {
+		return nil, err
+	}
+
+	tagItem, err := t.tagRepo.Update(ctx, tagEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return tagItem, nil
+}
+
+// DeleteTag removes a tag of the given user and detaches it from all tasks.
+func (t *Tag) DeleteTag(ctx context.Context, userID user.UserID, id tag.TagID) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return tag.ErrTagIDEmpty
+	}
+
+	return t.tagRepo.Delete(ctx, userID, id)
+}
@@ -0,0 +1,392 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTagRepository implements tag.TagRepository for testing
+type MockTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockTagRepository) FindById(ctx context.Context, ownerID user.UserID, id tag.TagID) (*tag.Tag, error) {
+	args := m.Called(ctx, ownerID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*tag.Tag, error) {
+	args := m.Called(ctx, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) FindByNames(ctx context.Context, ownerID user.UserID, names []string) ([]*tag.Tag, error) {
+	args := m.Called(ctx, ownerID, names)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) Create(ctx context.Context, tagEntity *tag.Tag) (*tag.Tag, error) {
+	args := m.Called(ctx, tagEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) Update(ctx context.Context, tagEntity *tag.Tag) (*tag.Tag, error) {
+	args := m.Called(ctx, tagEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*tag.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) Delete(ctx context.Context, ownerID user.UserID, id tag.TagID) error {
+	args := m.Called(ctx, ownerID, id)
+
+	return args.Error(0)
+}
+
+func TestNewTag(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	mockRepo := &MockTagRepository{}
+
+	// Act
+	controller := NewTag(mockRepo)
+
+	// Assert
+	assert.NotNil(t, controller)
+	assert.Equal(t, mockRepo, controller.tagRepo)
+}
+
+func TestTagController_GetAllTags(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	tags := []*tag.Tag{
+		tag.NewTagWithoutValidation(tag.GenerateTagID(), "home", testUserID),
+		tag.NewTagWithoutValidation(tag.GenerateTagID(), "work", testUserID),
+	}
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		mockReturn    []*tag.Tag
+		mockError     error
+		expectedTags  []*tag.Tag
+		expectedError error
+	}{
+		{
+			name:          "successful retrieval",
+			userID:        testUserID,
+			mockReturn:    tags,
+			mockError:     nil,
+			expectedTags:  tags,
+			expectedError: nil,
+		},
+		{
+			name:          "empty user ID",
+			userID:        user.UserID{},
+			mockReturn:    nil,
+			mockError:     nil,
+			expectedTags:  nil,
+			expectedError: user.ErrUserIDEmpty,
+		},
+		{
+			name:          "repository error",
+			userID:        testUserID,
+			mockReturn:    nil,
+			mockError:     errors.New("database error"),
+			expectedTags:  nil,
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTagRepository{}
+			controller := NewTag(mockRepo)
+			ctx := context.Background()
+
+			if !tt.userID.IsEmpty() {
+				mockRepo.On("FindAllByUserID", ctx, tt.userID).Return(tt.mockReturn, tt.mockError)
+			}
+
+			// Act
+			result, err := controller.GetAllTags(ctx, tt.userID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTags, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagController_CreateTag(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+
+	tests := []struct {
+		name          string
+		tagName       string
+		callsRepo     bool
+		mockError     error
+		expectedName  string
+		expectedError error
+	}{
+		{
+			name:          "successful creation normalizes the name",
+			tagName:       "  work ",
+			callsRepo:     true,
+			mockError:     nil,
+			expectedName:  "work",
+			expectedError: nil,
+		},
+		{
+			name:          "empty name should fail validation",
+			tagName:       " ",
+			callsRepo:     false,
+			mockError:     nil,
+			expectedName:  "",
+			expectedError: tag.ErrNameEmpty,
+		},
+		{
+			name:          "name already taken",
+			tagName:       "work",
+			callsRepo:     true,
+			mockError:     tag.ErrNameTaken,
+			expectedName:  "",
+			expectedError: tag.ErrNameTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTagRepository{}
+			controller := NewTag(mockRepo)
+			ctx := context.Background()
+
+			if tt.callsRepo {
+				call := mockRepo.On("Create", ctx, mock.MatchedBy(func(tagEntity *tag.Tag) bool {
+					return tagEntity.UserID() == testUserID && tagEntity.Name() == strings.TrimSpace(tt.tagName)
+				}))
+				if tt.mockError != nil {
+					call.Return(nil, tt.mockError)
+				} else {
+					call.Return(tag.NewTagWithoutValidation(tag.GenerateTagID(), tt.expectedName, testUserID), nil)
+				}
+			}
+
+			// Act
+			result, err := controller.CreateTag(ctx, testUserID, tt.tagName)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedName, result.Name())
+				assert.Equal(t, testUserID, result.UserID())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagController_RenameTag(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testTagID := tag.GenerateTagID()
+
+	tests := []struct {
+		name          string
+		tagName       string
+		findError     error
+		updateError   error
+		callsUpdate   bool
+		expectedError error
+	}{
+		{
+			name:          "successful rename",
+			tagName:       "office",
+			findError:     nil,
+			updateError:   nil,
+			callsUpdate:   true,
+			expectedError: nil,
+		},
+		{
+			name:          "tag not found",
+			tagName:       "office",
+			findError:     tag.ErrTagNotFound,
+			updateError:   nil,
+			callsUpdate:   false,
+			expectedError: tag.ErrTagNotFound,
+		},
+		{
+			name:          "name too long should fail validation",
+			tagName:       "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz",
+			findError:     nil,
+			updateError:   nil,
+			callsUpdate:   false,
+			expectedError: tag.ErrNameTooLong,
+		},
+		{
+			name:          "name already taken",
+			tagName:       "home",
+			findError:     nil,
+			updateError:   tag.ErrNameTaken,
+			callsUpdate:   true,
+			expectedError: tag.ErrNameTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTagRepository{}
+			controller := NewTag(mockRepo)
+			ctx := context.Background()
+
+			if tt.findError != nil {
+				mockRepo.On("FindById", ctx, testUserID, testTagID).Return(nil, tt.findError)
+			} else {
+				mockRepo.On("FindById", ctx, testUserID, testTagID).
+					Return(tag.NewTagWithoutValidation(testTagID, "work", testUserID), nil)
+			}
+
+			if tt.callsUpdate {
+				call := mockRepo.On("Update", ctx, mock.MatchedBy(func(tagEntity *tag.Tag) bool {
+					return tagEntity.Name() == tt.tagName
+				}))
+				if tt.updateError != nil {
+					call.Return(nil, tt.updateError)
+				} else {
+					call.Return(tag.NewTagWithoutValidation(testTagID, tt.tagName, testUserID), nil)
+				}
+			}
+
+			// Act
+			result, err := controller.RenameTag(ctx, testUserID, testTagID, tt.tagName)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.tagName, result.Name())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTagController_DeleteTag(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testTagID := tag.GenerateTagID()
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		tagID         tag.TagID
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:          "successful deletion",
+			userID:        testUserID,
+			tagID:         testTagID,
+			mockError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "tag not found",
+			userID:        testUserID,
+			tagID:         testTagID,
+			mockError:     tag.ErrTagNotFound,
+			expectedError: tag.ErrTagNotFound,
+		},
+		{
+			name:          "empty tag ID",
+			userID:        testUserID,
+			tagID:         tag.TagID{},
+			mockError:     nil,
+			expectedError: tag.ErrTagIDEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTagRepository{}
+			controller := NewTag(mockRepo)
+			ctx := context.Background()
+
+			if !tt.tagID.IsEmpty() {
+				mockRepo.On("Delete", ctx, tt.userID, tt.tagID).Return(tt.mockError)
+			}
+
+			// Act
+			err := controller.DeleteTag(ctx, tt.userID, tt.tagID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
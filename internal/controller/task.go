@@ -2,25 +2,100 @@ package controller
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
 	"golang.org/x/net/context"
 )
 
 // Task represents the task controller that handles business logic for task operations.
+// The events recorded by tasks are published once their changes have been saved.
 type Task struct {
-	taskRepo task.TaskRepository
+	taskRepo    task.TaskRepository
+	tagRepo     tag.TagRepository
+	projectRepo project.ProjectRepository
+	publisher   task.EventPublisher
 }
 
-// NewTask creates a new Task controller with the provided repository.
-func NewTask(taskRepo task.TaskRepository) *Task {
+// TaskCreate holds the values of a task created by CreateTask.
+// Tags names existing tags of the user. A non-nil ParentID creates the task as
+// a subtask of that task. A non-nil Recurrence makes the task the first
+// occurrence of a new series. A non-nil ProjectID puts the task into that
+// project; otherwise it is created in the inbox. A non-nil Workspace scopes the
+// task to the workspace of that membership of the user.
+type TaskCreate struct {
+	Title      string
+	StartAt    *time.Time
+	DueAt      *time.Time
+	AllDay     bool
+	Tags       []string
+	ParentID   *task.TaskID
+	Recurrence *TaskRecurrence
+	ProjectID  *project.ProjectID
+	Workspace  *workspace.Member
+}
+
+// TaskRecurrence holds an RFC 5545 recurrence rule and the IANA time zone its
+// dates are computed in.
+type TaskRecurrence struct {
+	Rule     string
+	Timezone string
+}
+
+// TaskUpdate holds the changes applied by UpdateTask.
+// A nil field leaves the corresponding value of the task unchanged.
+// ClearStartAt and ClearDueAt remove the start or due date and take
+// precedence over StartAt and DueAt. Tags replaces all tags of the task.
+// ParentID moves the task under another task, and ClearParent, which takes
+// precedence, makes it a root task. ProjectID moves the task into another
+// project, and ClearProject, which takes precedence, moves it to the inbox.
+type TaskUpdate struct {
+	Title        *string
+	Completed    *bool
+	StartAt      *time.Time
+	DueAt        *time.Time
+	AllDay       *bool
+	Tags         *[]string
+	ParentID     *task.TaskID
+	ProjectID    *project.ProjectID
+	ClearStartAt bool
+	ClearDueAt   bool
+	ClearParent  bool
+	ClearProject bool
+}
+
+// SeriesUpdate holds the changes applied by UpdateSeries to a whole series.
+// A nil field leaves the corresponding value of the series unchanged.
+type SeriesUpdate struct {
+	Title      *string
+	Recurrence *TaskRecurrence
+}
+
+// changesSchedule reports whether the update touches any schedule field.
+func (u TaskUpdate) changesSchedule() bool {
+	return u.StartAt != nil || u.DueAt != nil || u.AllDay != nil || u.ClearStartAt || u.ClearDueAt
+}
+
+// NewTask creates a new Task controller with the provided repositories and
+// the publisher of task events.
+func NewTask(taskRepo task.TaskRepository, tagRepo tag.TagRepository, projectRepo project.ProjectRepository, publisher task.EventPublisher) *Task {
 	return &Task{
-		taskRepo: taskRepo,
+		taskRepo:    taskRepo,
+		tagRepo:     tagRepo,
+		projectRepo: projectRepo,
+		publisher:   publisher,
 	}
 }
 
-// GetTaskById retrieves a specific task by its ID for the given user.
+// GetTaskById retrieves a specific task by its ID for the given user, who
+// created it or is a collaborator on it.
 func (t *Task) GetTaskById(ctx context.Context, userID user.UserID, id task.TaskID) (*task.Task, error) {
 	if userID.IsEmpty() {
 		return nil, user.ErrUserIDEmpty
@@ -30,7 +105,7 @@ func (t *Task) GetTaskById(ctx context.Context, userID user.UserID, id task.Task
 		return nil, task.ErrTaskIDEmpty
 	}
 
-	taskItem, err := t.taskRepo.FindById(ctx, userID, id)
+	taskItem, err := t.authorize(ctx, userID, id, task.PermissionView)
 	if err != nil {
 		return nil, err
 	}
@@ -38,14 +113,14 @@ func (t *Task) GetTaskById(ctx context.Context, userID user.UserID, id task.Task
 	return taskItem, nil
 }
 
-// GetAllTasks retrieves all tasks for the given user.
+// GetAllTasks retrieves all tasks for the given user that match the filter.
 // It returns an empty slice if no tasks are found.
-func (t *Task) GetAllTasks(ctx context.Context, userID user.UserID) ([]*task.Task, error) {
+func (t *Task) GetAllTasks(ctx context.Context, userID user.UserID, filter task.Filter) ([]*task.Task, error) {
 	if userID.IsEmpty() {
 		return nil, user.ErrUserIDEmpty
 	}
 
-	tasks, err := t.taskRepo.FindAllByUserID(ctx, userID)
+	tasks, err := t.taskRepo.FindAllByUserID(ctx, userID, filter)
 	if err != nil {
 		if errors.Is(err, task.ErrTaskNotFound) {
 			return []*task.Task{}, nil
@@ -57,28 +132,432 @@ func (t *Task) GetAllTasks(ctx context.Context, userID user.UserID) ([]*task.Tas
 	return tasks, nil
 }
 
-// CreateTask creates a new task with the provided title for the given user.
-// It validates the title using domain validation rules.
-func (t *Task) CreateTask(ctx context.Context, userID user.UserID, title string) (*task.Task, error) {
+// GetTaskPage retrieves one page of the tasks of the given user that match the filter.
+// Tasks are returned in the order of the page request; use the NextCursor of the result to fetch the next page.
+func (t *Task) GetTaskPage(ctx context.Context, userID user.UserID, filter task.Filter, page task.PageRequest) (*task.Page, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	taskPage, err := t.taskRepo.FindPageByUserID(ctx, userID, filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return taskPage, nil
+}
+
+// SearchTasks retrieves the tasks the given user may read whose titles match the query, best match first.
+// It returns an empty slice if no tasks match.
+func (t *Task) SearchTasks(ctx context.Context, userID user.UserID, query task.SearchQuery) ([]*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	tasks, err := t.taskRepo.Search(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// CreateTask creates a new task with the provided values for the given user.
+// It validates the title and schedule using domain validation rules, and
+// returns tag.ErrTagNotFound if a tag name does not refer to a tag of the user
+// and project.ErrProjectNotFound if the project is not one of the user's.
+// It returns workspace.ErrForbidden if the role of the user in the workspace
+// does not allow them to write tasks.
+// A subtask is checked against the hierarchy rules of task.Task.MoveUnder.
+func (t *Task) CreateTask(ctx context.Context, userID user.UserID, input TaskCreate) (*task.Task, error) {
 	if userID.IsEmpty() {
 		return nil, user.ErrUserIDEmpty
 	}
 
-	taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+	taskEntity, err := task.NewTask(task.GenerateTaskID(), input.Title, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := taskEntity.Reschedule(input.StartAt, input.DueAt, input.AllDay); err != nil {
+		return nil, err
+	}
+
+	if err := t.setTags(ctx, taskEntity, input.Tags); err != nil {
+		return nil, err
+	}
+
+	if input.Recurrence != nil {
+		if err := taskEntity.Repeat(task.GenerateSeriesID(), input.Recurrence.Rule, input.Recurrence.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.ParentID != nil {
+		// A new task has no subtasks, so its subtree is the task alone.
+		if err := t.moveUnder(ctx, taskEntity, *input.ParentID, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.ProjectID != nil {
+		if err := t.moveToProject(ctx, taskEntity, *input.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Workspace != nil {
+		if err := taskEntity.ScopeToWorkspace(*input.Workspace); err != nil {
+			return nil, err
+		}
+	}
+
 	taskItem, err := t.taskRepo.Create(ctx, taskEntity)
 	if err != nil {
 		return nil, err
 	}
 
+	t.publish(ctx, taskEntity)
+
 	return taskItem, nil
 }
 
-// DeleteTask removes a task by its ID for the given user.
-func (t *Task) DeleteTask(ctx context.Context, userID user.UserID, id task.TaskID) error {
+// DeleteTask moves a task the given user created to the trash.
+// If expectedVersion is not nil, the task is only deleted while it is at that
+// version; otherwise task.ErrVersionMismatch is returned.
+// With cascade, the subtasks of the task are moved to the trash as well;
+// without it, a task with subtasks is not deleted and task.ErrHasSubtasks is returned.
+// It returns task.ErrForbidden if the user is a collaborator on the task.
+func (t *Task) DeleteTask(ctx context.Context, userID user.UserID, id task.TaskID, expectedVersion *int64, cascade bool) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return task.ErrTaskIDEmpty
+	}
+
+	// The tasks are read first so that they can record their deletion. A task
+	// that is not found is left to the repository, which decides whether that
+	// is a conflict.
+	deleted, err := t.findDeleted(ctx, userID, id, cascade)
+	if err != nil {
+		return err
+	}
+
+	if cascade {
+		err = t.taskRepo.DeleteTree(ctx, userID, id, expectedVersion)
+	} else {
+		err = t.taskRepo.Delete(ctx, userID, id, expectedVersion)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	deletedAt := time.Now()
+	for _, taskEntity := range deleted {
+		taskEntity.MoveToTrash(deletedAt)
+	}
+
+	t.publish(ctx, deleted...)
+
+	return nil
+}
+
+// findDeleted reads the task with the given ID the user may delete and, with
+// cascade, its subtasks at every level. It returns no tasks if the task does
+// not exist.
+func (t *Task) findDeleted(ctx context.Context, userID user.UserID, id task.TaskID, cascade bool) ([]*task.Task, error) {
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionManage)
+	if err != nil {
+		if errors.Is(err, task.ErrTaskNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if !cascade {
+		return []*task.Task{taskEntity}, nil
+	}
+
+	subtasks, err := t.taskRepo.FindSubtasks(ctx, taskEntity.UserID(), []task.TaskID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*task.Task{taskEntity}, subtasks...), nil
+}
+
+// GetSubtasks retrieves the subtasks of a task the given user created or is a
+// collaborator on at every level below it, grouped by parent.
+// It returns task.ErrTaskNotFound if the task does not exist.
+func (t *Task) GetSubtasks(ctx context.Context, userID user.UserID, id task.TaskID) (task.Tree, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	subtasks, err := t.taskRepo.FindSubtasks(ctx, taskEntity.UserID(), []task.TaskID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return task.NewTree(subtasks), nil
+}
+
+// ExpandSubtasks retrieves the subtasks of the given tasks the user may read at
+// every level below them, grouped by parent. As in GetSubtasks, the subtasks
+// of a task are read for its creator, so those of shared tasks are included.
+func (t *Task) ExpandSubtasks(ctx context.Context, userID user.UserID, tasks []*task.Task) (task.Tree, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	var creatorIDs []user.UserID
+
+	idsByCreator := make(map[user.UserID][]task.TaskID)
+	for _, taskItem := range tasks {
+		creatorID := taskItem.UserID()
+		if _, ok := idsByCreator[creatorID]; !ok {
+			creatorIDs = append(creatorIDs, creatorID)
+		}
+
+		idsByCreator[creatorID] = append(idsByCreator[creatorID], taskItem.ID())
+	}
+
+	var subtasks []*task.Task
+
+	for _, creatorID := range creatorIDs {
+		found, err := t.taskRepo.FindSubtasks(ctx, creatorID, idsByCreator[creatorID])
+		if err != nil {
+			return nil, err
+		}
+
+		subtasks = append(subtasks, found...)
+	}
+
+	return task.NewTree(subtasks), nil
+}
+
+// GetBlockers retrieves the tasks that block a task the given user created or
+// is a collaborator on.
+// It returns task.ErrTaskNotFound if the task does not exist.
+func (t *Task) GetBlockers(ctx context.Context, userID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.taskRepo.FindBlockers(ctx, taskEntity.UserID(), id)
+}
+
+// AddDependency records that the task with blockerID blocks the task with the
+// given ID. The user must be allowed to edit the task and to read the blocker.
+// It returns task.ErrSelfDependency if both are the same task,
+// task.ErrDependencyCycle if the blocker already depends on the task and
+// task.ErrForbidden if the user is a viewer of the task.
+func (t *Task) AddDependency(ctx context.Context, userID user.UserID, id task.TaskID, blockerID task.TaskID) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	dependency, err := task.NewDependency(blockerID, id)
+	if err != nil {
+		return err
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	if _, err := t.authorize(ctx, userID, blockerID, task.PermissionView); err != nil {
+		return err
+	}
+
+	return t.taskRepo.AddDependency(ctx, taskEntity.UserID(), dependency)
+}
+
+// RemoveDependency removes the dependency of the task with the given ID on the
+// task with blockerID. It returns task.ErrDependencyNotFound if there is none,
+// task.ErrTaskNotFound if the task does not exist and task.ErrForbidden if the
+// user is a viewer of the task.
+func (t *Task) RemoveDependency(ctx context.Context, userID user.UserID, id task.TaskID, blockerID task.TaskID) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	dependency, err := task.NewDependency(blockerID, id)
+	if err != nil {
+		if errors.Is(err, task.ErrSelfDependency) {
+			return task.ErrDependencyNotFound
+		}
+
+		return err
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	return t.taskRepo.RemoveDependency(ctx, taskEntity.UserID(), dependency)
+}
+
+// MoveTask places a task the given user created or is an editor of in the
+// manual order of its creator directly after the task with afterID, directly
+// before the task with beforeID, or between the two. At least one of them must
+// be given.
+// It returns task.ErrNeighbourNotFound if a neighbour does not exist,
+// task.ErrRankOrder if the task with afterID comes after the task with beforeID
+// and task.ErrForbidden if the user is a viewer of the task.
+func (t *Task) MoveTask(ctx context.Context, userID user.UserID, id task.TaskID, afterID, beforeID *task.TaskID) (*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	placement, err := task.NewPlacement(id, afterID, beforeID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := t.taskRepo.Move(ctx, taskEntity.UserID(), id, placement); err != nil {
+		return nil, err
+	}
+
+	taskEntity.Touch()
+	t.publish(ctx, taskEntity)
+
+	return t.authorize(ctx, userID, id, task.PermissionView)
+}
+
+// GetCollaborators retrieves the users the task with the given ID is shared
+// with. The creator and every collaborator of the task may read them.
+func (t *Task) GetCollaborators(ctx context.Context, userID user.UserID, id task.TaskID) ([]task.Collaborator, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	if _, err := t.authorize(ctx, userID, id, task.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return t.taskRepo.FindCollaborators(ctx, id)
+}
+
+// GetAudience returns the users changes to the task with the given ID of the
+// given creator are announced to: the creator, the users the task is shared
+// with and its assignee, each once. It does not check access, as it is used
+// on behalf of the creator once a change has been saved. Of a task that is in
+// the trash or removed, only the creator and the users it is still shared
+// with are returned.
+func (t *Task) GetAudience(ctx context.Context, creatorID user.UserID, id task.TaskID) ([]user.UserID, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	collaborators, err := t.taskRepo.FindCollaborators(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	audience := []user.UserID{creatorID}
+	for _, collaborator := range collaborators {
+		audience = append(audience, collaborator.UserID())
+	}
+
+	taskEntity, err := t.taskRepo.FindAccessible(ctx, creatorID, id)
+	if err != nil {
+		if errors.Is(err, task.ErrTaskNotFound) {
+			return audience, nil
+		}
+
+		return nil, err
+	}
+
+	if assigneeID := taskEntity.AssigneeID(); assigneeID != nil && !slices.Contains(audience, *assigneeID) {
+		audience = append(audience, *assigneeID)
+	}
+
+	return audience, nil
+}
+
+// AddCollaborator shares a task of the given user with the user with
+// collaboratorID, or changes the role of a user it is already shared with.
+// Only the creator of the task may share it; a collaborator gets
+// task.ErrForbidden. It returns task.ErrShareWithCreator if collaboratorID is
+// the creator and task.ErrInvalidRole if role is not a role.
+func (t *Task) AddCollaborator(ctx context.Context, userID user.UserID, id task.TaskID, collaboratorID user.UserID, role string) (task.Collaborator, error) {
+	if userID.IsEmpty() {
+		return task.Collaborator{}, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return task.Collaborator{}, task.ErrTaskIDEmpty
+	}
+
+	parsedRole, err := task.ParseRole(role)
+	if err != nil {
+		return task.Collaborator{}, err
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionManage)
+	if err != nil {
+		return task.Collaborator{}, err
+	}
+
+	collaborator, err := taskEntity.ShareWith(collaboratorID, parsedRole)
+	if err != nil {
+		return task.Collaborator{}, err
+	}
+
+	if err := t.taskRepo.AddCollaborator(ctx, collaborator); err != nil {
+		return task.Collaborator{}, err
+	}
+
+	return collaborator, nil
+}
+
+// RemoveCollaborator stops sharing the task with the given ID with the user
+// with collaboratorID. The creator may remove anyone; a collaborator may only
+// remove themselves and gets task.ErrForbidden otherwise.
+// It returns task.ErrCollaboratorNotFound if the task is not shared with them.
+func (t *Task) RemoveCollaborator(ctx context.Context, userID user.UserID, id task.TaskID, collaboratorID user.UserID) error {
 	if userID.IsEmpty() {
 		return user.ErrUserIDEmpty
 	}
@@ -87,17 +566,175 @@ func (t *Task) DeleteTask(ctx context.Context, userID user.UserID, id task.TaskI
 		return task.ErrTaskIDEmpty
 	}
 
-	err := t.taskRepo.Delete(ctx, userID, id)
+	permission := task.PermissionManage
+	if collaboratorID == userID {
+		permission = task.PermissionView
+	}
+
+	if _, err := t.authorize(ctx, userID, id, permission); err != nil {
+		return err
+	}
+
+	return t.taskRepo.RemoveCollaborator(ctx, id, collaboratorID)
+}
+
+// AssignTask makes the user with assigneeID responsible for the task with the
+// given ID, or leaves nobody responsible if assigneeID is nil, and records the
+// change as made by the given user. The creator and editors of the task may
+// reassign it; a viewer gets task.ErrForbidden. Assigning the current assignee
+// again changes nothing.
+// It returns task.ErrAssigneeWithoutAccess if the assignee is neither the
+// creator of the task nor a collaborator on it.
+func (t *Task) AssignTask(ctx context.Context, userID user.UserID, id task.TaskID, assigneeID *user.UserID) (*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	if sameAssignee(taskEntity.AssigneeID(), assigneeID) {
+		return taskEntity, nil
+	}
+
+	var collaborators []task.Collaborator
+
+	if assigneeID != nil && *assigneeID != taskEntity.UserID() {
+		collaborators, err = t.taskRepo.FindCollaborators(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	assignment, err := taskEntity.AssignTo(assigneeID, collaborators, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	taskItem, err := t.taskRepo.Assign(ctx, taskEntity, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	t.publish(ctx, taskEntity)
+
+	return taskItem, nil
+}
+
+// GetAssignments retrieves the assignment history of the task with the given
+// ID, oldest first. The creator and every collaborator of the task may read it.
+func (t *Task) GetAssignments(ctx context.Context, userID user.UserID, id task.TaskID) ([]task.Assignment, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	if _, err := t.authorize(ctx, userID, id, task.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return t.taskRepo.FindAssignments(ctx, id)
+}
+
+// GetHistory retrieves a page of the changes made to the task with the given
+// ID, oldest first. The creator and every collaborator of the task may read it.
+func (t *Task) GetHistory(ctx context.Context, userID user.UserID, id task.TaskID, page task.PageRequest) (task.HistoryPage, error) {
+	if userID.IsEmpty() {
+		return task.HistoryPage{}, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return task.HistoryPage{}, task.ErrTaskIDEmpty
+	}
+
+	if _, err := t.authorize(ctx, userID, id, task.PermissionView); err != nil {
+		return task.HistoryPage{}, err
+	}
+
+	historyPage, err := t.taskRepo.FindHistory(ctx, id, page)
+	if err != nil {
+		return task.HistoryPage{}, err
+	}
+
+	return historyPage, nil
+}
+
+// GetTrash retrieves the tasks in the trash of the given user, most recently deleted first.
+// It returns an empty slice if the trash is empty.
+func (t *Task) GetTrash(ctx context.Context, userID user.UserID) ([]*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	tasks, err := t.taskRepo.FindTrashByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// RestoreTask moves a task of the given user out of the trash.
+// It returns task.ErrTaskNotFound if the task is not in the trash.
+func (t *Task) RestoreTask(ctx context.Context, userID user.UserID, id task.TaskID) (*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	taskItem, err := t.taskRepo.Restore(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	taskItem.Touch()
+	t.publish(ctx, taskItem)
+
+	return taskItem, nil
+}
+
+// EmptyTrash permanently removes the tasks in the trash of the given user.
+func (t *Task) EmptyTrash(ctx context.Context, userID user.UserID) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	purged, err := t.taskRepo.EmptyTrash(ctx, userID)
 	if err != nil {
 		return err
 	}
 
+	purgedAt := time.Now()
+	for _, taskEntity := range purged {
+		taskEntity.Purge(purgedAt)
+	}
+
+	t.publish(ctx, purged...)
+
 	return nil
 }
 
-// UpdateTask updates a task's title for the given user.
-// It validates the new title using domain validation rules.
-func (t *Task) UpdateTask(ctx context.Context, userID user.UserID, id task.TaskID, title string) (*task.Task, error) {
+// UpdateTask applies the given changes to a task the given user created or is
+// an editor of. It validates the new values using domain validation rules.
+// Setting Completed to the state the task is already in is a no-op.
+// The changes only apply to the task itself, even if it is an occurrence of a
+// series. Completing an occurrence creates the next occurrence of its series.
+// If expectedVersion is not nil and the task is at another version, or the task
+// is changed concurrently, it returns task.ErrVersionMismatch.
+// It returns task.ErrForbidden if the user is a viewer of the task.
+func (t *Task) UpdateTask(ctx context.Context, userID user.UserID, id task.TaskID, update TaskUpdate, expectedVersion *int64) (*task.Task, error) {
 	if userID.IsEmpty() {
 		return nil, user.ErrUserIDEmpty
 	}
@@ -106,15 +743,304 @@ func (t *Task) UpdateTask(ctx context.Context, userID user.UserID, id task.TaskI
 		return nil, task.ErrTaskIDEmpty
 	}
 
-	taskEntity, err := task.NewTask(id, title, userID)
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionEdit)
 	if err != nil {
 		return nil, err
 	}
 
-	taskItem, err := t.taskRepo.Update(ctx, taskEntity)
+	if expectedVersion != nil && *expectedVersion != taskEntity.Version() {
+		return nil, task.ErrVersionMismatch
+	}
+
+	if update.Title != nil {
+		if err := taskEntity.UpdateTitle(*update.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	if update.changesSchedule() {
+		if err := reschedule(taskEntity, update); err != nil {
+			return nil, err
+		}
+	}
+
+	completing := update.Completed != nil && *update.Completed && !taskEntity.IsCompleted()
+
+	if update.Completed != nil && *update.Completed != taskEntity.IsCompleted() {
+		if err := setCompletion(taskEntity, *update.Completed); err != nil {
+			return nil, err
+		}
+	}
+
+	if update.Tags != nil {
+		if err := t.setTags(ctx, taskEntity, *update.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	if update.ClearParent {
+		taskEntity.MoveToRoot()
+	} else if update.ParentID != nil && !sameParent(taskEntity.ParentID(), *update.ParentID) {
+		subtasks, err := t.taskRepo.FindSubtasks(ctx, taskEntity.UserID(), []task.TaskID{id})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := t.moveUnder(ctx, taskEntity, *update.ParentID, task.NewTree(subtasks).Height(id)); err != nil {
+			return nil, err
+		}
+	}
+
+	if update.ClearProject {
+		taskEntity.MoveToProject(nil)
+	} else if update.ProjectID != nil {
+		if err := t.moveToProject(ctx, taskEntity, *update.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	if completing && taskEntity.IsRecurring() {
+		next := taskEntity.Series().NextOccurrence(taskEntity, task.GenerateTaskID())
+
+		taskItem, err := t.taskRepo.CompleteOccurrence(ctx, userID, taskEntity, next)
+		if err != nil {
+			return nil, err
+		}
+
+		t.publish(ctx, taskEntity)
+
+		return taskItem, nil
+	}
+
+	taskItem, err := t.taskRepo.Update(ctx, userID, taskEntity)
 	if err != nil {
 		return nil, err
 	}
 
+	t.publish(ctx, taskEntity)
+
 	return taskItem, nil
 }
+
+// UpdateSeries applies the given changes to the series the task with the given
+// ID is an occurrence of. The user must have created the task or be an editor
+// of it. A new title is given to the open occurrences of the series as well; a
+// new rule is counted from the task.
+// It returns task.ErrNotRecurring if the task does not recur and
+// task.ErrForbidden if the user is a viewer of the task.
+func (t *Task) UpdateSeries(ctx context.Context, userID user.UserID, id task.TaskID, update SeriesUpdate) (*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	series := taskEntity.Series()
+	if series == nil {
+		return nil, task.ErrNotRecurring
+	}
+
+	if update.Title != nil {
+		if err := series.Rename(*update.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	if update.Recurrence != nil {
+		if err := series.ChangeRecurrence(update.Recurrence.Rule, update.Recurrence.Timezone, taskEntity); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := t.taskRepo.UpdateSeries(ctx, series); err != nil {
+		return nil, err
+	}
+
+	taskEntity.Touch()
+	t.publish(ctx, taskEntity)
+
+	return t.authorize(ctx, userID, id, task.PermissionView)
+}
+
+// GetOccurrences lists the schedules of up to limit occurrences that follow a
+// recurring task the given user created or is a collaborator on, earliest first. A limit of zero selects
+// task.DefaultOccurrenceLimit.
+// It returns task.ErrInvalidOccurrenceLimit if limit is outside 1 to
+// task.MaxOccurrenceLimit and task.ErrNotRecurring if the task does not recur.
+func (t *Task) GetOccurrences(ctx context.Context, userID user.UserID, id task.TaskID, limit int) ([]task.Schedule, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	if limit == 0 {
+		limit = task.DefaultOccurrenceLimit
+	}
+
+	if limit < 0 || limit > task.MaxOccurrenceLimit {
+		return nil, task.ErrInvalidOccurrenceLimit
+	}
+
+	taskEntity, err := t.authorize(ctx, userID, id, task.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	if !taskEntity.IsRecurring() {
+		return nil, task.ErrNotRecurring
+	}
+
+	return taskEntity.Series().Upcoming(taskEntity, limit), nil
+}
+
+// publish hands the events the tasks recorded to the publisher.
+// It must only be called once the changes of the tasks have been saved.
+func (t *Task) publish(ctx context.Context, tasks ...*task.Task) {
+	var events []task.Event
+	for _, taskEntity := range tasks {
+		events = append(events, taskEntity.PullEvents()...)
+	}
+
+	t.publisher.Publish(ctx, events...)
+}
+
+// authorize reads the task with the given ID for the user and checks that they
+// may act on it with the permission.
+// It returns task.ErrTaskNotFound if the task is neither the user's nor shared
+// with them and task.ErrForbidden if their role does not allow the permission.
+func (t *Task) authorize(ctx context.Context, userID user.UserID, id task.TaskID, permission task.Permission) (*task.Task, error) {
+	taskEntity, err := t.taskRepo.FindAccessible(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := taskEntity.Authorize(userID, permission); err != nil {
+		return nil, err
+	}
+
+	return taskEntity, nil
+}
+
+// setTags attaches the named tags of the task's creator to the task.
+// It returns tag.ErrTagNotFound naming the missing tags if the creator has no
+// tag for some of the names.
+func (t *Task) setTags(ctx context.Context, taskEntity *task.Task, names []string) error {
+	if err := taskEntity.SetTags(names); err != nil {
+		return err
+	}
+
+	wanted := taskEntity.Tags()
+	if len(wanted) == 0 {
+		return nil
+	}
+
+	found, err := t.tagRepo.FindByNames(ctx, taskEntity.UserID(), wanted)
+	if err != nil {
+		return err
+	}
+
+	if len(found) == len(wanted) {
+		return nil
+	}
+
+	existing := make(map[string]bool, len(found))
+	for _, tagItem := range found {
+		existing[tagItem.Name()] = true
+	}
+
+	missing := make([]string, 0, len(wanted)-len(found))
+
+	for _, name := range wanted {
+		if !existing[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	return fmt.Errorf("%w: %s", tag.ErrTagNotFound, strings.Join(missing, ", "))
+}
+
+// moveUnder makes the task a subtask of the task with the given ID.
+// height is the number of levels of the task's own subtree, counting the task.
+// It returns task.ErrParentNotFound if the creator has no such task.
+func (t *Task) moveUnder(ctx context.Context, taskEntity *task.Task, parentID task.TaskID, height int) error {
+	lineage, err := t.taskRepo.FindLineage(ctx, taskEntity.UserID(), parentID)
+	if err != nil {
+		if errors.Is(err, task.ErrTaskNotFound) {
+			return task.ErrParentNotFound
+		}
+
+		return err
+	}
+
+	return taskEntity.MoveUnder(lineage, height)
+}
+
+// moveToProject puts the task into the project with the given ID.
+// It returns project.ErrProjectNotFound if the creator has no such project.
+func (t *Task) moveToProject(ctx context.Context, taskEntity *task.Task, projectID project.ProjectID) error {
+	if _, err := t.projectRepo.FindById(ctx, taskEntity.UserID(), projectID); err != nil {
+		return err
+	}
+
+	taskEntity.MoveToProject(&projectID)
+
+	return nil
+}
+
+// sameParent reports whether current refers to the task with the given ID.
+func sameParent(current *task.TaskID, id task.TaskID) bool {
+	return current != nil && *current == id
+}
+
+func sameAssignee(current, assigneeID *user.UserID) bool {
+	if current == nil || assigneeID == nil {
+		return current == nil && assigneeID == nil
+	}
+
+	return *current == *assigneeID
+}
+
+func setCompletion(taskEntity *task.Task, completed bool) error {
+	if completed {
+		return taskEntity.Complete(time.Now())
+	}
+
+	return taskEntity.Reopen()
+}
+
+// reschedule merges the schedule fields of the update into the current
+// schedule of the task and validates the result as a whole.
+func reschedule(taskEntity *task.Task, update TaskUpdate) error {
+	current := taskEntity.Schedule()
+
+	startAt := current.StartAt()
+	if update.ClearStartAt {
+		startAt = nil
+	} else if update.StartAt != nil {
+		startAt = update.StartAt
+	}
+
+	dueAt := current.DueAt()
+	if update.ClearDueAt {
+		dueAt = nil
+	} else if update.DueAt != nil {
+		dueAt = update.DueAt
+	}
+
+	allDay := current.IsAllDay()
+	if update.AllDay != nil {
+		allDay = *update.AllDay
+	}
+
+	return taskEntity.Reschedule(startAt, dueAt, allDay)
+}
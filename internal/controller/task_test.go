@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -18,8 +23,26 @@ type MockTaskRepository struct {
 	mock.Mock
 }
 
-func (m *MockTaskRepository) FindAllByUserID(ctx context.Context, userID user.UserID) ([]*task.Task, error) {
-	args := m.Called(ctx, userID)
+func (m *MockTaskRepository) FindAllByUserID(ctx context.Context, userID user.UserID, filter task.Filter) ([]*task.Task, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindPageByUserID(ctx context.Context, userID user.UserID, filter task.Filter, page task.PageRequest) (*task.Page, error) {
+	args := m.Called(ctx, userID, filter, page)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*task.Page), args.Error(1)
+}
+
+func (m *MockTaskRepository) Search(ctx context.Context, userID user.UserID, query task.SearchQuery) ([]*task.Task, error) {
+	args := m.Called(ctx, userID, query)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -36,6 +59,15 @@ func (m *MockTaskRepository) FindById(ctx context.Context, userID user.UserID, i
 	return args.Get(0).(*task.Task), args.Error(1)
 }
 
+func (m *MockTaskRepository) FindAccessible(ctx context.Context, userID user.UserID, id task.TaskID) (*task.Task, error) {
+	args := m.Called(ctx, userID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*task.Task), args.Error(1)
+}
+
 func (m *MockTaskRepository) Create(ctx context.Context, taskEntity *task.Task) (*task.Task, error) {
 	args := m.Called(ctx, taskEntity)
 	if args.Get(0) == nil {
@@ -45,8 +77,32 @@ func (m *MockTaskRepository) Create(ctx context.Context, taskEntity *task.Task)
 	return args.Get(0).(*task.Task), args.Error(1)
 }
 
-func (m *MockTaskRepository) Update(ctx context.Context, taskEntity *task.Task) (*task.Task, error) {
-	args := m.Called(ctx, taskEntity)
+func (m *MockTaskRepository) Update(ctx context.Context, actorID user.UserID, taskEntity *task.Task) (*task.Task, error) {
+	args := m.Called(ctx, actorID, taskEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) CompleteOccurrence(ctx context.Context, actorID user.UserID, taskEntity *task.Task, next *task.Task) (*task.Task, error) {
+	args := m.Called(ctx, actorID, taskEntity, next)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) UpdateSeries(ctx context.Context, series *task.Series) error {
+	args := m.Called(ctx, series)
+
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) Move(ctx context.Context, userID user.UserID, id task.TaskID, placement task.Placement) (*task.Task, error) {
+	args := m.Called(ctx, userID, id, placement)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -54,24 +110,177 @@ func (m *MockTaskRepository) Update(ctx context.Context, taskEntity *task.Task)
 	return args.Get(0).(*task.Task), args.Error(1)
 }
 
-func (m *MockTaskRepository) Delete(ctx context.Context, userID user.UserID, id task.TaskID) error {
+func (m *MockTaskRepository) RebalanceRanks(ctx context.Context, maxLength int) (int64, error) {
+	args := m.Called(ctx, maxLength)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTaskRepository) Delete(ctx context.Context, userID user.UserID, id task.TaskID, expectedVersion *int64) error {
+	args := m.Called(ctx, userID, id, expectedVersion)
+
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindLineage(ctx context.Context, userID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	args := m.Called(ctx, userID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindSubtasks(ctx context.Context, userID user.UserID, ids []task.TaskID) ([]*task.Task, error) {
+	args := m.Called(ctx, userID, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) DeleteTree(ctx context.Context, userID user.UserID, id task.TaskID, expectedVersion *int64) error {
+	args := m.Called(ctx, userID, id, expectedVersion)
+
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindBlockers(ctx context.Context, userID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	args := m.Called(ctx, userID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) AddDependency(ctx context.Context, userID user.UserID, dependency task.Dependency) error {
+	args := m.Called(ctx, userID, dependency)
+
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) RemoveDependency(ctx context.Context, userID user.UserID, dependency task.Dependency) error {
+	args := m.Called(ctx, userID, dependency)
+
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindTrashByUserID(ctx context.Context, userID user.UserID) ([]*task.Task, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Restore(ctx context.Context, userID user.UserID, id task.TaskID) (*task.Task, error) {
 	args := m.Called(ctx, userID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) EmptyTrash(ctx context.Context, userID user.UserID) ([]*task.Task, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	args := m.Called(ctx, cutoff)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindCollaborators(ctx context.Context, id task.TaskID) ([]task.Collaborator, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]task.Collaborator), args.Error(1)
+}
+
+func (m *MockTaskRepository) AddCollaborator(ctx context.Context, collaborator task.Collaborator) error {
+	args := m.Called(ctx, collaborator)
+
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) RemoveCollaborator(ctx context.Context, id task.TaskID, userID user.UserID) error {
+	args := m.Called(ctx, id, userID)
 
 	return args.Error(0)
 }
 
+func (m *MockTaskRepository) Assign(ctx context.Context, taskEntity *task.Task, assignment task.Assignment) (*task.Task, error) {
+	args := m.Called(ctx, taskEntity, assignment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*task.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindAssignments(ctx context.Context, id task.TaskID) ([]task.Assignment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]task.Assignment), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindHistory(ctx context.Context, id task.TaskID, page task.PageRequest) (task.HistoryPage, error) {
+	args := m.Called(ctx, id, page)
+
+	return args.Get(0).(task.HistoryPage), args.Error(1)
+}
+
+// MockEventPublisher implements task.EventPublisher and keeps the published events
+type MockEventPublisher struct {
+	mu     sync.Mutex
+	events []task.Event
+}
+
+func (m *MockEventPublisher) Publish(_ context.Context, events ...task.Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, events...)
+}
+
+func (m *MockEventPublisher) Published() []task.Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.events
+}
+
 func TestNewTask(t *testing.T) {
 	t.Parallel()
 
 	// Arrange
 	mockRepo := &MockTaskRepository{}
+	mockTagRepo := &MockTagRepository{}
+	mockProjectRepo := &MockProjectRepository{}
 
 	// Act
-	controller := NewTask(mockRepo)
+	controller := NewTask(mockRepo, mockTagRepo, mockProjectRepo, &MockEventPublisher{})
 
 	// Assert
 	assert.NotNil(t, controller)
 	assert.Equal(t, mockRepo, controller.taskRepo)
+	assert.Equal(t, mockTagRepo, controller.tagRepo)
+	assert.Equal(t, mockProjectRepo, controller.projectRepo)
 }
 
 func TestTaskController_GetTaskById(t *testing.T) {
@@ -127,10 +336,10 @@ func TestTaskController_GetTaskById(t *testing.T) {
 
 			// Arrange
 			mockRepo := &MockTaskRepository{}
-			controller := NewTask(mockRepo)
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
 			ctx := context.Background()
 
-			mockRepo.On("FindById", ctx, tt.userID, tt.taskID).Return(tt.mockReturn, tt.mockError)
+			mockRepo.On("FindAccessible", ctx, tt.userID, tt.taskID).Return(tt.mockReturn, tt.mockError)
 
 			// Act & Assert
 			result, err := controller.GetTaskById(ctx, tt.userID, tt.taskID)
@@ -207,13 +416,13 @@ func TestTaskController_GetAllTasks(t *testing.T) {
 
 			// Arrange
 			mockRepo := &MockTaskRepository{}
-			controller := NewTask(mockRepo)
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
 			ctx := context.Background()
 
-			mockRepo.On("FindAllByUserID", ctx, tt.userID).Return(tt.mockReturn, tt.mockError)
+			mockRepo.On("FindAllByUserID", ctx, tt.userID, task.Filter{}).Return(tt.mockReturn, tt.mockError)
 
 			// Act
-			result, err := controller.GetAllTasks(ctx, tt.userID)
+			result, err := controller.GetAllTasks(ctx, tt.userID, task.Filter{})
 
 			// Assert
 			if tt.expectedError != nil {
@@ -236,57 +445,36 @@ func TestTaskController_GetAllTasks(t *testing.T) {
 	}
 }
 
-func TestTaskController_CreateTask(t *testing.T) {
+func TestTaskController_GetTaskPage(t *testing.T) {
 	t.Parallel()
 
-	// Generate UUIDs for consistent test data
 	testUserID := user.GenerateUserID()
-	newTaskID := task.GenerateTaskID()
+	pageRequest, err := task.NewPageRequest(2, "", nil)
+	require.NoError(t, err)
 
 	tests := []struct {
 		name          string
-		userID        user.UserID
-		title         string
-		mockReturn    *task.Task
+		mockReturn    *task.Page
 		mockError     error
-		expectedTask  *task.Task
 		expectedError error
 	}{
 		{
-			name:          "successful creation",
-			userID:        testUserID,
-			title:         "New Task",
-			mockReturn:    task.NewTaskWithoutValidation(newTaskID, "New Task", testUserID),
+			name: "page with more tasks",
+			mockReturn: &task.Page{
+				Tasks: []*task.Task{
+					task.NewTaskWithoutValidation(task.GenerateTaskID(), "Task 1", testUserID),
+					task.NewTaskWithoutValidation(task.GenerateTaskID(), "Task 2", testUserID),
+				},
+				NextCursor: "next",
+			},
 			mockError:     nil,
-			expectedTask:  task.NewTaskWithoutValidation(newTaskID, "New Task", testUserID),
 			expectedError: nil,
 		},
 		{
-			name:          "empty title should fail validation",
-			userID:        testUserID,
-			title:         "",
-			mockReturn:    nil,
-			mockError:     nil,
-			expectedTask:  nil,
-			expectedError: task.ErrTitleEmpty,
-		},
-		{
-			name:          "title too long should fail validation",
-			userID:        testUserID,
-			title:         strings.Repeat("a", task.MaxTitleLength+1),
-			mockReturn:    nil,
-			mockError:     nil,
-			expectedTask:  nil,
-			expectedError: task.ErrTitleTooLong,
-		},
-		{
-			name:          "repository error",
-			userID:        testUserID,
-			title:         "Valid Title",
+			name:          "invalid cursor",
 			mockReturn:    nil,
-			mockError:     errors.New("database error"),
-			expectedTask:  nil,
-			expectedError: errors.New("database error"),
+			mockError:     task.ErrInvalidCursor,
+			expectedError: task.ErrInvalidCursor,
 		},
 	}
 
@@ -296,28 +484,21 @@ func TestTaskController_CreateTask(t *testing.T) {
 
 			// Arrange
 			mockRepo := &MockTaskRepository{}
-			controller := NewTask(mockRepo)
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
 			ctx := context.Background()
 
-			// Only set up mock expectations if we expect the repository to be called
-			if tt.expectedError != task.ErrTitleEmpty && tt.expectedError != task.ErrTitleTooLong {
-				mockRepo.On("Create", ctx, mock.AnythingOfType("*task.Task")).Return(tt.mockReturn, tt.mockError)
-			}
+			mockRepo.On("FindPageByUserID", ctx, testUserID, task.Filter{}, pageRequest).Return(tt.mockReturn, tt.mockError)
 
 			// Act
-			result, err := controller.CreateTask(ctx, tt.userID, tt.title)
+			result, err := controller.GetTaskPage(ctx, testUserID, task.Filter{}, pageRequest)
 
 			// Assert
 			if tt.expectedError != nil {
-				assert.Error(t, err)
-				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.ErrorIs(t, err, tt.expectedError)
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
-				require.NotNil(t, result)
-				assert.Equal(t, tt.expectedTask.ID(), result.ID())
-				assert.Equal(t, tt.expectedTask.Title(), result.Title())
-				assert.Equal(t, tt.expectedTask.UserID(), result.UserID())
+				assert.Equal(t, tt.mockReturn, result)
 			}
 
 			mockRepo.AssertExpectations(t)
@@ -325,41 +506,43 @@ func TestTaskController_CreateTask(t *testing.T) {
 	}
 }
 
-func TestTaskController_DeleteTask(t *testing.T) {
+func TestTaskController_SearchTasks(t *testing.T) {
 	t.Parallel()
 
-	// Generate UUIDs for consistent test data
 	testUserID := user.GenerateUserID()
-	testTaskID := task.GenerateTaskID()
+	query, err := task.NewSearchQuery("レポート", 0)
+	require.NoError(t, err)
 
 	tests := []struct {
 		name          string
 		userID        user.UserID
-		taskID        task.TaskID
+		mockReturn    []*task.Task
 		mockError     error
 		expectedError error
 	}{
 		{
-			name:          "successful deletion",
-			userID:        testUserID,
-			taskID:        testTaskID,
+			name:   "matching tasks",
+			userID: testUserID,
+			mockReturn: []*task.Task{
+				task.NewTaskWithoutValidation(task.GenerateTaskID(), "週次レポート", testUserID),
+			},
 			mockError:     nil,
 			expectedError: nil,
 		},
-		{
-			name:          "task not found",
-			userID:        testUserID,
-			taskID:        testTaskID,
-			mockError:     task.ErrTaskNotFound,
-			expectedError: task.ErrTaskNotFound,
-		},
 		{
 			name:          "repository error",
 			userID:        testUserID,
-			taskID:        testTaskID,
+			mockReturn:    nil,
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "empty user ID",
+			userID:        user.UserID{},
+			mockReturn:    nil,
+			mockError:     nil,
+			expectedError: user.ErrUserIDEmpty,
+		},
 	}
 
 	for _, tt := range tests {
@@ -368,20 +551,24 @@ func TestTaskController_DeleteTask(t *testing.T) {
 
 			// Arrange
 			mockRepo := &MockTaskRepository{}
-			controller := NewTask(mockRepo)
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
 			ctx := context.Background()
 
-			mockRepo.On("Delete", ctx, tt.userID, tt.taskID).Return(tt.mockError)
+			if !tt.userID.IsEmpty() {
+				mockRepo.On("Search", ctx, tt.userID, query).Return(tt.mockReturn, tt.mockError)
+			}
 
 			// Act
-			err := controller.DeleteTask(ctx, tt.userID, tt.taskID)
+			result, err := controller.SearchTasks(ctx, tt.userID, query)
 
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err)
 				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.mockReturn, result)
 			}
 
 			mockRepo.AssertExpectations(t)
@@ -389,17 +576,16 @@ func TestTaskController_DeleteTask(t *testing.T) {
 	}
 }
 
-func TestTaskController_UpdateTask(t *testing.T) {
+func TestTaskController_CreateTask(t *testing.T) {
 	t.Parallel()
 
 	// Generate UUIDs for consistent test data
 	testUserID := user.GenerateUserID()
-	testTaskID := task.GenerateTaskID()
+	newTaskID := task.GenerateTaskID()
 
 	tests := []struct {
 		name          string
 		userID        user.UserID
-		taskID        task.TaskID
 		title         string
 		mockReturn    *task.Task
 		mockError     error
@@ -407,19 +593,17 @@ func TestTaskController_UpdateTask(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name:          "successful update",
+			name:          "successful creation",
 			userID:        testUserID,
-			taskID:        testTaskID,
-			title:         "Updated Task",
-			mockReturn:    task.NewTaskWithoutValidation(testTaskID, "Updated Task", testUserID),
+			title:         "New Task",
+			mockReturn:    task.NewTaskWithoutValidation(newTaskID, "New Task", testUserID),
 			mockError:     nil,
-			expectedTask:  task.NewTaskWithoutValidation(testTaskID, "Updated Task", testUserID),
+			expectedTask:  task.NewTaskWithoutValidation(newTaskID, "New Task", testUserID),
 			expectedError: nil,
 		},
 		{
 			name:          "empty title should fail validation",
 			userID:        testUserID,
-			taskID:        testTaskID,
 			title:         "",
 			mockReturn:    nil,
 			mockError:     nil,
@@ -429,27 +613,15 @@ func TestTaskController_UpdateTask(t *testing.T) {
 		{
 			name:          "title too long should fail validation",
 			userID:        testUserID,
-			taskID:        testTaskID,
 			title:         strings.Repeat("a", task.MaxTitleLength+1),
 			mockReturn:    nil,
 			mockError:     nil,
 			expectedTask:  nil,
 			expectedError: task.ErrTitleTooLong,
 		},
-		{
-			name:          "task not found",
-			userID:        testUserID,
-			taskID:        testTaskID,
-			title:         "Valid Title",
-			mockReturn:    nil,
-			mockError:     task.ErrTaskNotFound,
-			expectedTask:  nil,
-			expectedError: task.ErrTaskNotFound,
-		},
 		{
 			name:          "repository error",
 			userID:        testUserID,
-			taskID:        testTaskID,
 			title:         "Valid Title",
 			mockReturn:    nil,
 			mockError:     errors.New("database error"),
@@ -464,28 +636,2227 @@ func TestTaskController_UpdateTask(t *testing.T) {
 
 			// Arrange
 			mockRepo := &MockTaskRepository{}
-			controller := NewTask(mockRepo)
+			publisher := &MockEventPublisher{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
 			ctx := context.Background()
 
 			// Only set up mock expectations if we expect the repository to be called
 			if tt.expectedError != task.ErrTitleEmpty && tt.expectedError != task.ErrTitleTooLong {
-				mockRepo.On("Update", ctx, mock.AnythingOfType("*task.Task")).Return(tt.mockReturn, tt.mockError)
+				mockRepo.On("Create", ctx, mock.AnythingOfType("*task.Task")).Return(tt.mockReturn, tt.mockError)
 			}
 
 			// Act
-			result, err := controller.UpdateTask(ctx, tt.userID, tt.taskID, tt.title)
+			result, err := controller.CreateTask(ctx, tt.userID, TaskCreate{Title: tt.title})
 
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err)
 				assert.Equal(t, tt.expectedError.Error(), err.Error())
 				assert.Nil(t, result)
+				assert.Empty(t, publisher.Published())
 			} else {
 				assert.NoError(t, err)
 				require.NotNil(t, result)
 				assert.Equal(t, tt.expectedTask.ID(), result.ID())
 				assert.Equal(t, tt.expectedTask.Title(), result.Title())
 				assert.Equal(t, tt.expectedTask.UserID(), result.UserID())
+
+				events := publisher.Published()
+				require.Len(t, events, 1)
+				assert.IsType(t, task.TaskCreated{}, events[0])
+				assert.Equal(t, tt.userID, events[0].UserID())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_CreateTaskWithSchedule(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	start := time.Date(2024, 1, 18, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 1, 20, 17, 0, 0, 0, time.UTC)
+
+	t.Run("schedule is set on the created task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return taskEntity.Schedule().StartAt().Equal(start) &&
+				taskEntity.Schedule().DueAt().Equal(due) &&
+				taskEntity.Schedule().IsAllDay()
+		})).Return(task.NewTaskWithoutValidation(task.GenerateTaskID(), "New Task", testUserID), nil)
+
+		// Act
+		result, err := controller.CreateTask(ctx, testUserID, TaskCreate{Title: "New Task", StartAt: &start, DueAt: &due, AllDay: true})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("start after due should fail validation", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+
+		// Act
+		result, err := controller.CreateTask(context.Background(), testUserID, TaskCreate{Title: "New Task", StartAt: &due, DueAt: &start})
+
+		// Assert
+		assert.ErrorIs(t, err, task.ErrStartAfterDue)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTaskController_CreateTaskInWorkspace(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testWorkspaceID := workspace.GenerateWorkspaceID()
+
+	t.Run("task is scoped to the workspace", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		member, err := workspace.NewMember(testWorkspaceID, testUserID, workspace.RoleMember)
+		require.NoError(t, err)
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return taskEntity.WorkspaceID() != nil && *taskEntity.WorkspaceID() == testWorkspaceID
+		})).Return(task.NewTaskWithoutValidation(task.GenerateTaskID(), "New Task", testUserID), nil)
+
+		// Act
+		result, err := controller.CreateTask(ctx, testUserID, TaskCreate{Title: "New Task", Workspace: &member})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("guest may not create tasks", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+
+		member, err := workspace.NewMember(testWorkspaceID, testUserID, workspace.RoleGuest)
+		require.NoError(t, err)
+
+		// Act
+		result, err := controller.CreateTask(context.Background(), testUserID, TaskCreate{Title: "New Task", Workspace: &member})
+
+		// Assert
+		assert.ErrorIs(t, err, workspace.ErrForbidden)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTaskController_CreateTaskWithTags(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+
+	t.Run("existing tags are attached to the created task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		mockTagRepo := &MockTagRepository{}
+		controller := NewTask(mockRepo, mockTagRepo, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockTagRepo.On("FindByNames", ctx, testUserID, []string{"home", "work"}).Return([]*tag.Tag{
+			tag.NewTagWithoutValidation(tag.GenerateTagID(), "home", testUserID),
+			tag.NewTagWithoutValidation(tag.GenerateTagID(), "work", testUserID),
+		}, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return assert.ObjectsAreEqual([]string{"home", "work"}, taskEntity.Tags())
+		})).Return(task.NewTaskWithoutValidation(task.GenerateTaskID(), "New Task", testUserID), nil)
+
+		// Act
+		result, err := controller.CreateTask(ctx, testUserID, TaskCreate{Title: "New Task", Tags: []string{" work ", "home", "work"}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		mockRepo.AssertExpectations(t)
+		mockTagRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown tags should fail", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		mockTagRepo := &MockTagRepository{}
+		controller := NewTask(mockRepo, mockTagRepo, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockTagRepo.On("FindByNames", ctx, testUserID, []string{"home", "work"}).Return([]*tag.Tag{
+			tag.NewTagWithoutValidation(tag.GenerateTagID(), "home", testUserID),
+		}, nil)
+
+		// Act
+		result, err := controller.CreateTask(ctx, testUserID, TaskCreate{Title: "New Task", Tags: []string{"home", "work"}})
+
+		// Assert
+		assert.ErrorIs(t, err, tag.ErrTagNotFound)
+		assert.Contains(t, err.Error(), "work")
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid tag name should fail validation", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		mockTagRepo := &MockTagRepository{}
+		controller := NewTask(mockRepo, mockTagRepo, &MockProjectRepository{}, &MockEventPublisher{})
+
+		// Act
+		result, err := controller.CreateTask(context.Background(), testUserID, TaskCreate{Title: "New Task", Tags: []string{"  "}})
+
+		// Assert
+		assert.ErrorIs(t, err, tag.ErrNameEmpty)
+		assert.Nil(t, result)
+		mockTagRepo.AssertNotCalled(t, "FindByNames", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTaskController_CreateSubtask(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	parentID := task.GenerateTaskID()
+	parent := task.NewTaskWithoutValidation(parentID, "Parent", testUserID)
+
+	t.Run("subtask is created under the parent", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindLineage", ctx, testUserID, parentID).Return([]*task.Task{parent}, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return taskEntity.ParentID() != nil && *taskEntity.ParentID() == parentID
+		})).Return(task.NewTaskWithoutValidation(task.GenerateTaskID(), "Subtask", testUserID, task.WithParentID(&parentID)), nil)
+
+		// Act
+		result, err := controller.CreateTask(ctx, testUserID, TaskCreate{Title: "Subtask", ParentID: &parentID})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("missing parent", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindLineage", ctx, testUserID, parentID).Return(nil, task.ErrTaskNotFound)
+
+		// Act
+		result, err := controller.CreateTask(ctx, testUserID, TaskCreate{Title: "Subtask", ParentID: &parentID})
+
+		// Assert
+		assert.ErrorIs(t, err, task.ErrParentNotFound)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTaskController_UpdateTaskParent(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	childID := task.GenerateTaskID()
+	newParentID := task.GenerateTaskID()
+	oldParentID := task.GenerateTaskID()
+
+	existing := func() *task.Task {
+		return task.NewTaskWithoutValidation(taskID, "Task", testUserID, task.WithParentID(&oldParentID))
+	}
+	child := task.NewTaskWithoutValidation(childID, "Child", testUserID, task.WithParentID(&taskID))
+
+	t.Run("task is moved under the new parent", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).Return(existing(), nil)
+		mockRepo.On("FindSubtasks", ctx, testUserID, []task.TaskID{taskID}).Return([]*task.Task{child}, nil)
+		mockRepo.On("FindLineage", ctx, testUserID, newParentID).
+			Return([]*task.Task{task.NewTaskWithoutValidation(newParentID, "New Parent", testUserID)}, nil)
+		mockRepo.On("Update", ctx, testUserID, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return *taskEntity.ParentID() == newParentID
+		})).Return(existing(), nil)
+
+		// Act
+		_, err := controller.UpdateTask(ctx, testUserID, taskID, TaskUpdate{ParentID: &newParentID}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("task cannot be moved under its own subtask", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).Return(existing(), nil)
+		mockRepo.On("FindSubtasks", ctx, testUserID, []task.TaskID{taskID}).Return([]*task.Task{child}, nil)
+		mockRepo.On("FindLineage", ctx, testUserID, childID).Return([]*task.Task{child, existing()}, nil)
+
+		// Act
+		result, err := controller.UpdateTask(ctx, testUserID, taskID, TaskUpdate{ParentID: &childID}, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, task.ErrParentCycle)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("unchanged parent is not checked again", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).Return(existing(), nil)
+		mockRepo.On("Update", ctx, testUserID, mock.AnythingOfType("*task.Task")).Return(existing(), nil)
+
+		// Act
+		_, err := controller.UpdateTask(ctx, testUserID, taskID, TaskUpdate{ParentID: &oldParentID}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "FindLineage", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("clearing the parent makes a root task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).Return(existing(), nil)
+		mockRepo.On("Update", ctx, testUserID, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return taskEntity.ParentID() == nil
+		})).Return(existing(), nil)
+
+		// Act
+		_, err := controller.UpdateTask(ctx, testUserID, taskID, TaskUpdate{ClearParent: true}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestTaskController_TaskProject(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	projectID := project.GenerateProjectID()
+	workProject := project.NewProjectWithoutValidation(projectID, "Work", testUserID)
+
+	t.Run("task is created in the project", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		mockProjectRepo := &MockProjectRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, mockProjectRepo, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockProjectRepo.On("FindById", ctx, testUserID, projectID).Return(workProject, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return taskEntity.ProjectID() != nil && *taskEntity.ProjectID() == projectID
+		})).Return(task.NewTaskWithoutValidation(task.GenerateTaskID(), "Task", testUserID, task.WithProjectID(&projectID)), nil)
+
+		// Act
+		result, err := controller.CreateTask(ctx, testUserID, TaskCreate{Title: "Task", ProjectID: &projectID})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		mockRepo.AssertExpectations(t)
+		mockProjectRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown project should fail", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		mockProjectRepo := &MockProjectRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, mockProjectRepo, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockProjectRepo.On("FindById", ctx, testUserID, projectID).Return(nil, project.ErrProjectNotFound)
+
+		// Act
+		result, err := controller.CreateTask(ctx, testUserID, TaskCreate{Title: "Task", ProjectID: &projectID})
+
+		// Assert
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("task is moved into the project", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		mockProjectRepo := &MockProjectRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, mockProjectRepo, &MockEventPublisher{})
+		ctx := context.Background()
+
+		existing := task.NewTaskWithoutValidation(taskID, "Task", testUserID)
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).Return(existing, nil)
+		mockProjectRepo.On("FindById", ctx, testUserID, projectID).Return(workProject, nil)
+		mockRepo.On("Update", ctx, testUserID, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return taskEntity.ProjectID() != nil && *taskEntity.ProjectID() == projectID
+		})).Return(existing, nil)
+
+		// Act
+		_, err := controller.UpdateTask(ctx, testUserID, taskID, TaskUpdate{ProjectID: &projectID}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockProjectRepo.AssertExpectations(t)
+	})
+
+	t.Run("clearing the project moves the task to the inbox", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		mockProjectRepo := &MockProjectRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, mockProjectRepo, &MockEventPublisher{})
+		ctx := context.Background()
+
+		existing := task.NewTaskWithoutValidation(taskID, "Task", testUserID, task.WithProjectID(&projectID))
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).Return(existing, nil)
+		mockRepo.On("Update", ctx, testUserID, mock.MatchedBy(func(taskEntity *task.Task) bool {
+			return taskEntity.ProjectID() == nil
+		})).Return(existing, nil)
+
+		// Act
+		_, err := controller.UpdateTask(ctx, testUserID, taskID, TaskUpdate{ClearProject: true}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockProjectRepo.AssertNotCalled(t, "FindById", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTaskController_DeleteTask(t *testing.T) {
+	t.Parallel()
+
+	// Generate UUIDs for consistent test data
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+
+	tests := []struct {
+		name           string
+		userID         user.UserID
+		taskID         task.TaskID
+		findError      error
+		mockError      error
+		expectedError  error
+		expectedEvents int
+	}{
+		{
+			name:           "successful deletion",
+			userID:         testUserID,
+			taskID:         testTaskID,
+			findError:      nil,
+			mockError:      nil,
+			expectedError:  nil,
+			expectedEvents: 1,
+		},
+		{
+			name:           "task not found",
+			userID:         testUserID,
+			taskID:         testTaskID,
+			findError:      task.ErrTaskNotFound,
+			mockError:      task.ErrTaskNotFound,
+			expectedError:  task.ErrTaskNotFound,
+			expectedEvents: 0,
+		},
+		{
+			name:           "task with subtasks",
+			userID:         testUserID,
+			taskID:         testTaskID,
+			findError:      nil,
+			mockError:      task.ErrHasSubtasks,
+			expectedError:  task.ErrHasSubtasks,
+			expectedEvents: 0,
+		},
+		{
+			name:           "repository error",
+			userID:         testUserID,
+			taskID:         testTaskID,
+			findError:      nil,
+			mockError:      errors.New("database error"),
+			expectedError:  errors.New("database error"),
+			expectedEvents: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			publisher := &MockEventPublisher{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
+			ctx := context.Background()
+
+			if tt.findError != nil {
+				mockRepo.On("FindAccessible", ctx, tt.userID, tt.taskID).Return(nil, tt.findError)
+			} else {
+				mockRepo.On("FindAccessible", ctx, tt.userID, tt.taskID).
+					Return(task.NewTaskWithoutValidation(tt.taskID, "Test Task", tt.userID), nil)
+			}
+
+			mockRepo.On("Delete", ctx, tt.userID, tt.taskID, (*int64)(nil)).Return(tt.mockError)
+
+			// Act
+			err := controller.DeleteTask(ctx, tt.userID, tt.taskID, nil, false)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Len(t, publisher.Published(), tt.expectedEvents)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_DeleteTaskCascade(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+	subtaskID := task.GenerateTaskID()
+	version := int64(3)
+
+	mockRepo := &MockTaskRepository{}
+	publisher := &MockEventPublisher{}
+	controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
+	ctx := context.Background()
+
+	mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).
+		Return(task.NewTaskWithoutValidation(testTaskID, "Parent", testUserID), nil)
+	mockRepo.On("FindSubtasks", ctx, testUserID, []task.TaskID{testTaskID}).
+		Return([]*task.Task{task.NewTaskWithoutValidation(subtaskID, "Child", testUserID, task.WithParentID(&testTaskID))}, nil)
+	mockRepo.On("DeleteTree", ctx, testUserID, testTaskID, &version).Return(nil)
+
+	// Act
+	err := controller.DeleteTask(ctx, testUserID, testTaskID, &version, true)
+
+	// Assert
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	events := publisher.Published()
+	require.Len(t, events, 2)
+	assert.IsType(t, task.TaskDeleted{}, events[0])
+	assert.Equal(t, testTaskID, events[0].TaskID())
+	assert.Equal(t, subtaskID, events[1].TaskID())
+}
+
+func TestTaskController_GetSubtasks(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	parentID := task.GenerateTaskID()
+	childID := task.GenerateTaskID()
+	child := task.NewTaskWithoutValidation(childID, "Child", testUserID, task.WithParentID(&parentID))
+	grandchild := task.NewTaskWithoutValidation(task.GenerateTaskID(), "Grandchild", testUserID, task.WithParentID(&childID))
+
+	t.Run("subtasks are grouped by parent", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, testUserID, parentID).
+			Return(task.NewTaskWithoutValidation(parentID, "Parent", testUserID), nil)
+		mockRepo.On("FindSubtasks", ctx, testUserID, []task.TaskID{parentID}).
+			Return([]*task.Task{child, grandchild}, nil)
+
+		// Act
+		tree, err := controller.GetSubtasks(ctx, testUserID, parentID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []*task.Task{child}, tree.Children(parentID))
+		assert.Equal(t, []*task.Task{grandchild}, tree.Children(childID))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("missing task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, testUserID, parentID).Return(nil, task.ErrTaskNotFound)
+
+		// Act
+		tree, err := controller.GetSubtasks(ctx, testUserID, parentID)
+
+		// Assert
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+		assert.Nil(t, tree)
+		mockRepo.AssertNotCalled(t, "FindSubtasks", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTaskController_ExpandSubtasks(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	testUserID := user.GenerateUserID()
+	creatorID := user.GenerateUserID()
+	editor := task.RoleEditor
+	ownID := task.GenerateTaskID()
+	sharedID := task.GenerateTaskID()
+	own := task.NewTaskWithoutValidation(ownID, "Own", testUserID)
+	shared := task.NewTaskWithoutValidation(sharedID, "Shared", creatorID, task.WithSharedRole(&editor))
+	ownChild := task.NewTaskWithoutValidation(task.GenerateTaskID(), "Own child", testUserID, task.WithParentID(&ownID))
+	sharedChild := task.NewTaskWithoutValidation(task.GenerateTaskID(), "Shared child", creatorID, task.WithParentID(&sharedID))
+
+	mockRepo := &MockTaskRepository{}
+	controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+	ctx := context.Background()
+
+	mockRepo.On("FindSubtasks", ctx, testUserID, []task.TaskID{ownID}).Return([]*task.Task{ownChild}, nil)
+	mockRepo.On("FindSubtasks", ctx, creatorID, []task.TaskID{sharedID}).Return([]*task.Task{sharedChild}, nil)
+
+	// Act
+	tree, err := controller.ExpandSubtasks(ctx, testUserID, []*task.Task{own, shared})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []*task.Task{ownChild}, tree.Children(ownID))
+	assert.Equal(t, []*task.Task{sharedChild}, tree.Children(sharedID))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTaskController_AddDependency(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	blockerID := task.GenerateTaskID()
+
+	tests := []struct {
+		name          string
+		blockerID     task.TaskID
+		setupMock     func(repo *MockTaskRepository, ctx context.Context)
+		expectedError error
+	}{
+		{
+			name:      "dependency added",
+			blockerID: blockerID,
+			setupMock: func(repo *MockTaskRepository, ctx context.Context) {
+				dependency, _ := task.NewDependency(blockerID, taskID)
+				repo.On("FindAccessible", ctx, testUserID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Task", testUserID), nil)
+				repo.On("FindAccessible", ctx, testUserID, blockerID).Return(task.NewTaskWithoutValidation(blockerID, "Blocker", testUserID), nil)
+				repo.On("AddDependency", ctx, testUserID, dependency).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "cycle",
+			blockerID: blockerID,
+			setupMock: func(repo *MockTaskRepository, ctx context.Context) {
+				repo.On("FindAccessible", ctx, testUserID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Task", testUserID), nil)
+				repo.On("FindAccessible", ctx, testUserID, blockerID).Return(task.NewTaskWithoutValidation(blockerID, "Blocker", testUserID), nil)
+				repo.On("AddDependency", ctx, testUserID, mock.Anything).Return(task.ErrDependencyCycle)
+			},
+			expectedError: task.ErrDependencyCycle,
+		},
+		{
+			name:      "blocker not found",
+			blockerID: blockerID,
+			setupMock: func(repo *MockTaskRepository, ctx context.Context) {
+				repo.On("FindAccessible", ctx, testUserID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Task", testUserID), nil)
+				repo.On("FindAccessible", ctx, testUserID, blockerID).Return(nil, task.ErrTaskNotFound)
+			},
+			expectedError: task.ErrTaskNotFound,
+		},
+		{
+			name:          "task blocks itself",
+			blockerID:     taskID,
+			setupMock:     func(_ *MockTaskRepository, _ context.Context) {},
+			expectedError: task.ErrSelfDependency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			tt.setupMock(mockRepo, ctx)
+
+			// Act
+			err := controller.AddDependency(ctx, testUserID, taskID, tt.blockerID)
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedError)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_RemoveDependency(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	blockerID := task.GenerateTaskID()
+
+	t.Run("dependency removed", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		dependency, err := task.NewDependency(blockerID, taskID)
+		require.NoError(t, err)
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Task", testUserID), nil)
+		mockRepo.On("RemoveDependency", ctx, testUserID, dependency).Return(nil)
+
+		// Act
+		err = controller.RemoveDependency(ctx, testUserID, taskID, blockerID)
+
+		// Assert
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("task never blocks itself", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		// Act
+		err := controller.RemoveDependency(ctx, testUserID, taskID, taskID)
+
+		// Assert
+		assert.ErrorIs(t, err, task.ErrDependencyNotFound)
+		mockRepo.AssertNotCalled(t, "RemoveDependency", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTaskController_MoveTask(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	afterID := task.GenerateTaskID()
+	beforeID := task.GenerateTaskID()
+
+	tests := []struct {
+		name          string
+		afterID       *task.TaskID
+		beforeID      *task.TaskID
+		setupMock     func(repo *MockTaskRepository, ctx context.Context)
+		expectedError error
+	}{
+		{
+			name:     "task moved between two tasks",
+			afterID:  &afterID,
+			beforeID: &beforeID,
+			setupMock: func(repo *MockTaskRepository, ctx context.Context) {
+				placement, _ := task.NewPlacement(taskID, &afterID, &beforeID)
+				moved := task.NewTaskWithoutValidation(taskID, "Task", testUserID, task.WithRank("i"))
+				repo.On("FindAccessible", ctx, testUserID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Task", testUserID), nil).Once()
+				repo.On("Move", ctx, testUserID, taskID, placement).Return(moved, nil)
+				repo.On("FindAccessible", ctx, testUserID, taskID).Return(moved, nil).Once()
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "neighbour not found",
+			afterID:  &afterID,
+			beforeID: nil,
+			setupMock: func(repo *MockTaskRepository, ctx context.Context) {
+				repo.On("FindAccessible", ctx, testUserID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Task", testUserID), nil)
+				repo.On("Move", ctx, testUserID, taskID, mock.Anything).Return(nil, task.ErrNeighbourNotFound)
+			},
+			expectedError: task.ErrNeighbourNotFound,
+		},
+		{
+			name:          "no neighbour",
+			afterID:       nil,
+			beforeID:      nil,
+			setupMock:     func(_ *MockTaskRepository, _ context.Context) {},
+			expectedError: task.ErrPlacementWithoutNeighbour,
+		},
+		{
+			name:          "task placed after itself",
+			afterID:       &taskID,
+			beforeID:      nil,
+			setupMock:     func(_ *MockTaskRepository, _ context.Context) {},
+			expectedError: task.ErrInvalidPlacement,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			publisher := &MockEventPublisher{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
+			ctx := context.Background()
+
+			tt.setupMock(mockRepo, ctx)
+
+			// Act
+			result, err := controller.MoveTask(ctx, testUserID, taskID, tt.afterID, tt.beforeID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				assert.Empty(t, publisher.Published())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, task.Rank("i"), result.Rank())
+
+				events := publisher.Published()
+				require.Len(t, events, 1)
+				assert.IsType(t, task.TaskUpdated{}, events[0])
+				assert.Equal(t, taskID, events[0].TaskID())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_GetBlockers(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	blocker := task.NewTaskWithoutValidation(task.GenerateTaskID(), "Blocker", testUserID)
+
+	t.Run("blockers are returned", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).
+			Return(task.NewTaskWithoutValidation(taskID, "Blocked", testUserID, task.WithBlocked(true)), nil)
+		mockRepo.On("FindBlockers", ctx, testUserID, taskID).Return([]*task.Task{blocker}, nil)
+
+		// Act
+		blockers, err := controller.GetBlockers(ctx, testUserID, taskID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []*task.Task{blocker}, blockers)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("missing task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, testUserID, taskID).Return(nil, task.ErrTaskNotFound)
+
+		// Act
+		blockers, err := controller.GetBlockers(ctx, testUserID, taskID)
+
+		// Assert
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+		assert.Nil(t, blockers)
+		mockRepo.AssertNotCalled(t, "FindBlockers", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTaskController_SharedTaskAccess(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	collaboratorID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	title := "Renamed"
+
+	shared := func(role task.Role) *task.Task {
+		return task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithSharedRole(&role))
+	}
+
+	tests := []struct {
+		name          string
+		role          task.Role
+		callsUpdate   bool
+		expectedError error
+	}{
+		{
+			name:          "editor may change the task",
+			role:          task.RoleEditor,
+			callsUpdate:   true,
+			expectedError: nil,
+		},
+		{
+			name:          "viewer may not change the task",
+			role:          task.RoleViewer,
+			callsUpdate:   false,
+			expectedError: task.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			mockRepo.On("FindAccessible", ctx, collaboratorID, taskID).Return(shared(tt.role), nil)
+
+			if tt.callsUpdate {
+				mockRepo.On("Update", ctx, collaboratorID, mock.MatchedBy(func(taskEntity *task.Task) bool {
+					return taskEntity.Title() == title && taskEntity.UserID() == creatorID
+				})).Return(shared(tt.role), nil)
+			}
+
+			// Act
+			_, err := controller.UpdateTask(ctx, collaboratorID, taskID, TaskUpdate{Title: &title}, nil)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("viewer may read the task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		mockRepo.On("FindAccessible", ctx, collaboratorID, taskID).Return(shared(task.RoleViewer), nil)
+
+		// Act
+		result, err := controller.GetTaskById(ctx, collaboratorID, taskID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, result.IsShared())
+	})
+}
+
+func TestTaskController_AddCollaborator(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	collaboratorID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	editor := task.RoleEditor
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		collaborator  user.UserID
+		role          string
+		existing      *task.Task
+		callsRepo     bool
+		expectedError error
+	}{
+		{
+			name:          "creator shares the task",
+			userID:        creatorID,
+			collaborator:  collaboratorID,
+			role:          "viewer",
+			existing:      task.NewTaskWithoutValidation(taskID, "Task", creatorID),
+			callsRepo:     true,
+			expectedError: nil,
+		},
+		{
+			name:          "unknown role",
+			userID:        creatorID,
+			collaborator:  collaboratorID,
+			role:          "owner",
+			existing:      nil,
+			callsRepo:     false,
+			expectedError: task.ErrInvalidRole,
+		},
+		{
+			name:          "creator cannot be a collaborator",
+			userID:        creatorID,
+			collaborator:  creatorID,
+			role:          "editor",
+			existing:      task.NewTaskWithoutValidation(taskID, "Task", creatorID),
+			callsRepo:     false,
+			expectedError: task.ErrShareWithCreator,
+		},
+		{
+			name:          "editor may not share the task",
+			userID:        collaboratorID,
+			collaborator:  user.GenerateUserID(),
+			role:          "viewer",
+			existing:      task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithSharedRole(&editor)),
+			callsRepo:     false,
+			expectedError: task.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			if tt.existing != nil {
+				mockRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.existing, nil)
+			}
+
+			if tt.callsRepo {
+				mockRepo.On("AddCollaborator", ctx, mock.MatchedBy(func(collaborator task.Collaborator) bool {
+					return collaborator.TaskID() == taskID && collaborator.UserID() == tt.collaborator &&
+						string(collaborator.Role()) == tt.role
+				})).Return(nil)
+			}
+
+			// Act
+			collaborator, err := controller.AddCollaborator(ctx, tt.userID, taskID, tt.collaborator, tt.role)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				mockRepo.AssertNotCalled(t, "AddCollaborator", mock.Anything, mock.Anything)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.collaborator, collaborator.UserID())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_RemoveCollaborator(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	collaboratorID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	viewer := task.RoleViewer
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		collaborator  user.UserID
+		existing      *task.Task
+		callsRepo     bool
+		expectedError error
+	}{
+		{
+			name:          "creator removes a collaborator",
+			userID:        creatorID,
+			collaborator:  collaboratorID,
+			existing:      task.NewTaskWithoutValidation(taskID, "Task", creatorID),
+			callsRepo:     true,
+			expectedError: nil,
+		},
+		{
+			name:          "collaborator leaves the task",
+			userID:        collaboratorID,
+			collaborator:  collaboratorID,
+			existing:      task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithSharedRole(&viewer)),
+			callsRepo:     true,
+			expectedError: nil,
+		},
+		{
+			name:          "collaborator may not remove others",
+			userID:        collaboratorID,
+			collaborator:  user.GenerateUserID(),
+			existing:      task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithSharedRole(&viewer)),
+			callsRepo:     false,
+			expectedError: task.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			mockRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.existing, nil)
+
+			if tt.callsRepo {
+				mockRepo.On("RemoveCollaborator", ctx, taskID, tt.collaborator).Return(nil)
+			}
+
+			// Act
+			err := controller.RemoveCollaborator(ctx, tt.userID, taskID, tt.collaborator)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				mockRepo.AssertNotCalled(t, "RemoveCollaborator", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_GetAudience(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	collaboratorID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+
+	collaborator, err := task.NewCollaborator(taskID, collaboratorID, task.RoleEditor)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		existing         *task.Task
+		findError        error
+		expectedAudience []user.UserID
+	}{
+		{
+			name:             "assignee is a collaborator",
+			existing:         task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithAssigneeID(&collaboratorID)),
+			findError:        nil,
+			expectedAudience: []user.UserID{creatorID, collaboratorID},
+		},
+		{
+			name:             "task in the trash",
+			existing:         nil,
+			findError:        task.ErrTaskNotFound,
+			expectedAudience: []user.UserID{creatorID, collaboratorID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			mockRepo.On("FindCollaborators", ctx, taskID).Return([]task.Collaborator{collaborator}, nil)
+			mockRepo.On("FindAccessible", ctx, creatorID, taskID).Return(tt.existing, tt.findError)
+
+			// Act
+			audience, err := controller.GetAudience(ctx, creatorID, taskID)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAudience, audience)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_AssignTask(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	collaboratorID := user.GenerateUserID()
+	strangerID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	editor := task.RoleEditor
+	viewer := task.RoleViewer
+
+	collaborator, err := task.NewCollaborator(taskID, collaboratorID, task.RoleEditor)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		userID             user.UserID
+		assigneeID         *user.UserID
+		existing           *task.Task
+		readsCollaborators bool
+		callsRepo          bool
+		expectedError      error
+	}{
+		{
+			name:               "creator assigns a collaborator",
+			userID:             creatorID,
+			assigneeID:         &collaboratorID,
+			existing:           task.NewTaskWithoutValidation(taskID, "Task", creatorID),
+			readsCollaborators: true,
+			callsRepo:          true,
+			expectedError:      nil,
+		},
+		{
+			name:               "editor assigns the creator",
+			userID:             collaboratorID,
+			assigneeID:         &creatorID,
+			existing:           task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithSharedRole(&editor)),
+			readsCollaborators: false,
+			callsRepo:          true,
+			expectedError:      nil,
+		},
+		{
+			name:               "task is unassigned",
+			userID:             creatorID,
+			assigneeID:         nil,
+			existing:           task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithAssigneeID(&creatorID)),
+			readsCollaborators: false,
+			callsRepo:          true,
+			expectedError:      nil,
+		},
+		{
+			name:               "current assignee is assigned again",
+			userID:             creatorID,
+			assigneeID:         &collaboratorID,
+			existing:           task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithAssigneeID(&collaboratorID)),
+			readsCollaborators: false,
+			callsRepo:          false,
+			expectedError:      nil,
+		},
+		{
+			name:               "assignee without access",
+			userID:             creatorID,
+			assigneeID:         &strangerID,
+			existing:           task.NewTaskWithoutValidation(taskID, "Task", creatorID),
+			readsCollaborators: true,
+			callsRepo:          false,
+			expectedError:      task.ErrAssigneeWithoutAccess,
+		},
+		{
+			name:               "viewer may not reassign the task",
+			userID:             collaboratorID,
+			assigneeID:         &collaboratorID,
+			existing:           task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithSharedRole(&viewer)),
+			readsCollaborators: false,
+			callsRepo:          false,
+			expectedError:      task.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			publisher := &MockEventPublisher{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
+			ctx := context.Background()
+
+			mockRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.existing, nil)
+
+			if tt.readsCollaborators {
+				mockRepo.On("FindCollaborators", ctx, taskID).Return([]task.Collaborator{collaborator}, nil)
+			}
+
+			if tt.callsRepo {
+				mockRepo.On("Assign", ctx, tt.existing, mock.MatchedBy(func(assignment task.Assignment) bool {
+					return assignment.TaskID() == taskID && assignment.AssignedBy() == tt.userID &&
+						assert.ObjectsAreEqual(tt.assigneeID, assignment.AssigneeID())
+				})).Return(tt.existing, nil)
+			}
+
+			// Act
+			result, err := controller.AssignTask(ctx, tt.userID, taskID, tt.assigneeID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.assigneeID, result.AssigneeID())
+			}
+
+			if tt.callsRepo {
+				require.Len(t, publisher.Published(), 1)
+				assert.IsType(t, task.TaskUpdated{}, publisher.Published()[0])
+			} else {
+				mockRepo.AssertNotCalled(t, "Assign", mock.Anything, mock.Anything, mock.Anything)
+				assert.Empty(t, publisher.Published())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_GetHistory(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	collaboratorID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	viewer := task.RoleViewer
+
+	page, err := task.NewPageRequest(10, "", nil)
+	require.NoError(t, err)
+
+	created := task.CreatedChange(task.NewTaskWithoutValidation(taskID, "Task", creatorID), creatorID, time.Now())
+	history := task.HistoryPage{Changes: []task.Change{created}, NextCursor: "next"}
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		existing      *task.Task
+		findError     error
+		callsRepo     bool
+		expectedError error
+	}{
+		{
+			name:          "creator reads the history",
+			userID:        creatorID,
+			existing:      task.NewTaskWithoutValidation(taskID, "Task", creatorID),
+			findError:     nil,
+			callsRepo:     true,
+			expectedError: nil,
+		},
+		{
+			name:          "viewer reads the history",
+			userID:        collaboratorID,
+			existing:      task.NewTaskWithoutValidation(taskID, "Task", creatorID, task.WithSharedRole(&viewer)),
+			findError:     nil,
+			callsRepo:     true,
+			expectedError: nil,
+		},
+		{
+			name:          "task without access",
+			userID:        collaboratorID,
+			existing:      nil,
+			findError:     task.ErrTaskNotFound,
+			callsRepo:     false,
+			expectedError: task.ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			mockRepo.On("FindAccessible", ctx, tt.userID, taskID).Return(tt.existing, tt.findError)
+
+			if tt.callsRepo {
+				mockRepo.On("FindHistory", ctx, taskID, page).Return(history, nil)
+			}
+
+			// Act
+			result, err := controller.GetHistory(ctx, tt.userID, taskID, page)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Empty(t, result.Changes)
+				mockRepo.AssertNotCalled(t, "FindHistory", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, history, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_GetTrash(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	deletedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		mockReturn    []*task.Task
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:   "tasks in the trash",
+			userID: testUserID,
+			mockReturn: []*task.Task{
+				task.NewTaskWithoutValidation(task.GenerateTaskID(), "Deleted task", testUserID, task.WithDeletedAt(&deletedAt)),
+			},
+			mockError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "empty trash",
+			userID:        testUserID,
+			mockReturn:    []*task.Task{},
+			mockError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "repository error",
+			userID:        testUserID,
+			mockReturn:    nil,
+			mockError:     errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+		{
+			name:          "empty user ID",
+			userID:        user.UserID{},
+			mockReturn:    nil,
+			mockError:     nil,
+			expectedError: user.ErrUserIDEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			if !tt.userID.IsEmpty() {
+				mockRepo.On("FindTrashByUserID", ctx, tt.userID).Return(tt.mockReturn, tt.mockError)
+			}
+
+			// Act
+			result, err := controller.GetTrash(ctx, tt.userID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockReturn, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_RestoreTask(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+	restored := task.NewTaskWithoutValidation(testTaskID, "Restored task", testUserID, task.WithVersion(3))
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		taskID        task.TaskID
+		mockReturn    *task.Task
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:          "successful restore",
+			userID:        testUserID,
+			taskID:        testTaskID,
+			mockReturn:    restored,
+			mockError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "task not in the trash",
+			userID:        testUserID,
+			taskID:        testTaskID,
+			mockReturn:    nil,
+			mockError:     task.ErrTaskNotFound,
+			expectedError: task.ErrTaskNotFound,
+		},
+		{
+			name:          "empty user ID",
+			userID:        user.UserID{},
+			taskID:        testTaskID,
+			mockReturn:    nil,
+			mockError:     nil,
+			expectedError: user.ErrUserIDEmpty,
+		},
+		{
+			name:          "empty task ID",
+			userID:        testUserID,
+			taskID:        task.TaskID{},
+			mockReturn:    nil,
+			mockError:     nil,
+			expectedError: task.ErrTaskIDEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			publisher := &MockEventPublisher{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
+			ctx := context.Background()
+
+			if !tt.userID.IsEmpty() && !tt.taskID.IsEmpty() {
+				mockRepo.On("Restore", ctx, tt.userID, tt.taskID).Return(tt.mockReturn, tt.mockError)
+			}
+
+			// Act
+			result, err := controller.RestoreTask(ctx, tt.userID, tt.taskID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				assert.Empty(t, publisher.Published())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockReturn, result)
+
+				events := publisher.Published()
+				require.Len(t, events, 1)
+				assert.IsType(t, task.TaskUpdated{}, events[0])
+				assert.Equal(t, tt.taskID, events[0].TaskID())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_EmptyTrash(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	deletedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		userID         user.UserID
+		mockError      error
+		expectedError  error
+		expectedEvents int
+	}{
+		{
+			name:           "successful empty",
+			userID:         testUserID,
+			mockError:      nil,
+			expectedError:  nil,
+			expectedEvents: 2,
+		},
+		{
+			name:           "repository error",
+			userID:         testUserID,
+			mockError:      errors.New("database error"),
+			expectedError:  errors.New("database error"),
+			expectedEvents: 0,
+		},
+		{
+			name:           "empty user ID",
+			userID:         user.UserID{},
+			mockError:      nil,
+			expectedError:  user.ErrUserIDEmpty,
+			expectedEvents: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			publisher := &MockEventPublisher{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
+			ctx := context.Background()
+
+			if tt.mockError != nil {
+				mockRepo.On("EmptyTrash", ctx, tt.userID).Return([]*task.Task{}, tt.mockError)
+			} else if !tt.userID.IsEmpty() {
+				mockRepo.On("EmptyTrash", ctx, tt.userID).Return([]*task.Task{
+					task.NewTaskWithoutValidation(task.GenerateTaskID(), "Old task", tt.userID, task.WithDeletedAt(&deletedAt)),
+					task.NewTaskWithoutValidation(task.GenerateTaskID(), "Older task", tt.userID, task.WithDeletedAt(&deletedAt)),
+				}, nil)
+			}
+
+			// Act
+			err := controller.EmptyTrash(ctx, tt.userID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			events := publisher.Published()
+			require.Len(t, events, tt.expectedEvents)
+
+			for _, event := range events {
+				assert.IsType(t, task.TaskDeleted{}, event)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_UpdateTask(t *testing.T) {
+	t.Parallel()
+
+	// Generate UUIDs for consistent test data
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+	completedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	title := func(s string) *string { return &s }
+	completed := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name              string
+		userID            user.UserID
+		taskID            task.TaskID
+		existing          *task.Task
+		findError         error
+		update            TaskUpdate
+		mockError         error
+		expectedTitle     string
+		expectedCompleted bool
+		expectedEvents    []string
+		expectedError     error
+	}{
+		{
+			name:              "successful title update",
+			userID:            testUserID,
+			taskID:            testTaskID,
+			existing:          task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID),
+			update:            TaskUpdate{Title: title("Updated Task")},
+			expectedTitle:     "Updated Task",
+			expectedCompleted: false,
+			expectedEvents:    []string{"task.title_changed", "task.updated"},
+		},
+		{
+			name:              "unchanged title records no event",
+			userID:            testUserID,
+			taskID:            testTaskID,
+			existing:          task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID),
+			update:            TaskUpdate{Title: title("Original Task")},
+			expectedTitle:     "Original Task",
+			expectedCompleted: false,
+		},
+		{
+			name:              "mark task as completed",
+			userID:            testUserID,
+			taskID:            testTaskID,
+			existing:          task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID),
+			update:            TaskUpdate{Completed: completed(true)},
+			expectedTitle:     "Original Task",
+			expectedCompleted: true,
+			expectedEvents:    []string{"task.updated"},
+		},
+		{
+			name:              "reopen completed task",
+			userID:            testUserID,
+			taskID:            testTaskID,
+			existing:          task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID, task.WithCompletedAt(&completedAt)),
+			update:            TaskUpdate{Completed: completed(false)},
+			expectedTitle:     "Original Task",
+			expectedCompleted: false,
+			expectedEvents:    []string{"task.updated"},
+		},
+		{
+			name:              "completing an already completed task is a no-op",
+			userID:            testUserID,
+			taskID:            testTaskID,
+			existing:          task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID, task.WithCompletedAt(&completedAt)),
+			update:            TaskUpdate{Completed: completed(true)},
+			expectedTitle:     "Original Task",
+			expectedCompleted: true,
+		},
+		{
+			name:          "empty title should fail validation",
+			userID:        testUserID,
+			taskID:        testTaskID,
+			existing:      task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID),
+			update:        TaskUpdate{Title: title("")},
+			expectedError: task.ErrTitleEmpty,
+		},
+		{
+			name:          "title too long should fail validation",
+			userID:        testUserID,
+			taskID:        testTaskID,
+			existing:      task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID),
+			update:        TaskUpdate{Title: title(strings.Repeat("a", task.MaxTitleLength+1))},
+			expectedError: task.ErrTitleTooLong,
+		},
+		{
+			name:          "task not found",
+			userID:        testUserID,
+			taskID:        testTaskID,
+			findError:     task.ErrTaskNotFound,
+			update:        TaskUpdate{Title: title("Valid Title")},
+			expectedError: task.ErrTaskNotFound,
+		},
+		{
+			name:          "repository error",
+			userID:        testUserID,
+			taskID:        testTaskID,
+			existing:      task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID),
+			update:        TaskUpdate{Title: title("Valid Title")},
+			mockError:     errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			publisher := &MockEventPublisher{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
+			ctx := context.Background()
+
+			mockRepo.On("FindAccessible", ctx, tt.userID, tt.taskID).Return(tt.existing, tt.findError)
+
+			// Only set up the Update expectation if the change passes validation
+			if tt.findError == nil && tt.expectedError != task.ErrTitleEmpty && tt.expectedError != task.ErrTitleTooLong {
+				// The controller mutates the loaded task in place before saving it
+				var mockReturn *task.Task
+				if tt.mockError == nil {
+					mockReturn = tt.existing
+				}
+
+				mockRepo.On("Update", ctx, testUserID, tt.existing).Return(mockReturn, tt.mockError)
+			}
+
+			// Act
+			result, err := controller.UpdateTask(ctx, tt.userID, tt.taskID, tt.update, nil)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.taskID, result.ID())
+				assert.Equal(t, tt.expectedTitle, result.Title())
+				assert.Equal(t, tt.userID, result.UserID())
+				assert.Equal(t, tt.expectedCompleted, result.IsCompleted())
+			}
+
+			var published []string
+			for _, event := range publisher.Published() {
+				published = append(published, event.Name())
+			}
+
+			assert.Equal(t, tt.expectedEvents, published)
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_UpdateTaskVersion(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+	newTitle := "Updated Task"
+
+	version := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name            string
+		expectedVersion *int64
+		expectUpdate    bool
+		updateError     error
+		expectedError   error
+	}{
+		{
+			name:            "matching version",
+			expectedVersion: version(3),
+			expectUpdate:    true,
+			updateError:     nil,
+			expectedError:   nil,
+		},
+		{
+			name:            "stale version",
+			expectedVersion: version(2),
+			expectUpdate:    false,
+			updateError:     nil,
+			expectedError:   task.ErrVersionMismatch,
+		},
+		{
+			name:            "concurrent change detected by the repository",
+			expectedVersion: nil,
+			expectUpdate:    true,
+			updateError:     task.ErrVersionMismatch,
+			expectedError:   task.ErrVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			existing := task.NewTaskWithoutValidation(testTaskID, "Original Task", testUserID, task.WithVersion(3))
+			mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+
+			if tt.expectUpdate {
+				var updated *task.Task
+				if tt.updateError == nil {
+					updated = task.NewTaskWithoutValidation(testTaskID, newTitle, testUserID, task.WithVersion(4))
+				}
+
+				mockRepo.On("Update", ctx, testUserID, existing).Return(updated, tt.updateError)
+			}
+
+			// Act
+			result, err := controller.UpdateTask(ctx, testUserID, testTaskID, TaskUpdate{Title: &newTitle}, tt.expectedVersion)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, int64(4), result.Version())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_UpdateTaskSchedule(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+	start := time.Date(2024, 1, 18, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 1, 20, 17, 0, 0, 0, time.UTC)
+
+	t.Run("fields not in the update keep their current value", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		existing := task.NewTaskWithoutValidation(testTaskID, "Task", testUserID,
+			task.WithSchedule(task.NewScheduleWithoutValidation(&start, &due, false)))
+		newDue := due.Add(48 * time.Hour)
+
+		mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+		mockRepo.On("Update", ctx, testUserID, existing).Return(existing, nil)
+
+		// Act
+		result, err := controller.UpdateTask(ctx, testUserID, testTaskID, TaskUpdate{DueAt: &newDue}, nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, start, *result.Schedule().StartAt())
+		assert.Equal(t, newDue, *result.Schedule().DueAt())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("start moved after the current due date should fail validation", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		existing := task.NewTaskWithoutValidation(testTaskID, "Task", testUserID,
+			task.WithSchedule(task.NewScheduleWithoutValidation(&start, &due, false)))
+		newStart := due.Add(time.Hour)
+
+		mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+
+		// Act
+		result, err := controller.UpdateTask(ctx, testUserID, testTaskID, TaskUpdate{StartAt: &newStart}, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, task.ErrStartAfterDue)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+	t.Run("clearing a date removes it", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		existing := task.NewTaskWithoutValidation(testTaskID, "Task", testUserID,
+			task.WithSchedule(task.NewScheduleWithoutValidation(&start, &due, false)))
+
+		mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+		mockRepo.On("Update", ctx, testUserID, existing).Return(existing, nil)
+
+		// Act
+		result, err := controller.UpdateTask(ctx, testUserID, testTaskID, TaskUpdate{ClearStartAt: true, DueAt: &start, ClearDueAt: true}, nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, result.Schedule().StartAt())
+		assert.Nil(t, result.Schedule().DueAt())
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestTaskController_UpdateTaskRecurring(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+	due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+
+	newRecurringTask := func(t *testing.T, rule string) *task.Task {
+		t.Helper()
+
+		taskEntity := task.NewTaskWithoutValidation(testTaskID, "Weekly report", testUserID,
+			task.WithSchedule(task.NewScheduleWithoutValidation(nil, &due, false)))
+		require.NoError(t, taskEntity.Repeat(task.GenerateSeriesID(), rule, "UTC"))
+
+		return taskEntity
+	}
+
+	t.Run("completing an occurrence creates the next one", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		existing := newRecurringTask(t, "FREQ=WEEKLY")
+		completed := true
+
+		mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+		mockRepo.On("CompleteOccurrence", ctx, testUserID, existing, mock.MatchedBy(func(next *task.Task) bool {
+			return next != nil && next.Schedule().DueAt().Equal(due.AddDate(0, 0, 7)) && next.Series() == existing.Series()
+		})).Return(existing, nil)
+
+		// Act
+		result, err := controller.UpdateTask(ctx, testUserID, testTaskID, TaskUpdate{Completed: &completed}, nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, result.IsCompleted())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("completing the last occurrence creates none", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		existing := newRecurringTask(t, "FREQ=WEEKLY;COUNT=1")
+		completed := true
+
+		mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+		mockRepo.On("CompleteOccurrence", ctx, testUserID, existing, (*task.Task)(nil)).Return(existing, nil)
+
+		// Act
+		_, err := controller.UpdateTask(ctx, testUserID, testTaskID, TaskUpdate{Completed: &completed}, nil)
+
+		// Assert
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("other changes only touch the occurrence", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		mockRepo := &MockTaskRepository{}
+		controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+		ctx := context.Background()
+
+		existing := newRecurringTask(t, "FREQ=WEEKLY")
+		title := "This week's report"
+
+		mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+		mockRepo.On("Update", ctx, testUserID, existing).Return(existing, nil)
+
+		// Act
+		result, err := controller.UpdateTask(ctx, testUserID, testTaskID, TaskUpdate{Title: &title}, nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, title, result.Title())
+		assert.Equal(t, "Weekly report", result.Series().Title())
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestTaskController_UpdateSeries(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+	due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+	title := "Team report"
+
+	tests := []struct {
+		name          string
+		recurring     bool
+		update        SeriesUpdate
+		expectUpdate  bool
+		expectedError error
+	}{
+		{
+			name:          "rename and change rule",
+			recurring:     true,
+			update:        SeriesUpdate{Title: &title, Recurrence: &TaskRecurrence{Rule: "FREQ=DAILY", Timezone: "UTC"}},
+			expectUpdate:  true,
+			expectedError: nil,
+		},
+		{
+			name:          "invalid time zone",
+			recurring:     true,
+			update:        SeriesUpdate{Title: nil, Recurrence: &TaskRecurrence{Rule: "FREQ=DAILY", Timezone: "Nowhere/City"}},
+			expectUpdate:  false,
+			expectedError: task.ErrInvalidTimezone,
+		},
+		{
+			name:          "task does not recur",
+			recurring:     false,
+			update:        SeriesUpdate{Title: &title, Recurrence: nil},
+			expectUpdate:  false,
+			expectedError: task.ErrNotRecurring,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			publisher := &MockEventPublisher{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, publisher)
+			ctx := context.Background()
+
+			existing := task.NewTaskWithoutValidation(testTaskID, "Weekly report", testUserID,
+				task.WithSchedule(task.NewScheduleWithoutValidation(nil, &due, false)))
+			if tt.recurring {
+				require.NoError(t, existing.Repeat(task.GenerateSeriesID(), "FREQ=WEEKLY", "UTC"))
+				existing.PullEvents()
+			}
+
+			mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+
+			if tt.expectUpdate {
+				mockRepo.On("UpdateSeries", ctx, existing.Series()).Return(nil)
+			}
+
+			// Act
+			result, err := controller.UpdateSeries(ctx, testUserID, testTaskID, tt.update)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				assert.Empty(t, publisher.Published())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, title, result.Series().Title())
+				assert.Equal(t, "FREQ=DAILY", result.Series().Recurrence().Rule())
+
+				events := publisher.Published()
+				require.Len(t, events, 1)
+				assert.IsType(t, task.TaskUpdated{}, events[0])
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskController_GetOccurrences(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testTaskID := task.GenerateTaskID()
+	due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		limit         int
+		expectFind    bool
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name:          "default limit",
+			limit:         0,
+			expectFind:    true,
+			expectedCount: task.DefaultOccurrenceLimit,
+			expectedError: nil,
+		},
+		{
+			name:          "explicit limit",
+			limit:         2,
+			expectFind:    true,
+			expectedCount: 2,
+			expectedError: nil,
+		},
+		{
+			name:          "limit too large",
+			limit:         task.MaxOccurrenceLimit + 1,
+			expectFind:    false,
+			expectedCount: 0,
+			expectedError: task.ErrInvalidOccurrenceLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockTaskRepository{}
+			controller := NewTask(mockRepo, &MockTagRepository{}, &MockProjectRepository{}, &MockEventPublisher{})
+			ctx := context.Background()
+
+			existing := task.NewTaskWithoutValidation(testTaskID, "Daily standup", testUserID,
+				task.WithSchedule(task.NewScheduleWithoutValidation(nil, &due, false)))
+			require.NoError(t, existing.Repeat(task.GenerateSeriesID(), "FREQ=DAILY", "UTC"))
+
+			if tt.expectFind {
+				mockRepo.On("FindAccessible", ctx, testUserID, testTaskID).Return(existing, nil)
+			}
+
+			// Act
+			schedules, err := controller.GetOccurrences(ctx, testUserID, testTaskID, tt.limit)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedError)
+			assert.Len(t, schedules, tt.expectedCount)
+
+			if tt.expectedCount > 0 {
+				assert.Equal(t, due.AddDate(0, 0, 1), *schedules[0].DueAt())
 			}
 
 			mockRepo.AssertExpectations(t)
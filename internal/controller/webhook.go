@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+	"golang.org/x/net/context"
+)
+
+// Webhook represents the webhook controller that handles business logic for webhook operations.
+type Webhook struct {
+	webhookRepo webhook.WebhookRepository
+}
+
+// NewWebhook creates a new Webhook controller with the provided repository.
+func NewWebhook(webhookRepo webhook.WebhookRepository) *Webhook {
+	return &Webhook{
+		webhookRepo: webhookRepo,
+	}
+}
+
+// WebhookCreate holds the values of a webhook created by CreateWebhook.
+// No EventTypes subscribes the webhook to all events.
+type WebhookCreate struct {
+	URL        string
+	Secret     string
+	EventTypes []webhook.EventType
+}
+
+// WebhookUpdate holds the changes applied by UpdateWebhook.
+// A nil field leaves the corresponding value of the webhook unchanged.
+// EventTypes replaces all event types of the webhook. Active enables or
+// disables the webhook; enabling it also forgets its failed attempts.
+type WebhookUpdate struct {
+	URL        *string
+	Secret     *string
+	EventTypes *[]webhook.EventType
+	Active     *bool
+}
+
+// GetAllWebhooks retrieves all webhooks of the given user, oldest first.
+// It returns an empty slice if the user has no webhooks.
+func (w *Webhook) GetAllWebhooks(ctx context.Context, userID user.UserID) ([]*webhook.Webhook, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	webhooks, err := w.webhookRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// GetWebhookById retrieves a specific webhook by its ID for the given user.
+func (w *Webhook) GetWebhookById(ctx context.Context, userID user.UserID, id webhook.WebhookID) (*webhook.Webhook, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, webhook.ErrWebhookIDEmpty
+	}
+
+	webhookItem, err := w.webhookRepo.FindById(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookItem, nil
+}
+
+// CreateWebhook creates a new active webhook for the given user.
+func (w *Webhook) CreateWebhook(ctx context.Context, userID user.UserID, input WebhookCreate) (*webhook.Webhook, error) {
+	webhookEntity, err := webhook.NewWebhook(webhook.GenerateWebhookID(), userID, input.URL, input.Secret, input.EventTypes, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	webhookItem, err := w.webhookRepo.Create(ctx, webhookEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookItem, nil
+}
+
+// UpdateWebhook applies the changes in input to a webhook of the given user.
+func (w *Webhook) UpdateWebhook(ctx context.Context, userID user.UserID, id webhook.WebhookID, input WebhookUpdate) (*webhook.Webhook, error) {
+	webhookEntity, err := w.GetWebhookById(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.URL != nil {
+		if err := webhookEntity.ChangeURL(*input.URL); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Secret != nil {
+		if err := webhookEntity.ChangeSecret(*input.Secret); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.EventTypes != nil {
+		if err := webhookEntity.Subscribe(*input.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Active != nil {
+		if *input.Active {
+			webhookEntity.Enable()
+		} else {
+			webhookEntity.Disable(time.Now())
+		}
+	}
+
+	webhookItem, err := w.webhookRepo.Update(ctx, webhookEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookItem, nil
+}
+
+// DeleteWebhook removes a webhook of the given user together with its deliveries.
+func (w *Webhook) DeleteWebhook(ctx context.Context, userID user.UserID, id webhook.WebhookID) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return webhook.ErrWebhookIDEmpty
+	}
+
+	return w.webhookRepo.Delete(ctx, userID, id)
+}
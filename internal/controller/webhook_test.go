@@ -0,0 +1,390 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookSecret = "0123456789abcdef"
+
+// MockWebhookRepository implements webhook.WebhookRepository for testing
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) FindById(ctx context.Context, ownerID user.UserID, id webhook.WebhookID) (*webhook.Webhook, error) {
+	args := m.Called(ctx, ownerID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*webhook.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*webhook.Webhook, error) {
+	args := m.Called(ctx, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*webhook.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, webhookEntity *webhook.Webhook) (*webhook.Webhook, error) {
+	args := m.Called(ctx, webhookEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*webhook.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, webhookEntity *webhook.Webhook) (*webhook.Webhook, error) {
+	args := m.Called(ctx, webhookEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*webhook.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, ownerID user.UserID, id webhook.WebhookID) error {
+	args := m.Called(ctx, ownerID, id)
+
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) DispatchEvents(ctx context.Context, limit int) (int, error) {
+	args := m.Called(ctx, limit)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]webhook.PendingDelivery, error) {
+	args := m.Called(ctx, now, lease, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]webhook.PendingDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepository) RecordAttempt(ctx context.Context, delivery *webhook.Delivery, attempt webhook.Attempt, policy webhook.RetryPolicy) error {
+	args := m.Called(ctx, delivery, attempt, policy)
+
+	return args.Error(0)
+}
+
+func TestWebhookController_GetAllWebhooks(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	webhooks := []*webhook.Webhook{
+		webhook.NewWebhookWithoutValidation(webhook.GenerateWebhookID(), testUserID, "https://example.com/hooks", testWebhookSecret, nil, time.Now()),
+	}
+
+	tests := []struct {
+		name             string
+		userID           user.UserID
+		mockReturn       []*webhook.Webhook
+		mockError        error
+		expectedWebhooks []*webhook.Webhook
+		expectedError    error
+	}{
+		{
+			name:             "successful retrieval",
+			userID:           testUserID,
+			mockReturn:       webhooks,
+			mockError:        nil,
+			expectedWebhooks: webhooks,
+			expectedError:    nil,
+		},
+		{
+			name:             "empty user ID",
+			userID:           user.UserID{},
+			mockReturn:       nil,
+			mockError:        nil,
+			expectedWebhooks: nil,
+			expectedError:    user.ErrUserIDEmpty,
+		},
+		{
+			name:             "repository error",
+			userID:           testUserID,
+			mockReturn:       nil,
+			mockError:        errors.New("database error"),
+			expectedWebhooks: nil,
+			expectedError:    errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockWebhookRepository{}
+			controller := NewWebhook(mockRepo)
+			ctx := context.Background()
+
+			if !tt.userID.IsEmpty() {
+				mockRepo.On("FindAllByUserID", ctx, tt.userID).Return(tt.mockReturn, tt.mockError)
+			}
+
+			// Act
+			result, err := controller.GetAllWebhooks(ctx, tt.userID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedWebhooks, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookController_CreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+
+	tests := []struct {
+		name          string
+		input         WebhookCreate
+		callsRepo     bool
+		expectedURL   string
+		expectedTypes []webhook.EventType
+		expectedError error
+	}{
+		{
+			name: "successful creation normalizes the input",
+			input: WebhookCreate{
+				URL:        " https://example.com/hooks ",
+				Secret:     testWebhookSecret,
+				EventTypes: []webhook.EventType{"task.deleted", "task.created"},
+			},
+			callsRepo:     true,
+			expectedURL:   "https://example.com/hooks",
+			expectedTypes: []webhook.EventType{"task.created", "task.deleted"},
+			expectedError: nil,
+		},
+		{
+			name:          "invalid URL should fail validation",
+			input:         WebhookCreate{URL: "ftp://example.com", Secret: testWebhookSecret, EventTypes: nil},
+			callsRepo:     false,
+			expectedError: webhook.ErrInvalidURL,
+		},
+		{
+			name:          "short secret should fail validation",
+			input:         WebhookCreate{URL: "https://example.com", Secret: "secret", EventTypes: nil},
+			callsRepo:     false,
+			expectedError: webhook.ErrSecretTooShort,
+		},
+		{
+			name:          "unknown event type should fail validation",
+			input:         WebhookCreate{URL: "https://example.com", Secret: testWebhookSecret, EventTypes: []webhook.EventType{"task.renamed"}},
+			callsRepo:     false,
+			expectedError: webhook.ErrInvalidEventType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockWebhookRepository{}
+			controller := NewWebhook(mockRepo)
+			ctx := context.Background()
+
+			if tt.callsRepo {
+				mockRepo.On("Create", ctx, mock.MatchedBy(func(webhookEntity *webhook.Webhook) bool {
+					return webhookEntity.OwnerID() == testUserID && webhookEntity.URL() == tt.expectedURL && webhookEntity.IsActive()
+				})).Return(webhook.NewWebhookWithoutValidation(webhook.GenerateWebhookID(), testUserID, tt.expectedURL, tt.input.Secret, tt.expectedTypes, time.Now()), nil)
+			}
+
+			// Act
+			result, err := controller.CreateWebhook(ctx, testUserID, tt.input)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedURL, result.URL())
+				assert.Equal(t, tt.expectedTypes, result.EventTypes())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookController_UpdateWebhook(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testWebhookID := webhook.GenerateWebhookID()
+	newURL := "https://example.org/hooks"
+	shortSecret := "secret"
+	active := true
+	inactive := false
+	disabledAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          WebhookUpdate
+		findError      error
+		callsUpdate    bool
+		expectedURL    string
+		expectedActive bool
+		expectedError  error
+	}{
+		{
+			name:           "URL changed",
+			input:          WebhookUpdate{URL: &newURL, Secret: nil, EventTypes: nil, Active: nil},
+			findError:      nil,
+			callsUpdate:    true,
+			expectedURL:    newURL,
+			expectedActive: false,
+			expectedError:  nil,
+		},
+		{
+			name:           "re-enabled",
+			input:          WebhookUpdate{URL: nil, Secret: nil, EventTypes: nil, Active: &active},
+			findError:      nil,
+			callsUpdate:    true,
+			expectedURL:    "https://example.com/hooks",
+			expectedActive: true,
+			expectedError:  nil,
+		},
+		{
+			name:           "disabled stays disabled",
+			input:          WebhookUpdate{URL: nil, Secret: nil, EventTypes: nil, Active: &inactive},
+			findError:      nil,
+			callsUpdate:    true,
+			expectedURL:    "https://example.com/hooks",
+			expectedActive: false,
+			expectedError:  nil,
+		},
+		{
+			name:          "webhook not found",
+			input:         WebhookUpdate{URL: &newURL, Secret: nil, EventTypes: nil, Active: nil},
+			findError:     webhook.ErrWebhookNotFound,
+			callsUpdate:   false,
+			expectedError: webhook.ErrWebhookNotFound,
+		},
+		{
+			name:          "short secret should fail validation",
+			input:         WebhookUpdate{URL: nil, Secret: &shortSecret, EventTypes: nil, Active: nil},
+			findError:     nil,
+			callsUpdate:   false,
+			expectedError: webhook.ErrSecretTooShort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockWebhookRepository{}
+			controller := NewWebhook(mockRepo)
+			ctx := context.Background()
+
+			existing := webhook.NewWebhookWithoutValidation(testWebhookID, testUserID, "https://example.com/hooks", testWebhookSecret, nil, time.Now(),
+				webhook.WithFailureCount(20), webhook.WithDisabledAt(&disabledAt))
+
+			if tt.findError != nil {
+				mockRepo.On("FindById", ctx, testUserID, testWebhookID).Return(nil, tt.findError)
+			} else {
+				mockRepo.On("FindById", ctx, testUserID, testWebhookID).Return(existing, nil)
+			}
+
+			if tt.callsUpdate {
+				mockRepo.On("Update", ctx, existing).Return(existing, nil)
+			}
+
+			// Act
+			result, err := controller.UpdateWebhook(ctx, testUserID, testWebhookID, tt.input)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedURL, result.URL())
+				assert.Equal(t, tt.expectedActive, result.IsActive())
+
+				if tt.expectedActive {
+					assert.Zero(t, result.FailureCount())
+				} else {
+					assert.Equal(t, &disabledAt, result.DisabledAt())
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookController_DeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	testWebhookID := webhook.GenerateWebhookID()
+
+	tests := []struct {
+		name          string
+		webhookID     webhook.WebhookID
+		mockError     error
+		expectedError error
+	}{
+		{name: "successful deletion", webhookID: testWebhookID, mockError: nil, expectedError: nil},
+		{name: "webhook not found", webhookID: testWebhookID, mockError: webhook.ErrWebhookNotFound, expectedError: webhook.ErrWebhookNotFound},
+		{name: "empty webhook ID", webhookID: webhook.WebhookID{}, mockError: nil, expectedError: webhook.ErrWebhookIDEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockWebhookRepository{}
+			controller := NewWebhook(mockRepo)
+			ctx := context.Background()
+
+			if !tt.webhookID.IsEmpty() {
+				mockRepo.On("Delete", ctx, testUserID, tt.webhookID).Return(tt.mockError)
+			}
+
+			// Act
+			err := controller.DeleteWebhook(ctx, testUserID, tt.webhookID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+	"golang.org/x/net/context"
+)
+
+// Workspace represents the workspace controller that handles business logic for workspace operations.
+type Workspace struct {
+	workspaceRepo workspace.WorkspaceRepository
+}
+
+// NewWorkspace creates a new Workspace controller with the provided repository.
+func NewWorkspace(workspaceRepo workspace.WorkspaceRepository) *Workspace {
+	return &Workspace{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// GetAllWorkspaces retrieves the workspaces the given user is a member of, ordered by name.
+// It returns an empty slice if the user is not a member of any workspace.
+func (w *Workspace) GetAllWorkspaces(ctx context.Context, userID user.UserID) ([]*workspace.Workspace, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	workspaces, err := w.workspaceRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
+// CreateWorkspace creates a new workspace owned by the given user.
+// It validates the name using domain validation rules.
+func (w *Workspace) CreateWorkspace(ctx context.Context, userID user.UserID, name string) (*workspace.Workspace, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	workspaceEntity, err := workspace.NewWorkspace(workspace.GenerateWorkspaceID(), name, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceItem, err := w.workspaceRepo.Create(ctx, workspaceEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspaceItem, nil
+}
+
+// GetMembership retrieves the membership of the given user in a workspace.
+// It returns workspace.ErrWorkspaceNotFound if the user is not a member.
+func (w *Workspace) GetMembership(ctx context.Context, userID user.UserID, id workspace.WorkspaceID) (workspace.Member, error) {
+	if userID.IsEmpty() {
+		return workspace.Member{}, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return workspace.Member{}, workspace.ErrWorkspaceIDEmpty
+	}
+
+	return w.workspaceRepo.FindMember(ctx, id, userID)
+}
+
+// GetMembers retrieves the members of the workspace of the given member in
+// the order they joined. Every member may see the others.
+func (w *Workspace) GetMembers(ctx context.Context, actor workspace.Member) ([]workspace.Member, error) {
+	members, err := w.workspaceRepo.FindMembers(ctx, actor.WorkspaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// SetMember adds a user to the workspace of the given member with the role,
+// or changes the role of a user who is already a member.
+// It returns workspace.ErrInvalidRole if the role is unknown, and the errors of
+// workspace.Member.Admit if the member may not give the user the role.
+func (w *Workspace) SetMember(ctx context.Context, actor workspace.Member, userID user.UserID, roleName string) (workspace.Member, error) {
+	if userID.IsEmpty() {
+		return workspace.Member{}, user.ErrUserIDEmpty
+	}
+
+	role, err := workspace.ParseRole(roleName)
+	if err != nil {
+		return workspace.Member{}, err
+	}
+
+	member, err := actor.Admit(userID, role)
+	if err != nil {
+		return workspace.Member{}, err
+	}
+
+	if err := w.workspaceRepo.SaveMember(ctx, member); err != nil {
+		return workspace.Member{}, err
+	}
+
+	return member, nil
+}
+
+// RemoveMember removes a user from the workspace of the given member.
+// It returns the errors of workspace.Member.Dismiss if the member may not
+// remove the user, and workspace.ErrMemberNotFound if the user is not a member.
+func (w *Workspace) RemoveMember(ctx context.Context, actor workspace.Member, userID user.UserID) error {
+	if userID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if err := actor.Dismiss(userID); err != nil {
+		return err
+	}
+
+	return w.workspaceRepo.RemoveMember(ctx, actor.WorkspaceID(), userID)
+}
@@ -0,0 +1,367 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWorkspaceRepository implements workspace.WorkspaceRepository for testing
+type MockWorkspaceRepository struct {
+	mock.Mock
+}
+
+func (m *MockWorkspaceRepository) FindAllByUserID(ctx context.Context, userID user.UserID) ([]*workspace.Workspace, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*workspace.Workspace), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) Create(ctx context.Context, workspaceEntity *workspace.Workspace) (*workspace.Workspace, error) {
+	args := m.Called(ctx, workspaceEntity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*workspace.Workspace), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) FindMember(ctx context.Context, id workspace.WorkspaceID, userID user.UserID) (workspace.Member, error) {
+	args := m.Called(ctx, id, userID)
+
+	return args.Get(0).(workspace.Member), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) FindMembers(ctx context.Context, id workspace.WorkspaceID) ([]workspace.Member, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]workspace.Member), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) SaveMember(ctx context.Context, member workspace.Member) error {
+	args := m.Called(ctx, member)
+
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceRepository) RemoveMember(ctx context.Context, id workspace.WorkspaceID, userID user.UserID) error {
+	args := m.Called(ctx, id, userID)
+
+	return args.Error(0)
+}
+
+func TestNewWorkspace(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	mockRepo := &MockWorkspaceRepository{}
+
+	// Act
+	controller := NewWorkspace(mockRepo)
+
+	// Assert
+	assert.NotNil(t, controller)
+	assert.Equal(t, mockRepo, controller.workspaceRepo)
+}
+
+func TestWorkspaceController_GetAllWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+	workspaces := []*workspace.Workspace{
+		workspace.NewWorkspaceWithoutValidation(workspace.GenerateWorkspaceID(), "Home", testUserID),
+		workspace.NewWorkspaceWithoutValidation(workspace.GenerateWorkspaceID(), "Team", user.GenerateUserID()),
+	}
+
+	tests := []struct {
+		name               string
+		userID             user.UserID
+		mockReturn         []*workspace.Workspace
+		mockError          error
+		expectedWorkspaces []*workspace.Workspace
+		expectedError      error
+	}{
+		{
+			name:               "successful retrieval",
+			userID:             testUserID,
+			mockReturn:         workspaces,
+			mockError:          nil,
+			expectedWorkspaces: workspaces,
+			expectedError:      nil,
+		},
+		{
+			name:               "empty user ID",
+			userID:             user.UserID{},
+			mockReturn:         nil,
+			mockError:          nil,
+			expectedWorkspaces: nil,
+			expectedError:      user.ErrUserIDEmpty,
+		},
+		{
+			name:               "repository error",
+			userID:             testUserID,
+			mockReturn:         nil,
+			mockError:          errors.New("database error"),
+			expectedWorkspaces: nil,
+			expectedError:      errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockWorkspaceRepository{}
+			controller := NewWorkspace(mockRepo)
+			ctx := context.Background()
+
+			if !tt.userID.IsEmpty() {
+				mockRepo.On("FindAllByUserID", ctx, tt.userID).Return(tt.mockReturn, tt.mockError)
+			}
+
+			// Act
+			result, err := controller.GetAllWorkspaces(ctx, tt.userID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedWorkspaces, result)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkspaceController_CreateWorkspace(t *testing.T) {
+	t.Parallel()
+
+	testUserID := user.GenerateUserID()
+
+	tests := []struct {
+		name          string
+		workspaceName string
+		callsRepo     bool
+		expectedName  string
+		expectedError error
+	}{
+		{
+			name:          "successful creation normalizes the name",
+			workspaceName: "  Team ",
+			callsRepo:     true,
+			expectedName:  "Team",
+			expectedError: nil,
+		},
+		{
+			name:          "empty name should fail validation",
+			workspaceName: " ",
+			callsRepo:     false,
+			expectedName:  "",
+			expectedError: workspace.ErrNameEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockWorkspaceRepository{}
+			controller := NewWorkspace(mockRepo)
+			ctx := context.Background()
+
+			if tt.callsRepo {
+				mockRepo.On("Create", ctx, mock.MatchedBy(func(workspaceEntity *workspace.Workspace) bool {
+					return workspaceEntity.OwnerID() == testUserID && workspaceEntity.Name() == tt.expectedName
+				})).Return(workspace.NewWorkspaceWithoutValidation(workspace.GenerateWorkspaceID(), tt.expectedName, testUserID), nil)
+			}
+
+			// Act
+			result, err := controller.CreateWorkspace(ctx, testUserID, tt.workspaceName)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedName, result.Name())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkspaceController_SetMember(t *testing.T) {
+	t.Parallel()
+
+	testWorkspaceID := workspace.GenerateWorkspaceID()
+	ownerID := user.GenerateUserID()
+	newMemberID := user.GenerateUserID()
+
+	owner, err := workspace.NewMember(testWorkspaceID, ownerID, workspace.RoleOwner)
+	require.NoError(t, err)
+
+	admin, err := workspace.NewMember(testWorkspaceID, user.GenerateUserID(), workspace.RoleAdmin)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		actor         workspace.Member
+		userID        user.UserID
+		role          string
+		callsRepo     bool
+		expectedError error
+	}{
+		{
+			name:          "owner adds a member",
+			actor:         owner,
+			userID:        newMemberID,
+			role:          "member",
+			callsRepo:     true,
+			expectedError: nil,
+		},
+		{
+			name:          "unknown role",
+			actor:         owner,
+			userID:        newMemberID,
+			role:          "superuser",
+			callsRepo:     false,
+			expectedError: workspace.ErrInvalidRole,
+		},
+		{
+			name:          "owner role cannot be given",
+			actor:         owner,
+			userID:        newMemberID,
+			role:          "owner",
+			callsRepo:     false,
+			expectedError: workspace.ErrOwnerRoleAssignment,
+		},
+		{
+			name:          "admin may not manage members",
+			actor:         admin,
+			userID:        newMemberID,
+			role:          "guest",
+			callsRepo:     false,
+			expectedError: workspace.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockWorkspaceRepository{}
+			controller := NewWorkspace(mockRepo)
+			ctx := context.Background()
+
+			if tt.callsRepo {
+				mockRepo.On("SaveMember", ctx, mock.MatchedBy(func(member workspace.Member) bool {
+					return member.WorkspaceID() == testWorkspaceID && member.UserID() == tt.userID &&
+						string(member.Role()) == tt.role
+				})).Return(nil)
+			}
+
+			// Act
+			result, err := controller.SetMember(ctx, tt.actor, tt.userID, tt.role)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.userID, result.UserID())
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorkspaceController_RemoveMember(t *testing.T) {
+	t.Parallel()
+
+	testWorkspaceID := workspace.GenerateWorkspaceID()
+	ownerID := user.GenerateUserID()
+	memberID := user.GenerateUserID()
+
+	owner, err := workspace.NewMember(testWorkspaceID, ownerID, workspace.RoleOwner)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		callsRepo     bool
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:          "owner removes a member",
+			userID:        memberID,
+			callsRepo:     true,
+			mockError:     nil,
+			expectedError: nil,
+		},
+		{
+			name:          "user is not a member",
+			userID:        memberID,
+			callsRepo:     true,
+			mockError:     workspace.ErrMemberNotFound,
+			expectedError: workspace.ErrMemberNotFound,
+		},
+		{
+			name:          "owner cannot remove themselves",
+			userID:        ownerID,
+			callsRepo:     false,
+			mockError:     nil,
+			expectedError: workspace.ErrOwnerMembership,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mockRepo := &MockWorkspaceRepository{}
+			controller := NewWorkspace(mockRepo)
+			ctx := context.Background()
+
+			if tt.callsRepo {
+				mockRepo.On("RemoveMember", ctx, testWorkspaceID, tt.userID).Return(tt.mockError)
+			}
+
+			// Act
+			err := controller.RemoveMember(ctx, owner, tt.userID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
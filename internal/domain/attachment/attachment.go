@@ -0,0 +1,235 @@
+package attachment
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/text"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// MaxFileNameLength defines the maximum allowed length for attachment file names.
+const MaxFileNameLength = 255
+
+// SniffLength is the number of leading bytes DetectContentType looks at.
+const SniffLength = 512
+
+// allowedContentTypes lists the kinds of files that may be attached to a task:
+// screenshots and other images, and PDF documents.
+var allowedContentTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+// AttachmentID represents a unique identifier for an attachment.
+type AttachmentID struct {
+	value uuid.UUID
+}
+
+// NewAttachmentID creates a new AttachmentID from a string value.
+func NewAttachmentID(id string) (AttachmentID, error) {
+	if id == "" {
+		return AttachmentID{}, ErrAttachmentIDEmpty
+	}
+
+	parsedUUID, err := uuid.Parse(id)
+	if err != nil {
+		return AttachmentID{}, ErrInvalidAttachmentIDFormat
+	}
+
+	return AttachmentID{value: parsedUUID}, nil
+}
+
+// GenerateAttachmentID creates a new AttachmentID with a generated UUID.
+func GenerateAttachmentID() AttachmentID {
+	return AttachmentID{value: uuid.New()}
+}
+
+// String returns the string representation of the AttachmentID.
+func (a AttachmentID) String() string {
+	return a.value.String()
+}
+
+// UUID returns the underlying uuid.UUID value.
+func (a AttachmentID) UUID() uuid.UUID {
+	return a.value
+}
+
+// IsEmpty returns true if the AttachmentID is empty.
+func (a AttachmentID) IsEmpty() bool {
+	return a.value == uuid.Nil
+}
+
+// Attachment describes a file a user attached to a task. The content itself
+// lives in a BlobStore under Key; the attachment only holds its metadata.
+// An attachment whose task was permanently removed is detached: it has no
+// task ID left and waits for its content to be cleaned up.
+type Attachment struct {
+	id          AttachmentID
+	taskID      task.TaskID
+	uploaderID  user.UserID
+	fileName    string
+	contentType string
+	size        int64
+	checksum    string
+	createdAt   time.Time
+}
+
+// NewAttachment creates a new Attachment for a file the user with uploaderID
+// attached to the task with taskID at createdAt.
+// It returns an error if the file name is invalid according to NormalizeFileName,
+// if the content type may not be attached or if the file is empty.
+func NewAttachment(id AttachmentID, taskID task.TaskID, uploaderID user.UserID, fileName, contentType string, size int64, checksum string, createdAt time.Time) (*Attachment, error) {
+	if taskID.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	if uploaderID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	fileName, err := NormalizeFileName(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowedContentTypes[contentType] {
+		return nil, ErrUnsupportedContentType
+	}
+
+	if size <= 0 {
+		return nil, ErrFileEmpty
+	}
+
+	return &Attachment{
+		id:          id,
+		taskID:      taskID,
+		uploaderID:  uploaderID,
+		fileName:    fileName,
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		createdAt:   createdAt,
+	}, nil
+}
+
+// NewAttachmentWithoutValidation rebuilds an Attachment from stored values without validating them.
+func NewAttachmentWithoutValidation(id AttachmentID, taskID task.TaskID, uploaderID user.UserID, fileName, contentType string, size int64, checksum string, createdAt time.Time) *Attachment {
+	return &Attachment{
+		id:          id,
+		taskID:      taskID,
+		uploaderID:  uploaderID,
+		fileName:    fileName,
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		createdAt:   createdAt,
+	}
+}
+
+// NormalizeFileName reduces the name of an uploaded file to its last path
+// element, drops control characters and surrounding spaces, and validates the result.
+func NormalizeFileName(fileName string) (string, error) {
+	if i := strings.LastIndexAny(fileName, `/\`); i >= 0 {
+		fileName = fileName[i+1:]
+	}
+
+	fileName = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}, fileName)
+
+	fileName = text.TrimSpaceAndZeroWidth(fileName)
+	if fileName == "" || fileName == "." || fileName == ".." {
+		return "", ErrFileNameEmpty
+	}
+
+	if utf8.RuneCountInString(fileName) > MaxFileNameLength {
+		return "", ErrFileNameTooLong
+	}
+
+	return fileName, nil
+}
+
+// DetectContentType determines the media type of a file from its first
+// SniffLength bytes, ignoring whatever type the client claimed.
+// It returns ErrUnsupportedContentType for kinds of files that may not be attached.
+func DetectContentType(head []byte) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil || !allowedContentTypes[mediaType] {
+		return "", ErrUnsupportedContentType
+	}
+
+	return mediaType, nil
+}
+
+func (a *Attachment) ID() AttachmentID {
+	return a.id
+}
+
+// TaskID returns the task the file is attached to, or an empty ID if the
+// attachment is detached.
+func (a *Attachment) TaskID() task.TaskID {
+	return a.taskID
+}
+
+func (a *Attachment) UploaderID() user.UserID {
+	return a.uploaderID
+}
+
+func (a *Attachment) FileName() string {
+	return a.fileName
+}
+
+func (a *Attachment) ContentType() string {
+	return a.contentType
+}
+
+// Size returns the size of the file in bytes.
+func (a *Attachment) Size() int64 {
+	return a.size
+}
+
+// Checksum returns the hex-encoded SHA-256 digest of the file.
+func (a *Attachment) Checksum() string {
+	return a.checksum
+}
+
+func (a *Attachment) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// Key returns the key the content of the attachment is stored under.
+// It only depends on the attachment ID, so that it stays valid after the
+// attachment is detached from its task.
+func (a *Attachment) Key() string {
+	return KeyFor(a.id)
+}
+
+// IsDetached reports whether the task of the attachment was permanently removed.
+func (a *Attachment) IsDetached() bool {
+	return a.taskID.IsEmpty()
+}
+
+// IsUploadedBy reports whether the user attached the file.
+func (a *Attachment) IsUploadedBy(userID user.UserID) bool {
+	return a.uploaderID == userID
+}
+
+// KeyFor returns the key the content of the attachment with the given ID is stored under.
+func KeyFor(id AttachmentID) string {
+	return "attachments/" + id.String()
+}
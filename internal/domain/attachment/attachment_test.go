@@ -0,0 +1,309 @@
+package attachment
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestNormalizeFileName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:          "plain file name",
+			input:         "screenshot.png",
+			expected:      "screenshot.png",
+			expectedError: nil,
+		},
+		{
+			name:          "directories are dropped",
+			input:         "../../etc/passwd.pdf",
+			expected:      "passwd.pdf",
+			expectedError: nil,
+		},
+		{
+			name:          "windows directories are dropped",
+			input:         `C:\Users\me\請求書.pdf`,
+			expected:      "請求書.pdf",
+			expectedError: nil,
+		},
+		{
+			name:          "control characters and surrounding spaces are removed",
+			input:         " report\r\n.pdf\u200B ",
+			expected:      "report.pdf",
+			expectedError: nil,
+		},
+		{
+			name:          "empty file name",
+			input:         "  ",
+			expected:      "",
+			expectedError: ErrFileNameEmpty,
+		},
+		{
+			name:          "directory only",
+			input:         "uploads/..",
+			expected:      "",
+			expectedError: ErrFileNameEmpty,
+		},
+		{
+			name:          "file name too long",
+			input:         strings.Repeat("a", MaxFileNameLength-3) + ".pdf",
+			expected:      "",
+			expectedError: ErrFileNameTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result, err := NormalizeFileName(tt.input)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		head          []byte
+		expected      string
+		expectedError error
+	}{
+		{
+			name:          "png image",
+			head:          []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR"),
+			expected:      "image/png",
+			expectedError: nil,
+		},
+		{
+			name:          "jpeg image",
+			head:          []byte("\xFF\xD8\xFF\xE0\x00\x10JFIF"),
+			expected:      "image/jpeg",
+			expectedError: nil,
+		},
+		{
+			name:          "pdf document",
+			head:          []byte("%PDF-1.7\n%\xE2\xE3\xCF\xD3"),
+			expected:      "application/pdf",
+			expectedError: nil,
+		},
+		{
+			name:          "html is rejected",
+			head:          []byte("<!DOCTYPE html><html><script>alert(1)</script>"),
+			expected:      "",
+			expectedError: ErrUnsupportedContentType,
+		},
+		{
+			name:          "plain text is rejected",
+			head:          []byte("just some notes"),
+			expected:      "",
+			expectedError: ErrUnsupportedContentType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result, err := DetectContentType(tt.head)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNewAttachment(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	checksum := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name          string
+		taskID        task.TaskID
+		uploaderID    user.UserID
+		fileName      string
+		contentType   string
+		size          int64
+		expectedError error
+	}{
+		{
+			name:          "valid attachment",
+			taskID:        task.GenerateTaskID(),
+			uploaderID:    user.GenerateUserID(),
+			fileName:      "screenshot.png",
+			contentType:   "image/png",
+			size:          2048,
+			expectedError: nil,
+		},
+		{
+			name:          "empty task ID",
+			taskID:        task.TaskID{},
+			uploaderID:    user.GenerateUserID(),
+			fileName:      "screenshot.png",
+			contentType:   "image/png",
+			size:          2048,
+			expectedError: task.ErrTaskIDEmpty,
+		},
+		{
+			name:          "empty uploader ID",
+			taskID:        task.GenerateTaskID(),
+			uploaderID:    user.UserID{},
+			fileName:      "screenshot.png",
+			contentType:   "image/png",
+			size:          2048,
+			expectedError: user.ErrUserIDEmpty,
+		},
+		{
+			name:          "invalid file name",
+			taskID:        task.GenerateTaskID(),
+			uploaderID:    user.GenerateUserID(),
+			fileName:      " ",
+			contentType:   "image/png",
+			size:          2048,
+			expectedError: ErrFileNameEmpty,
+		},
+		{
+			name:          "unsupported content type",
+			taskID:        task.GenerateTaskID(),
+			uploaderID:    user.GenerateUserID(),
+			fileName:      "page.html",
+			contentType:   "text/html",
+			size:          2048,
+			expectedError: ErrUnsupportedContentType,
+		},
+		{
+			name:          "empty file",
+			taskID:        task.GenerateTaskID(),
+			uploaderID:    user.GenerateUserID(),
+			fileName:      "screenshot.png",
+			contentType:   "image/png",
+			size:          0,
+			expectedError: ErrFileEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			id := GenerateAttachmentID()
+
+			// Act
+			result, err := NewAttachment(id, tt.taskID, tt.uploaderID, tt.fileName, tt.contentType, tt.size, checksum, createdAt)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, id, result.ID())
+			assert.Equal(t, tt.taskID, result.TaskID())
+			assert.True(t, result.IsUploadedBy(tt.uploaderID))
+			assert.Equal(t, tt.fileName, result.FileName())
+			assert.Equal(t, tt.contentType, result.ContentType())
+			assert.Equal(t, tt.size, result.Size())
+			assert.Equal(t, checksum, result.Checksum())
+			assert.Equal(t, "attachments/"+id.String(), result.Key())
+			assert.False(t, result.IsDetached())
+		})
+	}
+}
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+
+	limits := Limits{MaxFileSize: 100, UserQuota: 250}
+
+	tests := []struct {
+		name          string
+		used          int64
+		size          int64
+		expectedError error
+	}{
+		{
+			name:          "file within limits",
+			used:          150,
+			size:          100,
+			expectedError: nil,
+		},
+		{
+			name:          "empty file",
+			used:          0,
+			size:          0,
+			expectedError: ErrFileEmpty,
+		},
+		{
+			name:          "file too large",
+			used:          0,
+			size:          101,
+			expectedError: ErrFileTooLarge,
+		},
+		{
+			name:          "quota exceeded",
+			used:          151,
+			size:          100,
+			expectedError: ErrQuotaExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := limits.CheckSize(tt.size)
+			if err == nil {
+				err = limits.CheckQuota(tt.used, tt.size)
+			}
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
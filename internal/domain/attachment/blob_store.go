@@ -0,0 +1,20 @@
+package attachment
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore keeps the content of attachments, addressed by the key of each
+// attachment. Keys are slash-separated relative paths chosen by the caller.
+type BlobStore interface {
+	// Put stores size bytes read from content under key, replacing anything
+	// stored there before. It returns ErrSizeMismatch if content does not
+	// hold exactly size bytes.
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Get opens the content stored under key for reading. The caller must
+	// close it. It returns ErrBlobNotFound if nothing is stored there.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content stored under key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,20 @@
+package attachment
+
+import "errors"
+
+var (
+	ErrFileNameEmpty             = errors.New("attachment file name cannot be empty")
+	ErrFileNameTooLong           = errors.New("attachment file name cannot exceed 255 characters")
+	ErrFileEmpty                 = errors.New("attached file cannot be empty")
+	ErrFileTooLarge              = errors.New("attached file exceeds the maximum file size")
+	ErrUnsupportedContentType    = errors.New("only images and PDF documents can be attached")
+	ErrQuotaExceeded             = errors.New("attached file exceeds the storage quota of the user")
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrAttachmentIDEmpty         = errors.New("attachment ID cannot be empty")
+	ErrInvalidAttachmentIDFormat = errors.New("attachment ID must be a valid UUID format")
+	ErrForbidden                 = errors.New("user is not allowed to perform this action on the attachment")
+
+	ErrBlobNotFound = errors.New("attachment content not found")
+	ErrInvalidKey   = errors.New("blob key is invalid")
+	ErrSizeMismatch = errors.New("attached file does not match its declared size")
+)
@@ -0,0 +1,32 @@
+package attachment
+
+// Limits bounds the size of a single attachment and the storage each user may
+// fill with the files they attach.
+type Limits struct {
+	MaxFileSize int64 // bytes
+	UserQuota   int64 // bytes
+}
+
+// CheckSize returns ErrFileEmpty or ErrFileTooLarge if a file of the given
+// size may not be attached.
+func (l Limits) CheckSize(size int64) error {
+	if size <= 0 {
+		return ErrFileEmpty
+	}
+
+	if size > l.MaxFileSize {
+		return ErrFileTooLarge
+	}
+
+	return nil
+}
+
+// CheckQuota returns ErrQuotaExceeded if a user who already stores used bytes
+// may not attach size more.
+func (l Limits) CheckQuota(used, size int64) error {
+	if used+size > l.UserQuota {
+		return ErrQuotaExceeded
+	}
+
+	return nil
+}
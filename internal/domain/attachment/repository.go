@@ -0,0 +1,28 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// AttachmentRepository defines the interface for attachment metadata persistence operations.
+// It does not check access to the task of an attachment; callers authorize against the task.
+type AttachmentRepository interface {
+	// FindById returns the attachment unless it is detached.
+	FindById(ctx context.Context, id AttachmentID) (*Attachment, error)
+	// FindAllByTaskID returns the attachments of the task, oldest first.
+	FindAllByTaskID(ctx context.Context, taskID task.TaskID) ([]*Attachment, error)
+	// FindDetached returns up to limit attachments whose task was permanently removed.
+	FindDetached(ctx context.Context, limit int) ([]*Attachment, error)
+	// UsageByUserID returns the total size in bytes of the files the user
+	// attached, including detached attachments that were not cleaned up yet.
+	UsageByUserID(ctx context.Context, userID user.UserID) (int64, error)
+	// CreateWithinQuota stores the attachment unless that would take the
+	// usage of its uploader over quota, in which case it returns ErrQuotaExceeded.
+	// Concurrent uploads by the same user are serialized for the check.
+	CreateWithinQuota(ctx context.Context, attachment *Attachment, quota int64) (*Attachment, error)
+	// Delete removes the metadata of the attachment, detached or not.
+	Delete(ctx context.Context, id AttachmentID) error
+}
@@ -0,0 +1,223 @@
+package comment
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/text"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// MaxBodyLength defines the maximum allowed length for comment bodies.
+const MaxBodyLength = 10000
+
+// CommentID represents a unique identifier for a comment.
+type CommentID struct {
+	value uuid.UUID
+}
+
+// NewCommentID creates a new CommentID from a string value.
+func NewCommentID(id string) (CommentID, error) {
+	if id == "" {
+		return CommentID{}, ErrCommentIDEmpty
+	}
+
+	parsedUUID, err := uuid.Parse(id)
+	if err != nil {
+		return CommentID{}, ErrInvalidCommentIDFormat
+	}
+
+	return CommentID{value: parsedUUID}, nil
+}
+
+// GenerateCommentID creates a new CommentID with a generated UUID.
+func GenerateCommentID() CommentID {
+	return CommentID{value: uuid.New()}
+}
+
+// String returns the string representation of the CommentID.
+func (c CommentID) String() string {
+	return c.value.String()
+}
+
+// UUID returns the underlying uuid.UUID value.
+func (c CommentID) UUID() uuid.UUID {
+	return c.value
+}
+
+// IsEmpty returns true if the CommentID is empty.
+func (c CommentID) IsEmpty() bool {
+	return c.value == uuid.Nil
+}
+
+// Comment is a message a user wrote on a task to discuss it with the other
+// users who have access to it.
+// A deleted comment keeps its place in the discussion but loses its body.
+type Comment struct {
+	id        CommentID
+	taskID    task.TaskID
+	authorID  user.UserID
+	body      string
+	createdAt time.Time
+	editedAt  *time.Time
+	deletedAt *time.Time
+}
+
+// RestoreOption sets additional state on a Comment rebuilt by NewCommentWithoutValidation.
+type RestoreOption func(*Comment)
+
+// WithEditedAt restores the time the comment was last edited.
+func WithEditedAt(editedAt *time.Time) RestoreOption {
+	return func(c *Comment) {
+		c.editedAt = editedAt
+	}
+}
+
+// WithDeletedAt restores the time the comment was deleted.
+func WithDeletedAt(deletedAt *time.Time) RestoreOption {
+	return func(c *Comment) {
+		c.deletedAt = deletedAt
+	}
+}
+
+// NewComment creates a new Comment written by the user with authorID on the
+// task with taskID at createdAt.
+// It returns an error if the body is invalid according to NormalizeBody.
+func NewComment(id CommentID, taskID task.TaskID, authorID user.UserID, body string, createdAt time.Time) (*Comment, error) {
+	if taskID.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	if authorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	body, err := NormalizeBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Comment{
+		id:        id,
+		taskID:    taskID,
+		authorID:  authorID,
+		body:      body,
+		createdAt: createdAt,
+		editedAt:  nil,
+		deletedAt: nil,
+	}, nil
+}
+
+// NewCommentWithoutValidation rebuilds a Comment from stored values without validating them.
+func NewCommentWithoutValidation(id CommentID, taskID task.TaskID, authorID user.UserID, body string, createdAt time.Time, opts ...RestoreOption) *Comment {
+	c := &Comment{
+		id:        id,
+		taskID:    taskID,
+		authorID:  authorID,
+		body:      body,
+		createdAt: createdAt,
+		editedAt:  nil,
+		deletedAt: nil,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// NormalizeBody removes surrounding spaces and zero-width characters from a
+// comment body and validates the result. Line breaks inside the body are kept.
+func NormalizeBody(body string) (string, error) {
+	body = text.TrimSpaceAndZeroWidth(body)
+	if body == "" {
+		return "", ErrBodyEmpty
+	}
+
+	if utf8.RuneCountInString(body) > MaxBodyLength {
+		return "", ErrBodyTooLong
+	}
+
+	return body, nil
+}
+
+func (c *Comment) ID() CommentID {
+	return c.id
+}
+
+func (c *Comment) TaskID() task.TaskID {
+	return c.taskID
+}
+
+func (c *Comment) AuthorID() user.UserID {
+	return c.authorID
+}
+
+// Body returns the text of the comment, or an empty string if it was deleted.
+func (c *Comment) Body() string {
+	return c.body
+}
+
+func (c *Comment) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// EditedAt returns the time the comment was last edited, or nil if it never was.
+func (c *Comment) EditedAt() *time.Time {
+	return c.editedAt
+}
+
+// DeletedAt returns the time the comment was deleted, or nil if it was not.
+func (c *Comment) DeletedAt() *time.Time {
+	return c.deletedAt
+}
+
+// IsEdited reports whether the body was changed after the comment was written.
+func (c *Comment) IsEdited() bool {
+	return c.editedAt != nil
+}
+
+// IsDeleted reports whether the comment was deleted.
+func (c *Comment) IsDeleted() bool {
+	return c.deletedAt != nil
+}
+
+// IsWrittenBy reports whether the user is the author of the comment.
+func (c *Comment) IsWrittenBy(userID user.UserID) bool {
+	return c.authorID == userID
+}
+
+// Edit replaces the body of the comment and marks it as edited at editedAt.
+// It returns ErrCommentDeleted if the comment was deleted.
+func (c *Comment) Edit(body string, editedAt time.Time) error {
+	if c.IsDeleted() {
+		return ErrCommentDeleted
+	}
+
+	body, err := NormalizeBody(body)
+	if err != nil {
+		return err
+	}
+
+	c.body = body
+	c.editedAt = &editedAt
+
+	return nil
+}
+
+// Delete removes the body of the comment and marks it as deleted at deletedAt.
+// It returns ErrCommentDeleted if the comment was already deleted.
+func (c *Comment) Delete(deletedAt time.Time) error {
+	if c.IsDeleted() {
+		return ErrCommentDeleted
+	}
+
+	c.body = ""
+	c.deletedAt = &deletedAt
+
+	return nil
+}
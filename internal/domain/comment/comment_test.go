@@ -0,0 +1,183 @@
+package comment
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestNormalizeBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:          "plain body",
+			input:         "Looks good to me",
+			expected:      "Looks good to me",
+			expectedError: nil,
+		},
+		{
+			name:          "surrounding whitespace and zero-width characters are removed",
+			input:         "\n\u200B確認しました\n次は？ ",
+			expected:      "確認しました\n次は？",
+			expectedError: nil,
+		},
+		{
+			name:          "empty body",
+			input:         " \n ",
+			expected:      "",
+			expectedError: ErrBodyEmpty,
+		},
+		{
+			name:          "multi-byte body at max length",
+			input:         strings.Repeat("あ", MaxBodyLength),
+			expected:      strings.Repeat("あ", MaxBodyLength),
+			expectedError: nil,
+		},
+		{
+			name:          "body too long",
+			input:         strings.Repeat("a", MaxBodyLength+1),
+			expected:      "",
+			expectedError: ErrBodyTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result, err := NormalizeBody(tt.input)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNewComment(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		taskID        task.TaskID
+		authorID      user.UserID
+		body          string
+		expectedError error
+	}{
+		{name: "valid comment", taskID: task.GenerateTaskID(), authorID: user.GenerateUserID(), body: " Done ", expectedError: nil},
+		{name: "empty task ID", taskID: task.TaskID{}, authorID: user.GenerateUserID(), body: "Done", expectedError: task.ErrTaskIDEmpty},
+		{name: "empty author", taskID: task.GenerateTaskID(), authorID: user.UserID{}, body: "Done", expectedError: user.ErrUserIDEmpty},
+		{name: "empty body", taskID: task.GenerateTaskID(), authorID: user.GenerateUserID(), body: "", expectedError: ErrBodyEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			commentEntity, err := NewComment(GenerateCommentID(), tt.taskID, tt.authorID, tt.body, createdAt)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "Done", commentEntity.Body())
+			assert.Equal(t, createdAt, commentEntity.CreatedAt())
+			assert.True(t, commentEntity.IsWrittenBy(tt.authorID))
+			assert.False(t, commentEntity.IsEdited())
+			assert.False(t, commentEntity.IsDeleted())
+		})
+	}
+}
+
+func TestEdit(t *testing.T) {
+	t.Parallel()
+
+	editedAt := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+	deletedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		opts          []RestoreOption
+		body          string
+		expectedBody  string
+		expectedError error
+	}{
+		{name: "body replaced", opts: nil, body: " Done now ", expectedBody: "Done now", expectedError: nil},
+		{name: "invalid body", opts: nil, body: " ", expectedBody: "Done", expectedError: ErrBodyEmpty},
+		{name: "deleted comment", opts: []RestoreOption{WithDeletedAt(&deletedAt)}, body: "Done now", expectedBody: "Done", expectedError: ErrCommentDeleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			commentEntity := NewCommentWithoutValidation(GenerateCommentID(), task.GenerateTaskID(), user.GenerateUserID(), "Done", time.Now(), tt.opts...)
+
+			// Act
+			err := commentEntity.Edit(tt.body, editedAt)
+
+			// Assert
+			assert.Equal(t, tt.expectedBody, commentEntity.Body())
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.False(t, commentEntity.IsEdited())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, commentEntity.IsEdited())
+			assert.Equal(t, &editedAt, commentEntity.EditedAt())
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	// Arrange
+	commentEntity := NewCommentWithoutValidation(GenerateCommentID(), task.GenerateTaskID(), user.GenerateUserID(), "Done", time.Now())
+
+	// Act
+	err := commentEntity.Delete(deletedAt)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, commentEntity.IsDeleted())
+	assert.Equal(t, &deletedAt, commentEntity.DeletedAt())
+	assert.Empty(t, commentEntity.Body())
+
+	err = commentEntity.Delete(time.Now())
+	assert.ErrorIs(t, err, ErrCommentDeleted)
+	assert.Equal(t, &deletedAt, commentEntity.DeletedAt())
+}
@@ -0,0 +1,16 @@
+package comment
+
+import "errors"
+
+var (
+	ErrBodyEmpty              = errors.New("comment body cannot be empty")
+	ErrBodyTooLong            = errors.New("comment body cannot exceed 10000 characters")
+	ErrCommentNotFound        = errors.New("comment not found")
+	ErrCommentIDEmpty         = errors.New("comment ID cannot be empty")
+	ErrInvalidCommentIDFormat = errors.New("comment ID must be a valid UUID format")
+	ErrCommentDeleted         = errors.New("comment has been deleted")
+	ErrForbidden              = errors.New("user is not allowed to perform this action on the comment")
+
+	ErrInvalidPageLimit = errors.New("page limit must be between 1 and 200")
+	ErrInvalidCursor    = errors.New("page cursor is invalid")
+)
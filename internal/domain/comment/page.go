@@ -0,0 +1,59 @@
+package comment
+
+const (
+	// DefaultPageLimit is the number of comments returned when no limit is requested.
+	DefaultPageLimit = 50
+	// MaxPageLimit is the largest number of comments a single page may contain.
+	MaxPageLimit = 200
+)
+
+// PageRequest describes which slice of the comments on a task to return.
+// Cursor is an opaque token returned as Page.NextCursor by a previous request;
+// an empty cursor starts from the oldest comment.
+type PageRequest struct {
+	limit  int
+	cursor string
+}
+
+// NewPageRequest creates a new PageRequest with limit validation.
+// A limit of zero selects DefaultPageLimit.
+func NewPageRequest(limit int, cursor string) (PageRequest, error) {
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+
+	if limit < 0 || limit > MaxPageLimit {
+		return PageRequest{}, ErrInvalidPageLimit
+	}
+
+	return PageRequest{
+		limit:  limit,
+		cursor: cursor,
+	}, nil
+}
+
+// Limit returns the maximum number of comments in the page.
+func (p PageRequest) Limit() int {
+	if p.limit == 0 {
+		return DefaultPageLimit
+	}
+
+	return p.limit
+}
+
+// Cursor returns the opaque position the page starts after.
+func (p PageRequest) Cursor() string {
+	return p.cursor
+}
+
+// Page is a slice of the comments on a task, oldest first.
+// NextCursor is empty when there are no more comments after this page.
+type Page struct {
+	Comments   []*Comment
+	NextCursor string
+}
+
+// HasNext reports whether more comments follow this page.
+func (p Page) HasNext() bool {
+	return p.NextCursor != ""
+}
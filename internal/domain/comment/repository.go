@@ -0,0 +1,21 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+)
+
+// CommentRepository defines the interface for comment data persistence operations.
+// It does not check access to the task of a comment; callers authorize against the task.
+type CommentRepository interface {
+	// FindById returns the comment, including a deleted one.
+	FindById(ctx context.Context, id CommentID) (*Comment, error)
+	// FindPageByTaskID returns a page of the comments on the task, oldest first,
+	// including deleted ones so that the discussion keeps its shape.
+	// It returns ErrInvalidCursor if the page cursor cannot be decoded.
+	FindPageByTaskID(ctx context.Context, taskID task.TaskID, page PageRequest) (Page, error)
+	Create(ctx context.Context, comment *Comment) (*Comment, error)
+	// Update stores the body and the edited and deleted state of a comment.
+	Update(ctx context.Context, comment *Comment) (*Comment, error)
+}
@@ -0,0 +1,13 @@
+package idempotency
+
+import "errors"
+
+var (
+	ErrKeyEmpty   = errors.New("idempotency key cannot be empty")
+	ErrKeyTooLong = errors.New("idempotency key cannot exceed 255 characters")
+	ErrKeyInvalid = errors.New("idempotency key must consist of printable ASCII characters")
+
+	ErrKeyReused         = errors.New("idempotency key was already used for a different request")
+	ErrRequestInProgress = errors.New("a request with this idempotency key is still in progress")
+	ErrRecordNotFound    = errors.New("idempotency record not found")
+)
@@ -0,0 +1,42 @@
+package idempotency
+
+import "strings"
+
+// MaxKeyLength is the maximum number of characters in an idempotency key.
+const MaxKeyLength = 255
+
+// Key is a client-chosen identifier that marks retries of the same request.
+type Key struct {
+	value string
+}
+
+// NewKey creates a new Key with validation.
+// Surrounding whitespace is removed; the remaining key must be printable ASCII.
+func NewKey(value string) (Key, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Key{}, ErrKeyEmpty
+	}
+
+	if len(value) > MaxKeyLength {
+		return Key{}, ErrKeyTooLong
+	}
+
+	for i := range len(value) {
+		if value[i] < 0x20 || value[i] > 0x7e {
+			return Key{}, ErrKeyInvalid
+		}
+	}
+
+	return Key{value: value}, nil
+}
+
+// String returns the string representation of the Key.
+func (k Key) String() string {
+	return k.value
+}
+
+// IsEmpty returns true if the Key is empty.
+func (k Key) IsEmpty() bool {
+	return k.value == ""
+}
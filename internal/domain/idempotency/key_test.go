@@ -0,0 +1,84 @@
+package idempotency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         string
+		expectedKey   string
+		expectedError error
+	}{
+		{
+			name:          "uuid",
+			value:         "0d6c3b1e-4f7a-4b8e-9c2d-5a1e7f3b9c40",
+			expectedKey:   "0d6c3b1e-4f7a-4b8e-9c2d-5a1e7f3b9c40",
+			expectedError: nil,
+		},
+		{
+			name:          "surrounding whitespace is trimmed",
+			value:         "  retry-1 ",
+			expectedKey:   "retry-1",
+			expectedError: nil,
+		},
+		{
+			name:          "maximum length",
+			value:         strings.Repeat("k", MaxKeyLength),
+			expectedKey:   strings.Repeat("k", MaxKeyLength),
+			expectedError: nil,
+		},
+		{
+			name:          "empty",
+			value:         "",
+			expectedError: ErrKeyEmpty,
+		},
+		{
+			name:          "whitespace only",
+			value:         " \t ",
+			expectedError: ErrKeyEmpty,
+		},
+		{
+			name:          "too long",
+			value:         strings.Repeat("k", MaxKeyLength+1),
+			expectedError: ErrKeyTooLong,
+		},
+		{
+			name:          "control character",
+			value:         "retry\x00",
+			expectedError: ErrKeyInvalid,
+		},
+		{
+			name:          "non-ASCII",
+			value:         "リトライ",
+			expectedError: ErrKeyInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			key, err := NewKey(tt.value)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.True(t, key.IsEmpty())
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedKey, key.String())
+			assert.False(t, key.IsEmpty())
+		})
+	}
+}
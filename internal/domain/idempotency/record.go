@@ -0,0 +1,55 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// Response is a stored response that is replayed for retries of a request.
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// Record ties an idempotency key of a user to the request it was first used
+// with and, once that request has finished, to its response.
+// Response is nil while the first request is still being processed.
+type Record struct {
+	UserID      user.UserID
+	Key         Key
+	Fingerprint string
+	Response    *Response
+	ExpiresAt   time.Time
+}
+
+// Fingerprint identifies a request by its method, path and body so that a
+// key reused for a different request can be detected.
+func Fingerprint(method, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(path))
+	hash.Write([]byte{0})
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Matches reports whether the record was created for a request with the given fingerprint.
+func (r Record) Matches(fingerprint string) bool {
+	return r.Fingerprint == fingerprint
+}
+
+// IsCompleted reports whether the response of the request has been stored.
+func (r Record) IsCompleted() bool {
+	return r.Response != nil
+}
+
+// IsExpired reports whether the record is no longer valid at the given time.
+func (r Record) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,50 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	base := Fingerprint("POST", "/tasks", []byte(`{"title":"Task"}`))
+
+	t.Run("same request has the same fingerprint", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, base, Fingerprint("POST", "/tasks", []byte(`{"title":"Task"}`)))
+	})
+
+	t.Run("different parts change the fingerprint", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NotEqual(t, base, Fingerprint("POST", "/tasks", []byte(`{"title":"Other"}`)))
+		assert.NotEqual(t, base, Fingerprint("PUT", "/tasks", []byte(`{"title":"Task"}`)))
+		assert.NotEqual(t, base, Fingerprint("POST", "/tasks/", []byte(`{"title":"Task"}`)))
+	})
+
+	t.Run("parts cannot be shifted into each other", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NotEqual(t, Fingerprint("POST", "/a", []byte("b")), Fingerprint("POST", "/ab", nil))
+	})
+}
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	record := Record{ExpiresAt: expiresAt, Fingerprint: "abc"} //nolint:exhaustruct
+
+	assert.True(t, record.Matches("abc"))
+	assert.False(t, record.Matches("abd"))
+	assert.False(t, record.IsCompleted())
+	assert.False(t, record.IsExpired(expiresAt.Add(-time.Second)))
+	assert.True(t, record.IsExpired(expiresAt))
+
+	record.Response = &Response{StatusCode: 201, ContentType: "application/json", Body: []byte(`{}`)}
+	assert.True(t, record.IsCompleted())
+}
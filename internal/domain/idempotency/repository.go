@@ -0,0 +1,25 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// IdempotencyRepository defines the interface for idempotency record persistence operations.
+type IdempotencyRepository interface {
+	// Reserve stores a new record without a response. If an unexpired record
+	// for the same user and key exists, nothing is stored and that record is
+	// returned instead; an expired record is replaced.
+	Reserve(ctx context.Context, record Record) (existing *Record, err error)
+	// Complete stores the response of a reserved request.
+	// It returns ErrRecordNotFound if no record without a response exists.
+	Complete(ctx context.Context, userID user.UserID, key Key, response Response) error
+	// Release removes a record without a response so that the request can be
+	// retried with the same key.
+	Release(ctx context.Context, userID user.UserID, key Key) error
+	// DeleteExpired removes all records that expired at or before now and
+	// returns how many were removed.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,12 @@
+package project
+
+import "errors"
+
+var (
+	ErrNameEmpty              = errors.New("project name cannot be empty")
+	ErrNameTooLong            = errors.New("project name cannot exceed 100 characters")
+	ErrProjectNotFound        = errors.New("project not found")
+	ErrProjectIDEmpty         = errors.New("project ID cannot be empty")
+	ErrInvalidProjectIDFormat = errors.New("project ID must be a valid UUID format")
+	ErrInvalidTaskDisposal    = errors.New("tasks of a deleted project must be moved to the inbox or deleted")
+)
@@ -0,0 +1,146 @@
+package project
+
+import (
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/text"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// MaxNameLength defines the maximum allowed length for project names.
+const MaxNameLength = 100
+
+// ProjectID represents a unique identifier for a project.
+type ProjectID struct {
+	value uuid.UUID
+}
+
+// NewProjectID creates a new ProjectID from a string value.
+func NewProjectID(id string) (ProjectID, error) {
+	if id == "" {
+		return ProjectID{}, ErrProjectIDEmpty
+	}
+
+	parsedUUID, err := uuid.Parse(id)
+	if err != nil {
+		return ProjectID{}, ErrInvalidProjectIDFormat
+	}
+
+	return ProjectID{value: parsedUUID}, nil
+}
+
+// GenerateProjectID creates a new ProjectID with a generated UUID.
+func GenerateProjectID() ProjectID {
+	return ProjectID{value: uuid.New()}
+}
+
+// String returns the string representation of the ProjectID.
+func (p ProjectID) String() string {
+	return p.value.String()
+}
+
+// UUID returns the underlying uuid.UUID value.
+func (p ProjectID) UUID() uuid.UUID {
+	return p.value
+}
+
+// IsEmpty returns true if the ProjectID is empty.
+func (p ProjectID) IsEmpty() bool {
+	return p.value == uuid.Nil
+}
+
+// Project represents a list a user groups tasks in, such as "Groceries".
+// Tasks without a project are in the user's inbox.
+type Project struct {
+	id      ProjectID
+	name    string
+	ownerID user.UserID
+}
+
+// NewProject creates a new Project instance with a normalised name.
+// It returns an error if the name is invalid according to NormalizeName.
+func NewProject(id ProjectID, name string, ownerID user.UserID) (*Project, error) {
+	name, err := NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Project{
+		id:      id,
+		name:    name,
+		ownerID: ownerID,
+	}, nil
+}
+
+// NewProjectWithoutValidation rebuilds a Project from stored values without validating them.
+func NewProjectWithoutValidation(id ProjectID, name string, ownerID user.UserID) *Project {
+	return &Project{
+		id:      id,
+		name:    name,
+		ownerID: ownerID,
+	}
+}
+
+// NormalizeName removes surrounding spaces and zero-width characters from a
+// project name, following the rules used for task titles, and validates the result.
+func NormalizeName(name string) (string, error) {
+	name = text.TrimSpaceAndZeroWidth(name)
+	if name == "" {
+		return "", ErrNameEmpty
+	}
+
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return "", ErrNameTooLong
+	}
+
+	return name, nil
+}
+
+func (p *Project) ID() ProjectID {
+	return p.id
+}
+
+func (p *Project) Name() string {
+	return p.name
+}
+
+func (p *Project) UserID() user.UserID {
+	return p.ownerID
+}
+
+// Rename changes the name of the project.
+func (p *Project) Rename(name string) error {
+	name, err := NormalizeName(name)
+	if err != nil {
+		return err
+	}
+
+	p.name = name
+
+	return nil
+}
+
+// TaskDisposal tells what happens to the tasks of a project when it is deleted.
+type TaskDisposal string
+
+const (
+	// MoveTasksToInbox keeps the tasks and takes them out of the project.
+	MoveTasksToInbox TaskDisposal = "inbox"
+	// DeleteTasks moves the tasks and their subtasks to the trash.
+	DeleteTasks TaskDisposal = "delete"
+)
+
+// ParseTaskDisposal converts the name of a disposal to a TaskDisposal.
+// An empty name selects MoveTasksToInbox.
+func ParseTaskDisposal(name string) (TaskDisposal, error) {
+	switch TaskDisposal(name) {
+	case "", MoveTasksToInbox:
+		return MoveTasksToInbox, nil
+	case DeleteTasks:
+		return DeleteTasks, nil
+	default:
+		return "", ErrInvalidTaskDisposal
+	}
+}
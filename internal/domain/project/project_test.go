@@ -0,0 +1,171 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestNormalizeName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:          "plain name",
+			input:         "Groceries",
+			expected:      "Groceries",
+			expectedError: nil,
+		},
+		{
+			name:          "surrounding whitespace and zero-width characters are removed",
+			input:         " \u200B家の用事\t",
+			expected:      "家の用事",
+			expectedError: nil,
+		},
+		{
+			name:          "empty name",
+			input:         "  ",
+			expected:      "",
+			expectedError: ErrNameEmpty,
+		},
+		{
+			name:          "multi-byte name at max length",
+			input:         strings.Repeat("あ", MaxNameLength),
+			expected:      strings.Repeat("あ", MaxNameLength),
+			expectedError: nil,
+		},
+		{
+			name:          "name too long",
+			input:         strings.Repeat("a", MaxNameLength+1),
+			expected:      "",
+			expectedError: ErrNameTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result, err := NormalizeName(tt.input)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNewProject(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	id := GenerateProjectID()
+	ownerID := user.GenerateUserID()
+
+	// Act
+	project, err := NewProject(id, " Groceries ", ownerID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, id, project.ID())
+	assert.Equal(t, "Groceries", project.Name())
+	assert.Equal(t, ownerID, project.UserID())
+
+	_, err = NewProject(id, "\u200B", ownerID)
+	assert.ErrorIs(t, err, ErrNameEmpty)
+}
+
+func TestProjectRename(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	project := NewProjectWithoutValidation(GenerateProjectID(), "Groceries", user.GenerateUserID())
+
+	// Act & Assert
+	require.NoError(t, project.Rename("Shopping "))
+	assert.Equal(t, "Shopping", project.Name())
+
+	assert.ErrorIs(t, project.Rename(""), ErrNameEmpty)
+	assert.Equal(t, "Shopping", project.Name())
+}
+
+func TestNewProjectID(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewProjectID("")
+	assert.ErrorIs(t, err, ErrProjectIDEmpty)
+
+	_, err = NewProjectID("not-a-uuid")
+	assert.ErrorIs(t, err, ErrInvalidProjectIDFormat)
+
+	id := GenerateProjectID()
+	parsed, err := NewProjectID(id.String())
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+	assert.False(t, parsed.IsEmpty())
+}
+
+func TestParseTaskDisposal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      TaskDisposal
+		expectedError error
+	}{
+		{
+			name:          "default moves tasks to the inbox",
+			input:         "",
+			expected:      MoveTasksToInbox,
+			expectedError: nil,
+		},
+		{
+			name:          "inbox",
+			input:         "inbox",
+			expected:      MoveTasksToInbox,
+			expectedError: nil,
+		},
+		{
+			name:          "delete",
+			input:         "delete",
+			expected:      DeleteTasks,
+			expectedError: nil,
+		},
+		{
+			name:          "unknown disposal",
+			input:         "archive",
+			expected:      "",
+			expectedError: ErrInvalidTaskDisposal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result, err := ParseTaskDisposal(tt.input)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedError)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
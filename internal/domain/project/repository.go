@@ -0,0 +1,19 @@
+package project
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// ProjectRepository defines the interface for project data persistence operations.
+type ProjectRepository interface {
+	FindById(ctx context.Context, ownerID user.UserID, id ProjectID) (*Project, error)
+	// FindAllByUserID returns the user's projects ordered by name.
+	FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*Project, error)
+	Create(ctx context.Context, project *Project) (*Project, error)
+	// Update stores the new name of a project.
+	Update(ctx context.Context, project *Project) (*Project, error)
+	// Delete removes a project and disposes of its tasks as given in one transaction.
+	Delete(ctx context.Context, ownerID user.UserID, id ProjectID, disposal TaskDisposal) error
+}
@@ -0,0 +1,12 @@
+package tag
+
+import "errors"
+
+var (
+	ErrNameEmpty          = errors.New("tag name cannot be empty")
+	ErrNameTooLong        = errors.New("tag name cannot exceed 50 characters")
+	ErrNameTaken          = errors.New("tag name is already in use")
+	ErrTagNotFound        = errors.New("tag not found")
+	ErrTagIDEmpty         = errors.New("tag ID cannot be empty")
+	ErrInvalidTagIDFormat = errors.New("tag ID must be a valid UUID format")
+)
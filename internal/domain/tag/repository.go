@@ -0,0 +1,24 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// TagRepository defines the interface for tag data persistence operations.
+type TagRepository interface {
+	FindById(ctx context.Context, ownerID user.UserID, id TagID) (*Tag, error)
+	// FindAllByUserID returns the user's tags ordered by name.
+	FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*Tag, error)
+	// FindByNames returns the user's tags with the given names. Names without a
+	// tag are skipped, so the result may be shorter than names.
+	FindByNames(ctx context.Context, ownerID user.UserID, names []string) ([]*Tag, error)
+	// Create stores a new tag. It returns ErrNameTaken if the user already has a tag with that name.
+	Create(ctx context.Context, tag *Tag) (*Tag, error)
+	// Update stores the new name of a tag. It returns ErrNameTaken if the user
+	// already has another tag with that name.
+	Update(ctx context.Context, tag *Tag) (*Tag, error)
+	// Delete removes a tag and detaches it from all tasks.
+	Delete(ctx context.Context, ownerID user.UserID, id TagID) error
+}
@@ -0,0 +1,123 @@
+package tag
+
+import (
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/text"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// MaxNameLength defines the maximum allowed length for tag names.
+const MaxNameLength = 50
+
+// TagID represents a unique identifier for a tag.
+type TagID struct {
+	value uuid.UUID
+}
+
+// NewTagID creates a new TagID from a string value.
+func NewTagID(id string) (TagID, error) {
+	if id == "" {
+		return TagID{}, ErrTagIDEmpty
+	}
+
+	parsedUUID, err := uuid.Parse(id)
+	if err != nil {
+		return TagID{}, ErrInvalidTagIDFormat
+	}
+
+	return TagID{value: parsedUUID}, nil
+}
+
+// GenerateTagID creates a new TagID with a generated UUID.
+func GenerateTagID() TagID {
+	return TagID{value: uuid.New()}
+}
+
+// String returns the string representation of the TagID.
+func (t TagID) String() string {
+	return t.value.String()
+}
+
+// UUID returns the underlying uuid.UUID value.
+func (t TagID) UUID() uuid.UUID {
+	return t.value
+}
+
+// IsEmpty returns true if the TagID is empty.
+func (t TagID) IsEmpty() bool {
+	return t.value == uuid.Nil
+}
+
+// Tag represents a label a user attaches to tasks, such as "home" or "work".
+// Names are unique per user, so tasks refer to their tags by name.
+type Tag struct {
+	id      TagID
+	name    string
+	ownerID user.UserID
+}
+
+// NewTag creates a new Tag instance with a normalised name.
+// It returns an error if the name is invalid according to NormalizeName.
+func NewTag(id TagID, name string, ownerID user.UserID) (*Tag, error) {
+	name, err := NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tag{
+		id:      id,
+		name:    name,
+		ownerID: ownerID,
+	}, nil
+}
+
+// NewTagWithoutValidation rebuilds a Tag from stored values without validating them.
+func NewTagWithoutValidation(id TagID, name string, ownerID user.UserID) *Tag {
+	return &Tag{
+		id:      id,
+		name:    name,
+		ownerID: ownerID,
+	}
+}
+
+// NormalizeName removes surrounding spaces and zero-width characters from a
+// tag name, following the rules used for task titles, and validates the result.
+func NormalizeName(name string) (string, error) {
+	name = text.TrimSpaceAndZeroWidth(name)
+	if name == "" {
+		return "", ErrNameEmpty
+	}
+
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return "", ErrNameTooLong
+	}
+
+	return name, nil
+}
+
+func (t *Tag) ID() TagID {
+	return t.id
+}
+
+func (t *Tag) Name() string {
+	return t.name
+}
+
+func (t *Tag) UserID() user.UserID {
+	return t.ownerID
+}
+
+// Rename changes the name of the tag. Tasks carrying the tag show the new name.
+func (t *Tag) Rename(name string) error {
+	name, err := NormalizeName(name)
+	if err != nil {
+		return err
+	}
+
+	t.name = name
+
+	return nil
+}
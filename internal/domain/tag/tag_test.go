@@ -0,0 +1,146 @@
+package tag
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestNormalizeName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:          "plain name",
+			input:         "work",
+			expected:      "work",
+			expectedError: nil,
+		},
+		{
+			name:          "surrounding whitespace is removed",
+			input:         "  home\t\n",
+			expected:      "home",
+			expectedError: nil,
+		},
+		{
+			name:          "surrounding zero-width characters are removed",
+			input:         "\u200B\uFEFF仕事\u200D",
+			expected:      "仕事",
+			expectedError: nil,
+		},
+		{
+			name:          "inner spaces are kept",
+			input:         "side project",
+			expected:      "side project",
+			expectedError: nil,
+		},
+		{
+			name:          "empty name",
+			input:         "",
+			expected:      "",
+			expectedError: ErrNameEmpty,
+		},
+		{
+			name:          "only zero-width characters",
+			input:         "\u200B\u200C\u200D",
+			expected:      "",
+			expectedError: ErrNameEmpty,
+		},
+		{
+			name:          "multi-byte name at max length",
+			input:         strings.Repeat("あ", MaxNameLength),
+			expected:      strings.Repeat("あ", MaxNameLength),
+			expectedError: nil,
+		},
+		{
+			name:          "name too long",
+			input:         strings.Repeat("a", MaxNameLength+1),
+			expected:      "",
+			expectedError: ErrNameTooLong,
+		},
+		{
+			name:          "trimmed name within limit",
+			input:         " " + strings.Repeat("a", MaxNameLength) + " ",
+			expected:      strings.Repeat("a", MaxNameLength),
+			expectedError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result, err := NormalizeName(tt.input)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNewTag(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	id := GenerateTagID()
+	ownerID := user.GenerateUserID()
+
+	// Act
+	tag, err := NewTag(id, " work ", ownerID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, id, tag.ID())
+	assert.Equal(t, "work", tag.Name())
+	assert.Equal(t, ownerID, tag.UserID())
+
+	_, err = NewTag(id, "\u200B", ownerID)
+	assert.ErrorIs(t, err, ErrNameEmpty)
+}
+
+func TestTagRename(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	tag := NewTagWithoutValidation(GenerateTagID(), "work", user.GenerateUserID())
+
+	// Act & Assert
+	require.NoError(t, tag.Rename("office "))
+	assert.Equal(t, "office", tag.Name())
+
+	assert.ErrorIs(t, tag.Rename(strings.Repeat("a", MaxNameLength+1)), ErrNameTooLong)
+	assert.Equal(t, "office", tag.Name())
+}
+
+func TestNewTagID(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTagID("")
+	assert.ErrorIs(t, err, ErrTagIDEmpty)
+
+	_, err = NewTagID("not-a-uuid")
+	assert.ErrorIs(t, err, ErrInvalidTagIDFormat)
+
+	id := GenerateTagID()
+	parsed, err := NewTagID(id.String())
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+	assert.False(t, parsed.IsEmpty())
+}
@@ -0,0 +1,96 @@
+package task
+
+import (
+	"slices"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// Assignment is a change of the assignee of a task, recorded together with
+// the user who made it.
+type Assignment struct {
+	taskID     TaskID
+	assigneeID *user.UserID
+	assignedBy user.UserID
+	assignedAt time.Time
+}
+
+// NewAssignment creates an assignment of the task with taskID to the user with
+// assigneeID, made by the user with assignedBy at assignedAt.
+// A nil assigneeID records that the task was unassigned.
+func NewAssignment(taskID TaskID, assigneeID *user.UserID, assignedBy user.UserID, assignedAt time.Time) (Assignment, error) {
+	if taskID.IsEmpty() {
+		return Assignment{}, ErrTaskIDEmpty
+	}
+
+	if assignedBy.IsEmpty() || (assigneeID != nil && assigneeID.IsEmpty()) {
+		return Assignment{}, user.ErrUserIDEmpty
+	}
+
+	return Assignment{
+		taskID:     taskID,
+		assigneeID: assigneeID,
+		assignedBy: assignedBy,
+		assignedAt: assignedAt,
+	}, nil
+}
+
+// TaskID returns the ID of the assigned task.
+func (a Assignment) TaskID() TaskID {
+	return a.taskID
+}
+
+// AssigneeID returns the ID of the user the task was assigned to, or nil if
+// the task was unassigned.
+func (a Assignment) AssigneeID() *user.UserID {
+	return a.assigneeID
+}
+
+// AssignedBy returns the ID of the user who made the assignment.
+func (a Assignment) AssignedBy() user.UserID {
+	return a.assignedBy
+}
+
+// AssignedAt returns the time the assignment was made.
+func (a Assignment) AssignedAt() time.Time {
+	return a.assignedAt
+}
+
+// AssigneeID returns the ID of the user responsible for the task, or nil if
+// nobody is. The assignee can differ from the creator of the task.
+func (t *Task) AssigneeID() *user.UserID {
+	return t.assigneeID
+}
+
+// IsAssignedTo reports whether the user is the assignee of the task.
+func (t *Task) IsAssignedTo(userID user.UserID) bool {
+	return t.assigneeID != nil && *t.assigneeID == userID
+}
+
+// AssignTo makes the user with assigneeID responsible for the task, or leaves
+// nobody responsible if assigneeID is nil, and returns the change made by the
+// user with assignedBy at assignedAt.
+// The assignee must have access to the task: it returns
+// ErrAssigneeWithoutAccess unless they are its creator or one of the given
+// collaborators.
+func (t *Task) AssignTo(assigneeID *user.UserID, collaborators []Collaborator, assignedBy user.UserID, assignedAt time.Time) (Assignment, error) {
+	if assigneeID != nil && *assigneeID != t.creatorID {
+		hasAccess := slices.ContainsFunc(collaborators, func(collaborator Collaborator) bool {
+			return collaborator.TaskID() == t.id && collaborator.UserID() == *assigneeID
+		})
+		if !hasAccess {
+			return Assignment{}, ErrAssigneeWithoutAccess
+		}
+	}
+
+	assignment, err := NewAssignment(t.id, assigneeID, assignedBy, assignedAt)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	t.assigneeID = assigneeID
+	t.recordUpdated()
+
+	return assignment, nil
+}
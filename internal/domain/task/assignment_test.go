@@ -0,0 +1,119 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestAssignTo(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	collaboratorID := user.GenerateUserID()
+	strangerID := user.GenerateUserID()
+	taskID := GenerateTaskID()
+	assignedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	collaborator, err := NewCollaborator(taskID, collaboratorID, RoleEditor)
+	require.NoError(t, err)
+
+	otherTaskCollaborator, err := NewCollaborator(GenerateTaskID(), strangerID, RoleEditor)
+	require.NoError(t, err)
+
+	collaborators := []Collaborator{collaborator, otherTaskCollaborator}
+
+	tests := []struct {
+		name          string
+		assigneeID    *user.UserID
+		expectedError error
+	}{
+		{name: "assigned to the creator", assigneeID: &creatorID, expectedError: nil},
+		{name: "assigned to a collaborator", assigneeID: &collaboratorID, expectedError: nil},
+		{name: "unassigned", assigneeID: nil, expectedError: nil},
+		{name: "assigned to a user without access", assigneeID: &strangerID, expectedError: ErrAssigneeWithoutAccess},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			previousID := user.GenerateUserID()
+			taskEntity := NewTaskWithoutValidation(taskID, "Report", creatorID, WithAssigneeID(&previousID))
+
+			// Act
+			assignment, err := taskEntity.AssignTo(tt.assigneeID, collaborators, collaboratorID, assignedAt)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Equal(t, &previousID, taskEntity.AssigneeID())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.assigneeID, taskEntity.AssigneeID())
+			assert.Equal(t, taskID, assignment.TaskID())
+			assert.Equal(t, tt.assigneeID, assignment.AssigneeID())
+			assert.Equal(t, collaboratorID, assignment.AssignedBy())
+			assert.Equal(t, assignedAt, assignment.AssignedAt())
+		})
+	}
+}
+
+func TestIsAssignedTo(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	assigneeID := user.GenerateUserID()
+
+	// Arrange
+	assigned := NewTaskWithoutValidation(GenerateTaskID(), "Report", creatorID, WithAssigneeID(&assigneeID))
+	unassigned := NewTaskWithoutValidation(GenerateTaskID(), "Report", creatorID)
+
+	// Act & Assert
+	assert.True(t, assigned.IsAssignedTo(assigneeID))
+	assert.False(t, assigned.IsAssignedTo(creatorID))
+	assert.False(t, unassigned.IsAssignedTo(creatorID))
+}
+
+func TestNewAssignment(t *testing.T) {
+	t.Parallel()
+
+	emptyUserID := user.UserID{}
+
+	tests := []struct {
+		name          string
+		taskID        TaskID
+		assigneeID    *user.UserID
+		assignedBy    user.UserID
+		expectedError error
+	}{
+		{name: "valid assignment", taskID: GenerateTaskID(), assigneeID: nil, assignedBy: user.GenerateUserID(), expectedError: nil},
+		{name: "empty task ID", taskID: TaskID{}, assigneeID: nil, assignedBy: user.GenerateUserID(), expectedError: ErrTaskIDEmpty},
+		{name: "empty assigner", taskID: GenerateTaskID(), assigneeID: nil, assignedBy: user.UserID{}, expectedError: user.ErrUserIDEmpty},
+		{name: "empty assignee", taskID: GenerateTaskID(), assigneeID: &emptyUserID, assignedBy: user.GenerateUserID(), expectedError: user.ErrUserIDEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			_, err := NewAssignment(tt.taskID, tt.assigneeID, tt.assignedBy, time.Now())
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
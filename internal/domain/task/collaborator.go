@@ -0,0 +1,138 @@
+package task
+
+import (
+	"fmt"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// Role is what a collaborator may do with a task shared with them.
+type Role string
+
+const (
+	// RoleViewer may read the task.
+	RoleViewer Role = "viewer"
+	// RoleEditor may read and change the task.
+	RoleEditor Role = "editor"
+)
+
+// ParseRole parses the name of a role.
+// It returns ErrInvalidRole for any other name.
+func ParseRole(name string) (Role, error) {
+	switch role := Role(name); role {
+	case RoleViewer, RoleEditor:
+		return role, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidRole, name)
+	}
+}
+
+// Permission is an action on a task that requires access to it.
+type Permission int
+
+const (
+	// PermissionView allows reading the task and its collaborators.
+	PermissionView Permission = iota + 1
+	// PermissionEdit allows changing the task.
+	PermissionEdit
+	// PermissionManage allows sharing the task. It is never granted by a role.
+	PermissionManage
+)
+
+// Allows reports whether a collaborator with the role has the permission.
+func (r Role) Allows(permission Permission) bool {
+	switch permission {
+	case PermissionView:
+		return r == RoleViewer || r == RoleEditor
+	case PermissionEdit:
+		return r == RoleEditor
+	default:
+		return false
+	}
+}
+
+// Collaborator is a user a task is shared with, with the role they have on it.
+type Collaborator struct {
+	taskID TaskID
+	userID user.UserID
+	role   Role
+}
+
+// NewCollaborator creates a collaborator with the given role on the task with taskID.
+func NewCollaborator(taskID TaskID, userID user.UserID, role Role) (Collaborator, error) {
+	if taskID.IsEmpty() {
+		return Collaborator{}, ErrTaskIDEmpty
+	}
+
+	if userID.IsEmpty() {
+		return Collaborator{}, user.ErrUserIDEmpty
+	}
+
+	if _, err := ParseRole(string(role)); err != nil {
+		return Collaborator{}, err
+	}
+
+	return Collaborator{taskID: taskID, userID: userID, role: role}, nil
+}
+
+// TaskID returns the ID of the shared task.
+func (c Collaborator) TaskID() TaskID {
+	return c.taskID
+}
+
+// UserID returns the ID of the user the task is shared with.
+func (c Collaborator) UserID() user.UserID {
+	return c.userID
+}
+
+// Role returns the role of the collaborator on the task.
+func (c Collaborator) Role() Role {
+	return c.role
+}
+
+// ShareWith makes the user a collaborator on the task with the given role.
+// It returns ErrShareWithCreator if the user created the task.
+func (t *Task) ShareWith(userID user.UserID, role Role) (Collaborator, error) {
+	if userID == t.creatorID {
+		return Collaborator{}, ErrShareWithCreator
+	}
+
+	return NewCollaborator(t.id, userID, role)
+}
+
+// SharedRole returns the role of the user the task was read for if the task
+// is shared with them, or nil if they created it.
+func (t *Task) SharedRole() *Role {
+	return t.sharedRole
+}
+
+// IsShared reports whether the task was read for a collaborator rather than
+// for its creator.
+func (t *Task) IsShared() bool {
+	return t.sharedRole != nil
+}
+
+// Authorize checks that the user the task was read for may act on it with the
+// given permission. The creator may do anything. A collaborator or a member of
+// the workspace of the task may do what their role allows and gets
+// ErrForbidden otherwise; for anyone else it returns ErrTaskNotFound, so that
+// the task stays hidden from them.
+func (t *Task) Authorize(userID user.UserID, permission Permission) error {
+	if userID == t.creatorID {
+		return nil
+	}
+
+	if t.sharedRole == nil && t.workspaceRole == nil {
+		return ErrTaskNotFound
+	}
+
+	if t.sharedRole != nil && t.sharedRole.Allows(permission) {
+		return nil
+	}
+
+	if t.workspaceRole != nil && workspaceAllows(*t.workspaceRole, permission) {
+		return nil
+	}
+
+	return ErrForbidden
+}
@@ -0,0 +1,144 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+)
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         string
+		expectedRole  Role
+		expectedError error
+	}{
+		{name: "viewer", value: "viewer", expectedRole: RoleViewer, expectedError: nil},
+		{name: "editor", value: "editor", expectedRole: RoleEditor, expectedError: nil},
+		{name: "unknown role", value: "owner", expectedRole: "", expectedError: ErrInvalidRole},
+		{name: "empty", value: "", expectedRole: "", expectedError: ErrInvalidRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			role, err := ParseRole(tt.value)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRole, role)
+		})
+	}
+}
+
+func TestShareWith(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Shopping", creatorID)
+
+	t.Run("task shared with another user", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		userID := user.GenerateUserID()
+
+		// Act
+		collaborator, err := taskEntity.ShareWith(userID, RoleEditor)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, taskEntity.ID(), collaborator.TaskID())
+		assert.Equal(t, userID, collaborator.UserID())
+		assert.Equal(t, RoleEditor, collaborator.Role())
+	})
+
+	t.Run("task shared with its creator", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		_, err := taskEntity.ShareWith(creatorID, RoleViewer)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrShareWithCreator)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		_, err := taskEntity.ShareWith(user.GenerateUserID(), Role("owner"))
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidRole)
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	readerID := user.GenerateUserID()
+	viewer := RoleViewer
+	editor := RoleEditor
+	member := workspace.RoleMember
+	guest := workspace.RoleGuest
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		sharedRole    *Role
+		workspaceRole *workspace.Role
+		permission    Permission
+		expectedError error
+	}{
+		{name: "creator manages", userID: creatorID, sharedRole: nil, workspaceRole: nil, permission: PermissionManage, expectedError: nil},
+		{name: "viewer views", userID: readerID, sharedRole: &viewer, workspaceRole: nil, permission: PermissionView, expectedError: nil},
+		{name: "viewer cannot edit", userID: readerID, sharedRole: &viewer, workspaceRole: nil, permission: PermissionEdit, expectedError: ErrForbidden},
+		{name: "editor edits", userID: readerID, sharedRole: &editor, workspaceRole: nil, permission: PermissionEdit, expectedError: nil},
+		{name: "editor cannot manage", userID: readerID, sharedRole: &editor, workspaceRole: nil, permission: PermissionManage, expectedError: ErrForbidden},
+		{name: "stranger cannot view", userID: readerID, sharedRole: nil, workspaceRole: nil, permission: PermissionView, expectedError: ErrTaskNotFound},
+		{name: "workspace member edits", userID: readerID, sharedRole: nil, workspaceRole: &member, permission: PermissionEdit, expectedError: nil},
+		{name: "workspace member cannot manage", userID: readerID, sharedRole: nil, workspaceRole: &member, permission: PermissionManage, expectedError: ErrForbidden},
+		{name: "workspace guest views", userID: readerID, sharedRole: nil, workspaceRole: &guest, permission: PermissionView, expectedError: nil},
+		{name: "workspace guest cannot edit", userID: readerID, sharedRole: nil, workspaceRole: &guest, permission: PermissionEdit, expectedError: ErrForbidden},
+		{name: "viewer edits as workspace member", userID: readerID, sharedRole: &viewer, workspaceRole: &member, permission: PermissionEdit, expectedError: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Shopping", creatorID,
+				WithSharedRole(tt.sharedRole), WithWorkspaceRole(tt.workspaceRole))
+
+			// Act
+			err := taskEntity.Authorize(tt.userID, tt.permission)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.sharedRole != nil, taskEntity.IsShared())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package task
+
+// IsBlocked reports whether the task is blocked by a task that is not completed yet.
+func (t *Task) IsBlocked() bool {
+	return t.blocked
+}
+
+// Dependency is a "blocks" relation between two tasks of the same user:
+// work on the blocked task cannot start until the blocker is completed.
+type Dependency struct {
+	blockerID TaskID
+	blockedID TaskID
+}
+
+// NewDependency creates a dependency in which the task with blockerID blocks
+// the task with blockedID. It returns ErrSelfDependency if both are the same task.
+func NewDependency(blockerID, blockedID TaskID) (Dependency, error) {
+	if blockerID.IsEmpty() || blockedID.IsEmpty() {
+		return Dependency{}, ErrTaskIDEmpty
+	}
+
+	if blockerID == blockedID {
+		return Dependency{}, ErrSelfDependency
+	}
+
+	return Dependency{blockerID: blockerID, blockedID: blockedID}, nil
+}
+
+// BlockerID returns the ID of the task that has to be completed first.
+func (d Dependency) BlockerID() TaskID {
+	return d.blockerID
+}
+
+// BlockedID returns the ID of the task that waits for the blocker.
+func (d Dependency) BlockedID() TaskID {
+	return d.blockedID
+}
+
+// DependencyGraph keeps the dependencies between tasks free of cycles.
+// It only needs to hold the dependencies reachable from the blocked task of a
+// new dependency to decide whether that dependency may be added.
+type DependencyGraph struct {
+	blocks map[TaskID][]TaskID
+}
+
+// NewDependencyGraph builds a graph from existing dependencies, which are
+// assumed to be free of cycles.
+func NewDependencyGraph(dependencies []Dependency) *DependencyGraph {
+	graph := &DependencyGraph{blocks: make(map[TaskID][]TaskID)}
+
+	for _, dependency := range dependencies {
+		graph.blocks[dependency.blockerID] = append(graph.blocks[dependency.blockerID], dependency.blockedID)
+	}
+
+	return graph
+}
+
+// Add adds a dependency to the graph. It returns ErrDependencyCycle if the
+// blocked task already blocks the blocker, directly or through other tasks.
+func (g *DependencyGraph) Add(dependency Dependency) error {
+	if g.reaches(dependency.blockedID, dependency.blockerID) {
+		return ErrDependencyCycle
+	}
+
+	g.blocks[dependency.blockerID] = append(g.blocks[dependency.blockerID], dependency.blockedID)
+
+	return nil
+}
+
+// reaches reports whether a chain of dependencies leads from one task to another.
+func (g *DependencyGraph) reaches(from, to TaskID) bool {
+	visited := map[TaskID]bool{from: true}
+	pending := []TaskID{from}
+
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		if current == to {
+			return true
+		}
+
+		for _, next := range g.blocks[current] {
+			if !visited[next] {
+				visited[next] = true
+				pending = append(pending, next)
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,110 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mustDependency creates a dependency in which blocker blocks blocked.
+func mustDependency(t *testing.T, blocker, blocked TaskID) Dependency {
+	t.Helper()
+
+	dependency, err := NewDependency(blocker, blocked)
+	require.NoError(t, err)
+
+	return dependency
+}
+
+func TestNewDependency(t *testing.T) {
+	t.Parallel()
+
+	taskID := GenerateTaskID()
+
+	tests := []struct {
+		name          string
+		blockerID     TaskID
+		blockedID     TaskID
+		expectedError error
+	}{
+		{name: "different tasks", blockerID: GenerateTaskID(), blockedID: taskID, expectedError: nil},
+		{name: "task blocks itself", blockerID: taskID, blockedID: taskID, expectedError: ErrSelfDependency},
+		{name: "empty blocker", blockerID: TaskID{}, blockedID: taskID, expectedError: ErrTaskIDEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			dependency, err := NewDependency(tt.blockerID, tt.blockedID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.blockerID, dependency.BlockerID())
+			assert.Equal(t, tt.blockedID, dependency.BlockedID())
+		})
+	}
+}
+
+func TestDependencyGraphAdd(t *testing.T) {
+	t.Parallel()
+
+	a, b, c, d := GenerateTaskID(), GenerateTaskID(), GenerateTaskID(), GenerateTaskID()
+
+	tests := []struct {
+		name          string
+		existing      [][2]TaskID
+		blocker       TaskID
+		blocked       TaskID
+		expectedError error
+	}{
+		{name: "empty graph", existing: nil, blocker: a, blocked: b, expectedError: nil},
+		{name: "extends a chain", existing: [][2]TaskID{{a, b}}, blocker: b, blocked: c, expectedError: nil},
+		{name: "diamond", existing: [][2]TaskID{{a, b}, {a, c}, {b, d}}, blocker: c, blocked: d, expectedError: nil},
+		{name: "direct cycle", existing: [][2]TaskID{{a, b}}, blocker: b, blocked: a, expectedError: ErrDependencyCycle},
+		{name: "indirect cycle", existing: [][2]TaskID{{a, b}, {b, c}, {c, d}}, blocker: d, blocked: a, expectedError: ErrDependencyCycle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			dependencies := make([]Dependency, len(tt.existing))
+			for i, pair := range tt.existing {
+				dependencies[i] = mustDependency(t, pair[0], pair[1])
+			}
+
+			graph := NewDependencyGraph(dependencies)
+
+			// Act
+			err := graph.Add(mustDependency(t, tt.blocker, tt.blocked))
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+
+	t.Run("added dependencies are considered", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		graph := NewDependencyGraph(nil)
+		require.NoError(t, graph.Add(mustDependency(t, a, b)))
+		require.NoError(t, graph.Add(mustDependency(t, b, c)))
+
+		// Act
+		err := graph.Add(mustDependency(t, c, a))
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDependencyCycle)
+	})
+}
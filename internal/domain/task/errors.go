@@ -6,6 +6,58 @@ var (
 	ErrTitleEmpty          = errors.New("task title cannot be empty")
 	ErrTitleTooLong        = errors.New("task title cannot exceed 255 characters")
 	ErrTaskNotFound        = errors.New("task not found")
+	ErrVersionMismatch     = errors.New("task has been modified since it was read")
 	ErrTaskIDEmpty         = errors.New("task ID cannot be empty")
 	ErrInvalidTaskIDFormat = errors.New("task ID must be a valid UUID format")
+
+	ErrTaskAlreadyCompleted = errors.New("task is already completed")
+	ErrTaskNotCompleted     = errors.New("task is not completed")
+
+	ErrStartAfterDue     = errors.New("task start date cannot be after its due date")
+	ErrAllDayWithoutDate = errors.New("all-day task must have a start or due date")
+
+	ErrInvalidPageLimit = errors.New("page limit must be between 1 and 200")
+	ErrInvalidCursor    = errors.New("page cursor is invalid")
+
+	ErrUnknownSortField          = errors.New("unknown sort field")
+	ErrDuplicateSortField        = errors.New("sort field is specified more than once")
+	ErrUnknownFilterField        = errors.New("unknown filter field")
+	ErrUnsupportedFilterOperator = errors.New("filter operator is not supported for this field")
+	ErrInvalidFilterValue        = errors.New("filter value is invalid")
+	ErrInvalidFilterExpression   = errors.New("filter must have the form field:operator:value")
+
+	ErrParentNotFound = errors.New("parent task not found")
+	ErrParentCycle    = errors.New("task cannot be a subtask of itself or of its own subtasks")
+	ErrTreeTooDeep    = errors.New("task tree cannot be deeper than 5 levels")
+	ErrHasSubtasks    = errors.New("task has subtasks")
+
+	ErrSelfDependency     = errors.New("task cannot block itself")
+	ErrDependencyCycle    = errors.New("dependency would create a cycle")
+	ErrDependencyNotFound = errors.New("dependency not found")
+
+	ErrInvalidRecurrenceRule  = errors.New("recurrence rule is invalid")
+	ErrInvalidTimezone        = errors.New("recurrence timezone must be a valid IANA time zone name")
+	ErrRecurrenceWithoutDate  = errors.New("recurring task must have a start or due date")
+	ErrNotRecurring           = errors.New("task is not an occurrence of a recurring series")
+	ErrInvalidSeriesIDFormat  = errors.New("series ID must be a valid UUID format")
+	ErrInvalidOccurrenceLimit = errors.New("occurrence limit must be between 1 and 100")
+
+	ErrInvalidRank               = errors.New("rank is invalid")
+	ErrRankOrder                 = errors.New("task placed after must rank before the task placed before")
+	ErrRankTooLong               = errors.New("no room left between the ranks of the neighbouring tasks")
+	ErrNeighbourNotFound         = errors.New("neighbouring task not found")
+	ErrPlacementWithoutNeighbour = errors.New("task must be placed after or before another task")
+	ErrInvalidPlacement          = errors.New("task cannot be placed next to itself or on both sides of the same task")
+
+	ErrInvalidRole          = errors.New("collaborator role must be viewer or editor")
+	ErrShareWithCreator     = errors.New("task cannot be shared with its creator")
+	ErrCollaboratorNotFound = errors.New("collaborator not found")
+	ErrForbidden            = errors.New("user is not allowed to perform this action on the task")
+
+	ErrAssigneeWithoutAccess = errors.New("assignee must be the creator of the task or a collaborator on it")
+
+	ErrInvalidChangeType = errors.New("task change type is invalid")
+
+	ErrSearchTextEmpty   = errors.New("search query cannot be empty")
+	ErrSearchTextTooLong = errors.New("search query cannot exceed 255 characters")
 )
@@ -0,0 +1,152 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// Event is something that happened to a task, recorded by the Task aggregate
+// and published once the change has been saved.
+type Event interface {
+	// Name returns the name of the kind of event, such as "task.created".
+	Name() string
+	// TaskID returns the ID of the task the event happened to.
+	TaskID() TaskID
+	// UserID returns the ID of the creator of the task.
+	UserID() user.UserID
+	// OccurredAt returns the time the event happened.
+	OccurredAt() time.Time
+}
+
+// EventPublisher hands saved events to the subscribers interested in them.
+type EventPublisher interface {
+	// Publish delivers the events in order. Failures of subscribers are
+	// handled by the publisher and do not undo the change that was saved.
+	Publish(ctx context.Context, events ...Event)
+}
+
+// eventHeader holds what every task event has in common.
+type eventHeader struct {
+	taskID     TaskID
+	userID     user.UserID
+	occurredAt time.Time
+}
+
+// newEventHeader returns the header of an event that happens to t at the given time.
+func newEventHeader(t *Task, at time.Time) eventHeader {
+	return eventHeader{taskID: t.id, userID: t.creatorID, occurredAt: at}
+}
+
+// TaskID returns the ID of the task the event happened to.
+func (h eventHeader) TaskID() TaskID {
+	return h.taskID
+}
+
+// UserID returns the ID of the creator of the task.
+func (h eventHeader) UserID() user.UserID {
+	return h.userID
+}
+
+// OccurredAt returns the time the event happened.
+func (h eventHeader) OccurredAt() time.Time {
+	return h.occurredAt
+}
+
+// TaskCreated is recorded when a new task is created.
+type TaskCreated struct {
+	eventHeader
+
+	title string
+}
+
+// Name returns "task.created".
+func (TaskCreated) Name() string {
+	return "task.created"
+}
+
+// Title returns the title the task was created with.
+func (e TaskCreated) Title() string {
+	return e.title
+}
+
+// TaskTitleChanged is recorded when a task is given a different title.
+type TaskTitleChanged struct {
+	eventHeader
+
+	previousTitle string
+	title         string
+}
+
+// Name returns "task.title_changed".
+func (TaskTitleChanged) Name() string {
+	return "task.title_changed"
+}
+
+// PreviousTitle returns the title of the task before the change.
+func (e TaskTitleChanged) PreviousTitle() string {
+	return e.previousTitle
+}
+
+// Title returns the new title of the task.
+func (e TaskTitleChanged) Title() string {
+	return e.title
+}
+
+// TaskUpdated is recorded once for the changes made to a task between two
+// saves, whichever fields they touch, so that subscribers interested in the
+// state of the task rather than in single changes are notified once.
+// It is not recorded for a task that was created in the same save.
+type TaskUpdated struct {
+	eventHeader
+}
+
+// Name returns "task.updated".
+func (TaskUpdated) Name() string {
+	return "task.updated"
+}
+
+// TaskDeleted is recorded when a task is moved to the trash or removed from it.
+type TaskDeleted struct {
+	eventHeader
+}
+
+// Name returns "task.deleted".
+func (TaskDeleted) Name() string {
+	return "task.deleted"
+}
+
+// record appends an event to those the task has not handed out yet.
+func (t *Task) record(event Event) {
+	t.events = append(t.events, event)
+}
+
+// recordUpdated records TaskUpdated unless the task has already recorded its
+// creation or an update since the events were last pulled.
+func (t *Task) recordUpdated() {
+	for _, event := range t.events {
+		switch event.(type) {
+		case TaskCreated, TaskUpdated:
+			return
+		}
+	}
+
+	t.record(TaskUpdated{eventHeader: newEventHeader(t, time.Now())})
+}
+
+// Touch records TaskUpdated for a change the repository saved for the task
+// without going through its methods, such as a move in the manual order, a
+// restore from the trash or an edit of its series.
+func (t *Task) Touch() {
+	t.recordUpdated()
+}
+
+// PullEvents returns the events recorded since they were last pulled, oldest
+// first, and forgets them. Callers publish them once the task has been saved.
+func (t *Task) PullEvents() []Event {
+	events := t.events
+	t.events = nil
+
+	return events
+}
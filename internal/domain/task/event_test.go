@@ -0,0 +1,220 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestNewTask_RecordsCreated(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	taskID := GenerateTaskID()
+	creatorID := user.GenerateUserID()
+
+	// Act
+	taskEntity, err := NewTask(taskID, "Write report", creatorID)
+
+	// Assert
+	require.NoError(t, err)
+
+	events := taskEntity.PullEvents()
+	require.Len(t, events, 1)
+
+	created, ok := events[0].(TaskCreated)
+	require.True(t, ok)
+	assert.Equal(t, "task.created", created.Name())
+	assert.Equal(t, taskID, created.TaskID())
+	assert.Equal(t, creatorID, created.UserID())
+	assert.Equal(t, "Write report", created.Title())
+	assert.False(t, created.OccurredAt().IsZero())
+}
+
+func TestTask_UpdateTitle_RecordsTitleChanged(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		title          string
+		expectedEvents int
+	}{
+		{name: "different title", title: "Final report", expectedEvents: 2},
+		{name: "same title", title: "Draft", expectedEvents: 0},
+		{name: "invalid title", title: "", expectedEvents: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Draft", user.GenerateUserID())
+
+			// Act
+			_ = taskEntity.UpdateTitle(tt.title)
+
+			// Assert
+			events := taskEntity.PullEvents()
+			require.Len(t, events, tt.expectedEvents)
+
+			if tt.expectedEvents == 0 {
+				return
+			}
+
+			changed, ok := events[0].(TaskTitleChanged)
+			require.True(t, ok)
+			assert.Equal(t, "task.title_changed", changed.Name())
+			assert.Equal(t, "Draft", changed.PreviousTitle())
+			assert.Equal(t, tt.title, changed.Title())
+			assert.IsType(t, TaskUpdated{}, events[1])
+		})
+	}
+}
+
+func TestTask_RecordsUpdatedOnce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("several changes", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		creatorID := user.GenerateUserID()
+		taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Draft", creatorID)
+
+		// Act
+		require.NoError(t, taskEntity.Complete(time.Now()))
+		require.NoError(t, taskEntity.SetTags([]string{"work"}))
+		taskEntity.MoveToRoot()
+
+		// Assert
+		events := taskEntity.PullEvents()
+		require.Len(t, events, 1)
+
+		updated, ok := events[0].(TaskUpdated)
+		require.True(t, ok)
+		assert.Equal(t, "task.updated", updated.Name())
+		assert.Equal(t, taskEntity.ID(), updated.TaskID())
+		assert.Equal(t, creatorID, updated.UserID())
+
+		// Act
+		require.NoError(t, taskEntity.Reopen())
+
+		// Assert
+		events = taskEntity.PullEvents()
+		require.Len(t, events, 1)
+		assert.IsType(t, TaskUpdated{}, events[0])
+	})
+
+	t.Run("changes of a new task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		taskEntity, err := NewTask(GenerateTaskID(), "Draft", user.GenerateUserID())
+		require.NoError(t, err)
+
+		// Act
+		require.NoError(t, taskEntity.SetTags([]string{"work"}))
+
+		// Assert
+		events := taskEntity.PullEvents()
+		require.Len(t, events, 1)
+		assert.IsType(t, TaskCreated{}, events[0])
+	})
+}
+
+func TestTask_MoveToTrash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("records deletion", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Draft", user.GenerateUserID())
+		deletedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+		// Act
+		taskEntity.MoveToTrash(deletedAt)
+
+		// Assert
+		assert.True(t, taskEntity.IsDeleted())
+
+		events := taskEntity.PullEvents()
+		require.Len(t, events, 1)
+		assert.IsType(t, TaskDeleted{}, events[0])
+		assert.Equal(t, "task.deleted", events[0].Name())
+		assert.Equal(t, deletedAt, events[0].OccurredAt())
+	})
+
+	t.Run("already deleted task records nothing", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		deletedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+		taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Draft", user.GenerateUserID(), WithDeletedAt(&deletedAt))
+
+		// Act
+		taskEntity.MoveToTrash(deletedAt.Add(time.Hour))
+
+		// Assert
+		assert.Empty(t, taskEntity.PullEvents())
+	})
+}
+
+func TestTask_Purge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deletedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	purgedAt := deletedAt.Add(24 * time.Hour)
+	taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Draft", user.GenerateUserID(), WithDeletedAt(&deletedAt))
+
+	// Act
+	taskEntity.Purge(purgedAt)
+
+	// Assert
+	events := taskEntity.PullEvents()
+	require.Len(t, events, 1)
+	assert.IsType(t, TaskDeleted{}, events[0])
+	assert.Equal(t, taskEntity.ID(), events[0].TaskID())
+	assert.Equal(t, purgedAt, events[0].OccurredAt())
+}
+
+func TestTask_Touch(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Draft", user.GenerateUserID())
+	require.NoError(t, taskEntity.Complete(time.Now()))
+
+	// Act
+	taskEntity.Touch()
+
+	// Assert
+	events := taskEntity.PullEvents()
+	require.Len(t, events, 1)
+	assert.IsType(t, TaskUpdated{}, events[0])
+}
+
+func TestTask_PullEvents(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	taskEntity, err := NewTask(GenerateTaskID(), "Draft", user.GenerateUserID())
+	require.NoError(t, err)
+	require.NoError(t, taskEntity.UpdateTitle("Report"))
+
+	// Act
+	first := taskEntity.PullEvents()
+	second := taskEntity.PullEvents()
+
+	// Assert
+	require.Len(t, first, 2)
+	assert.IsType(t, TaskCreated{}, first[0])
+	assert.IsType(t, TaskTitleChanged{}, first[1])
+	assert.Empty(t, second)
+}
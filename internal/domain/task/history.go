@@ -0,0 +1,264 @@
+package task
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// ChangeType names what a change in the history of a task did.
+type ChangeType string
+
+const (
+	ChangeCreated         ChangeType = "created"
+	ChangeTitleChanged    ChangeType = "title_changed"
+	ChangeCompleted       ChangeType = "completed"
+	ChangeReopened        ChangeType = "reopened"
+	ChangeStartChanged    ChangeType = "start_changed"
+	ChangeDueChanged      ChangeType = "due_changed"
+	ChangeAllDayChanged   ChangeType = "all_day_changed"
+	ChangeTagAdded        ChangeType = "tag_added"
+	ChangeTagRemoved      ChangeType = "tag_removed"
+	ChangeParentChanged   ChangeType = "parent_changed"
+	ChangeProjectChanged  ChangeType = "project_changed"
+	ChangeAssigneeChanged ChangeType = "assignee_changed"
+	ChangeDeleted         ChangeType = "deleted"
+	ChangeRestored        ChangeType = "restored"
+	ChangePurged          ChangeType = "purged"
+)
+
+// changeTypes lists every known ChangeType.
+var changeTypes = []ChangeType{
+	ChangeCreated, ChangeTitleChanged, ChangeCompleted, ChangeReopened,
+	ChangeStartChanged, ChangeDueChanged, ChangeAllDayChanged, ChangeTagAdded, ChangeTagRemoved,
+	ChangeParentChanged, ChangeProjectChanged, ChangeAssigneeChanged, ChangeDeleted, ChangeRestored,
+	ChangePurged,
+}
+
+// ParseChangeType converts a stored name to a ChangeType.
+// It returns ErrInvalidChangeType for an unknown name.
+func ParseChangeType(name string) (ChangeType, error) {
+	changeType := ChangeType(name)
+	if !slices.Contains(changeTypes, changeType) {
+		return "", ErrInvalidChangeType
+	}
+
+	return changeType, nil
+}
+
+// Change is an entry in the history of a task, recorded together with the
+// user who made it. Before and after hold the previous and the new value as
+// text where the change has one: titles and tag names as they are, times in
+// RFC 3339 format, flags as "true" or "false" and references as IDs.
+// A nil value stands for a value that was not set.
+type Change struct {
+	taskID     TaskID
+	changeType ChangeType
+	actorID    user.UserID
+	before     *string
+	after      *string
+	occurredAt time.Time
+}
+
+// NewChange creates a change of the given type to the task with taskID, made
+// by the user with actorID at occurredAt.
+func NewChange(taskID TaskID, changeType ChangeType, actorID user.UserID, before, after *string, occurredAt time.Time) (Change, error) {
+	if taskID.IsEmpty() {
+		return Change{}, ErrTaskIDEmpty
+	}
+
+	if actorID.IsEmpty() {
+		return Change{}, user.ErrUserIDEmpty
+	}
+
+	if _, err := ParseChangeType(string(changeType)); err != nil {
+		return Change{}, err
+	}
+
+	return Change{
+		taskID:     taskID,
+		changeType: changeType,
+		actorID:    actorID,
+		before:     before,
+		after:      after,
+		occurredAt: occurredAt,
+	}, nil
+}
+
+// TaskID returns the ID of the changed task.
+func (c Change) TaskID() TaskID {
+	return c.taskID
+}
+
+// Type returns what the change did.
+func (c Change) Type() ChangeType {
+	return c.changeType
+}
+
+// ActorID returns the ID of the user who made the change.
+func (c Change) ActorID() user.UserID {
+	return c.actorID
+}
+
+// Before returns the value before the change, or nil if there was none.
+func (c Change) Before() *string {
+	return c.before
+}
+
+// After returns the value after the change, or nil if there is none.
+func (c Change) After() *string {
+	return c.after
+}
+
+// OccurredAt returns the time the change was made.
+func (c Change) OccurredAt() time.Time {
+	return c.occurredAt
+}
+
+// HistoryPage is a slice of the history of a task, oldest change first.
+// NextCursor is empty when there are no more changes after this page.
+type HistoryPage struct {
+	Changes    []Change
+	NextCursor string
+}
+
+// HasNext reports whether more changes follow this page.
+func (p HistoryPage) HasNext() bool {
+	return p.NextCursor != ""
+}
+
+// CreatedChange returns the change that records the creation of the task.
+func CreatedChange(t *Task, actorID user.UserID, at time.Time) Change {
+	title := t.title
+
+	return Change{taskID: t.id, changeType: ChangeCreated, actorID: actorID, before: nil, after: &title, occurredAt: at}
+}
+
+// DeletedChange returns the change that records moving the task with id to the trash.
+func DeletedChange(id TaskID, actorID user.UserID, at time.Time) Change {
+	return Change{taskID: id, changeType: ChangeDeleted, actorID: actorID, before: nil, after: nil, occurredAt: at}
+}
+
+// RestoredChange returns the change that records moving the task with id out of the trash.
+func RestoredChange(id TaskID, actorID user.UserID, at time.Time) Change {
+	return Change{taskID: id, changeType: ChangeRestored, actorID: actorID, before: nil, after: nil, occurredAt: at}
+}
+
+// PurgedChange returns the change that records removing the task with id from
+// the trash permanently. The history of the task is removed with it, so the
+// change is only announced to webhooks.
+func PurgedChange(id TaskID, actorID user.UserID, at time.Time) Change {
+	return Change{taskID: id, changeType: ChangePurged, actorID: actorID, before: nil, after: nil, occurredAt: at}
+}
+
+// Diff returns the changes that turn before into after, made by the user
+// with actorID at the given time. Both must be states of the same task.
+// Every tag that was added or removed is a change of its own.
+func Diff(before, after *Task, actorID user.UserID, at time.Time) []Change {
+	var changes []Change
+
+	add := func(changeType ChangeType, from, to *string) {
+		changes = append(changes, Change{
+			taskID:     after.id,
+			changeType: changeType,
+			actorID:    actorID,
+			before:     from,
+			after:      to,
+			occurredAt: at,
+		})
+	}
+
+	if before.title != after.title {
+		from, to := before.title, after.title
+		add(ChangeTitleChanged, &from, &to)
+	}
+
+	switch {
+	case !before.IsCompleted() && after.IsCompleted():
+		add(ChangeCompleted, nil, formatTime(after.completedAt))
+	case before.IsCompleted() && !after.IsCompleted():
+		add(ChangeReopened, formatTime(before.completedAt), nil)
+	}
+
+	if !sameTime(before.schedule.StartAt(), after.schedule.StartAt()) {
+		add(ChangeStartChanged, formatTime(before.schedule.StartAt()), formatTime(after.schedule.StartAt()))
+	}
+
+	if !sameTime(before.schedule.DueAt(), after.schedule.DueAt()) {
+		add(ChangeDueChanged, formatTime(before.schedule.DueAt()), formatTime(after.schedule.DueAt()))
+	}
+
+	if before.schedule.IsAllDay() != after.schedule.IsAllDay() {
+		from := strconv.FormatBool(before.schedule.IsAllDay())
+		to := strconv.FormatBool(after.schedule.IsAllDay())
+		add(ChangeAllDayChanged, &from, &to)
+	}
+
+	for _, name := range after.tags {
+		if !slices.Contains(before.tags, name) {
+			add(ChangeTagAdded, nil, &name)
+		}
+	}
+
+	for _, name := range before.tags {
+		if !slices.Contains(after.tags, name) {
+			add(ChangeTagRemoved, &name, nil)
+		}
+	}
+
+	if from, to := formatID(before.parentID), formatID(after.parentID); !sameValue(from, to) {
+		add(ChangeParentChanged, from, to)
+	}
+
+	if from, to := formatID(before.projectID), formatID(after.projectID); !sameValue(from, to) {
+		add(ChangeProjectChanged, from, to)
+	}
+
+	if from, to := formatID(before.assigneeID), formatID(after.assigneeID); !sameValue(from, to) {
+		add(ChangeAssigneeChanged, from, to)
+	}
+
+	return changes
+}
+
+// formatTime converts a time to the text of a change value.
+func formatTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+
+	formatted := value.UTC().Format(time.RFC3339Nano)
+
+	return &formatted
+}
+
+// formatID converts a reference to the text of a change value.
+func formatID[T interface{ String() string }](id *T) *string {
+	if id == nil {
+		return nil
+	}
+
+	formatted := (*id).String()
+
+	return &formatted
+}
+
+// sameTime reports whether two optional times are both unset or equal.
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+// sameValue reports whether two optional change values are both unset or equal.
+func sameValue(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
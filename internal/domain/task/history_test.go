@@ -0,0 +1,165 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestNewChange(t *testing.T) {
+	t.Parallel()
+
+	taskID := GenerateTaskID()
+	actorID := user.GenerateUserID()
+	occurredAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	before := "Draft"
+	after := "Report"
+
+	tests := []struct {
+		name          string
+		taskID        TaskID
+		changeType    ChangeType
+		actorID       user.UserID
+		expectedError error
+	}{
+		{name: "valid change", taskID: taskID, changeType: ChangeTitleChanged, actorID: actorID, expectedError: nil},
+		{name: "empty task ID", taskID: TaskID{}, changeType: ChangeTitleChanged, actorID: actorID, expectedError: ErrTaskIDEmpty},
+		{name: "empty actor ID", taskID: taskID, changeType: ChangeTitleChanged, actorID: user.UserID{}, expectedError: user.ErrUserIDEmpty},
+		{name: "unknown type", taskID: taskID, changeType: "renamed", actorID: actorID, expectedError: ErrInvalidChangeType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			change, err := NewChange(tt.taskID, tt.changeType, tt.actorID, &before, &after, occurredAt)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, taskID, change.TaskID())
+			assert.Equal(t, ChangeTitleChanged, change.Type())
+			assert.Equal(t, actorID, change.ActorID())
+			assert.Equal(t, &before, change.Before())
+			assert.Equal(t, &after, change.After())
+			assert.Equal(t, occurredAt, change.OccurredAt())
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	taskID := GenerateTaskID()
+	creatorID := user.GenerateUserID()
+	actorID := user.GenerateUserID()
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 3, 8, 17, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	completedAt := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+	projectID := project.GenerateProjectID()
+
+	stringPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name     string
+		before   *Task
+		after    *Task
+		expected []Change
+	}{
+		{
+			name:     "nothing changed",
+			before:   NewTaskWithoutValidation(taskID, "Report", creatorID, WithTags([]string{"work"})),
+			after:    NewTaskWithoutValidation(taskID, "Report", creatorID, WithTags([]string{"work"})),
+			expected: nil,
+		},
+		{
+			name:   "title changed",
+			before: NewTaskWithoutValidation(taskID, "Draft", creatorID),
+			after:  NewTaskWithoutValidation(taskID, "Report", creatorID),
+			expected: []Change{
+				{taskID: taskID, changeType: ChangeTitleChanged, actorID: actorID, before: stringPtr("Draft"), after: stringPtr("Report"), occurredAt: at},
+			},
+		},
+		{
+			name:   "completed and given a due date",
+			before: NewTaskWithoutValidation(taskID, "Report", creatorID),
+			after: NewTaskWithoutValidation(taskID, "Report", creatorID, WithCompletedAt(&completedAt),
+				WithSchedule(NewScheduleWithoutValidation(nil, &due, false))),
+			expected: []Change{
+				{taskID: taskID, changeType: ChangeCompleted, actorID: actorID, before: nil, after: stringPtr("2024-03-01T08:30:00Z"), occurredAt: at},
+				{taskID: taskID, changeType: ChangeDueChanged, actorID: actorID, before: nil, after: stringPtr("2024-03-08T08:00:00Z"), occurredAt: at},
+			},
+		},
+		{
+			name: "reopened and made an all-day task",
+			before: NewTaskWithoutValidation(taskID, "Report", creatorID, WithCompletedAt(&completedAt),
+				WithSchedule(NewScheduleWithoutValidation(nil, &due, false))),
+			after: NewTaskWithoutValidation(taskID, "Report", creatorID,
+				WithSchedule(NewScheduleWithoutValidation(nil, &due, true))),
+			expected: []Change{
+				{taskID: taskID, changeType: ChangeReopened, actorID: actorID, before: stringPtr("2024-03-01T08:30:00Z"), after: nil, occurredAt: at},
+				{taskID: taskID, changeType: ChangeAllDayChanged, actorID: actorID, before: stringPtr("false"), after: stringPtr("true"), occurredAt: at},
+			},
+		},
+		{
+			name:   "tags replaced and moved to a project",
+			before: NewTaskWithoutValidation(taskID, "Report", creatorID, WithTags([]string{"home", "work"})),
+			after: NewTaskWithoutValidation(taskID, "Report", creatorID, WithTags([]string{"urgent", "work"}),
+				WithProjectID(&projectID)),
+			expected: []Change{
+				{taskID: taskID, changeType: ChangeTagAdded, actorID: actorID, before: nil, after: stringPtr("urgent"), occurredAt: at},
+				{taskID: taskID, changeType: ChangeTagRemoved, actorID: actorID, before: stringPtr("home"), after: nil, occurredAt: at},
+				{taskID: taskID, changeType: ChangeProjectChanged, actorID: actorID, before: nil, after: stringPtr(projectID.String()), occurredAt: at},
+			},
+		},
+		{
+			name:   "unassigned",
+			before: NewTaskWithoutValidation(taskID, "Report", creatorID, WithAssigneeID(&creatorID)),
+			after:  NewTaskWithoutValidation(taskID, "Report", creatorID),
+			expected: []Change{
+				{taskID: taskID, changeType: ChangeAssigneeChanged, actorID: actorID, before: stringPtr(creatorID.String()), after: nil, occurredAt: at},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			changes := Diff(tt.before, tt.after, actorID, at)
+
+			// Assert
+			assert.Equal(t, tt.expected, changes)
+		})
+	}
+}
+
+func TestDiff_KeepsValuesOfChangedTask(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	actorID := user.GenerateUserID()
+	before := NewTaskWithoutValidation(GenerateTaskID(), "Draft", actorID)
+	after := NewTaskWithoutValidation(before.ID(), "Report", actorID)
+
+	changes := Diff(before, after, actorID, time.Now())
+	require.Len(t, changes, 1)
+
+	// Act
+	require.NoError(t, after.UpdateTitle("Final report"))
+
+	// Assert
+	assert.Equal(t, "Report", *changes[0].After())
+}
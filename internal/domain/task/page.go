@@ -0,0 +1,71 @@
+package task
+
+const (
+	// DefaultPageLimit is the number of tasks returned when no limit is requested.
+	DefaultPageLimit = 50
+	// MaxPageLimit is the largest number of tasks a single page may contain.
+	MaxPageLimit = 200
+)
+
+// PageRequest describes which slice of a task list to return.
+// Cursor is an opaque token returned as Page.NextCursor by a previous request;
+// an empty cursor starts from the beginning of the list.
+// A cursor is only valid for the sort it was issued with.
+type PageRequest struct {
+	limit  int
+	cursor string
+	sort   Sort
+}
+
+// NewPageRequest creates a new PageRequest with limit validation.
+// A limit of zero selects DefaultPageLimit and an empty sort selects DefaultSort.
+func NewPageRequest(limit int, cursor string, sort Sort) (PageRequest, error) {
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+
+	if limit < 0 || limit > MaxPageLimit {
+		return PageRequest{}, ErrInvalidPageLimit
+	}
+
+	return PageRequest{
+		limit:  limit,
+		cursor: cursor,
+		sort:   sort,
+	}, nil
+}
+
+// Limit returns the maximum number of tasks in the page.
+func (p PageRequest) Limit() int {
+	if p.limit == 0 {
+		return DefaultPageLimit
+	}
+
+	return p.limit
+}
+
+// Cursor returns the opaque position the page starts after.
+func (p PageRequest) Cursor() string {
+	return p.cursor
+}
+
+// Sort returns the order of the list the page is taken from.
+func (p PageRequest) Sort() Sort {
+	if len(p.sort) == 0 {
+		return DefaultSort
+	}
+
+	return p.sort
+}
+
+// Page is a slice of a task list in a stable order.
+// NextCursor is empty when there are no more tasks after this page.
+type Page struct {
+	Tasks      []*Task
+	NextCursor string
+}
+
+// HasNext reports whether more tasks follow this page.
+func (p Page) HasNext() bool {
+	return p.NextCursor != ""
+}
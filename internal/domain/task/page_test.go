@@ -0,0 +1,81 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPageRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		limit         int
+		cursor        string
+		expectedLimit int
+		expectedError error
+	}{
+		{
+			name:          "zero limit selects default",
+			limit:         0,
+			cursor:        "",
+			expectedLimit: DefaultPageLimit,
+			expectedError: nil,
+		},
+		{
+			name:          "explicit limit with cursor",
+			limit:         10,
+			cursor:        "opaque",
+			expectedLimit: 10,
+			expectedError: nil,
+		},
+		{
+			name:          "limit exactly max",
+			limit:         MaxPageLimit,
+			cursor:        "",
+			expectedLimit: MaxPageLimit,
+			expectedError: nil,
+		},
+		{
+			name:          "limit over max",
+			limit:         MaxPageLimit + 1,
+			cursor:        "",
+			expectedError: ErrInvalidPageLimit,
+		},
+		{
+			name:          "negative limit",
+			limit:         -1,
+			cursor:        "",
+			expectedError: ErrInvalidPageLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			page, err := NewPageRequest(tt.limit, tt.cursor, nil)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedLimit, page.Limit())
+			assert.Equal(t, tt.cursor, page.Cursor())
+			assert.Equal(t, DefaultSort, page.Sort())
+		})
+	}
+}
+
+func TestPageHasNext(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, Page{Tasks: nil, NextCursor: ""}.HasNext())
+	assert.True(t, Page{Tasks: nil, NextCursor: "next"}.HasNext())
+}
@@ -0,0 +1,166 @@
+package task
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SortField is a task attribute a task list can be ordered by.
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByUpdatedAt SortField = "updated_at"
+	SortByTitle     SortField = "title"
+	SortByRank      SortField = "rank"
+)
+
+// SortKey orders a task list by a single field.
+type SortKey struct {
+	Field      SortField
+	Descending bool
+}
+
+// Sort is an ordered list of sort keys; earlier keys take precedence.
+// Ties that remain after the last key are broken by task ID so that the
+// order is total.
+type Sort []SortKey
+
+// DefaultSort orders tasks by their rank in the manual order.
+var DefaultSort = Sort{{Field: SortByRank, Descending: false}}
+
+// ParseSort parses a comma-separated list of field names, each optionally
+// prefixed with "-" for descending order, e.g. "-updated_at,title".
+// An empty expression selects DefaultSort.
+func ParseSort(expr string) (Sort, error) {
+	if strings.TrimSpace(expr) == "" {
+		return DefaultSort, nil
+	}
+
+	parts := strings.Split(expr, ",")
+	sort := make(Sort, 0, len(parts))
+	seen := make(map[SortField]bool, len(parts))
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		descending := strings.HasPrefix(part, "-")
+		field := SortField(strings.TrimPrefix(part, "-"))
+
+		switch field {
+		case SortByCreatedAt, SortByUpdatedAt, SortByTitle, SortByRank:
+		default:
+			return nil, fmt.Errorf("%w: %q", ErrUnknownSortField, field)
+		}
+
+		if seen[field] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateSortField, field)
+		}
+
+		seen[field] = true
+		sort = append(sort, SortKey{Field: field, Descending: descending})
+	}
+
+	return sort, nil
+}
+
+// String returns the sort in the form accepted by ParseSort.
+func (s Sort) String() string {
+	parts := make([]string, len(s))
+	for i, key := range s {
+		if key.Descending {
+			parts[i] = "-" + string(key.Field)
+		} else {
+			parts[i] = string(key.Field)
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// FilterField is a task attribute a task list can be filtered on.
+type FilterField string
+
+const (
+	FilterByTitle     FilterField = "title"
+	FilterByCompleted FilterField = "completed"
+	FilterByCreatedAt FilterField = "created_at"
+	FilterByUpdatedAt FilterField = "updated_at"
+	FilterByStartAt   FilterField = "start_at"
+	FilterByDueAt     FilterField = "due_at"
+)
+
+// FilterOperator compares a task attribute with the value of a condition.
+type FilterOperator string
+
+const (
+	OpEqual          FilterOperator = "eq"
+	OpContains       FilterOperator = "contains"
+	OpGreater        FilterOperator = "gt"
+	OpGreaterOrEqual FilterOperator = "gte"
+	OpLess           FilterOperator = "lt"
+	OpLessOrEqual    FilterOperator = "lte"
+)
+
+// Condition is a single predicate on a task attribute.
+// Value holds a string for title, a bool for completed and a time.Time for
+// the date fields.
+type Condition struct {
+	Field    FilterField
+	Operator FilterOperator
+	Value    any
+}
+
+// ParseCondition parses a condition of the form "field:operator:value",
+// e.g. "title:contains:report" or "due_at:lt:2024-02-01T00:00:00Z".
+// Everything after the second colon is the value, so values may contain colons.
+func ParseCondition(expr string) (Condition, error) {
+	parts := strings.SplitN(expr, ":", 3)
+	if len(parts) != 3 {
+		return Condition{}, fmt.Errorf("%w: %q", ErrInvalidFilterExpression, expr)
+	}
+
+	field := FilterField(parts[0])
+	operator := FilterOperator(parts[1])
+	raw := parts[2]
+
+	switch field {
+	case FilterByTitle:
+		if operator != OpEqual && operator != OpContains {
+			return Condition{}, fmt.Errorf("%w: %q on %q", ErrUnsupportedFilterOperator, operator, field)
+		}
+
+		if raw == "" {
+			return Condition{}, fmt.Errorf("%w: %q", ErrInvalidFilterValue, expr)
+		}
+
+		return Condition{Field: field, Operator: operator, Value: raw}, nil
+	case FilterByCompleted:
+		if operator != OpEqual {
+			return Condition{}, fmt.Errorf("%w: %q on %q", ErrUnsupportedFilterOperator, operator, field)
+		}
+
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return Condition{}, fmt.Errorf("%w: %q", ErrInvalidFilterValue, expr)
+		}
+
+		return Condition{Field: field, Operator: operator, Value: value}, nil
+	case FilterByCreatedAt, FilterByUpdatedAt, FilterByStartAt, FilterByDueAt:
+		switch operator {
+		case OpEqual, OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual:
+		default:
+			return Condition{}, fmt.Errorf("%w: %q on %q", ErrUnsupportedFilterOperator, operator, field)
+		}
+
+		value, err := time.Parse(time.RFC3339Nano, raw)
+		if err != nil {
+			return Condition{}, fmt.Errorf("%w: %q", ErrInvalidFilterValue, expr)
+		}
+
+		return Condition{Field: field, Operator: operator, Value: value.UTC()}, nil
+	default:
+		return Condition{}, fmt.Errorf("%w: %q", ErrUnknownFilterField, field)
+	}
+}
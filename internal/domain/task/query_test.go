@@ -0,0 +1,186 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expr          string
+		expectedSort  Sort
+		expectedError error
+	}{
+		{
+			name:          "empty selects default",
+			expr:          "",
+			expectedSort:  DefaultSort,
+			expectedError: nil,
+		},
+		{
+			name: "mixed directions",
+			expr: "-updated_at,title",
+			expectedSort: Sort{
+				{Field: SortByUpdatedAt, Descending: true},
+				{Field: SortByTitle, Descending: false},
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "surrounding spaces",
+			expr:          " created_at , -title ",
+			expectedSort:  Sort{{Field: SortByCreatedAt, Descending: false}, {Field: SortByTitle, Descending: true}},
+			expectedError: nil,
+		},
+		{
+			name:          "rank",
+			expr:          "rank",
+			expectedSort:  Sort{{Field: SortByRank, Descending: false}},
+			expectedError: nil,
+		},
+		{
+			name:          "unknown field",
+			expr:          "-priority",
+			expectedSort:  nil,
+			expectedError: ErrUnknownSortField,
+		},
+		{
+			name:          "empty key",
+			expr:          "title,",
+			expectedSort:  nil,
+			expectedError: ErrUnknownSortField,
+		},
+		{
+			name:          "duplicate field",
+			expr:          "title,-title",
+			expectedSort:  nil,
+			expectedError: ErrDuplicateSortField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			sort, err := ParseSort(tt.expr)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedSort, sort)
+		})
+	}
+}
+
+func TestSortString(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	sort := Sort{{Field: SortByUpdatedAt, Descending: true}, {Field: SortByTitle, Descending: false}}
+
+	// Act & Assert
+	assert.Equal(t, "-updated_at,title", sort.String())
+}
+
+func TestParseCondition(t *testing.T) {
+	t.Parallel()
+
+	dueAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		expr              string
+		expectedCondition Condition
+		expectedError     error
+	}{
+		{
+			name:              "title contains",
+			expr:              "title:contains:weekly report",
+			expectedCondition: Condition{Field: FilterByTitle, Operator: OpContains, Value: "weekly report"},
+			expectedError:     nil,
+		},
+		{
+			name:              "value with colons",
+			expr:              "title:eq:a:b",
+			expectedCondition: Condition{Field: FilterByTitle, Operator: OpEqual, Value: "a:b"},
+			expectedError:     nil,
+		},
+		{
+			name:              "completed equal",
+			expr:              "completed:eq:true",
+			expectedCondition: Condition{Field: FilterByCompleted, Operator: OpEqual, Value: true},
+			expectedError:     nil,
+		},
+		{
+			name:              "date range bound normalised to UTC",
+			expr:              "due_at:lt:2024-02-01T09:00:00+09:00",
+			expectedCondition: Condition{Field: FilterByDueAt, Operator: OpLess, Value: dueAt},
+			expectedError:     nil,
+		},
+		{
+			name:          "unknown field",
+			expr:          "priority:eq:high",
+			expectedError: ErrUnknownFilterField,
+		},
+		{
+			name:          "unsupported operator for title",
+			expr:          "title:gt:a",
+			expectedError: ErrUnsupportedFilterOperator,
+		},
+		{
+			name:          "contains on date field",
+			expr:          "created_at:contains:2024",
+			expectedError: ErrUnsupportedFilterOperator,
+		},
+		{
+			name:          "invalid date value",
+			expr:          "updated_at:gte:yesterday",
+			expectedError: ErrInvalidFilterValue,
+		},
+		{
+			name:          "invalid boolean value",
+			expr:          "completed:eq:maybe",
+			expectedError: ErrInvalidFilterValue,
+		},
+		{
+			name:          "empty title value",
+			expr:          "title:contains:",
+			expectedError: ErrInvalidFilterValue,
+		},
+		{
+			name:          "missing operator",
+			expr:          "title",
+			expectedError: ErrInvalidFilterExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			condition, err := ParseCondition(tt.expr)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCondition, condition)
+		})
+	}
+}
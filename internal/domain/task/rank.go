@@ -0,0 +1,238 @@
+package task
+
+import (
+	"strings"
+)
+
+// rankDigits are the digits of a rank in ascending order.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const (
+	// MaxRankLength is the longest rank that can be stored.
+	MaxRankLength = 255
+	// RebalanceRankLength is the rank length above which the ranks of a user
+	// are spread out again by a rebalance.
+	RebalanceRankLength = 32
+	// rankStepLength is the precision at which a rank is appended after the
+	// last one, so that appending does not make ranks longer.
+	rankStepLength = 6
+)
+
+// Rank is the position of a task in the manual order of the tasks of a user.
+// A rank is a base-36 fraction written without its leading "0.", so ranks
+// compare as plain strings. A rank never ends with the digit 0, which leaves
+// room for another rank between any two ranks.
+// The zero value is an open end of the order.
+type Rank string
+
+// NewRank creates a Rank from its stored form.
+// It returns ErrInvalidRank if value is not a rank.
+func NewRank(value string) (Rank, error) {
+	if value == "" || len(value) > MaxRankLength || strings.HasSuffix(value, "0") {
+		return "", ErrInvalidRank
+	}
+
+	for i := range len(value) {
+		if strings.IndexByte(rankDigits, value[i]) < 0 {
+			return "", ErrInvalidRank
+		}
+	}
+
+	return Rank(value), nil
+}
+
+// String returns the stored form of the rank.
+func (r Rank) String() string {
+	return string(r)
+}
+
+// IsEmpty reports whether the rank is an open end of the order.
+func (r Rank) IsEmpty() bool {
+	return r == ""
+}
+
+// RankBetween returns a rank that sorts after lower and before upper. An empty
+// lower or upper leaves that end open.
+// It returns ErrRankOrder if lower does not sort before upper, and
+// ErrRankTooLong if the ranks are too close to fit another one in; a
+// rebalance makes room again.
+func RankBetween(lower, upper Rank) (Rank, error) {
+	if !lower.IsEmpty() && !upper.IsEmpty() && lower >= upper {
+		return "", ErrRankOrder
+	}
+
+	var rank string
+	if upper.IsEmpty() && !lower.IsEmpty() {
+		rank = rankAfter(string(lower))
+	} else {
+		rank = rankMidpoint(string(lower), string(upper))
+	}
+
+	if len(rank) > MaxRankLength {
+		return "", ErrRankTooLong
+	}
+
+	return Rank(rank), nil
+}
+
+// SpreadRanks returns n ranks of equal length in ascending order, spaced
+// evenly over the whole order. A rebalance gives them to the tasks of a user
+// in their current order.
+func SpreadRanks(n int) []Rank {
+	if n <= 0 {
+		return []Rank{}
+	}
+
+	// One digit more than needed keeps room between neighbouring ranks.
+	length := 1
+	scale := uint64(len(rankDigits))
+
+	for scale <= uint64(n) {
+		length++
+		scale *= uint64(len(rankDigits))
+	}
+
+	length++
+	scale *= uint64(len(rankDigits))
+
+	ranks := make([]Rank, n)
+	for i := range ranks {
+		value := (uint64(i) + 1) * scale / (uint64(n) + 1)
+		ranks[i] = Rank(strings.TrimRight(formatRankDigits(value, length), "0"))
+	}
+
+	return ranks
+}
+
+// rankAfter returns a rank after lower. Instead of halving the open space
+// after lower, it adds one at rankStepLength digits, so that appending tasks
+// one after another keeps their ranks short.
+func rankAfter(lower string) string {
+	digits := []byte(lower)
+	if len(digits) > rankStepLength {
+		digits = digits[:rankStepLength]
+	}
+
+	for len(digits) < rankStepLength {
+		digits = append(digits, rankDigits[0])
+	}
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := strings.IndexByte(rankDigits, digits[i])
+		if digit < len(rankDigits)-1 {
+			digits[i] = rankDigits[digit+1]
+
+			return strings.TrimRight(string(digits), "0")
+		}
+
+		digits[i] = rankDigits[0]
+	}
+
+	// Every digit was the largest one, so there is no room at this precision.
+	return rankMidpoint(lower, "")
+}
+
+// rankMidpoint returns the shortest rank between lower and upper, where an
+// empty lower stands for the start and an empty upper for the end of the order.
+// Missing digits of lower count as zeros.
+func rankMidpoint(lower, upper string) string {
+	if upper != "" {
+		prefix := 0
+		for prefix < len(upper) && rankDigitAt(lower, prefix) == upper[prefix] {
+			prefix++
+		}
+
+		if prefix > 0 {
+			rest := ""
+			if prefix < len(lower) {
+				rest = lower[prefix:]
+			}
+
+			return upper[:prefix] + rankMidpoint(rest, upper[prefix:])
+		}
+	}
+
+	low := 0
+	if lower != "" {
+		low = strings.IndexByte(rankDigits, lower[0])
+	}
+
+	high := len(rankDigits)
+	if upper != "" {
+		high = strings.IndexByte(rankDigits, upper[0])
+	}
+
+	if high-low > 1 {
+		return string(rankDigits[(low+high)/2])
+	}
+
+	// The first digits are adjacent. A longer upper bound can be cut off after
+	// its first digit; otherwise the rank continues after the first digit of lower.
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+
+	rest := ""
+	if len(lower) > 1 {
+		rest = lower[1:]
+	}
+
+	return string(rankDigits[low]) + rankMidpoint(rest, "")
+}
+
+// rankDigitAt returns the digit of the rank at index i, or zero past its end.
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+
+	return rankDigits[0]
+}
+
+// formatRankDigits writes value as a base-36 number of exactly length digits.
+func formatRankDigits(value uint64, length int) string {
+	digits := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		digits[i] = rankDigits[value%uint64(len(rankDigits))]
+		value /= uint64(len(rankDigits))
+	}
+
+	return string(digits)
+}
+
+// Placement describes where a task is moved to in the manual order: directly
+// after one task, directly before another, or between the two.
+type Placement struct {
+	afterID  *TaskID
+	beforeID *TaskID
+}
+
+// NewPlacement creates the placement of the task with the given ID after the
+// task with afterID and before the task with beforeID; either may be nil.
+// It returns ErrPlacementWithoutNeighbour if both are nil and
+// ErrInvalidPlacement if a neighbour is the task itself or both are the same task.
+func NewPlacement(id TaskID, afterID, beforeID *TaskID) (Placement, error) {
+	if afterID == nil && beforeID == nil {
+		return Placement{}, ErrPlacementWithoutNeighbour
+	}
+
+	if (afterID != nil && *afterID == id) || (beforeID != nil && *beforeID == id) {
+		return Placement{}, ErrInvalidPlacement
+	}
+
+	if afterID != nil && beforeID != nil && *afterID == *beforeID {
+		return Placement{}, ErrInvalidPlacement
+	}
+
+	return Placement{afterID: afterID, beforeID: beforeID}, nil
+}
+
+// AfterID returns the ID of the task the moved task follows, or nil.
+func (p Placement) AfterID() *TaskID {
+	return p.afterID
+}
+
+// BeforeID returns the ID of the task the moved task precedes, or nil.
+func (p Placement) BeforeID() *TaskID {
+	return p.beforeID
+}
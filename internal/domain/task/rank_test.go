@@ -0,0 +1,197 @@
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRank(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		value         string
+		expectedError error
+	}{
+		{name: "valid rank", value: "0000001i", expectedError: nil},
+		{name: "empty", value: "", expectedError: ErrInvalidRank},
+		{name: "trailing zero", value: "i0", expectedError: ErrInvalidRank},
+		{name: "upper case digit", value: "I", expectedError: ErrInvalidRank},
+		{name: "too long", value: strings.Repeat("i", MaxRankLength+1), expectedError: ErrInvalidRank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			rank, err := NewRank(tt.value)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.value, rank.String())
+		})
+	}
+}
+
+func TestRankBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		lower         Rank
+		upper         Rank
+		expectedRank  Rank
+		expectedError error
+	}{
+		{name: "empty order", lower: "", upper: "", expectedRank: "i", expectedError: nil},
+		{name: "before first", lower: "", upper: "i", expectedRank: "9", expectedError: nil},
+		{name: "after last", lower: "i", upper: "", expectedRank: "i00001", expectedError: nil},
+		{name: "after last carries", lower: "i0000z", upper: "", expectedRank: "i0001", expectedError: nil},
+		{name: "after a long rank", lower: "i00001zzz", upper: "", expectedRank: "i00002", expectedError: nil},
+		{name: "between distant ranks", lower: "a", upper: "k", expectedRank: "f", expectedError: nil},
+		{name: "between adjacent digits", lower: "a", upper: "b", expectedRank: "ai", expectedError: nil},
+		{name: "between ranks with common prefix", lower: "i00001", upper: "i00002", expectedRank: "i00001i", expectedError: nil},
+		{name: "between prefix and longer rank", lower: "i", upper: "i1", expectedRank: "i0i", expectedError: nil},
+		{name: "shorter upper digit", lower: "a5", upper: "b5", expectedRank: "b", expectedError: nil},
+		{name: "lower after upper", lower: "k", upper: "a", expectedRank: "", expectedError: ErrRankOrder},
+		{name: "equal ranks", lower: "k", upper: "k", expectedRank: "", expectedError: ErrRankOrder},
+		{
+			name:          "no room left",
+			lower:         Rank(strings.Repeat("0", MaxRankLength-1) + "1"),
+			upper:         Rank(strings.Repeat("0", MaxRankLength-1) + "2"),
+			expectedRank:  "",
+			expectedError: ErrRankTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			rank, err := RankBetween(tt.lower, tt.upper)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRank, rank)
+
+			_, err = NewRank(rank.String())
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRankBetweenRepeatedInserts(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	lower, upper := Rank("a"), Rank("b")
+
+	// Act & Assert
+	for range 100 {
+		rank, err := RankBetween(lower, upper)
+		require.NoError(t, err)
+		assert.Less(t, lower, rank)
+		assert.Less(t, rank, upper)
+
+		upper = rank
+	}
+}
+
+func TestSpreadRanks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		count int
+	}{
+		{name: "no tasks", count: 0},
+		{name: "single task", count: 1},
+		{name: "one digit", count: 35},
+		{name: "two digits", count: 36},
+		{name: "many tasks", count: 5000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			ranks := SpreadRanks(tt.count)
+
+			// Assert
+			require.Len(t, ranks, tt.count)
+
+			for i, rank := range ranks {
+				_, err := NewRank(rank.String())
+				require.NoError(t, err)
+
+				if i > 0 {
+					assert.Less(t, ranks[i-1], rank)
+
+					_, err = RankBetween(ranks[i-1], rank)
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewPlacement(t *testing.T) {
+	t.Parallel()
+
+	taskID := GenerateTaskID()
+	afterID := GenerateTaskID()
+	beforeID := GenerateTaskID()
+
+	tests := []struct {
+		name          string
+		afterID       *TaskID
+		beforeID      *TaskID
+		expectedError error
+	}{
+		{name: "after a task", afterID: &afterID, beforeID: nil, expectedError: nil},
+		{name: "before a task", afterID: nil, beforeID: &beforeID, expectedError: nil},
+		{name: "between two tasks", afterID: &afterID, beforeID: &beforeID, expectedError: nil},
+		{name: "no neighbour", afterID: nil, beforeID: nil, expectedError: ErrPlacementWithoutNeighbour},
+		{name: "after itself", afterID: &taskID, beforeID: nil, expectedError: ErrInvalidPlacement},
+		{name: "before itself", afterID: nil, beforeID: &taskID, expectedError: ErrInvalidPlacement},
+		{name: "both sides of the same task", afterID: &afterID, beforeID: &afterID, expectedError: ErrInvalidPlacement},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			placement, err := NewPlacement(taskID, tt.afterID, tt.beforeID)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.afterID, placement.AfterID())
+			assert.Equal(t, tt.beforeID, placement.BeforeID())
+		})
+	}
+}
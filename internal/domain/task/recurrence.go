@@ -0,0 +1,572 @@
+package task
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxRecurrenceRuleLength defines the maximum allowed length for recurrence rules.
+const MaxRecurrenceRuleLength = 500
+
+// DefaultOccurrenceLimit is the number of upcoming occurrences listed when no
+// limit is given.
+const DefaultOccurrenceLimit = 10
+
+// MaxOccurrenceLimit is the largest number of upcoming occurrences that can be
+// listed at once.
+const MaxOccurrenceLimit = 100
+
+// maxRecurrencePeriods bounds how many periods of a rule are expanded when
+// looking for occurrences, so that a rule whose parts never match a date, such
+// as the 30th of February, cannot loop forever.
+const maxRecurrencePeriods = 10000
+
+// frequency is the FREQ part of a recurrence rule.
+type frequency string
+
+const (
+	daily   frequency = "DAILY"
+	weekly  frequency = "WEEKLY"
+	monthly frequency = "MONTHLY"
+	yearly  frequency = "YEARLY"
+)
+
+// weekdays maps the two-letter day names of RFC 5545 to time.Weekday.
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// weekdayNum is an entry of BYDAY: a day of the week, optionally restricted to
+// its nth occurrence within the month or year. A negative ordinal counts from
+// the end, and zero means every such day.
+type weekdayNum struct {
+	ordinal int
+	weekday time.Weekday
+}
+
+// rule is the parsed form of a recurrence rule.
+type rule struct {
+	freq       frequency
+	interval   int
+	count      int
+	until      *time.Time
+	byDay      []weekdayNum
+	byMonthDay []int
+	byMonth    []time.Month
+	weekStart  time.Weekday
+}
+
+// Recurrence is an RFC 5545 recurrence rule together with the time zone its
+// dates are computed in and the first occurrence it is counted from (DTSTART).
+//
+// The rule parts FREQ, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH and
+// WKST are supported. Occurrences keep the wall-clock time of the first
+// occurrence in the time zone, so a daily 9:00 rule stays at 9:00 across
+// daylight saving changes.
+type Recurrence struct {
+	rule     string
+	location *time.Location
+	start    time.Time
+	parsed   rule
+}
+
+// NewRecurrence parses and validates a recurrence rule. The rule may carry the
+// "RRULE:" prefix of its iCalendar property. timezone is an IANA time zone name
+// such as "Asia/Tokyo".
+// It returns ErrInvalidRecurrenceRule describing the offending part if the rule
+// is malformed, uses an unsupported part, or has no occurrence at all, and
+// ErrInvalidTimezone if the time zone is unknown.
+func NewRecurrence(rrule, timezone string, start time.Time) (Recurrence, error) {
+	if timezone == "" || timezone == "Local" {
+		return Recurrence{}, ErrInvalidTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return Recurrence{}, ErrInvalidTimezone
+	}
+
+	text := strings.TrimSpace(rrule)
+	if len(text) >= len("RRULE:") && strings.EqualFold(text[:len("RRULE:")], "RRULE:") {
+		text = text[len("RRULE:"):]
+	}
+
+	if len(text) > MaxRecurrenceRuleLength {
+		return Recurrence{}, fmt.Errorf("%w: rule cannot exceed %d characters", ErrInvalidRecurrenceRule, MaxRecurrenceRuleLength)
+	}
+
+	parsed, err := parseRule(text, location)
+	if err != nil {
+		return Recurrence{}, err
+	}
+
+	recurrence := Recurrence{
+		rule:     text,
+		location: location,
+		start:    start.In(location),
+		parsed:   parsed,
+	}
+
+	if len(recurrence.Occurrences(recurrence.start.Add(-time.Nanosecond), 1)) == 0 {
+		return Recurrence{}, fmt.Errorf("%w: rule has no occurrences", ErrInvalidRecurrenceRule)
+	}
+
+	return recurrence, nil
+}
+
+// Rule returns the recurrence rule without the "RRULE:" prefix.
+func (r Recurrence) Rule() string {
+	return r.rule
+}
+
+// Timezone returns the name of the time zone the rule is computed in.
+func (r Recurrence) Timezone() string {
+	return r.location.String()
+}
+
+// Start returns the first occurrence the rule is counted from.
+func (r Recurrence) Start() time.Time {
+	return r.start
+}
+
+// Occurrences returns up to limit occurrences strictly after the given
+// instant, earliest first. Only instants at or after Start are occurrences.
+func (r Recurrence) Occurrences(after time.Time, limit int) []time.Time {
+	occurrences := make([]time.Time, 0, max(limit, 0))
+	if limit <= 0 {
+		return occurrences
+	}
+
+	first := 0
+	// Without COUNT, occurrences before the instant need not be counted, so
+	// expansion can skip ahead to the period containing it.
+	if r.parsed.count == 0 && after.After(r.start) {
+		first = max(r.parsed.periodsBetween(r.start, after.In(r.location))-1, 0)
+	}
+
+	seen := 0
+
+	for period := first; period < first+maxRecurrencePeriods; period++ {
+		for _, candidate := range r.parsed.expand(r.start, period) {
+			if candidate.Before(r.start) {
+				continue
+			}
+
+			if r.parsed.until != nil && candidate.After(*r.parsed.until) {
+				return occurrences
+			}
+
+			seen++
+			if r.parsed.count > 0 && seen > r.parsed.count {
+				return occurrences
+			}
+
+			if !candidate.After(after) {
+				continue
+			}
+
+			occurrences = append(occurrences, candidate)
+			if len(occurrences) == limit {
+				return occurrences
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// parseRule parses the parts of a rule without its "RRULE:" prefix.
+// UNTIL values without a UTC designator are read in location.
+func parseRule(text string, location *time.Location) (rule, error) {
+	parsed := rule{interval: 1, weekStart: time.Monday}
+	seen := make(map[string]bool)
+
+	if text == "" {
+		return rule{}, fmt.Errorf("%w: rule is empty", ErrInvalidRecurrenceRule)
+	}
+
+	for part := range strings.SplitSeq(text, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(name)
+
+		if !ok || value == "" {
+			return rule{}, fmt.Errorf("%w: %q must have the form NAME=VALUE", ErrInvalidRecurrenceRule, part)
+		}
+
+		if seen[name] {
+			return rule{}, fmt.Errorf("%w: %s is specified more than once", ErrInvalidRecurrenceRule, name)
+		}
+
+		seen[name] = true
+
+		if err := parsed.set(name, strings.ToUpper(value), location); err != nil {
+			return rule{}, err
+		}
+	}
+
+	if err := parsed.validate(); err != nil {
+		return rule{}, err
+	}
+
+	return parsed, nil
+}
+
+// set parses the value of a single rule part into the rule.
+func (r *rule) set(name, value string, location *time.Location) error {
+	var err error
+
+	switch name {
+	case "FREQ":
+		switch frequency(value) {
+		case daily, weekly, monthly, yearly:
+			r.freq = frequency(value)
+		default:
+			err = fmt.Errorf("%w: FREQ=%s is not supported", ErrInvalidRecurrenceRule, value)
+		}
+	case "INTERVAL":
+		r.interval, err = parsePositive(name, value)
+	case "COUNT":
+		r.count, err = parsePositive(name, value)
+	case "UNTIL":
+		r.until, err = parseUntil(value, location)
+	case "BYDAY":
+		r.byDay, err = parseByDay(value)
+	case "BYMONTHDAY":
+		r.byMonthDay, err = parseIntList(name, value, 1, 31, true)
+	case "BYMONTH":
+		var months []int
+
+		months, err = parseIntList(name, value, 1, 12, false)
+		for _, month := range months {
+			r.byMonth = append(r.byMonth, time.Month(month))
+		}
+	case "WKST":
+		weekday, ok := weekdays[value]
+		if !ok {
+			return fmt.Errorf("%w: WKST=%s is not a day of the week", ErrInvalidRecurrenceRule, value)
+		}
+
+		r.weekStart = weekday
+	default:
+		err = fmt.Errorf("%w: %s is not supported", ErrInvalidRecurrenceRule, name)
+	}
+
+	return err
+}
+
+// validate checks the combination of rule parts.
+func (r rule) validate() error {
+	if r.freq == "" {
+		return fmt.Errorf("%w: FREQ is required", ErrInvalidRecurrenceRule)
+	}
+
+	if r.count > 0 && r.until != nil {
+		return fmt.Errorf("%w: COUNT and UNTIL cannot be combined", ErrInvalidRecurrenceRule)
+	}
+
+	if r.freq == weekly && len(r.byMonthDay) > 0 {
+		return fmt.Errorf("%w: BYMONTHDAY cannot be used with FREQ=WEEKLY", ErrInvalidRecurrenceRule)
+	}
+
+	for _, day := range r.byDay {
+		if day.ordinal == 0 {
+			continue
+		}
+
+		// Numbered days only make sense within a month or a year.
+		limit := 5
+		if r.freq == yearly && len(r.byMonth) == 0 {
+			limit = 53
+		}
+
+		if (r.freq != monthly && r.freq != yearly) || day.ordinal > limit || day.ordinal < -limit {
+			return fmt.Errorf("%w: BYDAY ordinal %d is not allowed with FREQ=%s", ErrInvalidRecurrenceRule, day.ordinal, r.freq)
+		}
+	}
+
+	return nil
+}
+
+func parsePositive(name, value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, fmt.Errorf("%w: %s must be a positive integer", ErrInvalidRecurrenceRule, name)
+	}
+
+	return number, nil
+}
+
+// parseIntList parses a comma-separated list of integers between low and high.
+// With negative, values between -high and -low are accepted as well.
+func parseIntList(name, value string, low, high int, negative bool) ([]int, error) {
+	var numbers []int
+
+	for item := range strings.SplitSeq(value, ",") {
+		number, err := strconv.Atoi(item)
+
+		magnitude := number
+		if negative && number < 0 {
+			magnitude = -number
+		}
+
+		if err != nil || magnitude < low || magnitude > high {
+			return nil, fmt.Errorf("%w: %s value %q is out of range", ErrInvalidRecurrenceRule, name, item)
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	return numbers, nil
+}
+
+// parseByDay parses a BYDAY list such as "MO,WE" or "1MO,-1FR".
+func parseByDay(value string) ([]weekdayNum, error) {
+	var days []weekdayNum
+
+	for item := range strings.SplitSeq(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("%w: BYDAY value %q is not a day of the week", ErrInvalidRecurrenceRule, item)
+		}
+
+		weekday, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("%w: BYDAY value %q is not a day of the week", ErrInvalidRecurrenceRule, item)
+		}
+
+		ordinal := 0
+
+		if prefix := item[:len(item)-2]; prefix != "" {
+			number, err := strconv.Atoi(prefix)
+			if err != nil || number == 0 {
+				return nil, fmt.Errorf("%w: BYDAY value %q has an invalid ordinal", ErrInvalidRecurrenceRule, item)
+			}
+
+			ordinal = number
+		}
+
+		days = append(days, weekdayNum{ordinal: ordinal, weekday: weekday})
+	}
+
+	return days, nil
+}
+
+// untilLayouts are the forms of UNTIL: a UTC date-time, a floating date-time
+// and a date, which includes the whole day.
+const (
+	untilUTCLayout      = "20060102T150405Z"
+	untilFloatingLayout = "20060102T150405"
+	untilDateLayout     = "20060102"
+)
+
+func parseUntil(value string, location *time.Location) (*time.Time, error) {
+	if until, err := time.Parse(untilUTCLayout, value); err == nil {
+		return &until, nil
+	}
+
+	if until, err := time.ParseInLocation(untilFloatingLayout, value, location); err == nil {
+		return &until, nil
+	}
+
+	if date, err := time.ParseInLocation(untilDateLayout, value, location); err == nil {
+		until := date.AddDate(0, 0, 1).Add(-time.Nanosecond)
+
+		return &until, nil
+	}
+
+	return nil, fmt.Errorf("%w: UNTIL=%s is not a valid date or date-time", ErrInvalidRecurrenceRule, value)
+}
+
+// periodsBetween returns how many whole periods of the rule lie between the
+// periods containing start and t.
+func (r rule) periodsBetween(start, t time.Time) int {
+	var periods int
+
+	switch r.freq {
+	case daily:
+		periods = int(dateOf(t).Sub(dateOf(start)).Hours() / 24)
+	case weekly:
+		periods = int(r.weekOf(dateOf(t)).Sub(r.weekOf(dateOf(start))).Hours() / 24 / 7)
+	case monthly:
+		periods = (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+	case yearly:
+		periods = t.Year() - start.Year()
+	}
+
+	return periods / r.interval
+}
+
+// expand returns the instants of the rule within the nth period after the one
+// containing start, earliest first. Instants before start are included and
+// left to the caller to skip.
+func (r rule) expand(start time.Time, period int) []time.Time {
+	var days []time.Time
+
+	first := dateOf(start)
+
+	switch r.freq {
+	case daily:
+		day := first.AddDate(0, 0, period*r.interval)
+		if r.matchesDaily(day) {
+			days = append(days, day)
+		}
+	case weekly:
+		weekStart := r.weekOf(first).AddDate(0, 0, 7*period*r.interval)
+		for i := range 7 {
+			if day := weekStart.AddDate(0, 0, i); r.matchesWeekly(day, start) {
+				days = append(days, day)
+			}
+		}
+	case monthly:
+		month := time.Date(first.Year(), first.Month()+time.Month(period*r.interval), 1, 0, 0, 0, 0, time.UTC)
+		for day := month; day.Month() == month.Month(); day = day.AddDate(0, 0, 1) {
+			if r.matchesMonthly(day, start) {
+				days = append(days, day)
+			}
+		}
+	case yearly:
+		year := first.Year() + period*r.interval
+		for month := time.January; month <= time.December; month++ {
+			if !r.inMonths(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)) {
+				continue
+			}
+
+			for day := 1; day <= daysIn(year, month); day++ {
+				if date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC); r.matchesYearly(date, start) {
+					days = append(days, date)
+				}
+			}
+		}
+	}
+
+	instants := make([]time.Time, len(days))
+	for i, day := range days {
+		instants[i] = time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	}
+
+	return instants
+}
+
+// In daily rules, BYMONTH, BYMONTHDAY and BYDAY only limit the days.
+func (r rule) matchesDaily(day time.Time) bool {
+	return r.inMonths(day) && r.onMonthDays(day) && r.onWeekdays(day, false)
+}
+
+// In weekly rules, BYDAY picks days of the week, defaulting to the weekday of
+// the first occurrence.
+func (r rule) matchesWeekly(day, start time.Time) bool {
+	if !r.inMonths(day) {
+		return false
+	}
+
+	if len(r.byDay) == 0 {
+		return day.Weekday() == start.Weekday()
+	}
+
+	return r.onWeekdays(day, false)
+}
+
+// In monthly rules, BYMONTHDAY and BYDAY pick days of the month, defaulting to
+// the day of the month of the first occurrence. Months without that day are
+// skipped.
+func (r rule) matchesMonthly(day, start time.Time) bool {
+	if !r.inMonths(day) {
+		return false
+	}
+
+	if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		return day.Day() == start.Day()
+	}
+
+	return r.onMonthDays(day) && r.onWeekdays(day, false)
+}
+
+// In yearly rules, BYMONTH picks months, and BYMONTHDAY and BYDAY pick days in
+// them, defaulting to the month and day of the first occurrence. Numbered
+// BYDAY entries count within the year unless BYMONTH is given.
+func (r rule) matchesYearly(day, start time.Time) bool {
+	if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		if len(r.byMonth) == 0 {
+			return day.Month() == start.Month() && day.Day() == start.Day()
+		}
+
+		return r.inMonths(day) && day.Day() == start.Day()
+	}
+
+	return r.inMonths(day) && r.onMonthDays(day) && r.onWeekdays(day, len(r.byMonth) == 0)
+}
+
+func (r rule) inMonths(day time.Time) bool {
+	return len(r.byMonth) == 0 || slices.Contains(r.byMonth, day.Month())
+}
+
+func (r rule) onMonthDays(day time.Time) bool {
+	if len(r.byMonthDay) == 0 {
+		return true
+	}
+
+	length := daysIn(day.Year(), day.Month())
+
+	for _, monthDay := range r.byMonthDay {
+		if monthDay == day.Day() || (monthDay < 0 && length+monthDay+1 == day.Day()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// onWeekdays reports whether the day matches BYDAY. Numbered entries count
+// within the year if inYear is set and within the month otherwise.
+func (r rule) onWeekdays(day time.Time, inYear bool) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+
+	index, length := day.Day(), daysIn(day.Year(), day.Month())
+	if inYear {
+		index, length = day.YearDay(), time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+
+	for _, entry := range r.byDay {
+		if entry.weekday != day.Weekday() {
+			continue
+		}
+
+		switch {
+		case entry.ordinal == 0:
+			return true
+		case entry.ordinal > 0 && (index-1)/7+1 == entry.ordinal:
+			return true
+		case entry.ordinal < 0 && (length-index)/7+1 == -entry.ordinal:
+			return true
+		}
+	}
+
+	return false
+}
+
+// weekOf returns the first day of the week containing day, by WKST.
+func (r rule) weekOf(day time.Time) time.Time {
+	offset := (int(day.Weekday()) - int(r.weekStart) + 7) % 7
+
+	return day.AddDate(0, 0, -offset)
+}
+
+// dateOf returns the calendar date of t as midnight UTC, so that dates can be
+// stepped through without daylight saving changes getting in the way.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,250 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecurrence(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		rule          string
+		timezone      string
+		expectedRule  string
+		expectedError error
+	}{
+		{name: "daily", rule: "FREQ=DAILY", timezone: "UTC", expectedRule: "FREQ=DAILY", expectedError: nil},
+		{name: "RRULE prefix is dropped", rule: "RRULE:FREQ=WEEKLY;BYDAY=MO,WE", timezone: "Asia/Tokyo", expectedRule: "FREQ=WEEKLY;BYDAY=MO,WE", expectedError: nil},
+		{name: "lower case", rule: "freq=monthly;bymonthday=-1", timezone: "UTC", expectedRule: "freq=monthly;bymonthday=-1", expectedError: nil},
+		{name: "numbered weekday", rule: "FREQ=MONTHLY;BYDAY=-1FR", timezone: "UTC", expectedRule: "FREQ=MONTHLY;BYDAY=-1FR", expectedError: nil},
+		{name: "count", rule: "FREQ=YEARLY;COUNT=3", timezone: "UTC", expectedRule: "FREQ=YEARLY;COUNT=3", expectedError: nil},
+		{name: "until", rule: "FREQ=DAILY;UNTIL=20240131T000000Z", timezone: "UTC", expectedRule: "FREQ=DAILY;UNTIL=20240131T000000Z", expectedError: nil},
+		{name: "empty rule", rule: "", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "missing FREQ", rule: "INTERVAL=2", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "unsupported FREQ", rule: "FREQ=HOURLY", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "unsupported part", rule: "FREQ=DAILY;BYSETPOS=1", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "part without value", rule: "FREQ=DAILY;COUNT", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "duplicate part", rule: "FREQ=DAILY;FREQ=WEEKLY", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "count with until", rule: "FREQ=DAILY;COUNT=2;UNTIL=20240131", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "invalid weekday", rule: "FREQ=WEEKLY;BYDAY=XX", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "numbered weekday in weekly rule", rule: "FREQ=WEEKLY;BYDAY=1MO", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "month day out of range", rule: "FREQ=MONTHLY;BYMONTHDAY=32", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "no occurrences", rule: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "until before start", rule: "FREQ=DAILY;UNTIL=20240101", timezone: "UTC", expectedRule: "", expectedError: ErrInvalidRecurrenceRule},
+		{name: "unknown timezone", rule: "FREQ=DAILY", timezone: "Mars/Olympus", expectedRule: "", expectedError: ErrInvalidTimezone},
+		{name: "empty timezone", rule: "FREQ=DAILY", timezone: "", expectedRule: "", expectedError: ErrInvalidTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			recurrence, err := NewRecurrence(tt.rule, tt.timezone, start)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRule, recurrence.Rule())
+			assert.Equal(t, tt.timezone, recurrence.Timezone())
+			assert.True(t, start.Equal(recurrence.Start()))
+		})
+	}
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		rule     string
+		timezone string
+		start    time.Time
+		after    time.Time
+		limit    int
+		expected []time.Time
+	}{
+		{
+			name:     "every other day",
+			rule:     "FREQ=DAILY;INTERVAL=2",
+			timezone: "UTC",
+			start:    time.Date(2024, 1, 30, 9, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 30, 9, 0, 0, 0, time.UTC),
+			limit:    3,
+			expected: []time.Time{
+				time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 3, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 5, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "weekly on Monday and Thursday",
+			rule:     "FREQ=WEEKLY;BYDAY=MO,TH",
+			timezone: "UTC",
+			start:    time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			limit:    3,
+			expected: []time.Time{
+				time.Date(2024, 1, 18, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 22, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 25, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "every other week on the start weekday",
+			rule:     "FREQ=WEEKLY;INTERVAL=2",
+			timezone: "UTC",
+			start:    time.Date(2024, 1, 17, 9, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 17, 9, 0, 0, 0, time.UTC),
+			limit:    2,
+			expected: []time.Time{
+				time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 14, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "monthly on the 31st skips shorter months",
+			rule:     "FREQ=MONTHLY",
+			timezone: "UTC",
+			start:    time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+			limit:    2,
+			expected: []time.Time{
+				time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 5, 31, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "last day of the month",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			timezone: "UTC",
+			start:    time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+			limit:    2,
+			expected: []time.Time{
+				time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "last Friday of the month",
+			rule:     "FREQ=MONTHLY;BYDAY=-1FR",
+			timezone: "UTC",
+			start:    time.Date(2024, 1, 26, 17, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 26, 17, 0, 0, 0, time.UTC),
+			limit:    2,
+			expected: []time.Time{
+				time.Date(2024, 2, 23, 17, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 29, 17, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "yearly on the fourth Thursday of November",
+			rule:     "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			timezone: "UTC",
+			start:    time.Date(2024, 11, 28, 12, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 11, 28, 12, 0, 0, 0, time.UTC),
+			limit:    2,
+			expected: []time.Time{
+				time.Date(2025, 11, 27, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 26, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "count includes the first occurrence",
+			rule:     "FREQ=DAILY;COUNT=3",
+			timezone: "UTC",
+			start:    time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			limit:    5,
+			expected: []time.Time{
+				time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 17, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "until date includes that day",
+			rule:     "FREQ=DAILY;UNTIL=20240117",
+			timezone: "UTC",
+			start:    time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			limit:    5,
+			expected: []time.Time{
+				time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 17, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "dates follow the time zone",
+			rule:     "FREQ=WEEKLY;BYDAY=MO",
+			timezone: "Asia/Tokyo",
+			start:    time.Date(2024, 1, 15, 8, 0, 0, 0, tokyo),
+			after:    time.Date(2024, 1, 15, 8, 0, 0, 0, tokyo),
+			limit:    1,
+			expected: []time.Time{
+				time.Date(2024, 1, 22, 8, 0, 0, 0, tokyo),
+			},
+		},
+		{
+			name:     "wall-clock time is kept across daylight saving",
+			rule:     "FREQ=DAILY",
+			timezone: "America/New_York",
+			start:    time.Date(2024, 3, 9, 9, 0, 0, 0, newYork),
+			after:    time.Date(2024, 3, 9, 9, 0, 0, 0, newYork),
+			limit:    1,
+			expected: []time.Time{
+				time.Date(2024, 3, 10, 9, 0, 0, 0, newYork),
+			},
+		},
+		{
+			name:     "skips ahead to the given instant",
+			rule:     "FREQ=DAILY",
+			timezone: "UTC",
+			start:    time.Date(2000, 1, 1, 9, 0, 0, 0, time.UTC),
+			after:    time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC),
+			limit:    1,
+			expected: []time.Time{
+				time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			recurrence, err := NewRecurrence(tt.rule, tt.timezone, tt.start)
+			require.NoError(t, err)
+
+			// Act
+			occurrences := recurrence.Occurrences(tt.after, tt.limit)
+
+			// Assert
+			require.Len(t, occurrences, len(tt.expected))
+
+			for i, expected := range tt.expected {
+				assert.True(t, expected.Equal(occurrences[i]), "occurrence %d: expected %s, got %s", i, expected, occurrences[i])
+			}
+		})
+	}
+}
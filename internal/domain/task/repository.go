@@ -2,15 +2,143 @@ package task
 
 import (
 	"context"
+	"time"
 
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
 )
 
+// Filter narrows the set of tasks returned by FindAllByUserID.
+// The zero value matches every task of the user.
+// DueAfter is inclusive and DueBefore is exclusive; tasks without a due date
+// never match a due range.
+// Tag matches tasks carrying the tag with that name.
+// ProjectID matches the tasks of that project.
+// WorkspaceID matches the tasks scoped to that workspace.
+// AssigneeID matches the tasks assigned to that user.
+// Conditions are combined with the other fields using AND.
+type Filter struct {
+	Completed   *bool
+	DueBefore   *time.Time
+	DueAfter    *time.Time
+	Tag         *string
+	ProjectID   *project.ProjectID
+	WorkspaceID *workspace.WorkspaceID
+	AssigneeID  *user.UserID
+	Conditions  []Condition
+}
+
 // TaskRepository defines the interface for task data persistence operations.
+// Every write that changes a task records the changes in the history of the
+// task within the same transaction.
 type TaskRepository interface {
 	FindById(ctx context.Context, creatorID user.UserID, id TaskID) (*Task, error)
-	FindAllByUserID(ctx context.Context, creatorID user.UserID) ([]*Task, error)
+	// FindAccessible returns the task if the user created it, it is shared
+	// with them or it is scoped to a workspace they are a member of, in which
+	// case it carries the user's roles.
+	// It returns ErrTaskNotFound otherwise.
+	FindAccessible(ctx context.Context, userID user.UserID, id TaskID) (*Task, error)
+	// FindAllByUserID returns the user's tasks together with the tasks shared
+	// with them and the tasks of their workspaces, in the manual order.
+	FindAllByUserID(ctx context.Context, creatorID user.UserID, filter Filter) ([]*Task, error)
+	// FindPageByUserID returns one page of the user's tasks and the tasks shared
+	// with them in the order of the page request.
+	// It returns ErrInvalidCursor if the page cursor cannot be decoded.
+	FindPageByUserID(ctx context.Context, creatorID user.UserID, filter Filter, page PageRequest) (*Page, error)
+	// Search returns the tasks the user may read whose titles match the query,
+	// best match first.
+	Search(ctx context.Context, userID user.UserID, query SearchQuery) ([]*Task, error)
+	// Create stores a new task together with its tags. Tag names the user has
+	// no tag for are not stored. The series of a recurring task is stored as
+	// well unless it already exists.
 	Create(ctx context.Context, task *Task) (*Task, error)
-	Delete(ctx context.Context, creatorID user.UserID, id TaskID) error
-	Update(ctx context.Context, task *Task) (*Task, error)
+	// FindLineage returns the task followed by its ancestors up to the root task.
+	// It returns ErrTaskNotFound if the task does not exist or is in the trash.
+	FindLineage(ctx context.Context, creatorID user.UserID, id TaskID) ([]*Task, error)
+	// FindSubtasks returns the subtasks of the given tasks at every level below
+	// them, oldest first. Subtasks in the trash are skipped together with their
+	// own subtasks.
+	FindSubtasks(ctx context.Context, creatorID user.UserID, ids []TaskID) ([]*Task, error)
+	// Delete moves a task to the trash, after which the other finders no longer
+	// return it. If expectedVersion is not nil, the task is only deleted when it
+	// is at that version; otherwise ErrVersionMismatch is returned.
+	// It returns ErrHasSubtasks if the task has subtasks outside the trash.
+	Delete(ctx context.Context, creatorID user.UserID, id TaskID, expectedVersion *int64) error
+	// DeleteTree moves a task and all of its subtasks to the trash at once.
+	// expectedVersion is checked against the task itself as in Delete.
+	DeleteTree(ctx context.Context, creatorID user.UserID, id TaskID, expectedVersion *int64) error
+	// FindBlockers returns the tasks that block the given task, oldest first.
+	// Blockers in the trash are skipped.
+	FindBlockers(ctx context.Context, creatorID user.UserID, id TaskID) ([]*Task, error)
+	// AddDependency stores a dependency between two tasks of the user after
+	// checking it with DependencyGraph. The check and the insert run in one
+	// transaction that is serialised per user, so concurrent inserts cannot
+	// create a cycle. Adding an existing dependency changes nothing.
+	// It returns ErrTaskNotFound if either task does not exist or is in the
+	// trash, and ErrDependencyCycle if the dependency would close a cycle.
+	AddDependency(ctx context.Context, creatorID user.UserID, dependency Dependency) error
+	// RemoveDependency deletes a dependency between two tasks of the user.
+	// It returns ErrDependencyNotFound if there is no such dependency.
+	RemoveDependency(ctx context.Context, creatorID user.UserID, dependency Dependency) error
+	// FindTrashByUserID returns the user's tasks in the trash, most recently deleted first.
+	FindTrashByUserID(ctx context.Context, creatorID user.UserID) ([]*Task, error)
+	// Restore moves a task out of the trash and returns it with an incremented version.
+	// A subtask whose parent is still in the trash becomes a root task.
+	// It returns ErrTaskNotFound if the task is not in the trash.
+	Restore(ctx context.Context, creatorID user.UserID, id TaskID) (*Task, error)
+	// EmptyTrash permanently removes the user's tasks in the trash and returns them.
+	// The removal is recorded as a ChangePurged for each task.
+	EmptyTrash(ctx context.Context, creatorID user.UserID) ([]*Task, error)
+	// PurgeDeletedBefore permanently removes the tasks of all users that were
+	// moved to the trash before cutoff and returns how many were removed.
+	// The removal is recorded as a ChangePurged for each task.
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	// Update writes the task on behalf of the user with actorID if its stored
+	// version still equals task.Version() and returns it with the incremented
+	// version. The tags of the task are replaced in the same transaction. It
+	// returns ErrVersionMismatch if the task was changed in the meantime.
+	Update(ctx context.Context, actorID user.UserID, task *Task) (*Task, error)
+	// CompleteOccurrence writes a completed occurrence of a series like Update
+	// and stores next, the occurrence that follows it, in the same transaction.
+	// next is skipped if it is nil or if the series already has an occurrence
+	// dated after the completed one, so that completing an occurrence again
+	// after reopening it does not duplicate the next one.
+	CompleteOccurrence(ctx context.Context, actorID user.UserID, task *Task, next *Task) (*Task, error)
+	// UpdateSeries writes the title and recurrence of a series and gives its
+	// title to the occurrences that are neither completed nor in the trash.
+	// It returns ErrNotRecurring if the series does not exist.
+	UpdateSeries(ctx context.Context, series *Series) error
+	// Move gives the task a rank that puts it at the placement and returns it
+	// with an incremented version. Only the moved task is written. The change
+	// is serialised per user together with tasks being appended.
+	// It returns ErrTaskNotFound if the task does not exist or is in the trash,
+	// ErrNeighbourNotFound if a neighbour does not, ErrRankOrder if the task
+	// placed after ranks after the task placed before, and ErrRankTooLong if
+	// there is no room left between the neighbours.
+	Move(ctx context.Context, creatorID user.UserID, id TaskID, placement Placement) (*Task, error)
+	// RebalanceRanks gives new, evenly spaced ranks to the tasks of every user
+	// that has a rank longer than maxLength, keeping their order, and returns
+	// how many tasks were given a new rank.
+	RebalanceRanks(ctx context.Context, maxLength int) (int64, error)
+	// FindCollaborators returns the users the task is shared with, in the order
+	// they were added.
+	FindCollaborators(ctx context.Context, id TaskID) ([]Collaborator, error)
+	// AddCollaborator shares a task with a user, or changes the role of a user
+	// it is already shared with.
+	AddCollaborator(ctx context.Context, collaborator Collaborator) error
+	// RemoveCollaborator stops sharing a task with a user.
+	// It returns ErrCollaboratorNotFound if the task is not shared with them.
+	RemoveCollaborator(ctx context.Context, id TaskID, userID user.UserID) error
+	// Assign writes the assignee of the task if its stored version still equals
+	// task.Version() and records the assignment in the same transaction. It
+	// returns the task with the incremented version, or ErrVersionMismatch if
+	// the task was changed in the meantime.
+	Assign(ctx context.Context, task *Task, assignment Assignment) (*Task, error)
+	// FindAssignments returns the assignment history of the task, oldest first.
+	FindAssignments(ctx context.Context, id TaskID) ([]Assignment, error)
+	// FindHistory returns one page of the changes made to the task, oldest
+	// first. The sort of the page request does not apply to the history.
+	// It returns ErrInvalidCursor if the page cursor cannot be decoded.
+	FindHistory(ctx context.Context, id TaskID, page PageRequest) (HistoryPage, error)
 }
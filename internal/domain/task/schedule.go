@@ -0,0 +1,109 @@
+package task
+
+import "time"
+
+// Schedule represents when a task is planned to start and when it is due.
+// Both instants are optional. AllDay marks that only the calendar date of the
+// instants is meaningful, as for a deadline without a time of day.
+type Schedule struct {
+	startAt *time.Time
+	dueAt   *time.Time
+	allDay  bool
+}
+
+// NewSchedule creates a new Schedule with validation.
+// It returns an error if the start is after the due instant, or if the schedule
+// is marked as all-day without any date.
+func NewSchedule(startAt, dueAt *time.Time, allDay bool) (Schedule, error) {
+	if startAt != nil && dueAt != nil && startAt.After(*dueAt) {
+		return Schedule{}, ErrStartAfterDue
+	}
+
+	if allDay && startAt == nil && dueAt == nil {
+		return Schedule{}, ErrAllDayWithoutDate
+	}
+
+	return Schedule{
+		startAt: toUTC(startAt),
+		dueAt:   toUTC(dueAt),
+		allDay:  allDay,
+	}, nil
+}
+
+// NewScheduleWithoutValidation creates a new Schedule with the provided parameters.
+// It does not perform validation on the input parameters.
+func NewScheduleWithoutValidation(startAt, dueAt *time.Time, allDay bool) Schedule {
+	return Schedule{
+		startAt: startAt,
+		dueAt:   dueAt,
+		allDay:  allDay,
+	}
+}
+
+// toUTC normalises an instant to UTC so that schedules compare and persist
+// independently of the offset the client sent.
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	utc := t.UTC()
+
+	return &utc
+}
+
+// StartAt returns the planned start instant, or nil if none is set.
+func (s Schedule) StartAt() *time.Time {
+	return s.startAt
+}
+
+// DueAt returns the due instant, or nil if none is set.
+func (s Schedule) DueAt() *time.Time {
+	return s.dueAt
+}
+
+// IsAllDay reports whether only the calendar date of the schedule is meaningful.
+func (s Schedule) IsAllDay() bool {
+	return s.allDay
+}
+
+// IsEmpty returns true if the schedule has neither a start nor a due instant.
+func (s Schedule) IsEmpty() bool {
+	return s.startAt == nil && s.dueAt == nil
+}
+
+// OccursAt returns the instant a recurring task is dated by: the due instant,
+// or the start instant if the task has no due date. It returns nil for an
+// empty schedule.
+func (s Schedule) OccursAt() *time.Time {
+	if s.dueAt != nil {
+		return s.dueAt
+	}
+
+	return s.startAt
+}
+
+// shiftTo moves the schedule so that it occurs at the given instant, keeping
+// the time between its start and due instants.
+func (s Schedule) shiftTo(at time.Time) Schedule {
+	occursAt := s.OccursAt()
+	if occursAt == nil {
+		return s
+	}
+
+	offset := at.Sub(*occursAt)
+
+	shifted := Schedule{allDay: s.allDay}
+
+	if s.startAt != nil {
+		startAt := s.startAt.Add(offset).UTC()
+		shifted.startAt = &startAt
+	}
+
+	if s.dueAt != nil {
+		dueAt := s.dueAt.Add(offset).UTC()
+		shifted.dueAt = &dueAt
+	}
+
+	return shifted
+}
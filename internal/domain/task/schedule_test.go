@@ -0,0 +1,156 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSchedule(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("JST", 9*60*60)
+	start := time.Date(2024, 1, 18, 9, 0, 0, 0, jst)
+	due := time.Date(2024, 1, 20, 17, 0, 0, 0, jst)
+
+	tests := []struct {
+		name          string
+		startAt       *time.Time
+		dueAt         *time.Time
+		allDay        bool
+		expectedError error
+	}{
+		{
+			name:          "empty schedule",
+			startAt:       nil,
+			dueAt:         nil,
+			allDay:        false,
+			expectedError: nil,
+		},
+		{
+			name:          "due date only",
+			startAt:       nil,
+			dueAt:         &due,
+			allDay:        false,
+			expectedError: nil,
+		},
+		{
+			name:          "start date only",
+			startAt:       &start,
+			dueAt:         nil,
+			allDay:        false,
+			expectedError: nil,
+		},
+		{
+			name:          "start before due",
+			startAt:       &start,
+			dueAt:         &due,
+			allDay:        false,
+			expectedError: nil,
+		},
+		{
+			name:          "start equal to due",
+			startAt:       &due,
+			dueAt:         &due,
+			allDay:        false,
+			expectedError: nil,
+		},
+		{
+			name:          "start after due",
+			startAt:       &due,
+			dueAt:         &start,
+			allDay:        false,
+			expectedError: ErrStartAfterDue,
+		},
+		{
+			name:          "all-day with due date",
+			startAt:       nil,
+			dueAt:         &due,
+			allDay:        true,
+			expectedError: nil,
+		},
+		{
+			name:          "all-day without any date",
+			startAt:       nil,
+			dueAt:         nil,
+			allDay:        true,
+			expectedError: ErrAllDayWithoutDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			schedule, err := NewSchedule(tt.startAt, tt.dueAt, tt.allDay)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.True(t, schedule.IsEmpty())
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.allDay, schedule.IsAllDay())
+			assert.Equal(t, tt.startAt == nil && tt.dueAt == nil, schedule.IsEmpty())
+
+			if tt.dueAt != nil {
+				assert.True(t, tt.dueAt.Equal(*schedule.DueAt()))
+				assert.Equal(t, time.UTC, schedule.DueAt().Location())
+			} else {
+				assert.Nil(t, schedule.DueAt())
+			}
+
+			if tt.startAt != nil {
+				assert.True(t, tt.startAt.Equal(*schedule.StartAt()))
+				assert.Equal(t, time.UTC, schedule.StartAt().Location())
+			} else {
+				assert.Nil(t, schedule.StartAt())
+			}
+		})
+	}
+}
+
+func TestTaskReschedule(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 18, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 1, 20, 17, 0, 0, 0, time.UTC)
+	initial := NewScheduleWithoutValidation(&start, &due, false)
+
+	t.Run("valid schedule replaces the current one", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		task := NewTaskWithoutValidation(GenerateTaskID(), "Task", user.GenerateUserID(), WithSchedule(initial))
+		newDue := due.Add(24 * time.Hour)
+
+		// Act
+		err := task.Reschedule(nil, &newDue, true)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, task.Schedule().StartAt())
+		assert.Equal(t, newDue, *task.Schedule().DueAt())
+		assert.True(t, task.Schedule().IsAllDay())
+	})
+
+	t.Run("invalid schedule keeps the current one", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		task := NewTaskWithoutValidation(GenerateTaskID(), "Task", user.GenerateUserID(), WithSchedule(initial))
+
+		// Act
+		err := task.Reschedule(&due, &start, false)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrStartAfterDue)
+		assert.Equal(t, initial, task.Schedule())
+	})
+}
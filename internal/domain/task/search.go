@@ -0,0 +1,52 @@
+package task
+
+import "unicode/utf8"
+
+// MaxSearchTextLength is the maximum number of characters in a search text.
+const MaxSearchTextLength = 255
+
+// SearchQuery describes a full-text search over the titles of a user's tasks.
+type SearchQuery struct {
+	text  string
+	limit int
+}
+
+// NewSearchQuery creates a new SearchQuery with validation.
+// Surrounding whitespace is removed from the text; a limit of zero selects DefaultPageLimit.
+func NewSearchQuery(text string, limit int) (SearchQuery, error) {
+	text = trimSpaceAndZeroWidth(text)
+	if text == "" {
+		return SearchQuery{}, ErrSearchTextEmpty
+	}
+
+	if utf8.RuneCountInString(text) > MaxSearchTextLength {
+		return SearchQuery{}, ErrSearchTextTooLong
+	}
+
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+
+	if limit < 0 || limit > MaxPageLimit {
+		return SearchQuery{}, ErrInvalidPageLimit
+	}
+
+	return SearchQuery{
+		text:  text,
+		limit: limit,
+	}, nil
+}
+
+// Text returns the text to search for.
+func (q SearchQuery) Text() string {
+	return q.text
+}
+
+// Limit returns the maximum number of tasks to return.
+func (q SearchQuery) Limit() int {
+	if q.limit == 0 {
+		return DefaultPageLimit
+	}
+
+	return q.limit
+}
@@ -0,0 +1,87 @@
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSearchQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		text          string
+		limit         int
+		expectedText  string
+		expectedLimit int
+		expectedError error
+	}{
+		{
+			name:          "latin text with default limit",
+			text:          "weekly report",
+			limit:         0,
+			expectedText:  "weekly report",
+			expectedLimit: DefaultPageLimit,
+			expectedError: nil,
+		},
+		{
+			name:          "japanese text is trimmed",
+			text:          "　レポート​",
+			limit:         10,
+			expectedText:  "レポート",
+			expectedLimit: 10,
+			expectedError: nil,
+		},
+		{
+			name:          "text exactly max length",
+			text:          strings.Repeat("検", MaxSearchTextLength),
+			limit:         0,
+			expectedText:  strings.Repeat("検", MaxSearchTextLength),
+			expectedLimit: DefaultPageLimit,
+			expectedError: nil,
+		},
+		{
+			name:          "empty text",
+			text:          "",
+			expectedError: ErrSearchTextEmpty,
+		},
+		{
+			name:          "whitespace only text",
+			text:          " \t　 ",
+			expectedError: ErrSearchTextEmpty,
+		},
+		{
+			name:          "text too long",
+			text:          strings.Repeat("検", MaxSearchTextLength+1),
+			expectedError: ErrSearchTextTooLong,
+		},
+		{
+			name:          "limit over max",
+			text:          "report",
+			limit:         MaxPageLimit + 1,
+			expectedError: ErrInvalidPageLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			query, err := NewSearchQuery(tt.text, tt.limit)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedText, query.Text())
+			assert.Equal(t, tt.expectedLimit, query.Limit())
+		})
+	}
+}
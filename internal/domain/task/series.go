@@ -0,0 +1,176 @@
+package task
+
+import (
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// SeriesID represents a unique identifier for a series of recurring tasks.
+type SeriesID struct {
+	value uuid.UUID
+}
+
+// NewSeriesID creates a new SeriesID from a string value.
+func NewSeriesID(id string) (SeriesID, error) {
+	parsedUUID, err := uuid.Parse(id)
+	if err != nil {
+		return SeriesID{}, ErrInvalidSeriesIDFormat
+	}
+
+	return SeriesID{value: parsedUUID}, nil
+}
+
+// GenerateSeriesID creates a new SeriesID with a generated UUID.
+func GenerateSeriesID() SeriesID {
+	return SeriesID{value: uuid.New()}
+}
+
+// String returns the string representation of the SeriesID.
+func (s SeriesID) String() string {
+	return s.value.String()
+}
+
+// UUID returns the underlying uuid.UUID value.
+func (s SeriesID) UUID() uuid.UUID {
+	return s.value
+}
+
+// Series links the occurrences of a recurring task. It holds what the
+// occurrences have in common: the title given to new occurrences and the
+// recurrence that dates them. Editing a single occurrence leaves the series
+// unchanged.
+type Series struct {
+	id         SeriesID
+	creatorID  user.UserID
+	title      string
+	recurrence Recurrence
+}
+
+// NewSeriesWithoutValidation creates a new Series instance with the provided parameters.
+// It does not perform validation on the input parameters.
+func NewSeriesWithoutValidation(id SeriesID, creatorID user.UserID, title string, recurrence Recurrence) *Series {
+	return &Series{
+		id:         id,
+		creatorID:  creatorID,
+		title:      title,
+		recurrence: recurrence,
+	}
+}
+
+func (s *Series) ID() SeriesID {
+	return s.id
+}
+
+func (s *Series) UserID() user.UserID {
+	return s.creatorID
+}
+
+// Title returns the title given to new occurrences.
+func (s *Series) Title() string {
+	return s.title
+}
+
+func (s *Series) Recurrence() Recurrence {
+	return s.recurrence
+}
+
+// Rename changes the title given to new occurrences.
+func (s *Series) Rename(title string) error {
+	if err := validateTitle(title); err != nil {
+		return err
+	}
+
+	s.title = title
+
+	return nil
+}
+
+// ChangeRecurrence replaces the rule of the series. The new rule is counted
+// from the given occurrence, so that COUNT limits the occurrences from there on.
+func (s *Series) ChangeRecurrence(rrule, timezone string, from *Task) error {
+	occursAt := from.schedule.OccursAt()
+	if occursAt == nil {
+		return ErrRecurrenceWithoutDate
+	}
+
+	recurrence, err := NewRecurrence(rrule, timezone, *occursAt)
+	if err != nil {
+		return err
+	}
+
+	s.recurrence = recurrence
+
+	return nil
+}
+
+// NextOccurrence builds the occurrence that follows the given one, with the
+// given ID. Its schedule is moved to the next date of the rule and keeps the
+// length of the current schedule; its tags, parent and project are copied over.
+// It has no rank, so it is appended to the end of the manual order.
+// It returns nil if the series has no further occurrence.
+func (s *Series) NextOccurrence(current *Task, id TaskID) *Task {
+	schedules := s.Upcoming(current, 1)
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	return &Task{
+		id:        id,
+		title:     s.title,
+		creatorID: current.creatorID,
+		schedule:  schedules[0],
+		version:   InitialVersion,
+		tags:      current.Tags(),
+		parentID:  current.parentID,
+		series:    s,
+		projectID: current.projectID,
+	}
+}
+
+// Upcoming returns the schedules of up to limit occurrences that follow the
+// given one, earliest first.
+func (s *Series) Upcoming(current *Task, limit int) []Schedule {
+	occursAt := current.schedule.OccursAt()
+	if occursAt == nil {
+		return []Schedule{}
+	}
+
+	occurrences := s.recurrence.Occurrences(*occursAt, limit)
+
+	schedules := make([]Schedule, len(occurrences))
+	for i, at := range occurrences {
+		schedules[i] = current.schedule.shiftTo(at)
+	}
+
+	return schedules
+}
+
+// Series returns the series the task is an occurrence of, or nil if the task
+// does not recur.
+func (t *Task) Series() *Series {
+	return t.series
+}
+
+// IsRecurring reports whether the task is an occurrence of a series.
+func (t *Task) IsRecurring() bool {
+	return t.series != nil
+}
+
+// Repeat makes the task the first occurrence of a new series with the given
+// rule. The rule is counted from the due date of the task, or its start date
+// if it has no due date; it returns ErrRecurrenceWithoutDate if it has neither.
+func (t *Task) Repeat(id SeriesID, rrule, timezone string) error {
+	occursAt := t.schedule.OccursAt()
+	if occursAt == nil {
+		return ErrRecurrenceWithoutDate
+	}
+
+	recurrence, err := NewRecurrence(rrule, timezone, *occursAt)
+	if err != nil {
+		return err
+	}
+
+	t.series = NewSeriesWithoutValidation(id, t.creatorID, t.title, recurrence)
+
+	return nil
+}
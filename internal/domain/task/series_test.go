@@ -0,0 +1,150 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskRepeat(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+	userID, _ := user.NewUserID("user-1")
+
+	t.Run("counts the rule from the due date", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Weekly report", userID,
+			WithSchedule(NewScheduleWithoutValidation(nil, &due, false)))
+
+		// Act
+		err := taskEntity.Repeat(GenerateSeriesID(), "FREQ=WEEKLY", "UTC")
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, taskEntity.IsRecurring())
+		assert.Equal(t, "Weekly report", taskEntity.Series().Title())
+		assert.True(t, due.Equal(taskEntity.Series().Recurrence().Start()))
+	})
+
+	t.Run("task without dates", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Weekly report", userID)
+
+		// Act
+		err := taskEntity.Repeat(GenerateSeriesID(), "FREQ=WEEKLY", "UTC")
+
+		// Assert
+		require.ErrorIs(t, err, ErrRecurrenceWithoutDate)
+		assert.False(t, taskEntity.IsRecurring())
+	})
+
+	t.Run("recurring task cannot lose its dates", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Weekly report", userID,
+			WithSchedule(NewScheduleWithoutValidation(nil, &due, false)))
+		require.NoError(t, taskEntity.Repeat(GenerateSeriesID(), "FREQ=WEEKLY", "UTC"))
+
+		// Act
+		err := taskEntity.Reschedule(nil, nil, false)
+
+		// Assert
+		require.ErrorIs(t, err, ErrRecurrenceWithoutDate)
+	})
+}
+
+func TestSeriesNextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	userID, _ := user.NewUserID("user-1")
+	parentID := GenerateTaskID()
+	projectID := project.GenerateProjectID()
+
+	t.Run("moves the schedule to the next date and keeps its length", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+		due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+		current := NewTaskWithoutValidation(GenerateTaskID(), "Renamed occurrence", userID,
+			WithSchedule(NewScheduleWithoutValidation(&start, &due, false)),
+			WithTags([]string{"home"}),
+			WithParentID(&parentID),
+			WithProjectID(&projectID),
+		)
+		recurrence, err := NewRecurrence("FREQ=WEEKLY", "UTC", due)
+		require.NoError(t, err)
+
+		series := NewSeriesWithoutValidation(GenerateSeriesID(), userID, "Chores", recurrence)
+		nextID := GenerateTaskID()
+
+		// Act
+		next := series.NextOccurrence(current, nextID)
+
+		// Assert
+		require.NotNil(t, next)
+		assert.Equal(t, nextID, next.ID())
+		assert.Equal(t, "Chores", next.Title())
+		assert.Equal(t, time.Date(2024, 1, 22, 9, 0, 0, 0, time.UTC), *next.Schedule().StartAt())
+		assert.Equal(t, time.Date(2024, 1, 22, 17, 0, 0, 0, time.UTC), *next.Schedule().DueAt())
+		assert.Equal(t, []string{"home"}, next.Tags())
+		assert.Equal(t, &parentID, next.ParentID())
+		assert.Equal(t, &projectID, next.ProjectID())
+		assert.Same(t, series, next.Series())
+		assert.False(t, next.IsCompleted())
+	})
+
+	t.Run("series has ended", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+		current := NewTaskWithoutValidation(GenerateTaskID(), "Chores", userID,
+			WithSchedule(NewScheduleWithoutValidation(nil, &due, false)))
+		recurrence, err := NewRecurrence("FREQ=DAILY;COUNT=1", "UTC", due)
+		require.NoError(t, err)
+
+		series := NewSeriesWithoutValidation(GenerateSeriesID(), userID, "Chores", recurrence)
+
+		// Act
+		next := series.NextOccurrence(current, GenerateTaskID())
+
+		// Assert
+		assert.Nil(t, next)
+	})
+}
+
+func TestSeriesUpcoming(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	userID, _ := user.NewUserID("user-1")
+	due := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	current := NewTaskWithoutValidation(GenerateTaskID(), "Monthly report", userID,
+		WithSchedule(NewScheduleWithoutValidation(nil, &due, true)))
+	recurrence, err := NewRecurrence("FREQ=MONTHLY;BYMONTHDAY=-1", "UTC", due)
+	require.NoError(t, err)
+
+	series := NewSeriesWithoutValidation(GenerateSeriesID(), userID, "Monthly report", recurrence)
+
+	// Act
+	schedules := series.Upcoming(current, 3)
+
+	// Assert
+	require.Len(t, schedules, 3)
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), *schedules[0].DueAt())
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), *schedules[1].DueAt())
+	assert.Equal(t, time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC), *schedules[2].DueAt())
+	assert.True(t, schedules[0].IsAllDay())
+	assert.Nil(t, schedules[0].StartAt())
+}
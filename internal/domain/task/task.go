@@ -1,17 +1,24 @@
 package task
 
 import (
-	"strings"
-	"unicode"
+	"slices"
+	"time"
 	"unicode/utf8"
 
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/text"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
 	"github.com/google/uuid"
 )
 
 // MaxTitleLength defines the maximum allowed length for task titles.
 const MaxTitleLength = 255
 
+// InitialVersion is the version of a task that has never been updated.
+const InitialVersion int64 = 1
+
 // TaskID represents a unique identifier for a task.
 type TaskID struct {
 	value uuid.UUID
@@ -53,52 +60,177 @@ func (t TaskID) IsEmpty() bool {
 
 // Task represents a task entity in the domain layer.
 // It encapsulates task data and business logic for task management.
+// Changes record events, which are handed out by PullEvents; tasks rebuilt by
+// NewTaskWithoutValidation start without any.
 type Task struct {
-	id        TaskID
-	title     string
-	creatorID user.UserID
+	id            TaskID
+	title         string
+	creatorID     user.UserID
+	completedAt   *time.Time
+	schedule      Schedule
+	version       int64
+	deletedAt     *time.Time
+	tags          []string
+	parentID      *TaskID
+	blocked       bool
+	series        *Series
+	projectID     *project.ProjectID
+	rank          Rank
+	sharedRole    *Role
+	workspaceID   *workspace.WorkspaceID
+	workspaceRole *workspace.Role
+	assigneeID    *user.UserID
+	events        []Event
+}
+
+// RestoreOption sets additional state on a Task rebuilt by NewTaskWithoutValidation.
+type RestoreOption func(*Task)
+
+// WithCompletedAt restores the completion timestamp of a task.
+// A nil value restores the task as not completed.
+func WithCompletedAt(completedAt *time.Time) RestoreOption {
+	return func(t *Task) {
+		t.completedAt = completedAt
+	}
+}
+
+// WithSchedule restores the start and due dates of a task.
+func WithSchedule(schedule Schedule) RestoreOption {
+	return func(t *Task) {
+		t.schedule = schedule
+	}
 }
 
-// NewTask creates a new Task instance with title validation.
+// WithVersion restores the version of a task.
+func WithVersion(version int64) RestoreOption {
+	return func(t *Task) {
+		t.version = version
+	}
+}
+
+// WithDeletedAt restores the time a task was moved to the trash.
+// A nil value restores the task as not deleted.
+func WithDeletedAt(deletedAt *time.Time) RestoreOption {
+	return func(t *Task) {
+		t.deletedAt = deletedAt
+	}
+}
+
+// WithTags restores the names of the tags attached to a task.
+func WithTags(tags []string) RestoreOption {
+	return func(t *Task) {
+		t.tags = tags
+	}
+}
+
+// WithParentID restores the parent of a subtask.
+// A nil value restores the task as a root task.
+func WithParentID(parentID *TaskID) RestoreOption {
+	return func(t *Task) {
+		t.parentID = parentID
+	}
+}
+
+// WithBlocked restores whether a task is blocked by an incomplete task.
+func WithBlocked(blocked bool) RestoreOption {
+	return func(t *Task) {
+		t.blocked = blocked
+	}
+}
+
+// WithSeries restores the series a recurring task is an occurrence of.
+// A nil value restores the task as not recurring.
+func WithSeries(series *Series) RestoreOption {
+	return func(t *Task) {
+		t.series = series
+	}
+}
+
+// WithProjectID restores the project a task belongs to.
+// A nil value restores the task as in the inbox.
+func WithProjectID(projectID *project.ProjectID) RestoreOption {
+	return func(t *Task) {
+		t.projectID = projectID
+	}
+}
+
+// WithWorkspaceID restores the workspace a task is scoped to.
+// A nil value restores the task as a personal task of its creator.
+func WithWorkspaceID(workspaceID *workspace.WorkspaceID) RestoreOption {
+	return func(t *Task) {
+		t.workspaceID = workspaceID
+	}
+}
+
+// WithAssigneeID restores the user responsible for a task.
+// A nil value restores the task as unassigned.
+func WithAssigneeID(assigneeID *user.UserID) RestoreOption {
+	return func(t *Task) {
+		t.assigneeID = assigneeID
+	}
+}
+
+// WithRank restores the position of a task in the manual order.
+func WithRank(rank Rank) RestoreOption {
+	return func(t *Task) {
+		t.rank = rank
+	}
+}
+
+// WithSharedRole restores the role of the user a task is read for, if the
+// task is shared with them. A nil value restores the task as read for its creator.
+func WithSharedRole(role *Role) RestoreOption {
+	return func(t *Task) {
+		t.sharedRole = role
+	}
+}
+
+// WithWorkspaceRole restores the role the user a task is read for has in the
+// workspace of the task, if they are a member of it and did not create the
+// task. A nil value restores the task as read without membership.
+func WithWorkspaceRole(role *workspace.Role) RestoreOption {
+	return func(t *Task) {
+		t.workspaceRole = role
+	}
+}
+
+// NewTask creates a new Task instance with title validation and records TaskCreated.
 // It returns an error if the title is invalid according to business rules.
 func NewTask(id TaskID, title string, creatorID user.UserID) (*Task, error) {
 	if err := validateTitle(title); err != nil {
 		return nil, err
 	}
 
-	return &Task{
+	t := &Task{
 		id:        id,
 		title:     title,
 		creatorID: creatorID,
-	}, nil
+		version:   InitialVersion,
+	}
+	t.record(TaskCreated{eventHeader: newEventHeader(t, time.Now()), title: title})
+
+	return t, nil
 }
 
 // NewTaskWithoutValidation creates a new Task instance with the provided parameters.
 // It does not perform validation on the input parameters.
-func NewTaskWithoutValidation(id TaskID, title string, creatorID user.UserID) *Task {
-	return &Task{
+func NewTaskWithoutValidation(id TaskID, title string, creatorID user.UserID, opts ...RestoreOption) *Task {
+	t := &Task{
 		id:        id,
 		title:     title,
 		creatorID: creatorID,
+		version:   InitialVersion,
 	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
 }
 
 // trimSpaceAndZeroWidth removes leading/trailing spaces and zero-width characters
-func trimSpaceAndZeroWidth(s string) string {
-	// First trim standard spaces
-	s = strings.TrimSpace(s)
-
-	// Remove zero-width characters from both ends
-	return strings.TrimFunc(s, func(r rune) bool {
-		return unicode.IsSpace(r) ||
-			r == '\u200B' || // Zero-width space
-			r == '\u200C' || // Zero-width non-joiner
-			r == '\u200D' || // Zero-width joiner
-			r == '\u200E' || // Left-to-right mark
-			r == '\u200F' || // Right-to-left mark
-			r == '\uFEFF' // Zero-width no-break space (BOM)
-	})
-}
+var trimSpaceAndZeroWidth = text.TrimSpaceAndZeroWidth
 
 func validateTitle(title string) error {
 	if trimSpaceAndZeroWidth(title) == "" {
@@ -124,12 +256,201 @@ func (t *Task) UserID() user.UserID {
 	return t.creatorID
 }
 
+// Version returns the version of the task as last read from or written to the
+// repository. Every successful update increments it.
+func (t *Task) Version() int64 {
+	return t.version
+}
+
+// UpdateTitle gives the task a new title and records TaskTitleChanged and
+// TaskUpdated if it differs from the current one.
 func (t *Task) UpdateTitle(title string) error {
 	if err := validateTitle(title); err != nil {
 		return err
 	}
 
+	if title == t.title {
+		return nil
+	}
+
+	t.record(TaskTitleChanged{eventHeader: newEventHeader(t, time.Now()), previousTitle: t.title, title: title})
 	t.title = title
+	t.recordUpdated()
 
 	return nil
 }
+
+// Schedule returns the start and due dates of the task.
+func (t *Task) Schedule() Schedule {
+	return t.schedule
+}
+
+// Reschedule replaces the start and due dates of the task.
+// It returns an error if the new schedule is invalid according to business rules.
+// A recurring task cannot lose both dates, since they date its occurrence.
+func (t *Task) Reschedule(startAt, dueAt *time.Time, allDay bool) error {
+	schedule, err := NewSchedule(startAt, dueAt, allDay)
+	if err != nil {
+		return err
+	}
+
+	if t.series != nil && schedule.IsEmpty() {
+		return ErrRecurrenceWithoutDate
+	}
+
+	t.schedule = schedule
+	t.recordUpdated()
+
+	return nil
+}
+
+// IsCompleted reports whether the task has been marked as done.
+func (t *Task) IsCompleted() bool {
+	return t.completedAt != nil
+}
+
+// CompletedAt returns the time the task was completed, or nil if it is still open.
+func (t *Task) CompletedAt() *time.Time {
+	return t.completedAt
+}
+
+// Complete marks the task as done at the given time.
+// It returns ErrTaskAlreadyCompleted if the task is already completed.
+func (t *Task) Complete(at time.Time) error {
+	if t.IsCompleted() {
+		return ErrTaskAlreadyCompleted
+	}
+
+	t.completedAt = &at
+	t.recordUpdated()
+
+	return nil
+}
+
+// Reopen marks a completed task as not done.
+// It returns ErrTaskNotCompleted if the task is still open.
+func (t *Task) Reopen() error {
+	if !t.IsCompleted() {
+		return ErrTaskNotCompleted
+	}
+
+	t.completedAt = nil
+	t.recordUpdated()
+
+	return nil
+}
+
+// IsDeleted reports whether the task is in the trash.
+func (t *Task) IsDeleted() bool {
+	return t.deletedAt != nil
+}
+
+// DeletedAt returns the time the task was moved to the trash, or nil if it is not deleted.
+func (t *Task) DeletedAt() *time.Time {
+	return t.deletedAt
+}
+
+// MoveToTrash marks the task as deleted at the given time and records TaskDeleted.
+// A task that is already in the trash is left unchanged.
+func (t *Task) MoveToTrash(at time.Time) {
+	if t.IsDeleted() {
+		return
+	}
+
+	t.deletedAt = &at
+	t.record(TaskDeleted{eventHeader: newEventHeader(t, at)})
+}
+
+// Purge records TaskDeleted for a task that was removed from the trash for good
+// at the given time, so that subscribers that missed it being trashed drop it too.
+func (t *Task) Purge(at time.Time) {
+	t.record(TaskDeleted{eventHeader: newEventHeader(t, at)})
+}
+
+// Tags returns the names of the tags attached to the task in alphabetical order.
+func (t *Task) Tags() []string {
+	return slices.Clone(t.tags)
+}
+
+// SetTags replaces the tags attached to the task.
+// Names are normalised with tag.NormalizeName and duplicates are dropped.
+func (t *Task) SetTags(names []string) error {
+	tags := make([]string, 0, len(names))
+
+	for _, name := range names {
+		normalized, err := tag.NormalizeName(name)
+		if err != nil {
+			return err
+		}
+
+		tags = append(tags, normalized)
+	}
+
+	slices.Sort(tags)
+	t.tags = slices.Compact(tags)
+	t.recordUpdated()
+
+	return nil
+}
+
+// ProjectID returns the ID of the project the task belongs to, or nil for a
+// task in the inbox.
+func (t *Task) ProjectID() *project.ProjectID {
+	return t.projectID
+}
+
+// MoveToProject puts the task into the project with the given ID.
+// A nil value moves the task to the inbox.
+func (t *Task) MoveToProject(projectID *project.ProjectID) {
+	t.projectID = projectID
+	t.recordUpdated()
+}
+
+// WorkspaceID returns the ID of the workspace the task is scoped to, or nil
+// for a personal task of its creator.
+func (t *Task) WorkspaceID() *workspace.WorkspaceID {
+	return t.workspaceID
+}
+
+// ScopeToWorkspace scopes the task to the workspace of the member, who must be
+// its creator.
+// It returns workspace.ErrForbidden if the member is someone else or their
+// role does not allow them to write tasks.
+func (t *Task) ScopeToWorkspace(member workspace.Member) error {
+	if member.UserID() != t.creatorID || !member.Role().CanWriteTasks() {
+		return workspace.ErrForbidden
+	}
+
+	workspaceID := member.WorkspaceID()
+	t.workspaceID = &workspaceID
+
+	return nil
+}
+
+// WorkspaceRole returns the role the user the task was read for has in the
+// workspace of the task, or nil if they created it or are not a member.
+func (t *Task) WorkspaceRole() *workspace.Role {
+	return t.workspaceRole
+}
+
+// workspaceAllows reports whether a member of the workspace of a task with the
+// role has the permission on it. Every member may read the tasks of the
+// workspace; changing them requires a role that may write tasks. Sharing is
+// left to the creator.
+func workspaceAllows(role workspace.Role, permission Permission) bool {
+	switch permission {
+	case PermissionView:
+		return true
+	case PermissionEdit:
+		return role.CanWriteTasks()
+	default:
+		return false
+	}
+}
+
+// Rank returns the position of the task in the manual order of the tasks of
+// its creator. It is empty for a task that has not been stored yet; the
+// repository appends such a task to the end of the order.
+func (t *Task) Rank() Rank {
+	return t.rank
+}
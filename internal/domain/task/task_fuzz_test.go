@@ -193,3 +193,52 @@ func FuzzNewTaskWithValidation(f *testing.F) {
 		}
 	})
 }
+
+// FuzzNewSearchQuery tests that NewSearchQuery accepts exactly the texts that
+// are non-empty and within MaxSearchTextLength after trimming
+func FuzzNewSearchQuery(f *testing.F) {
+	testCases := []string{
+		"",
+		"   ",
+		"report",
+		"週次レポート",
+		"買い",
+		"​レポート​",
+		"100% _done_",
+		strings.Repeat("検", MaxSearchTextLength),
+		strings.Repeat("検", MaxSearchTextLength+1),
+	}
+
+	for _, tc := range testCases {
+		f.Add(tc)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		if !utf8.ValidString(text) {
+			t.Skip("Skipping invalid UTF-8 string")
+		}
+
+		query, err := NewSearchQuery(text, 0)
+
+		trimmed := trimSpaceAndZeroWidth(text)
+
+		switch {
+		case trimmed == "":
+			if !errors.Is(err, ErrSearchTextEmpty) {
+				t.Errorf("Expected ErrSearchTextEmpty for text %q, got %v", text, err)
+			}
+		case utf8.RuneCountInString(trimmed) > MaxSearchTextLength:
+			if !errors.Is(err, ErrSearchTextTooLong) {
+				t.Errorf("Expected ErrSearchTextTooLong for text %q, got %v", text, err)
+			}
+		default:
+			if err != nil {
+				t.Errorf("Expected no error for text %q, got %v", text, err)
+			}
+
+			if query.Text() != trimmed {
+				t.Errorf("Search text mismatch: expected %q, got %q", trimmed, query.Text())
+			}
+		}
+	})
+}
@@ -3,8 +3,11 @@ package task
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -348,6 +351,152 @@ func TestTaskGetters(t *testing.T) {
 	assert.Equal(t, expectedID, task.ID())
 	assert.Equal(t, expectedTitle, task.Title())
 	assert.Equal(t, expectedUserID, task.UserID())
+	assert.Equal(t, InitialVersion, task.Version())
+}
+
+func TestTaskVersion(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	newTask, err := NewTask(GenerateTaskID(), "New Task", user.GenerateUserID())
+	require.NoError(t, err)
+
+	restored := NewTaskWithoutValidation(GenerateTaskID(), "Restored Task", user.GenerateUserID(), WithVersion(7))
+
+	// Act & Assert
+	assert.Equal(t, InitialVersion, newTask.Version())
+	assert.Equal(t, int64(7), restored.Version())
+}
+
+func TestTaskDeletedAt(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deletedAt := time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC)
+
+	active := NewTaskWithoutValidation(GenerateTaskID(), "Active Task", user.GenerateUserID())
+	trashed := NewTaskWithoutValidation(GenerateTaskID(), "Trashed Task", user.GenerateUserID(), WithDeletedAt(&deletedAt))
+
+	// Act & Assert
+	assert.False(t, active.IsDeleted())
+	assert.Nil(t, active.DeletedAt())
+	assert.True(t, trashed.IsDeleted())
+	assert.Equal(t, &deletedAt, trashed.DeletedAt())
+}
+
+func TestTaskSetTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		tags          []string
+		expected      []string
+		expectedError error
+	}{
+		{
+			name:          "names are sorted",
+			tags:          []string{"work", "home"},
+			expected:      []string{"home", "work"},
+			expectedError: nil,
+		},
+		{
+			name:          "names are normalised and deduplicated",
+			tags:          []string{" work", "work\u200B", "home"},
+			expected:      []string{"home", "work"},
+			expectedError: nil,
+		},
+		{
+			name:          "empty list removes all tags",
+			tags:          []string{},
+			expected:      []string{},
+			expectedError: nil,
+		},
+		{
+			name:          "invalid name",
+			tags:          []string{"home", " "},
+			expected:      []string{"urgent"},
+			expectedError: tag.ErrNameEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Task", user.GenerateUserID(), WithTags([]string{"urgent"}))
+
+			// Act
+			err := taskEntity.SetTags(tt.tags)
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Equal(t, tt.expected, taskEntity.Tags())
+		})
+	}
+}
+
+func TestTaskScopeToWorkspace(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	workspaceID := workspace.GenerateWorkspaceID()
+
+	tests := []struct {
+		name          string
+		userID        user.UserID
+		role          workspace.Role
+		expectedError error
+	}{
+		{
+			name:          "member scopes their task",
+			userID:        creatorID,
+			role:          workspace.RoleMember,
+			expectedError: nil,
+		},
+		{
+			name:          "admin scopes their task",
+			userID:        creatorID,
+			role:          workspace.RoleAdmin,
+			expectedError: nil,
+		},
+		{
+			name:          "guest may not write tasks",
+			userID:        creatorID,
+			role:          workspace.RoleGuest,
+			expectedError: workspace.ErrForbidden,
+		},
+		{
+			name:          "membership of another user",
+			userID:        user.GenerateUserID(),
+			role:          workspace.RoleOwner,
+			expectedError: workspace.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			taskEntity := NewTaskWithoutValidation(GenerateTaskID(), "Task", creatorID)
+			member, err := workspace.NewMember(workspaceID, tt.userID, tt.role)
+			require.NoError(t, err)
+
+			// Act
+			err = taskEntity.ScopeToWorkspace(member)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, taskEntity.WorkspaceID())
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, taskEntity.WorkspaceID())
+				assert.Equal(t, workspaceID, *taskEntity.WorkspaceID())
+			}
+		})
+	}
 }
 
 func TestMaxTitleLength(t *testing.T) {
@@ -356,3 +505,97 @@ func TestMaxTitleLength(t *testing.T) {
 	// Assert that the constant is properly defined
 	assert.Equal(t, 255, MaxTitleLength)
 }
+
+func TestTaskComplete(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	now := completedAt.Add(time.Hour)
+
+	tests := []struct {
+		name                string
+		initialCompletedAt  *time.Time
+		expectedError       error
+		expectedCompletedAt *time.Time
+	}{
+		{
+			name:                "complete open task",
+			initialCompletedAt:  nil,
+			expectedError:       nil,
+			expectedCompletedAt: &now,
+		},
+		{
+			name:                "complete already completed task",
+			initialCompletedAt:  &completedAt,
+			expectedError:       ErrTaskAlreadyCompleted,
+			expectedCompletedAt: &completedAt,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			task := NewTaskWithoutValidation(GenerateTaskID(), "Task", user.GenerateUserID(), WithCompletedAt(tt.initialCompletedAt))
+
+			// Act
+			err := task.Complete(now)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.True(t, task.IsCompleted())
+			assert.Equal(t, tt.expectedCompletedAt, task.CompletedAt())
+		})
+	}
+}
+
+func TestTaskReopen(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		initialCompletedAt *time.Time
+		expectedError      error
+	}{
+		{
+			name:               "reopen completed task",
+			initialCompletedAt: &completedAt,
+			expectedError:      nil,
+		},
+		{
+			name:               "reopen open task",
+			initialCompletedAt: nil,
+			expectedError:      ErrTaskNotCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			task := NewTaskWithoutValidation(GenerateTaskID(), "Task", user.GenerateUserID(), WithCompletedAt(tt.initialCompletedAt))
+
+			// Act
+			err := task.Reopen()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.False(t, task.IsCompleted())
+			assert.Nil(t, task.CompletedAt())
+		})
+	}
+}
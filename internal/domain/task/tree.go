@@ -0,0 +1,86 @@
+package task
+
+// MaxTreeDepth is the maximum number of levels of a task tree, counting the
+// root task as the first level.
+const MaxTreeDepth = 5
+
+// ParentID returns the ID of the task this task is a subtask of, or nil for a root task.
+func (t *Task) ParentID() *TaskID {
+	return t.parentID
+}
+
+// MoveUnder makes the task a subtask of the first task of lineage.
+// lineage holds the new parent followed by its ancestors up to the root task,
+// and height is the number of levels of the task's own subtree, counting the
+// task itself. It returns ErrParentNotFound if the parent belongs to another
+// user, ErrParentCycle if the task would become its own ancestor, and
+// ErrTreeTooDeep if the tree would exceed MaxTreeDepth levels.
+func (t *Task) MoveUnder(lineage []*Task, height int) error {
+	if len(lineage) == 0 || lineage[0].UserID() != t.creatorID {
+		return ErrParentNotFound
+	}
+
+	for _, ancestor := range lineage {
+		if ancestor.ID() == t.id {
+			return ErrParentCycle
+		}
+	}
+
+	if len(lineage)+height > MaxTreeDepth {
+		return ErrTreeTooDeep
+	}
+
+	parentID := lineage[0].ID()
+	t.parentID = &parentID
+	t.recordUpdated()
+
+	return nil
+}
+
+// MoveToRoot detaches the task from its parent.
+func (t *Task) MoveToRoot() {
+	t.parentID = nil
+	t.recordUpdated()
+}
+
+// Tree groups tasks by the ID of their parent so that task trees can be walked
+// from the root downwards.
+type Tree map[TaskID][]*Task
+
+// NewTree builds a Tree from subtasks. The order of subtasks is kept among siblings.
+func NewTree(subtasks []*Task) Tree {
+	tree := make(Tree)
+
+	for _, subtask := range subtasks {
+		if subtask.ParentID() != nil {
+			tree[*subtask.ParentID()] = append(tree[*subtask.ParentID()], subtask)
+		}
+	}
+
+	return tree
+}
+
+// Children returns the direct subtasks of the task with the given ID.
+func (tr Tree) Children(id TaskID) []*Task {
+	return tr[id]
+}
+
+// Height returns the number of levels of the subtree rooted at the task with
+// the given ID, counting the task itself. Levels beyond MaxTreeDepth are not counted.
+func (tr Tree) Height(id TaskID) int {
+	return tr.height(id, 1)
+}
+
+func (tr Tree) height(id TaskID, level int) int {
+	if level > MaxTreeDepth {
+		return 0
+	}
+
+	height := 1
+
+	for _, child := range tr[id] {
+		height = max(height, 1+tr.height(child.ID(), level+1))
+	}
+
+	return height
+}
@@ -0,0 +1,183 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chain builds a line of tasks where each task is a subtask of the previous one.
+func chain(creatorID user.UserID, length int) []*Task {
+	tasks := make([]*Task, length)
+
+	for i := range tasks {
+		var opts []RestoreOption
+		if i > 0 {
+			parentID := tasks[i-1].ID()
+			opts = append(opts, WithParentID(&parentID))
+		}
+
+		tasks[i] = NewTaskWithoutValidation(GenerateTaskID(), "Task", creatorID, opts...)
+	}
+
+	return tasks
+}
+
+// lineageOf returns the task at index and its ancestors in chain, starting with the task.
+func lineageOf(tasks []*Task, index int) []*Task {
+	lineage := make([]*Task, 0, index+1)
+	for i := index; i >= 0; i-- {
+		lineage = append(lineage, tasks[i])
+	}
+
+	return lineage
+}
+
+func TestTaskMoveUnder(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	tasks := chain(creatorID, MaxTreeDepth)
+
+	tests := []struct {
+		name          string
+		task          *Task
+		lineage       []*Task
+		height        int
+		expectedError error
+	}{
+		{
+			name:          "root task as parent",
+			task:          NewTaskWithoutValidation(GenerateTaskID(), "Task", creatorID),
+			lineage:       lineageOf(tasks, 0),
+			height:        1,
+			expectedError: nil,
+		},
+		{
+			name:          "deepest allowed level",
+			task:          NewTaskWithoutValidation(GenerateTaskID(), "Task", creatorID),
+			lineage:       lineageOf(tasks, MaxTreeDepth-2),
+			height:        1,
+			expectedError: nil,
+		},
+		{
+			name:          "too deep",
+			task:          NewTaskWithoutValidation(GenerateTaskID(), "Task", creatorID),
+			lineage:       lineageOf(tasks, MaxTreeDepth-1),
+			height:        1,
+			expectedError: ErrTreeTooDeep,
+		},
+		{
+			name:          "subtree of the task counts towards the depth",
+			task:          NewTaskWithoutValidation(GenerateTaskID(), "Task", creatorID),
+			lineage:       lineageOf(tasks, 1),
+			height:        MaxTreeDepth - 1,
+			expectedError: ErrTreeTooDeep,
+		},
+		{
+			name:          "task as its own parent",
+			task:          tasks[0],
+			lineage:       lineageOf(tasks, 0),
+			height:        1,
+			expectedError: ErrParentCycle,
+		},
+		{
+			name:          "task under its own subtask",
+			task:          tasks[1],
+			lineage:       lineageOf(tasks, 3),
+			height:        1,
+			expectedError: ErrParentCycle,
+		},
+		{
+			name:          "parent of another user",
+			task:          NewTaskWithoutValidation(GenerateTaskID(), "Task", creatorID),
+			lineage:       []*Task{NewTaskWithoutValidation(GenerateTaskID(), "Task", user.GenerateUserID())},
+			height:        1,
+			expectedError: ErrParentNotFound,
+		},
+		{
+			name:          "missing parent",
+			task:          NewTaskWithoutValidation(GenerateTaskID(), "Task", creatorID),
+			lineage:       nil,
+			height:        1,
+			expectedError: ErrParentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			previousParent := tt.task.ParentID()
+
+			// Act
+			err := tt.task.MoveUnder(tt.lineage, tt.height)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Equal(t, previousParent, tt.task.ParentID())
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, tt.task.ParentID())
+				assert.Equal(t, tt.lineage[0].ID(), *tt.task.ParentID())
+			}
+		})
+	}
+}
+
+func TestTaskMoveToRoot(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	tasks := chain(user.GenerateUserID(), 2)
+
+	// Act
+	tasks[1].MoveToRoot()
+
+	// Assert
+	assert.Nil(t, tasks[1].ParentID())
+}
+
+func TestTree(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	tasks := chain(creatorID, 3)
+	rootID := tasks[0].ID()
+	sibling := NewTaskWithoutValidation(GenerateTaskID(), "Sibling", creatorID, WithParentID(&rootID))
+
+	tree := NewTree([]*Task{tasks[1], tasks[2], sibling})
+
+	t.Run("children keep their order", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []*Task{tasks[1], sibling}, tree.Children(rootID))
+		assert.Empty(t, tree.Children(sibling.ID()))
+	})
+
+	t.Run("height counts the levels of the subtree", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 3, tree.Height(rootID))
+		assert.Equal(t, 2, tree.Height(tasks[1].ID()))
+		assert.Equal(t, 1, tree.Height(sibling.ID()))
+	})
+
+	t.Run("height stops at the maximum depth", func(t *testing.T) {
+		t.Parallel()
+
+		// A cycle must not make the walk run forever.
+		first := GenerateTaskID()
+		second := GenerateTaskID()
+		cyclic := NewTree([]*Task{
+			NewTaskWithoutValidation(second, "Second", creatorID, WithParentID(&first)),
+			NewTaskWithoutValidation(first, "First", creatorID, WithParentID(&second)),
+		})
+
+		assert.Equal(t, MaxTreeDepth, cyclic.Height(first))
+	})
+}
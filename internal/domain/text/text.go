@@ -0,0 +1,24 @@
+// Package text holds the normalisation rules shared by user-entered names and titles.
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TrimSpaceAndZeroWidth removes leading/trailing spaces and zero-width characters
+func TrimSpaceAndZeroWidth(s string) string {
+	// First trim standard spaces
+	s = strings.TrimSpace(s)
+
+	// Remove zero-width characters from both ends
+	return strings.TrimFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) ||
+			r == '\u200B' || // Zero-width space
+			r == '\u200C' || // Zero-width non-joiner
+			r == '\u200D' || // Zero-width joiner
+			r == '\u200E' || // Left-to-right mark
+			r == '\u200F' || // Right-to-left mark
+			r == '\uFEFF' // Zero-width no-break space (BOM)
+	})
+}
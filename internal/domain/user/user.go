@@ -47,6 +47,11 @@ func (u UserID) String() string {
 	return u.value.String()
 }
 
+// UUID returns the underlying uuid.UUID value.
+func (u UserID) UUID() uuid.UUID {
+	return u.value
+}
+
 // IsEmpty returns true if the UserID is empty.
 func (u UserID) IsEmpty() bool {
 	return u.value == uuid.Nil
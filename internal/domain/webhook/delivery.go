@@ -0,0 +1,250 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeliveryID represents a unique identifier for a delivery.
+type DeliveryID struct {
+	value uuid.UUID
+}
+
+// NewDeliveryID creates a new DeliveryID from a string value.
+func NewDeliveryID(id string) (DeliveryID, error) {
+	if id == "" {
+		return DeliveryID{}, ErrDeliveryIDEmpty
+	}
+
+	parsedUUID, err := uuid.Parse(id)
+	if err != nil {
+		return DeliveryID{}, ErrInvalidDeliveryIDFormat
+	}
+
+	return DeliveryID{value: parsedUUID}, nil
+}
+
+// GenerateDeliveryID creates a new DeliveryID with a generated UUID.
+func GenerateDeliveryID() DeliveryID {
+	return DeliveryID{value: uuid.New()}
+}
+
+// String returns the string representation of the DeliveryID.
+func (d DeliveryID) String() string {
+	return d.value.String()
+}
+
+// UUID returns the underlying uuid.UUID value.
+func (d DeliveryID) UUID() uuid.UUID {
+	return d.value
+}
+
+// IsEmpty returns true if the DeliveryID is empty.
+func (d DeliveryID) IsEmpty() bool {
+	return d.value == uuid.Nil
+}
+
+// DeliveryStatus tells whether a delivery is still being attempted.
+type DeliveryStatus string
+
+const (
+	// DeliveryPending is a delivery that is attempted again at its next attempt time.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliverySucceeded is a delivery the endpoint accepted.
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	// DeliveryFailed is a delivery that ran out of attempts.
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// Attempt is the outcome of posting a delivery to the endpoint of its webhook
+// once. StatusCode is zero if no response was received.
+type Attempt struct {
+	attemptedAt time.Time
+	duration    time.Duration
+	statusCode  int
+	err         string
+}
+
+// NewAttempt records an attempt that started at attemptedAt and took duration.
+// err is the reason the attempt failed, or nil if the endpoint accepted it.
+func NewAttempt(attemptedAt time.Time, duration time.Duration, statusCode int, err error) Attempt {
+	message := ""
+	if err != nil {
+		message = err.Error()
+	}
+
+	return Attempt{
+		attemptedAt: attemptedAt,
+		duration:    duration,
+		statusCode:  statusCode,
+		err:         message,
+	}
+}
+
+func (a Attempt) AttemptedAt() time.Time {
+	return a.attemptedAt
+}
+
+func (a Attempt) Duration() time.Duration {
+	return a.duration
+}
+
+// StatusCode returns the HTTP status of the response, or zero if there was none.
+func (a Attempt) StatusCode() int {
+	return a.statusCode
+}
+
+// Error returns the reason the attempt failed, or an empty string if it succeeded.
+func (a Attempt) Error() string {
+	return a.err
+}
+
+// Succeeded reports whether the endpoint accepted the delivery.
+func (a Attempt) Succeeded() bool {
+	return a.err == ""
+}
+
+// Delivery is an event on its way to one webhook. A delivery is attempted
+// until the endpoint accepts it or the retry policy runs out of attempts.
+type Delivery struct {
+	id            DeliveryID
+	webhookID     WebhookID
+	eventID       string
+	eventType     EventType
+	payload       []byte
+	status        DeliveryStatus
+	attempts      int
+	nextAttemptAt time.Time
+	lastError     string
+	createdAt     time.Time
+}
+
+// DeliveryRestoreOption sets additional state on a Delivery rebuilt by NewDeliveryWithoutValidation.
+type DeliveryRestoreOption func(*Delivery)
+
+// WithStatus restores the status of the delivery.
+func WithStatus(status DeliveryStatus) DeliveryRestoreOption {
+	return func(d *Delivery) {
+		d.status = status
+	}
+}
+
+// WithAttempts restores the number of attempts made so far.
+func WithAttempts(attempts int) DeliveryRestoreOption {
+	return func(d *Delivery) {
+		d.attempts = attempts
+	}
+}
+
+// WithNextAttemptAt restores the time the delivery is attempted next.
+func WithNextAttemptAt(nextAttemptAt time.Time) DeliveryRestoreOption {
+	return func(d *Delivery) {
+		d.nextAttemptAt = nextAttemptAt
+	}
+}
+
+// WithLastError restores the reason the last attempt failed.
+func WithLastError(lastError string) DeliveryRestoreOption {
+	return func(d *Delivery) {
+		d.lastError = lastError
+	}
+}
+
+// NewDelivery creates a pending delivery of the event with eventID to the
+// webhook with webhookID, due at createdAt.
+func NewDelivery(id DeliveryID, webhookID WebhookID, eventID string, eventType EventType, payload []byte, createdAt time.Time) *Delivery {
+	return &Delivery{
+		id:            id,
+		webhookID:     webhookID,
+		eventID:       eventID,
+		eventType:     eventType,
+		payload:       payload,
+		status:        DeliveryPending,
+		attempts:      0,
+		nextAttemptAt: createdAt,
+		lastError:     "",
+		createdAt:     createdAt,
+	}
+}
+
+// NewDeliveryWithoutValidation rebuilds a Delivery from stored values without validating them.
+func NewDeliveryWithoutValidation(id DeliveryID, webhookID WebhookID, eventID string, eventType EventType, payload []byte, createdAt time.Time, opts ...DeliveryRestoreOption) *Delivery {
+	d := NewDelivery(id, webhookID, eventID, eventType, payload, createdAt)
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func (d *Delivery) ID() DeliveryID {
+	return d.id
+}
+
+func (d *Delivery) WebhookID() WebhookID {
+	return d.webhookID
+}
+
+// EventID returns the ID of the event, which is the same for every webhook
+// notified of it and for every attempt.
+func (d *Delivery) EventID() string {
+	return d.eventID
+}
+
+func (d *Delivery) EventType() EventType {
+	return d.eventType
+}
+
+// Payload returns the JSON body posted to the endpoint.
+func (d *Delivery) Payload() []byte {
+	return d.payload
+}
+
+func (d *Delivery) Status() DeliveryStatus {
+	return d.status
+}
+
+// Attempts returns the number of attempts made so far.
+func (d *Delivery) Attempts() int {
+	return d.attempts
+}
+
+// NextAttemptAt returns the time a pending delivery is attempted next.
+func (d *Delivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+// LastError returns the reason the last attempt failed, or an empty string.
+func (d *Delivery) LastError() string {
+	return d.lastError
+}
+
+func (d *Delivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// RecordAttempt counts an attempt of the delivery. A successful attempt
+// completes it; a failed one schedules the next attempt according to policy
+// or, once the policy runs out of attempts, fails the delivery.
+// It returns ErrDeliveryFinished if the delivery is no longer pending.
+func (d *Delivery) RecordAttempt(attempt Attempt, policy RetryPolicy) error {
+	if d.status != DeliveryPending {
+		return ErrDeliveryFinished
+	}
+
+	d.attempts++
+	d.lastError = attempt.Error()
+
+	switch {
+	case attempt.Succeeded():
+		d.status = DeliverySucceeded
+	case d.attempts >= policy.MaxAttempts:
+		d.status = DeliveryFailed
+	default:
+		d.nextAttemptAt = attempt.AttemptedAt().Add(policy.Backoff(d.attempts))
+	}
+
+	return nil
+}
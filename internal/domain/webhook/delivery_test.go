@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPolicy = RetryPolicy{
+	MaxAttempts:  3,
+	BaseDelay:    30 * time.Second,
+	MaxDelay:     time.Hour,
+	DisableAfter: 5,
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		failedAttempts int
+		expected       time.Duration
+	}{
+		{name: "first failure waits the base delay", failedAttempts: 1, expected: 30 * time.Second},
+		{name: "second failure doubles it", failedAttempts: 2, expected: time.Minute},
+		{name: "fifth failure", failedAttempts: 5, expected: 8 * time.Minute},
+		{name: "capped at the max delay", failedAttempts: 8, expected: time.Hour},
+		{name: "many failures do not overflow", failedAttempts: 200, expected: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act & Assert
+			assert.Equal(t, tt.expected, testPolicy.Backoff(tt.failedAttempts))
+		})
+	}
+}
+
+func TestRetryPolicy_ShouldDisable(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, testPolicy.ShouldDisable(4))
+	assert.True(t, testPolicy.ShouldDisable(5))
+}
+
+func TestDelivery_RecordAttempt(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	attemptedAt := createdAt.Add(time.Second)
+	failure := errors.New("connection refused")
+
+	tests := []struct {
+		name                  string
+		previousAttempts      int
+		attempt               Attempt
+		expectedStatus        DeliveryStatus
+		expectedNextAttemptAt time.Time
+		expectedLastError     string
+	}{
+		{
+			name:                  "success completes the delivery",
+			previousAttempts:      1,
+			attempt:               NewAttempt(attemptedAt, time.Millisecond, 204, nil),
+			expectedStatus:        DeliverySucceeded,
+			expectedNextAttemptAt: createdAt,
+			expectedLastError:     "",
+		},
+		{
+			name:                  "failure schedules a retry",
+			previousAttempts:      1,
+			attempt:               NewAttempt(attemptedAt, time.Millisecond, 0, failure),
+			expectedStatus:        DeliveryPending,
+			expectedNextAttemptAt: attemptedAt.Add(time.Minute),
+			expectedLastError:     "connection refused",
+		},
+		{
+			name:                  "last failure fails the delivery",
+			previousAttempts:      2,
+			attempt:               NewAttempt(attemptedAt, time.Millisecond, 500, failure),
+			expectedStatus:        DeliveryFailed,
+			expectedNextAttemptAt: createdAt,
+			expectedLastError:     "connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			delivery := NewDeliveryWithoutValidation(GenerateDeliveryID(), GenerateWebhookID(), "event-1", "task.created", []byte(`{}`), createdAt,
+				WithAttempts(tt.previousAttempts))
+
+			// Act
+			err := delivery.RecordAttempt(tt.attempt, testPolicy)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.previousAttempts+1, delivery.Attempts())
+			assert.Equal(t, tt.expectedStatus, delivery.Status())
+			assert.Equal(t, tt.expectedNextAttemptAt, delivery.NextAttemptAt())
+			assert.Equal(t, tt.expectedLastError, delivery.LastError())
+		})
+	}
+}
+
+func TestDelivery_RecordAttempt_Finished(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	delivery := NewDeliveryWithoutValidation(GenerateDeliveryID(), GenerateWebhookID(), "event-1", "task.created", []byte(`{}`), time.Now(),
+		WithStatus(DeliverySucceeded), WithAttempts(1))
+
+	// Act
+	err := delivery.RecordAttempt(NewAttempt(time.Now(), time.Millisecond, 200, nil), testPolicy)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrDeliveryFinished)
+	assert.Equal(t, 1, delivery.Attempts())
+}
@@ -0,0 +1,21 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrURLEmpty                = errors.New("webhook URL cannot be empty")
+	ErrURLTooLong              = errors.New("webhook URL cannot exceed 2048 characters")
+	ErrInvalidURL              = errors.New("webhook URL must be an absolute http or https URL")
+	ErrSecretTooShort          = errors.New("webhook secret must be at least 16 characters")
+	ErrSecretTooLong           = errors.New("webhook secret cannot exceed 256 characters")
+	ErrInvalidEventType        = errors.New("webhook event type is invalid")
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookIDEmpty          = errors.New("webhook ID cannot be empty")
+	ErrInvalidWebhookIDFormat  = errors.New("webhook ID must be a valid UUID format")
+	ErrDeliveryIDEmpty         = errors.New("delivery ID cannot be empty")
+	ErrInvalidDeliveryIDFormat = errors.New("delivery ID must be a valid UUID format")
+	ErrDeliveryFinished        = errors.New("delivery has already succeeded or failed")
+
+	ErrUnexpectedStatus  = errors.New("webhook endpoint responded with a non-2xx status")
+	ErrAddressNotAllowed = errors.New("webhook endpoint address is not allowed")
+)
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+)
+
+// eventTypePrefix precedes the type of a task change in the name of an event.
+const eventTypePrefix = "task."
+
+// EventType names the kind of change a webhook is notified of, such as
+// "task.created". Every task.ChangeType has an event type of its own.
+type EventType string
+
+// EventTypeOf returns the event type that notifies of a change of the given type.
+func EventTypeOf(changeType task.ChangeType) EventType {
+	return EventType(eventTypePrefix + string(changeType))
+}
+
+// ParseEventType converts a name such as "task.title_changed" to an EventType.
+// It returns ErrInvalidEventType for an unknown name.
+func ParseEventType(name string) (EventType, error) {
+	changeType, ok := strings.CutPrefix(name, eventTypePrefix)
+	if !ok {
+		return "", ErrInvalidEventType
+	}
+
+	if _, err := task.ParseChangeType(changeType); err != nil {
+		return "", ErrInvalidEventType
+	}
+
+	return EventType(name), nil
+}
+
+// NormalizeEventTypes validates the event types a webhook subscribes to and
+// returns them sorted without duplicates. An empty list subscribes to every
+// event type.
+func NormalizeEventTypes(eventTypes []EventType) ([]EventType, error) {
+	normalized := make([]EventType, 0, len(eventTypes))
+
+	for _, eventType := range eventTypes {
+		if _, err := ParseEventType(string(eventType)); err != nil {
+			return nil, err
+		}
+
+		normalized = append(normalized, eventType)
+	}
+
+	slices.Sort(normalized)
+
+	return slices.Compact(normalized), nil
+}
+
+// eventPayload is the JSON body posted to the webhooks notified of a change.
+type eventPayload struct {
+	ID         string    `json:"id"`
+	Type       EventType `json:"type"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Data       eventData `json:"data"`
+}
+
+// eventData describes the change an event notifies of, in the same terms as
+// the history of the task.
+type eventData struct {
+	TaskID  string  `json:"taskId"`
+	ActorID string  `json:"actorId"`
+	Before  *string `json:"before,omitempty"`
+	After   *string `json:"after,omitempty"`
+}
+
+// EncodeEvent returns the JSON body that notifies webhooks of the change as
+// the event with eventID. Receivers can use the ID to recognise an event
+// delivered more than once.
+func EncodeEvent(eventID string, change task.Change) ([]byte, error) {
+	return json.Marshal(eventPayload{
+		ID:         eventID,
+		Type:       EventTypeOf(change.Type()),
+		OccurredAt: change.OccurredAt().UTC(),
+		Data: eventData{
+			TaskID:  change.TaskID().String(),
+			ActorID: change.ActorID().String(),
+			Before:  change.Before(),
+			After:   change.After(),
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestParseEventType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		expectedError error
+	}{
+		{name: "created", input: "task.created", expectedError: nil},
+		{name: "title changed", input: "task.title_changed", expectedError: nil},
+		{name: "purged", input: "task.purged", expectedError: nil},
+		{name: "missing prefix", input: "created", expectedError: ErrInvalidEventType},
+		{name: "unknown change", input: "task.renamed", expectedError: ErrInvalidEventType},
+		{name: "other resource", input: "project.created", expectedError: ErrInvalidEventType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			eventType, err := ParseEventType(tt.input)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, EventType(tt.input), eventType)
+		})
+	}
+}
+
+func TestEncodeEvent(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	taskID := task.GenerateTaskID()
+	actorID := user.GenerateUserID()
+	before := "Draft"
+	after := "Report"
+	occurredAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	change, err := task.NewChange(taskID, task.ChangeTitleChanged, actorID, &before, &after, occurredAt)
+	require.NoError(t, err)
+
+	// Act
+	payload, err := EncodeEvent("event-1", change)
+
+	// Assert
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	assert.Equal(t, map[string]any{
+		"id":         "event-1",
+		"type":       "task.title_changed",
+		"occurredAt": "2024-03-01T09:00:00Z",
+		"data": map[string]any{
+			"taskId":  taskID.String(),
+			"actorId": actorID.String(),
+			"before":  "Draft",
+			"after":   "Report",
+		},
+	}, decoded)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	timestamp := time.Unix(1700000000, 0)
+	payload := []byte(`{"id":"event-1"}`)
+
+	// Act
+	signature := Sign(testSecret, timestamp, payload)
+
+	// Assert
+	assert.Equal(t, "sha256=e60b4672029a5d67632cec3e991bc7a5ce2dd74325d2e75d0c9e5b5a56b8cff5", signature)
+	assert.True(t, Verify(testSecret, timestamp, payload, signature))
+	assert.False(t, Verify("another-secret-value", timestamp, payload, signature))
+	assert.False(t, Verify(testSecret, timestamp.Add(time.Second), payload, signature))
+	assert.False(t, Verify(testSecret, timestamp, []byte(`{"id":"event-2"}`), signature))
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// PendingDelivery is a delivery that is due together with the webhook it is for.
+type PendingDelivery struct {
+	Delivery *Delivery
+	Webhook  *Webhook
+}
+
+// WebhookRepository defines the interface for webhook data persistence operations.
+// Events are written to an outbox by the task repository in the transaction
+// of the change they notify of, and turned into deliveries by DispatchEvents.
+type WebhookRepository interface {
+	FindById(ctx context.Context, ownerID user.UserID, id WebhookID) (*Webhook, error)
+	// FindAllByUserID returns the webhooks of the user, oldest first.
+	FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*Webhook, error)
+	Create(ctx context.Context, webhook *Webhook) (*Webhook, error)
+	// Update stores the URL, secret, event types and the disabled state of a
+	// webhook together with its failure count.
+	Update(ctx context.Context, webhook *Webhook) (*Webhook, error)
+	// Delete removes the webhook together with its deliveries.
+	Delete(ctx context.Context, ownerID user.UserID, id WebhookID) error
+	// DispatchEvents takes up to limit events from the outbox, oldest first,
+	// and creates a delivery for every active webhook of the owner of the task
+	// that accepts the event. It returns the number of events taken.
+	DispatchEvents(ctx context.Context, limit int) (int, error)
+	// ClaimDueDeliveries returns up to limit pending deliveries that are due at
+	// now and whose webhook is active, oldest first. Their next attempt is
+	// moved to now plus lease, so that no other worker picks them up while
+	// they are being attempted.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]PendingDelivery, error)
+	// RecordAttempt stores the attempt and the state of the delivery it was
+	// recorded on. A failed attempt counts against the webhook, which is
+	// disabled once policy says so; a successful one resets its failure count.
+	RecordAttempt(ctx context.Context, delivery *Delivery, attempt Attempt, policy RetryPolicy) error
+}
+
+// Sender posts deliveries to the endpoints of webhooks.
+type Sender interface {
+	// Send posts the payload of the delivery to the URL of the webhook, signed
+	// with its secret. It returns the status of the response, or zero if there
+	// was none, and an error unless the endpoint responded with a 2xx status.
+	Send(ctx context.Context, webhook *Webhook, delivery *Delivery) (int, error)
+}
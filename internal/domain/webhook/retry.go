@@ -0,0 +1,35 @@
+package webhook
+
+import "time"
+
+// RetryPolicy decides when failed deliveries are attempted again and when a
+// webhook whose endpoint keeps failing is disabled.
+type RetryPolicy struct {
+	MaxAttempts  int           // attempts of a delivery before it fails
+	BaseDelay    time.Duration // wait after the first failed attempt
+	MaxDelay     time.Duration // longest wait between two attempts
+	DisableAfter int           // failed attempts in a row that disable a webhook
+}
+
+// Backoff returns how long to wait after the given number of failed attempts.
+// The wait doubles with every attempt, starting at BaseDelay and never
+// exceeding MaxDelay.
+func (p RetryPolicy) Backoff(failedAttempts int) time.Duration {
+	delay := p.BaseDelay
+
+	for i := 1; i < failedAttempts; i++ {
+		if delay >= p.MaxDelay/2 {
+			return p.MaxDelay
+		}
+
+		delay *= 2
+	}
+
+	return min(delay, p.MaxDelay)
+}
+
+// ShouldDisable reports whether a webhook with the given number of failed
+// attempts in a row is disabled.
+func (p RetryPolicy) ShouldDisable(failureCount int) bool {
+	return failureCount >= p.DisableAfter
+}
@@ -0,0 +1,304 @@
+package webhook
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+const (
+	// MaxURLLength defines the maximum allowed length for webhook URLs.
+	MaxURLLength = 2048
+	// MinSecretLength defines the minimum length of the secret payloads are signed with.
+	MinSecretLength = 16
+	// MaxSecretLength defines the maximum length of the secret payloads are signed with.
+	MaxSecretLength = 256
+)
+
+// WebhookID represents a unique identifier for a webhook.
+type WebhookID struct {
+	value uuid.UUID
+}
+
+// NewWebhookID creates a new WebhookID from a string value.
+func NewWebhookID(id string) (WebhookID, error) {
+	if id == "" {
+		return WebhookID{}, ErrWebhookIDEmpty
+	}
+
+	parsedUUID, err := uuid.Parse(id)
+	if err != nil {
+		return WebhookID{}, ErrInvalidWebhookIDFormat
+	}
+
+	return WebhookID{value: parsedUUID}, nil
+}
+
+// GenerateWebhookID creates a new WebhookID with a generated UUID.
+func GenerateWebhookID() WebhookID {
+	return WebhookID{value: uuid.New()}
+}
+
+// String returns the string representation of the WebhookID.
+func (w WebhookID) String() string {
+	return w.value.String()
+}
+
+// UUID returns the underlying uuid.UUID value.
+func (w WebhookID) UUID() uuid.UUID {
+	return w.value
+}
+
+// IsEmpty returns true if the WebhookID is empty.
+func (w WebhookID) IsEmpty() bool {
+	return w.value == uuid.Nil
+}
+
+// Webhook is an endpoint of another service that a user has notified of
+// changes to their tasks. Payloads are signed with the secret of the webhook
+// so that the receiver can verify where they came from.
+// A disabled webhook is not notified; webhooks are disabled by their owner or
+// when their endpoint keeps failing.
+type Webhook struct {
+	id           WebhookID
+	ownerID      user.UserID
+	url          string
+	secret       string
+	eventTypes   []EventType
+	failureCount int
+	disabledAt   *time.Time
+	createdAt    time.Time
+}
+
+// RestoreOption sets additional state on a Webhook rebuilt by NewWebhookWithoutValidation.
+type RestoreOption func(*Webhook)
+
+// WithFailureCount restores the number of delivery attempts that failed in a row.
+func WithFailureCount(failureCount int) RestoreOption {
+	return func(w *Webhook) {
+		w.failureCount = failureCount
+	}
+}
+
+// WithDisabledAt restores the time the webhook was disabled.
+func WithDisabledAt(disabledAt *time.Time) RestoreOption {
+	return func(w *Webhook) {
+		w.disabledAt = disabledAt
+	}
+}
+
+// NewWebhook creates a new Webhook of the user with ownerID that posts the
+// events of the given types to rawURL. No event types subscribes to all of them.
+// It returns an error if the URL, the secret or an event type is invalid.
+func NewWebhook(id WebhookID, ownerID user.UserID, rawURL, secret string, eventTypes []EventType, createdAt time.Time) (*Webhook, error) {
+	if ownerID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	w := &Webhook{
+		id:           id,
+		ownerID:      ownerID,
+		url:          "",
+		secret:       "",
+		eventTypes:   nil,
+		failureCount: 0,
+		disabledAt:   nil,
+		createdAt:    createdAt,
+	}
+
+	if err := w.ChangeURL(rawURL); err != nil {
+		return nil, err
+	}
+
+	if err := w.ChangeSecret(secret); err != nil {
+		return nil, err
+	}
+
+	if err := w.Subscribe(eventTypes); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// NewWebhookWithoutValidation rebuilds a Webhook from stored values without validating them.
+func NewWebhookWithoutValidation(id WebhookID, ownerID user.UserID, rawURL, secret string, eventTypes []EventType, createdAt time.Time, opts ...RestoreOption) *Webhook {
+	w := &Webhook{
+		id:           id,
+		ownerID:      ownerID,
+		url:          rawURL,
+		secret:       secret,
+		eventTypes:   eventTypes,
+		failureCount: 0,
+		disabledAt:   nil,
+		createdAt:    createdAt,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+// NormalizeURL removes surrounding spaces from a webhook URL and validates
+// that it is an absolute http or https URL. Whether the host may be reached is
+// decided by the sender when it connects, as the address a name resolves to
+// can change after the webhook is saved.
+func NormalizeURL(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return "", ErrURLEmpty
+	}
+
+	if len(rawURL) > MaxURLLength {
+		return "", ErrURLTooLong
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", ErrInvalidURL
+	}
+
+	return rawURL, nil
+}
+
+// ValidateSecret checks the length of the secret payloads are signed with.
+func ValidateSecret(secret string) error {
+	length := utf8.RuneCountInString(secret)
+	if length < MinSecretLength {
+		return ErrSecretTooShort
+	}
+
+	if length > MaxSecretLength {
+		return ErrSecretTooLong
+	}
+
+	return nil
+}
+
+func (w *Webhook) ID() WebhookID {
+	return w.id
+}
+
+func (w *Webhook) OwnerID() user.UserID {
+	return w.ownerID
+}
+
+func (w *Webhook) URL() string {
+	return w.url
+}
+
+// Secret returns the secret payloads are signed with. It is never shown to
+// the owner again after they set it.
+func (w *Webhook) Secret() string {
+	return w.secret
+}
+
+// EventTypes returns the event types the webhook is notified of in
+// alphabetical order, or none if it is notified of all of them.
+func (w *Webhook) EventTypes() []EventType {
+	return slices.Clone(w.eventTypes)
+}
+
+// FailureCount returns the number of delivery attempts that failed since the
+// last one that succeeded.
+func (w *Webhook) FailureCount() int {
+	return w.failureCount
+}
+
+// DisabledAt returns the time the webhook was disabled, or nil if it is active.
+func (w *Webhook) DisabledAt() *time.Time {
+	return w.disabledAt
+}
+
+func (w *Webhook) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// IsActive reports whether the webhook is notified of events.
+func (w *Webhook) IsActive() bool {
+	return w.disabledAt == nil
+}
+
+// Accepts reports whether the webhook is notified of events of the given type.
+func (w *Webhook) Accepts(eventType EventType) bool {
+	return len(w.eventTypes) == 0 || slices.Contains(w.eventTypes, eventType)
+}
+
+// ChangeURL replaces the URL events are posted to.
+// It returns an error if the URL is invalid according to NormalizeURL.
+func (w *Webhook) ChangeURL(rawURL string) error {
+	normalized, err := NormalizeURL(rawURL)
+	if err != nil {
+		return err
+	}
+
+	w.url = normalized
+
+	return nil
+}
+
+// ChangeSecret replaces the secret payloads are signed with.
+// It returns an error if the secret is invalid according to ValidateSecret.
+func (w *Webhook) ChangeSecret(secret string) error {
+	if err := ValidateSecret(secret); err != nil {
+		return err
+	}
+
+	w.secret = secret
+
+	return nil
+}
+
+// Subscribe replaces the event types the webhook is notified of. No event
+// types subscribes to all of them.
+// It returns ErrInvalidEventType if an event type is unknown.
+func (w *Webhook) Subscribe(eventTypes []EventType) error {
+	normalized, err := NormalizeEventTypes(eventTypes)
+	if err != nil {
+		return err
+	}
+
+	w.eventTypes = normalized
+
+	return nil
+}
+
+// Enable makes a disabled webhook active again and forgets its failures.
+func (w *Webhook) Enable() {
+	w.disabledAt = nil
+	w.failureCount = 0
+}
+
+// Disable stops notifying the webhook from disabledAt on.
+// Disabling a disabled webhook keeps the time it was first disabled.
+func (w *Webhook) Disable(disabledAt time.Time) {
+	if w.disabledAt != nil {
+		return
+	}
+
+	w.disabledAt = &disabledAt
+}
+
+// RecordSuccess forgets the failures of the webhook after its endpoint
+// accepted a delivery.
+func (w *Webhook) RecordSuccess() {
+	w.failureCount = 0
+}
+
+// RecordFailure counts a failed delivery attempt made at attemptedAt and
+// disables the webhook once policy says its endpoint keeps failing.
+func (w *Webhook) RecordFailure(attemptedAt time.Time, policy RetryPolicy) {
+	w.failureCount++
+
+	if policy.ShouldDisable(w.failureCount) {
+		w.Disable(attemptedAt)
+	}
+}
@@ -0,0 +1,201 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+const testSecret = "0123456789abcdef"
+
+func TestNewWebhookID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		id            string
+		expectedError error
+	}{
+		{name: "valid UUID", id: "123e4567-e89b-12d3-a456-426614174000", expectedError: nil},
+		{name: "empty", id: "", expectedError: ErrWebhookIDEmpty},
+		{name: "not a UUID", id: "webhook-1", expectedError: ErrInvalidWebhookIDFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			id, err := NewWebhookID(tt.id)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.id, id.String())
+		})
+	}
+}
+
+func TestNewWebhook(t *testing.T) {
+	t.Parallel()
+
+	ownerID := user.GenerateUserID()
+	createdAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		ownerID       user.UserID
+		url           string
+		secret        string
+		eventTypes    []EventType
+		expectedURL   string
+		expectedTypes []EventType
+		expectedError error
+	}{
+		{
+			name:          "valid webhook",
+			ownerID:       ownerID,
+			url:           " https://example.com/hooks ",
+			secret:        testSecret,
+			eventTypes:    []EventType{"task.deleted", "task.created", "task.deleted"},
+			expectedURL:   "https://example.com/hooks",
+			expectedTypes: []EventType{"task.created", "task.deleted"},
+		},
+		{
+			name:          "all event types",
+			ownerID:       ownerID,
+			url:           "http://localhost:9000/hooks",
+			secret:        testSecret,
+			eventTypes:    nil,
+			expectedURL:   "http://localhost:9000/hooks",
+			expectedTypes: []EventType{},
+		},
+		{name: "empty owner", ownerID: user.UserID{}, url: "https://example.com", secret: testSecret, expectedError: user.ErrUserIDEmpty},
+		{name: "empty URL", ownerID: ownerID, url: "  ", secret: testSecret, expectedError: ErrURLEmpty},
+		{name: "URL too long", ownerID: ownerID, url: "https://example.com/" + strings.Repeat("a", MaxURLLength), secret: testSecret, expectedError: ErrURLTooLong},
+		{name: "relative URL", ownerID: ownerID, url: "/hooks", secret: testSecret, expectedError: ErrInvalidURL},
+		{name: "unsupported scheme", ownerID: ownerID, url: "ftp://example.com/hooks", secret: testSecret, expectedError: ErrInvalidURL},
+		{name: "secret too short", ownerID: ownerID, url: "https://example.com", secret: "short", expectedError: ErrSecretTooShort},
+		{name: "secret too long", ownerID: ownerID, url: "https://example.com", secret: strings.Repeat("s", MaxSecretLength+1), expectedError: ErrSecretTooLong},
+		{name: "unknown event type", ownerID: ownerID, url: "https://example.com", secret: testSecret, eventTypes: []EventType{"task.renamed"}, expectedError: ErrInvalidEventType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			webhookEntity, err := NewWebhook(GenerateWebhookID(), tt.ownerID, tt.url, tt.secret, tt.eventTypes, createdAt)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, webhookEntity)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedURL, webhookEntity.URL())
+			assert.Equal(t, tt.secret, webhookEntity.Secret())
+			assert.Equal(t, tt.expectedTypes, webhookEntity.EventTypes())
+			assert.True(t, webhookEntity.IsActive())
+			assert.Zero(t, webhookEntity.FailureCount())
+			assert.Equal(t, createdAt, webhookEntity.CreatedAt())
+		})
+	}
+}
+
+func TestWebhook_Accepts(t *testing.T) {
+	t.Parallel()
+
+	ownerID := user.GenerateUserID()
+
+	tests := []struct {
+		name       string
+		eventTypes []EventType
+		eventType  EventType
+		expected   bool
+	}{
+		{name: "subscribed type", eventTypes: []EventType{"task.created"}, eventType: "task.created", expected: true},
+		{name: "other type", eventTypes: []EventType{"task.created"}, eventType: "task.deleted", expected: false},
+		{name: "no filter accepts everything", eventTypes: nil, eventType: "task.deleted", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			webhookEntity := NewWebhookWithoutValidation(GenerateWebhookID(), ownerID, "https://example.com", testSecret, tt.eventTypes, time.Now())
+
+			// Act & Assert
+			assert.Equal(t, tt.expected, webhookEntity.Accepts(tt.eventType))
+		})
+	}
+}
+
+func TestWebhook_EnableAndDisable(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	disabledAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	webhookEntity := NewWebhookWithoutValidation(GenerateWebhookID(), user.GenerateUserID(), "https://example.com", testSecret, nil, time.Now(),
+		WithFailureCount(15), WithDisabledAt(&disabledAt))
+
+	// Act
+	webhookEntity.Disable(disabledAt.Add(time.Hour))
+
+	// Assert
+	require.NotNil(t, webhookEntity.DisabledAt())
+	assert.Equal(t, disabledAt, *webhookEntity.DisabledAt())
+	assert.False(t, webhookEntity.IsActive())
+
+	// Act
+	webhookEntity.Enable()
+
+	// Assert
+	assert.True(t, webhookEntity.IsActive())
+	assert.Nil(t, webhookEntity.DisabledAt())
+	assert.Zero(t, webhookEntity.FailureCount())
+}
+
+func TestWebhook_RecordFailure(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	attemptedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	webhookEntity := NewWebhookWithoutValidation(GenerateWebhookID(), user.GenerateUserID(), "https://example.com", testSecret, nil, time.Now(),
+		WithFailureCount(testPolicy.DisableAfter-2))
+
+	// Act
+	webhookEntity.RecordFailure(attemptedAt, testPolicy)
+
+	// Assert
+	assert.True(t, webhookEntity.IsActive())
+
+	// Act
+	webhookEntity.RecordFailure(attemptedAt, testPolicy)
+
+	// Assert
+	assert.False(t, webhookEntity.IsActive())
+	assert.Equal(t, testPolicy.DisableAfter, webhookEntity.FailureCount())
+	assert.Equal(t, &attemptedAt, webhookEntity.DisabledAt())
+
+	// Act
+	webhookEntity.RecordSuccess()
+
+	// Assert
+	assert.Zero(t, webhookEntity.FailureCount())
+	assert.False(t, webhookEntity.IsActive())
+}
@@ -0,0 +1,16 @@
+package workspace
+
+import "errors"
+
+var (
+	ErrNameEmpty                = errors.New("workspace name cannot be empty")
+	ErrNameTooLong              = errors.New("workspace name cannot exceed 100 characters")
+	ErrWorkspaceNotFound        = errors.New("workspace not found")
+	ErrWorkspaceIDEmpty         = errors.New("workspace ID cannot be empty")
+	ErrInvalidWorkspaceIDFormat = errors.New("workspace ID must be a valid UUID format")
+	ErrInvalidRole              = errors.New("member role must be owner, admin, member or guest")
+	ErrOwnerRoleAssignment      = errors.New("the owner role cannot be given to a member")
+	ErrOwnerMembership          = errors.New("the owner of a workspace cannot be changed or removed")
+	ErrMemberNotFound           = errors.New("member not found")
+	ErrForbidden                = errors.New("the role of the member does not allow this action")
+)
@@ -0,0 +1,119 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// Role is what a member may do in a workspace.
+type Role string
+
+const (
+	// RoleOwner created the workspace and is the only one who manages its members.
+	RoleOwner Role = "owner"
+	// RoleAdmin works on the tasks of the workspace like a member.
+	RoleAdmin Role = "admin"
+	// RoleMember creates and works on tasks in the workspace.
+	RoleMember Role = "member"
+	// RoleGuest may only read the tasks of the workspace.
+	RoleGuest Role = "guest"
+)
+
+// ParseRole parses the name of a role.
+// It returns ErrInvalidRole for any other name.
+func ParseRole(name string) (Role, error) {
+	switch role := Role(name); role {
+	case RoleOwner, RoleAdmin, RoleMember, RoleGuest:
+		return role, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidRole, name)
+	}
+}
+
+// CanWriteTasks reports whether a member with the role may create tasks in the workspace.
+func (r Role) CanWriteTasks() bool {
+	return r == RoleOwner || r == RoleAdmin || r == RoleMember
+}
+
+// CanManageMembers reports whether a member with the role may add, change and
+// remove the members of the workspace.
+func (r Role) CanManageMembers() bool {
+	return r == RoleOwner
+}
+
+// Member is a user who belongs to a workspace, with the role they have in it.
+type Member struct {
+	workspaceID WorkspaceID
+	userID      user.UserID
+	role        Role
+}
+
+// NewMember creates a member with the given role in the workspace with workspaceID.
+// It returns ErrInvalidRole if role is not a role.
+func NewMember(workspaceID WorkspaceID, userID user.UserID, role Role) (Member, error) {
+	if workspaceID.IsEmpty() {
+		return Member{}, ErrWorkspaceIDEmpty
+	}
+
+	if userID.IsEmpty() {
+		return Member{}, user.ErrUserIDEmpty
+	}
+
+	if _, err := ParseRole(string(role)); err != nil {
+		return Member{}, err
+	}
+
+	return Member{workspaceID: workspaceID, userID: userID, role: role}, nil
+}
+
+// WorkspaceID returns the ID of the workspace the member belongs to.
+func (m Member) WorkspaceID() WorkspaceID {
+	return m.workspaceID
+}
+
+// UserID returns the ID of the user who is the member.
+func (m Member) UserID() user.UserID {
+	return m.userID
+}
+
+// Role returns the role of the member in the workspace.
+func (m Member) Role() Role {
+	return m.role
+}
+
+// Admit gives the user the role in the workspace of the member, who must be
+// allowed to manage members.
+// It returns ErrForbidden if the member may not manage members,
+// ErrOwnerRoleAssignment if role is RoleOwner, and ErrOwnerMembership if the
+// user is the member themselves, since only the owner manages members.
+func (m Member) Admit(userID user.UserID, role Role) (Member, error) {
+	if !m.role.CanManageMembers() {
+		return Member{}, ErrForbidden
+	}
+
+	if role == RoleOwner {
+		return Member{}, ErrOwnerRoleAssignment
+	}
+
+	if userID == m.userID {
+		return Member{}, ErrOwnerMembership
+	}
+
+	return NewMember(m.workspaceID, userID, role)
+}
+
+// Dismiss checks that the member may remove the user from the workspace.
+// It returns ErrForbidden if the member may not manage members and
+// ErrOwnerMembership if the user is the member themselves.
+func (m Member) Dismiss(userID user.UserID) error {
+	if !m.role.CanManageMembers() {
+		return ErrForbidden
+	}
+
+	if userID == m.userID {
+		return ErrOwnerMembership
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      Role
+		expectedError error
+	}{
+		{
+			name:          "owner",
+			input:         "owner",
+			expected:      RoleOwner,
+			expectedError: nil,
+		},
+		{
+			name:          "guest",
+			input:         "guest",
+			expected:      RoleGuest,
+			expectedError: nil,
+		},
+		{
+			name:          "unknown role",
+			input:         "viewer",
+			expected:      "",
+			expectedError: ErrInvalidRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result, err := ParseRole(tt.input)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestRolePermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		role          Role
+		writeTasks    bool
+		manageMembers bool
+	}{
+		{role: RoleOwner, writeTasks: true, manageMembers: true},
+		{role: RoleAdmin, writeTasks: true, manageMembers: false},
+		{role: RoleMember, writeTasks: true, manageMembers: false},
+		{role: RoleGuest, writeTasks: false, manageMembers: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			t.Parallel()
+
+			// Act & Assert
+			assert.Equal(t, tt.writeTasks, tt.role.CanWriteTasks())
+			assert.Equal(t, tt.manageMembers, tt.role.CanManageMembers())
+		})
+	}
+}
+
+func TestMemberAdmit(t *testing.T) {
+	t.Parallel()
+
+	workspace := NewWorkspaceWithoutValidation(GenerateWorkspaceID(), "Team", user.GenerateUserID())
+	owner := workspace.Owner()
+	newcomer := user.GenerateUserID()
+
+	admin, err := NewMember(workspace.ID(), user.GenerateUserID(), RoleAdmin)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		actor         Member
+		userID        user.UserID
+		role          Role
+		expectedError error
+	}{
+		{
+			name:          "owner admits a member",
+			actor:         owner,
+			userID:        newcomer,
+			role:          RoleMember,
+			expectedError: nil,
+		},
+		{
+			name:          "admin cannot manage members",
+			actor:         admin,
+			userID:        newcomer,
+			role:          RoleGuest,
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "owner role cannot be given",
+			actor:         owner,
+			userID:        newcomer,
+			role:          RoleOwner,
+			expectedError: ErrOwnerRoleAssignment,
+		},
+		{
+			name:          "owner cannot change their own role",
+			actor:         owner,
+			userID:        owner.UserID(),
+			role:          RoleAdmin,
+			expectedError: ErrOwnerMembership,
+		},
+		{
+			name:          "unknown role",
+			actor:         owner,
+			userID:        newcomer,
+			role:          Role("viewer"),
+			expectedError: ErrInvalidRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			member, err := tt.actor.Admit(tt.userID, tt.role)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, workspace.ID(), member.WorkspaceID())
+			assert.Equal(t, tt.userID, member.UserID())
+			assert.Equal(t, tt.role, member.Role())
+		})
+	}
+}
+
+func TestMemberDismiss(t *testing.T) {
+	t.Parallel()
+
+	workspace := NewWorkspaceWithoutValidation(GenerateWorkspaceID(), "Team", user.GenerateUserID())
+	owner := workspace.Owner()
+
+	member, err := NewMember(workspace.ID(), user.GenerateUserID(), RoleMember)
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.NoError(t, owner.Dismiss(member.UserID()))
+	assert.ErrorIs(t, owner.Dismiss(owner.UserID()), ErrOwnerMembership)
+	assert.ErrorIs(t, member.Dismiss(owner.UserID()), ErrForbidden)
+}
@@ -0,0 +1,26 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// WorkspaceRepository defines the interface for workspace data persistence operations.
+type WorkspaceRepository interface {
+	// FindAllByUserID returns the workspaces the user is a member of, ordered by name.
+	FindAllByUserID(ctx context.Context, userID user.UserID) ([]*Workspace, error)
+	// Create stores a new workspace together with the membership of its owner.
+	Create(ctx context.Context, workspace *Workspace) (*Workspace, error)
+	// FindMember returns the membership of the user in the workspace.
+	// It returns ErrWorkspaceNotFound if the user is not a member, so that
+	// workspaces stay hidden from users outside them.
+	FindMember(ctx context.Context, id WorkspaceID, userID user.UserID) (Member, error)
+	// FindMembers returns the members of the workspace in the order they joined.
+	FindMembers(ctx context.Context, id WorkspaceID) ([]Member, error)
+	// SaveMember adds a member to a workspace, or changes the role of an existing member.
+	SaveMember(ctx context.Context, member Member) error
+	// RemoveMember removes the user from the workspace.
+	// It returns ErrMemberNotFound if the user is not a member.
+	RemoveMember(ctx context.Context, id WorkspaceID, userID user.UserID) error
+}
@@ -0,0 +1,117 @@
+package workspace
+
+import (
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/text"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// MaxNameLength defines the maximum allowed length for workspace names.
+const MaxNameLength = 100
+
+// WorkspaceID represents a unique identifier for a workspace.
+type WorkspaceID struct {
+	value uuid.UUID
+}
+
+// NewWorkspaceID creates a new WorkspaceID from a string value.
+func NewWorkspaceID(id string) (WorkspaceID, error) {
+	if id == "" {
+		return WorkspaceID{}, ErrWorkspaceIDEmpty
+	}
+
+	parsedUUID, err := uuid.Parse(id)
+	if err != nil {
+		return WorkspaceID{}, ErrInvalidWorkspaceIDFormat
+	}
+
+	return WorkspaceID{value: parsedUUID}, nil
+}
+
+// GenerateWorkspaceID creates a new WorkspaceID with a generated UUID.
+func GenerateWorkspaceID() WorkspaceID {
+	return WorkspaceID{value: uuid.New()}
+}
+
+// String returns the string representation of the WorkspaceID.
+func (w WorkspaceID) String() string {
+	return w.value.String()
+}
+
+// UUID returns the underlying uuid.UUID value.
+func (w WorkspaceID) UUID() uuid.UUID {
+	return w.value
+}
+
+// IsEmpty returns true if the WorkspaceID is empty.
+func (w WorkspaceID) IsEmpty() bool {
+	return w.value == uuid.Nil
+}
+
+// Workspace represents a team that shares one deployment with other teams.
+// Its members work on tasks scoped to the workspace; the user who created it
+// is its owner.
+type Workspace struct {
+	id      WorkspaceID
+	name    string
+	ownerID user.UserID
+}
+
+// NewWorkspace creates a new Workspace instance with a normalised name.
+// It returns an error if the name is invalid according to NormalizeName.
+func NewWorkspace(id WorkspaceID, name string, ownerID user.UserID) (*Workspace, error) {
+	name, err := NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Workspace{
+		id:      id,
+		name:    name,
+		ownerID: ownerID,
+	}, nil
+}
+
+// NewWorkspaceWithoutValidation rebuilds a Workspace from stored values without validating them.
+func NewWorkspaceWithoutValidation(id WorkspaceID, name string, ownerID user.UserID) *Workspace {
+	return &Workspace{
+		id:      id,
+		name:    name,
+		ownerID: ownerID,
+	}
+}
+
+// NormalizeName removes surrounding spaces and zero-width characters from a
+// workspace name, following the rules used for project names, and validates the result.
+func NormalizeName(name string) (string, error) {
+	name = text.TrimSpaceAndZeroWidth(name)
+	if name == "" {
+		return "", ErrNameEmpty
+	}
+
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return "", ErrNameTooLong
+	}
+
+	return name, nil
+}
+
+func (w *Workspace) ID() WorkspaceID {
+	return w.id
+}
+
+func (w *Workspace) Name() string {
+	return w.name
+}
+
+func (w *Workspace) OwnerID() user.UserID {
+	return w.ownerID
+}
+
+// Owner returns the membership of the owner of the workspace.
+func (w *Workspace) Owner() Member {
+	return Member{workspaceID: w.id, userID: w.ownerID, role: RoleOwner}
+}
@@ -0,0 +1,55 @@
+package workspace
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func TestNewWorkspace(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	id := GenerateWorkspaceID()
+	ownerID := user.GenerateUserID()
+
+	// Act
+	workspace, err := NewWorkspace(id, " Platform Team ", ownerID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, id, workspace.ID())
+	assert.Equal(t, "Platform Team", workspace.Name())
+	assert.Equal(t, ownerID, workspace.OwnerID())
+
+	owner := workspace.Owner()
+	assert.Equal(t, id, owner.WorkspaceID())
+	assert.Equal(t, ownerID, owner.UserID())
+	assert.Equal(t, RoleOwner, owner.Role())
+
+	_, err = NewWorkspace(id, "\u200B", ownerID)
+	assert.ErrorIs(t, err, ErrNameEmpty)
+
+	_, err = NewWorkspace(id, strings.Repeat("a", MaxNameLength+1), ownerID)
+	assert.ErrorIs(t, err, ErrNameTooLong)
+}
+
+func TestNewWorkspaceID(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewWorkspaceID("")
+	assert.ErrorIs(t, err, ErrWorkspaceIDEmpty)
+
+	_, err = NewWorkspaceID("not-a-uuid")
+	assert.ErrorIs(t, err, ErrInvalidWorkspaceIDFormat)
+
+	id := GenerateWorkspaceID()
+	parsed, err := NewWorkspaceID(id.String())
+	require.NoError(t, err)
+	assert.Equal(t, id, parsed)
+	assert.False(t, parsed.IsEmpty())
+}
@@ -0,0 +1,60 @@
+package blobstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+)
+
+var ErrUnexpectedResponse = errors.New("unexpected response from object store")
+
+// New creates the BlobStore selected by the storage configuration.
+func New(cfg config.StorageConfig) (attachment.BlobStore, error) {
+	switch cfg.Backend {
+	case config.StorageBackendLocal:
+		return NewLocalStore(cfg.LocalDir)
+	case config.StorageBackendS3:
+		return NewS3Store(cfg.S3)
+	default:
+		return nil, fmt.Errorf("%w: %s", config.ErrStorageBackendInvalid, cfg.Backend)
+	}
+}
+
+// sizedReader reads exactly remaining bytes from r. It fails with
+// attachment.ErrSizeMismatch if r ends early or holds more than that.
+type sizedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (s *sizedReader) Read(p []byte) (int, error) {
+	if s.remaining == 0 {
+		var extra [1]byte
+		if n, _ := io.ReadFull(s.r, extra[:]); n > 0 {
+			return 0, attachment.ErrSizeMismatch
+		}
+
+		return 0, io.EOF
+	}
+
+	if int64(len(p)) > s.remaining {
+		p = p[:s.remaining]
+	}
+
+	n, err := s.r.Read(p)
+	s.remaining -= int64(n)
+
+	if errors.Is(err, io.EOF) {
+		if s.remaining > 0 {
+			return n, attachment.ErrSizeMismatch
+		}
+
+		// Let the next call confirm that nothing follows.
+		return n, nil
+	}
+
+	return n, err
+}
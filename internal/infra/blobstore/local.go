@@ -0,0 +1,117 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/google/uuid"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+)
+
+// LocalStore keeps blobs as files below a directory on the local filesystem.
+// All access goes through an os.Root, so no key can reach outside of it.
+type LocalStore struct {
+	root *os.Root
+}
+
+// NewLocalStore creates a LocalStore in dir, creating the directory if needed.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalStore{root: root}, nil
+}
+
+// Close releases the directory of the store.
+func (s *LocalStore) Close() error {
+	return s.root.Close()
+}
+
+// Put writes the content to a temporary file next to its destination and
+// renames it into place once complete, so that readers never see a partial blob.
+func (s *LocalStore) Put(ctx context.Context, key string, content io.Reader, size int64, _ string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := s.root.MkdirAll(path.Dir(key), 0o750); err != nil {
+		return err
+	}
+
+	tmpName := key + ".upload-" + uuid.NewString()
+
+	file, err := s.root.OpenFile(tmpName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o640)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, &sizedReader{r: content, remaining: size})
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = s.root.Rename(tmpName, key)
+	}
+
+	if err != nil {
+		_ = s.root.Remove(tmpName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *LocalStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	file, err := s.root.Open(key)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, attachment.ErrBlobNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	if err := s.root.Remove(key); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// validateKey accepts slash-separated relative paths without "." or ".." elements.
+func validateKey(key string) error {
+	if !fs.ValidPath(key) || key == "." {
+		return attachment.ErrInvalidKey
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+)
+
+func TestLocalStore(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	dir := filepath.Join(t.TempDir(), "attachments")
+	store, err := NewLocalStore(dir)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { store.Close() })
+
+	ctx := context.Background()
+
+	t.Run("stored content can be read back", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		require.NoError(t, store.Put(ctx, "attachments/a", strings.NewReader("%PDF-1.7"), 8, "application/pdf"))
+
+		reader, err := store.Get(ctx, "attachments/a")
+		require.NoError(t, err)
+
+		defer reader.Close()
+
+		content, err := io.ReadAll(reader)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "%PDF-1.7", string(content))
+		assert.FileExists(t, filepath.Join(dir, "attachments", "a"))
+	})
+
+	t.Run("content of another size is not stored", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		shortErr := store.Put(ctx, "attachments/short", strings.NewReader("abc"), 4, "image/png")
+		longErr := store.Put(ctx, "attachments/long", strings.NewReader("abcde"), 4, "image/png")
+
+		// Assert
+		require.ErrorIs(t, shortErr, attachment.ErrSizeMismatch)
+		require.ErrorIs(t, longErr, attachment.ErrSizeMismatch)
+
+		_, err := store.Get(ctx, "attachments/short")
+		assert.ErrorIs(t, err, attachment.ErrBlobNotFound)
+
+		entries, err := os.ReadDir(filepath.Join(dir, "attachments"))
+		require.NoError(t, err)
+
+		for _, entry := range entries {
+			assert.NotContains(t, entry.Name(), ".upload-")
+		}
+	})
+
+	t.Run("deleted content is gone", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		require.NoError(t, store.Put(ctx, "attachments/b", strings.NewReader("gif"), 3, "image/gif"))
+
+		// Act
+		require.NoError(t, store.Delete(ctx, "attachments/b"))
+
+		// Assert
+		_, err := store.Get(ctx, "attachments/b")
+		require.ErrorIs(t, err, attachment.ErrBlobNotFound)
+		assert.NoError(t, store.Delete(ctx, "attachments/b"))
+	})
+
+	t.Run("keys cannot leave the directory", func(t *testing.T) {
+		t.Parallel()
+
+		for _, key := range []string{"../outside", "/etc/passwd", "attachments/../../outside", ""} {
+			assert.ErrorIs(t, store.Put(ctx, key, strings.NewReader("x"), 1, "image/png"), attachment.ErrInvalidKey, key)
+
+			_, err := store.Get(ctx, key)
+			assert.ErrorIs(t, err, attachment.ErrInvalidKey, key)
+			assert.ErrorIs(t, store.Delete(ctx, key), attachment.ErrInvalidKey, key)
+		}
+
+		assert.NoFileExists(t, filepath.Join(filepath.Dir(dir), "outside"))
+	})
+}
@@ -0,0 +1,148 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+)
+
+// maxErrorBodySize limits how much of an error response is kept for the error message.
+const maxErrorBodySize = 1024
+
+// S3Store keeps blobs as objects in a bucket of an S3-compatible object store.
+// Objects are addressed path-style, so that it works the same against AWS and
+// against local stand-ins such as MinIO. Uploads are streamed without hashing
+// the payload first.
+type S3Store struct {
+	client   *http.Client
+	endpoint *url.URL
+	bucket   string
+	signer   *sigV4Signer
+}
+
+// NewS3Store creates an S3Store for the bucket and endpoint of the configuration.
+func NewS3Store(cfg config.S3Config) (*S3Store, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("%w: %s", config.ErrS3EndpointRequired, cfg.Endpoint)
+	}
+
+	return &S3Store{
+		client:   &http.Client{},
+		endpoint: endpoint,
+		bucket:   cfg.Bucket,
+		signer: &sigV4Signer{
+			accessKeyID:     cfg.AccessKeyID,
+			secretAccessKey: cfg.SecretAccessKey,
+			region:          cfg.Region,
+			service:         "s3",
+			now:             time.Now,
+		},
+	}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, &sizedReader{r: content, remaining: size})
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	s.signer.sign(req, unsignedPayload)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return unexpectedResponse(resp)
+	}
+
+	return nil
+}
+
+// Get streams the object; the caller reads it straight from the response body.
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.signer.sign(req, hashHex(nil))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+
+		return nil, attachment.ErrBlobNotFound
+	default:
+		defer resp.Body.Close()
+
+		return nil, unexpectedResponse(resp)
+	}
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	s.signer.sign(req, hashHex(nil))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return unexpectedResponse(resp)
+	}
+}
+
+// newRequest builds a request for the object stored under key. The path is
+// encoded the same way it is signed, so that the store computes the same signature.
+func (s *S3Store) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	objectURL := *s.endpoint
+	objectURL.Path = s.endpoint.Path + "/" + s.bucket + "/" + key
+	objectURL.RawPath = uriEncode(objectURL.Path, false)
+
+	return http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+}
+
+// unexpectedResponse turns an error response of the store into an error
+// carrying its status and the start of its body.
+func unexpectedResponse(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	return fmt.Errorf("%w: %s: %s", ErrUnexpectedResponse, resp.Status, strings.TrimSpace(string(body)))
+}
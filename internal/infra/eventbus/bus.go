@@ -0,0 +1,133 @@
+// Package eventbus provides an in-process bus that delivers the domain events
+// of tasks to typed subscribers.
+package eventbus
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+)
+
+// Handler reacts to an event of type E.
+type Handler[E task.Event] func(ctx context.Context, event E) error
+
+// subscription is a handler registered for the events it accepts.
+type subscription struct {
+	async   bool
+	accepts func(event task.Event) bool
+	handle  func(ctx context.Context, event task.Event) error
+}
+
+// Bus delivers published events to the subscribers of their type.
+// Synchronous subscribers run in the order they subscribed before Publish
+// returns; asynchronous subscribers run in their own goroutine. Failures of
+// subscribers are logged and do not affect other subscribers.
+type Bus struct {
+	mu            sync.RWMutex
+	subscriptions []subscription
+	running       sync.WaitGroup
+}
+
+// New creates a Bus without subscribers.
+func New() *Bus {
+	return &Bus{
+		mu:            sync.RWMutex{},
+		subscriptions: nil,
+		running:       sync.WaitGroup{},
+	}
+}
+
+// Subscribe registers a handler that receives every event of type E while
+// Publish runs. E may be an interface such as task.Event to receive all events
+// that implement it.
+func Subscribe[E task.Event](bus *Bus, handler Handler[E]) {
+	bus.subscribe(newSubscription(false, handler))
+}
+
+// SubscribeAsync registers a handler that receives every event of type E in
+// a goroutine of its own, so that Publish does not wait for it. The handler
+// gets a context that keeps the values of the publishing context but is not
+// cancelled with it.
+func SubscribeAsync[E task.Event](bus *Bus, handler Handler[E]) {
+	bus.subscribe(newSubscription(true, handler))
+}
+
+// newSubscription wraps a typed handler so that it can be kept with the
+// handlers of other event types.
+func newSubscription[E task.Event](async bool, handler Handler[E]) subscription {
+	return subscription{
+		async: async,
+		accepts: func(event task.Event) bool {
+			_, ok := event.(E)
+
+			return ok
+		},
+		handle: func(ctx context.Context, event task.Event) error {
+			typed, ok := event.(E)
+			if !ok {
+				return nil
+			}
+
+			return handler(ctx, typed)
+		},
+	}
+}
+
+func (b *Bus) subscribe(sub subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscriptions = append(b.subscriptions, sub)
+}
+
+// Publish delivers the events in order to their subscribers.
+func (b *Bus) Publish(ctx context.Context, events ...task.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	b.mu.RLock()
+	subscriptions := b.subscriptions
+	b.mu.RUnlock()
+
+	for _, event := range events {
+		for _, sub := range subscriptions {
+			if !sub.accepts(event) {
+				continue
+			}
+
+			if sub.async {
+				b.running.Go(func() {
+					deliverAsync(context.WithoutCancel(ctx), sub, event)
+				})
+
+				continue
+			}
+
+			if err := sub.handle(ctx, event); err != nil {
+				log.Println("Failed to handle event", event.Name()+":", err)
+			}
+		}
+	}
+}
+
+// deliverAsync runs an asynchronous subscriber, which must not take the
+// process down if it panics.
+func deliverAsync(ctx context.Context, sub subscription, event task.Event) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			log.Println("Event handler panicked on", event.Name()+":", recovered)
+		}
+	}()
+
+	if err := sub.handle(ctx, event); err != nil {
+		log.Println("Failed to handle event", event.Name()+":", err)
+	}
+}
+
+// Wait blocks until every asynchronous subscriber started so far has returned.
+func (b *Bus) Wait() {
+	b.running.Wait()
+}
@@ -0,0 +1,178 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func newTestEvents(t *testing.T) (task.TaskCreated, task.TaskTitleChanged) {
+	t.Helper()
+
+	taskEntity, err := task.NewTask(task.GenerateTaskID(), "Draft", user.GenerateUserID())
+	require.NoError(t, err)
+	require.NoError(t, taskEntity.UpdateTitle("Report"))
+
+	events := taskEntity.PullEvents()
+	require.Len(t, events, 2)
+
+	created, ok := events[0].(task.TaskCreated)
+	require.True(t, ok)
+
+	changed, ok := events[1].(task.TaskTitleChanged)
+	require.True(t, ok)
+
+	return created, changed
+}
+
+func TestBus_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	bus := New()
+	created, changed := newTestEvents(t)
+
+	var received []string
+
+	Subscribe(bus, func(_ context.Context, event task.TaskCreated) error {
+		received = append(received, "first:"+event.Name())
+
+		return nil
+	})
+	Subscribe(bus, func(_ context.Context, event task.Event) error {
+		received = append(received, "all:"+event.Name())
+
+		return nil
+	})
+	Subscribe(bus, func(_ context.Context, event task.TaskTitleChanged) error {
+		received = append(received, "title:"+event.Title())
+
+		return errors.New("subscriber failed")
+	})
+	Subscribe(bus, func(_ context.Context, event task.TaskCreated) error {
+		received = append(received, "second:"+event.Title())
+
+		return nil
+	})
+
+	// Act
+	bus.Publish(context.Background(), created, changed)
+
+	// Assert
+	assert.Equal(t, []string{
+		"first:task.created",
+		"all:task.created",
+		"second:Draft",
+		"all:task.title_changed",
+		"title:Report",
+	}, received)
+}
+
+func TestBus_SubscribeAsync(t *testing.T) {
+	t.Parallel()
+
+	t.Run("handlers run after publish", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		bus := New()
+		created, changed := newTestEvents(t)
+
+		var (
+			mu       sync.Mutex
+			received []task.Event
+		)
+
+		SubscribeAsync(bus, func(_ context.Context, event task.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			received = append(received, event)
+
+			return nil
+		})
+
+		// Act
+		bus.Publish(context.Background(), created, changed)
+		bus.Wait()
+
+		// Assert
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.ElementsMatch(t, []task.Event{created, changed}, received)
+	})
+
+	t.Run("handlers outlive the publishing context", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		bus := New()
+		created, _ := newTestEvents(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var handlerErr error
+
+		SubscribeAsync(bus, func(ctx context.Context, _ task.TaskCreated) error {
+			handlerErr = ctx.Err()
+
+			return nil
+		})
+
+		// Act
+		bus.Publish(ctx, created)
+		cancel()
+		bus.Wait()
+
+		// Assert
+		assert.NoError(t, handlerErr)
+	})
+
+	t.Run("panicking handler does not stop others", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		bus := New()
+		created, _ := newTestEvents(t)
+
+		var delivered bool
+
+		SubscribeAsync(bus, func(context.Context, task.TaskCreated) error {
+			panic("subscriber panicked")
+		})
+		Subscribe(bus, func(context.Context, task.TaskCreated) error {
+			delivered = true
+
+			return nil
+		})
+
+		// Act
+		bus.Publish(context.Background(), created)
+		bus.Wait()
+
+		// Assert
+		assert.True(t, delivered)
+	})
+}
+
+func TestBus_PublishWithoutSubscribers(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	bus := New()
+	created, changed := newTestEvents(t)
+
+	// Act & Assert
+	assert.NotPanics(t, func() {
+		bus.Publish(context.Background(), created, changed)
+		bus.Publish(context.Background())
+		bus.Wait()
+	})
+}
@@ -0,0 +1,167 @@
+// Package eventstream keeps a bounded log of the changes to the tasks of users
+// and fans them out to the streams the users have open.
+package eventstream
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// ErrEventsExpired is returned when a stream cannot be resumed because the
+// events that followed the last one it received are no longer in the log.
+var ErrEventsExpired = errors.New("events after the given ID are no longer available")
+
+// Event is a change to a task, sent to the streams of the creator of the task.
+type Event struct {
+	ID     uint64
+	UserID user.UserID
+	Type   string // "created", "updated" or "deleted"
+	Data   []byte
+}
+
+// Broker logs published events and hands them to the subscriptions of their
+// user. The log keeps the latest events of all users up to its size, so that
+// a stream that was interrupted can be resumed from the last event it got.
+// A subscription that falls more events behind than its buffer holds is
+// dropped rather than holding up the publisher; its client resumes from the log.
+type Broker struct {
+	mu                sync.Mutex
+	log               []Event
+	head              int // index in log the next event is written to
+	count             int // number of events in log
+	nextID            uint64
+	bufferSize        int
+	heartbeatInterval time.Duration
+	subscriptions     map[user.UserID]map[*Subscription]struct{}
+}
+
+// NewBroker creates a Broker that logs up to logSize events and buffers up to
+// bufferSize events for every subscription. Streams are sent a heartbeat
+// after heartbeatInterval without events.
+func NewBroker(logSize, bufferSize int, heartbeatInterval time.Duration) *Broker {
+	return &Broker{
+		mu:    sync.Mutex{},
+		log:   make([]Event, logSize),
+		head:  0,
+		count: 0,
+		// IDs continue from the start time so that the IDs a client got from
+		// an earlier process are older than any in the log and are not
+		// mistaken for events of this one.
+		nextID:            uint64(time.Now().UnixMicro()),
+		bufferSize:        bufferSize,
+		heartbeatInterval: heartbeatInterval,
+		subscriptions:     make(map[user.UserID]map[*Subscription]struct{}),
+	}
+}
+
+// HeartbeatInterval returns how long a stream may go without events before it
+// is sent a heartbeat.
+func (b *Broker) HeartbeatInterval() time.Duration {
+	return b.heartbeatInterval
+}
+
+// Publish logs an event of the given type for the user and hands it to their
+// subscriptions. It returns the logged event.
+func (b *Broker) Publish(userID user.UserID, eventType string, data []byte) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	event := Event{ID: b.nextID, UserID: userID, Type: eventType, Data: data}
+	b.nextID++
+
+	b.log[b.head] = event
+	b.head = (b.head + 1) % len(b.log)
+	b.count = min(b.count+1, len(b.log))
+
+	for sub := range b.subscriptions[userID] {
+		select {
+		case sub.events <- event:
+		default:
+			b.remove(sub)
+			close(sub.dropped)
+		}
+	}
+
+	return event
+}
+
+// Subscribe opens a subscription to the events of the user. With
+// lastEventID, it also returns the logged events of the user that followed
+// the event with that ID, oldest first, and ErrEventsExpired if some of them
+// are no longer logged.
+func (b *Broker) Subscribe(userID user.UserID, lastEventID *uint64) (*Subscription, []Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var missed []Event
+
+	if lastEventID != nil {
+		oldestID := b.nextID - uint64(b.count)
+		if *lastEventID+1 < oldestID || *lastEventID >= b.nextID {
+			return nil, nil, ErrEventsExpired
+		}
+
+		for i := range b.count {
+			event := b.log[(b.head-b.count+i+len(b.log))%len(b.log)]
+			if event.ID > *lastEventID && event.UserID == userID {
+				missed = append(missed, event)
+			}
+		}
+	}
+
+	sub := &Subscription{
+		broker:  b,
+		userID:  userID,
+		events:  make(chan Event, b.bufferSize),
+		dropped: make(chan struct{}),
+	}
+
+	if b.subscriptions[userID] == nil {
+		b.subscriptions[userID] = make(map[*Subscription]struct{})
+	}
+
+	b.subscriptions[userID][sub] = struct{}{}
+
+	return sub, missed, nil
+}
+
+// remove forgets the subscription. The caller must hold b.mu.
+func (b *Broker) remove(sub *Subscription) {
+	subs := b.subscriptions[sub.userID]
+	delete(subs, sub)
+
+	if len(subs) == 0 {
+		delete(b.subscriptions, sub.userID)
+	}
+}
+
+// Subscription receives the events of a user published after it was opened.
+type Subscription struct {
+	broker  *Broker
+	userID  user.UserID
+	events  chan Event
+	dropped chan struct{}
+}
+
+// Events returns the channel the events of the subscription are sent to.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped returns a channel that is closed when the subscription fell too far
+// behind and no further events are sent to it. Its client resumes from the
+// log with the ID of the last event it got.
+func (s *Subscription) Dropped() <-chan struct{} {
+	return s.dropped
+}
+
+// Close stops sending events to the subscription.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	s.broker.remove(s)
+}
@@ -0,0 +1,140 @@
+package eventstream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+func eventTypes(events []Event) []string {
+	types := make([]string, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+
+	return types
+}
+
+func TestBroker_Publish(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	broker := NewBroker(10, 10, time.Second)
+	userID := user.GenerateUserID()
+
+	first, _, err := broker.Subscribe(userID, nil)
+	require.NoError(t, err)
+	defer first.Close()
+
+	second, _, err := broker.Subscribe(userID, nil)
+	require.NoError(t, err)
+	defer second.Close()
+
+	other, _, err := broker.Subscribe(user.GenerateUserID(), nil)
+	require.NoError(t, err)
+	defer other.Close()
+
+	// Act
+	created := broker.Publish(userID, "created", []byte(`{"title":"Draft"}`))
+	updated := broker.Publish(userID, "updated", []byte(`{"title":"Report"}`))
+
+	// Assert
+	assert.Greater(t, updated.ID, created.ID)
+
+	for _, sub := range []*Subscription{first, second} {
+		require.Len(t, sub.Events(), 2)
+		assert.Equal(t, created, <-sub.Events())
+		assert.Equal(t, updated, <-sub.Events())
+	}
+
+	assert.Empty(t, other.Events())
+}
+
+func TestBroker_Subscribe_Resume(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	broker := NewBroker(3, 10, time.Second)
+	userID := user.GenerateUserID()
+	otherID := user.GenerateUserID()
+
+	first := broker.Publish(userID, "created", nil)
+	second := broker.Publish(userID, "updated", nil)
+	broker.Publish(otherID, "created", nil)
+	third := broker.Publish(userID, "deleted", nil)
+
+	tests := []struct {
+		name          string
+		lastEventID   uint64
+		expectedTypes []string
+		expectedError error
+	}{
+		{name: "events after the last one are replayed", lastEventID: second.ID, expectedTypes: []string{"deleted"}},
+		{name: "oldest logged event is the next one", lastEventID: first.ID, expectedTypes: []string{"updated", "deleted"}},
+		{name: "stream is up to date", lastEventID: third.ID, expectedTypes: []string{}},
+		{name: "next event is no longer logged", lastEventID: first.ID - 1, expectedError: ErrEventsExpired},
+		{name: "event of another process", lastEventID: third.ID + 1, expectedError: ErrEventsExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			sub, missed, err := broker.Subscribe(userID, &tt.lastEventID)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, sub)
+
+				return
+			}
+
+			require.NoError(t, err)
+			defer sub.Close()
+
+			assert.Equal(t, tt.expectedTypes, eventTypes(missed))
+		})
+	}
+}
+
+func TestBroker_DropsSlowSubscription(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	broker := NewBroker(10, 1, time.Second)
+	userID := user.GenerateUserID()
+
+	slow, _, err := broker.Subscribe(userID, nil)
+	require.NoError(t, err)
+
+	// Act
+	first := broker.Publish(userID, "created", nil)
+	broker.Publish(userID, "updated", nil)
+	broker.Publish(userID, "deleted", nil)
+
+	// Assert
+	select {
+	case <-slow.Dropped():
+	default:
+		t.Fatal("slow subscription was not dropped")
+	}
+
+	assert.Equal(t, first, <-slow.Events())
+	assert.Empty(t, slow.Events())
+
+	resumed, missed, err := broker.Subscribe(userID, &first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"updated", "deleted"}, eventTypes(missed))
+
+	// Closing a dropped subscription leaves the others alone
+	slow.Close()
+	broker.Publish(userID, "created", nil)
+	assert.Len(t, resumed.Events(), 1)
+	resumed.Close()
+}
@@ -4,6 +4,8 @@ import (
 	openapiTypes "github.com/oapi-codegen/runtime/types"
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/service"
 	"github.com/labstack/echo/v4"
 )
@@ -11,44 +13,310 @@ import (
 // APIServer handles HTTP requests for all API operations.
 // It implements the ServerInterface and delegates to specialized handlers.
 type APIServer struct {
-	taskHandler   *TaskHandler
-	healthHandler *HealthHandler
+	taskHandler       *TaskHandler
+	taskEventHandler  *TaskEventHandler
+	tagHandler        *TagHandler
+	projectHandler    *ProjectHandler
+	workspaceHandler  *WorkspaceHandler
+	commentHandler    *CommentHandler
+	attachmentHandler *AttachmentHandler
+	webhookHandler    *WebhookHandler
+	healthHandler     *HealthHandler
 }
 
 // NewAPIServer creates a new APIServer with the provided handlers.
-func NewAPIServer(taskController controller.Task, healthService service.HealthService) *APIServer {
+// Task event streams are served from taskEvents.
+func NewAPIServer(
+	taskController controller.Task,
+	tagController controller.Tag,
+	projectController controller.Project,
+	workspaceController controller.Workspace,
+	commentController controller.Comment,
+	attachmentController controller.Attachment,
+	webhookController controller.Webhook,
+	taskEvents *eventstream.Broker,
+	healthService service.HealthService,
+) *APIServer {
 	return &APIServer{
-		taskHandler:   NewTaskHandler(taskController),
-		healthHandler: NewHealthHandler(healthService),
+		taskHandler:       NewTaskHandler(taskController),
+		taskEventHandler:  NewTaskEventHandler(taskEvents),
+		tagHandler:        NewTagHandler(tagController),
+		projectHandler:    NewProjectHandler(projectController),
+		workspaceHandler:  NewWorkspaceHandler(workspaceController),
+		commentHandler:    NewCommentHandler(commentController),
+		attachmentHandler: NewAttachmentHandler(attachmentController),
+		webhookHandler:    NewWebhookHandler(webhookController),
+		healthHandler:     NewHealthHandler(healthService),
 	}
 }
 
+// AttachmentDeleteAttachment implements the ServerInterface for attachment deletion by delegating to AttachmentHandler
+func (s *APIServer) AttachmentDeleteAttachment(c echo.Context, attachmentId openapiTypes.UUID) error {
+	return s.attachmentHandler.DeleteAttachment(c, attachmentId)
+}
+
+// AttachmentDownloadAttachment implements the ServerInterface for downloading an attachment by delegating to AttachmentHandler
+func (s *APIServer) AttachmentDownloadAttachment(c echo.Context, attachmentId openapiTypes.UUID) error {
+	return s.attachmentHandler.DownloadAttachment(c, attachmentId)
+}
+
+// AttachmentGetAttachments implements the ServerInterface for listing the attachments of a task by delegating to AttachmentHandler
+func (s *APIServer) AttachmentGetAttachments(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.attachmentHandler.GetAttachments(c, taskId)
+}
+
+// AttachmentUploadAttachment implements the ServerInterface for attaching a file to a task by delegating to AttachmentHandler
+func (s *APIServer) AttachmentUploadAttachment(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.attachmentHandler.UploadAttachment(c, taskId)
+}
+
+// CommentDeleteComment implements the ServerInterface for comment deletion by delegating to CommentHandler
+func (s *APIServer) CommentDeleteComment(c echo.Context, commentId openapiTypes.UUID) error {
+	return s.commentHandler.DeleteComment(c, commentId)
+}
+
+// CommentUpdateComment implements the ServerInterface for editing a comment by delegating to CommentHandler
+func (s *APIServer) CommentUpdateComment(c echo.Context, commentId openapiTypes.UUID) error {
+	return s.commentHandler.UpdateComment(c, commentId)
+}
+
+// CommentGetComments implements the ServerInterface for listing the comments on a task by delegating to CommentHandler
+func (s *APIServer) CommentGetComments(c echo.Context, taskId openapiTypes.UUID, params generated.CommentGetCommentsParams) error {
+	return s.commentHandler.GetComments(c, taskId, params)
+}
+
+// CommentCreateComment implements the ServerInterface for commenting on a task by delegating to CommentHandler
+func (s *APIServer) CommentCreateComment(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.commentHandler.CreateComment(c, taskId)
+}
+
 // HealthGetHealth implements the ServerInterface for health endpoint by delegating to HealthHandler
 func (s *APIServer) HealthGetHealth(c echo.Context) error {
 	return s.healthHandler.GetHealth(c)
 }
 
+// ProjectGetAllProjects implements the ServerInterface for listing projects by delegating to ProjectHandler
+func (s *APIServer) ProjectGetAllProjects(c echo.Context) error {
+	return s.projectHandler.GetAllProjects(c)
+}
+
+// ProjectCreateProject implements the ServerInterface for project creation by delegating to ProjectHandler
+func (s *APIServer) ProjectCreateProject(c echo.Context) error {
+	return s.projectHandler.CreateProject(c)
+}
+
+// ProjectDeleteProject implements the ServerInterface for project deletion by delegating to ProjectHandler
+func (s *APIServer) ProjectDeleteProject(c echo.Context, projectId openapiTypes.UUID, params generated.ProjectDeleteProjectParams) error {
+	return s.projectHandler.DeleteProject(c, projectId, params)
+}
+
+// ProjectGetProject implements the ServerInterface for getting a specific project by delegating to ProjectHandler
+func (s *APIServer) ProjectGetProject(c echo.Context, projectId openapiTypes.UUID) error {
+	return s.projectHandler.GetProject(c, projectId)
+}
+
+// ProjectUpdateProject implements the ServerInterface for renaming a project by delegating to ProjectHandler
+func (s *APIServer) ProjectUpdateProject(c echo.Context, projectId openapiTypes.UUID) error {
+	return s.projectHandler.UpdateProject(c, projectId)
+}
+
+// ProjectGetProjectTasks implements the ServerInterface for listing the tasks of a project by delegating to ProjectHandler
+func (s *APIServer) ProjectGetProjectTasks(c echo.Context, projectId openapiTypes.UUID) error {
+	return s.projectHandler.GetProjectTasks(c, projectId)
+}
+
+// TagGetAllTags implements the ServerInterface for listing tags by delegating to TagHandler
+func (s *APIServer) TagGetAllTags(c echo.Context) error {
+	return s.tagHandler.GetAllTags(c)
+}
+
+// TagCreateTag implements the ServerInterface for tag creation by delegating to TagHandler
+func (s *APIServer) TagCreateTag(c echo.Context) error {
+	return s.tagHandler.CreateTag(c)
+}
+
+// TagDeleteTag implements the ServerInterface for tag deletion by delegating to TagHandler
+func (s *APIServer) TagDeleteTag(c echo.Context, tagId openapiTypes.UUID) error {
+	return s.tagHandler.DeleteTag(c, tagId)
+}
+
+// TagGetTag implements the ServerInterface for getting a specific tag by delegating to TagHandler
+func (s *APIServer) TagGetTag(c echo.Context, tagId openapiTypes.UUID) error {
+	return s.tagHandler.GetTag(c, tagId)
+}
+
+// TagUpdateTag implements the ServerInterface for renaming a tag by delegating to TagHandler
+func (s *APIServer) TagUpdateTag(c echo.Context, tagId openapiTypes.UUID) error {
+	return s.tagHandler.UpdateTag(c, tagId)
+}
+
 // TaskGetAllTasks implements the ServerInterface for task operations by delegating to TaskHandler
-func (s *APIServer) TaskGetAllTasks(c echo.Context) error {
-	return s.taskHandler.GetAllTasks(c)
+func (s *APIServer) TaskGetAllTasks(c echo.Context, params generated.TaskGetAllTasksParams) error {
+	return s.taskHandler.GetAllTasks(c, params)
 }
 
-// TaskCreateTask implements the ServerInterface for task creation by delegating to TaskHandler
-func (s *APIServer) TaskCreateTask(c echo.Context) error {
+// TaskCreateTask implements the ServerInterface for task creation by delegating to TaskHandler.
+// The Idempotency-Key header is handled by IdempotencyMiddleware before the request gets here.
+func (s *APIServer) TaskCreateTask(c echo.Context, _ generated.TaskCreateTaskParams) error {
 	return s.taskHandler.CreateTask(c)
 }
 
+// TaskStreamEvents implements the ServerInterface for streaming task changes by delegating to TaskEventHandler
+func (s *APIServer) TaskStreamEvents(c echo.Context, params generated.TaskStreamEventsParams) error {
+	return s.taskEventHandler.StreamEvents(c, params)
+}
+
+// TaskSearchTasks implements the ServerInterface for task search by delegating to TaskHandler
+func (s *APIServer) TaskSearchTasks(c echo.Context, params generated.TaskSearchTasksParams) error {
+	return s.taskHandler.SearchTasks(c, params)
+}
+
+// TaskEmptyTrash implements the ServerInterface for emptying the trash by delegating to TaskHandler
+func (s *APIServer) TaskEmptyTrash(c echo.Context) error {
+	return s.taskHandler.EmptyTrash(c)
+}
+
+// TaskGetTrash implements the ServerInterface for listing the trash by delegating to TaskHandler
+func (s *APIServer) TaskGetTrash(c echo.Context) error {
+	return s.taskHandler.GetTrash(c)
+}
+
 // TaskDeleteTask implements the ServerInterface for task deletion by delegating to TaskHandler
-func (s *APIServer) TaskDeleteTask(c echo.Context, taskId openapiTypes.UUID) error {
-	return s.taskHandler.DeleteTask(c, taskId)
+func (s *APIServer) TaskDeleteTask(c echo.Context, taskId openapiTypes.UUID, params generated.TaskDeleteTaskParams) error {
+	return s.taskHandler.DeleteTask(c, taskId, params)
 }
 
 // TaskGetTask implements the ServerInterface for getting a specific task by delegating to TaskHandler
-func (s *APIServer) TaskGetTask(c echo.Context, taskId openapiTypes.UUID) error {
-	return s.taskHandler.GetTask(c, taskId)
+func (s *APIServer) TaskGetTask(c echo.Context, taskId openapiTypes.UUID, params generated.TaskGetTaskParams) error {
+	return s.taskHandler.GetTask(c, taskId, params)
+}
+
+// TaskPatchTask implements the ServerInterface for partial task updates by delegating to TaskHandler
+func (s *APIServer) TaskPatchTask(c echo.Context, taskId openapiTypes.UUID, params generated.TaskPatchTaskParams) error {
+	return s.taskHandler.PatchTask(c, taskId, params)
+}
+
+// TaskRestoreTask implements the ServerInterface for restoring a task from the trash by delegating to TaskHandler
+func (s *APIServer) TaskRestoreTask(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.taskHandler.RestoreTask(c, taskId)
+}
+
+// TaskGetDependencies implements the ServerInterface for listing blockers by delegating to TaskHandler
+func (s *APIServer) TaskGetDependencies(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.taskHandler.GetDependencies(c, taskId)
+}
+
+// TaskAddDependency implements the ServerInterface for adding a dependency by delegating to TaskHandler
+func (s *APIServer) TaskAddDependency(c echo.Context, taskId openapiTypes.UUID, blockerId openapiTypes.UUID) error {
+	return s.taskHandler.AddDependency(c, taskId, blockerId)
+}
+
+// TaskRemoveDependency implements the ServerInterface for removing a dependency by delegating to TaskHandler
+func (s *APIServer) TaskRemoveDependency(c echo.Context, taskId openapiTypes.UUID, blockerId openapiTypes.UUID) error {
+	return s.taskHandler.RemoveDependency(c, taskId, blockerId)
+}
+
+// TaskAssignTask implements the ServerInterface for reassigning a task by delegating to TaskHandler
+func (s *APIServer) TaskAssignTask(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.taskHandler.AssignTask(c, taskId)
+}
+
+// TaskGetAssignments implements the ServerInterface for listing the assignment history of a task by delegating to TaskHandler
+func (s *APIServer) TaskGetAssignments(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.taskHandler.GetAssignments(c, taskId)
+}
+
+// TaskGetHistory implements the ServerInterface for listing the changes made to a task by delegating to TaskHandler
+func (s *APIServer) TaskGetHistory(c echo.Context, taskId openapiTypes.UUID, params generated.TaskGetHistoryParams) error {
+	return s.taskHandler.GetHistory(c, taskId, params)
+}
+
+// TaskGetCollaborators implements the ServerInterface for listing collaborators by delegating to TaskHandler
+func (s *APIServer) TaskGetCollaborators(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.taskHandler.GetCollaborators(c, taskId)
+}
+
+// TaskAddCollaborator implements the ServerInterface for sharing a task by delegating to TaskHandler
+func (s *APIServer) TaskAddCollaborator(c echo.Context, taskId openapiTypes.UUID, userId openapiTypes.UUID) error {
+	return s.taskHandler.AddCollaborator(c, taskId, userId)
+}
+
+// TaskRemoveCollaborator implements the ServerInterface for removing a collaborator by delegating to TaskHandler
+func (s *APIServer) TaskRemoveCollaborator(c echo.Context, taskId openapiTypes.UUID, userId openapiTypes.UUID) error {
+	return s.taskHandler.RemoveCollaborator(c, taskId, userId)
+}
+
+// TaskMoveTask implements the ServerInterface for moving a task in the manual order by delegating to TaskHandler
+func (s *APIServer) TaskMoveTask(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.taskHandler.MoveTask(c, taskId)
+}
+
+// TaskGetOccurrences implements the ServerInterface for previewing occurrences of a series by delegating to TaskHandler
+func (s *APIServer) TaskGetOccurrences(c echo.Context, taskId openapiTypes.UUID, params generated.TaskGetOccurrencesParams) error {
+	return s.taskHandler.GetOccurrences(c, taskId, params)
+}
+
+// TaskUpdateSeries implements the ServerInterface for editing a whole series by delegating to TaskHandler
+func (s *APIServer) TaskUpdateSeries(c echo.Context, taskId openapiTypes.UUID) error {
+	return s.taskHandler.UpdateSeries(c, taskId)
+}
+
+// TaskGetSubtasks implements the ServerInterface for listing subtasks by delegating to TaskHandler
+func (s *APIServer) TaskGetSubtasks(c echo.Context, taskId openapiTypes.UUID, params generated.TaskGetSubtasksParams) error {
+	return s.taskHandler.GetSubtasks(c, taskId, params)
 }
 
 // TaskUpdateTask implements the ServerInterface for task updates by delegating to TaskHandler
-func (s *APIServer) TaskUpdateTask(c echo.Context, taskId openapiTypes.UUID) error {
-	return s.taskHandler.UpdateTask(c, taskId)
+func (s *APIServer) TaskUpdateTask(c echo.Context, taskId openapiTypes.UUID, params generated.TaskUpdateTaskParams) error {
+	return s.taskHandler.UpdateTask(c, taskId, params)
+}
+
+// WebhookGetAllWebhooks implements the ServerInterface for listing webhooks by delegating to WebhookHandler
+func (s *APIServer) WebhookGetAllWebhooks(c echo.Context) error {
+	return s.webhookHandler.GetAllWebhooks(c)
+}
+
+// WebhookCreateWebhook implements the ServerInterface for webhook creation by delegating to WebhookHandler
+func (s *APIServer) WebhookCreateWebhook(c echo.Context) error {
+	return s.webhookHandler.CreateWebhook(c)
+}
+
+// WebhookGetWebhook implements the ServerInterface for getting a specific webhook by delegating to WebhookHandler
+func (s *APIServer) WebhookGetWebhook(c echo.Context, webhookId openapiTypes.UUID) error {
+	return s.webhookHandler.GetWebhook(c, webhookId)
+}
+
+// WebhookUpdateWebhook implements the ServerInterface for webhook updates by delegating to WebhookHandler
+func (s *APIServer) WebhookUpdateWebhook(c echo.Context, webhookId openapiTypes.UUID) error {
+	return s.webhookHandler.UpdateWebhook(c, webhookId)
+}
+
+// WebhookDeleteWebhook implements the ServerInterface for webhook deletion by delegating to WebhookHandler
+func (s *APIServer) WebhookDeleteWebhook(c echo.Context, webhookId openapiTypes.UUID) error {
+	return s.webhookHandler.DeleteWebhook(c, webhookId)
+}
+
+// WorkspaceGetAllWorkspaces implements the ServerInterface for listing workspaces by delegating to WorkspaceHandler
+func (s *APIServer) WorkspaceGetAllWorkspaces(c echo.Context) error {
+	return s.workspaceHandler.GetAllWorkspaces(c)
+}
+
+// WorkspaceCreateWorkspace implements the ServerInterface for workspace creation by delegating to WorkspaceHandler
+func (s *APIServer) WorkspaceCreateWorkspace(c echo.Context) error {
+	return s.workspaceHandler.CreateWorkspace(c)
+}
+
+// WorkspaceGetMembers implements the ServerInterface for listing the members of a workspace by delegating to WorkspaceHandler
+func (s *APIServer) WorkspaceGetMembers(c echo.Context, workspaceId openapiTypes.UUID) error {
+	return s.workspaceHandler.GetMembers(c, workspaceId)
+}
+
+// WorkspaceSetMember implements the ServerInterface for adding or updating a member by delegating to WorkspaceHandler
+func (s *APIServer) WorkspaceSetMember(c echo.Context, workspaceId openapiTypes.UUID, userId openapiTypes.UUID) error {
+	return s.workspaceHandler.SetMember(c, workspaceId, userId)
+}
+
+// WorkspaceRemoveMember implements the ServerInterface for removing a member by delegating to WorkspaceHandler
+func (s *APIServer) WorkspaceRemoveMember(c echo.Context, workspaceId openapiTypes.UUID, userId openapiTypes.UUID) error {
+	return s.workspaceHandler.RemoveMember(c, workspaceId, userId)
 }
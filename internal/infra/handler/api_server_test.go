@@ -16,8 +16,11 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventbus"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/service"
@@ -60,7 +63,7 @@ func TestNewAPIServer(t *testing.T) {
 			name: "valid dependencies",
 			setupMocks: func(ctrl *gomock.Controller) (controller.Task, service.HealthService) {
 				mockRepo := mocks.NewMockTaskRepository(ctrl)
-				taskController := controller.NewTask(mockRepo)
+				taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 				mockHealthService := mocks.NewMockHealthService(ctrl)
 
 				return *taskController, mockHealthService
@@ -82,7 +85,7 @@ func TestNewAPIServer(t *testing.T) {
 			name: "nil health service",
 			setupMocks: func(ctrl *gomock.Controller) (controller.Task, service.HealthService) {
 				mockRepo := mocks.NewMockTaskRepository(ctrl)
-				taskController := controller.NewTask(mockRepo)
+				taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
 				return *taskController, nil
 			},
@@ -102,7 +105,7 @@ func TestNewAPIServer(t *testing.T) {
 			taskController, healthService := tt.setupMocks(ctrl)
 
 			// Act
-			apiServer := NewAPIServer(taskController, healthService)
+			apiServer := NewAPIServer(taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), healthService)
 
 			// Assert
 			if tt.expectedNil {
@@ -112,6 +115,8 @@ func TestNewAPIServer(t *testing.T) {
 
 				if tt.expectedFieldsValid {
 					assert.NotNil(t, apiServer.taskHandler)
+					assert.NotNil(t, apiServer.taskEventHandler)
+					assert.NotNil(t, apiServer.tagHandler)
 					assert.NotNil(t, apiServer.healthHandler)
 				}
 			}
@@ -174,12 +179,12 @@ func TestAPIServer_HealthGetHealth(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockRepo := mocks.NewMockTaskRepository(ctrl)
-			taskController := controller.NewTask(mockRepo)
+			taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 			mockHealthService := mocks.NewMockHealthService(ctrl)
 
 			mockHealthService.EXPECT().CheckHealth(gomock.Any()).Return(tt.healthStatus)
 
-			apiServer := NewAPIServer(*taskController, mockHealthService)
+			apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodGet, "/health", nil)
@@ -219,7 +224,7 @@ func TestAPIServer_TaskGetAllTasks(t *testing.T) {
 			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID) {
 				task1 := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Task 1", userID)
 				task2 := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Task 2", userID)
-				mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return([]*task.Task{task1, task2}, nil)
+				mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{}, gomock.Any()).Return(&task.Page{Tasks: []*task.Task{task1, task2}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedTaskCount:  2,
@@ -228,7 +233,7 @@ func TestAPIServer_TaskGetAllTasks(t *testing.T) {
 			name:   "empty task list",
 			userID: uuid.New().String(),
 			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID) {
-				mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return([]*task.Task{}, nil)
+				mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{}, gomock.Any()).Return(&task.Page{Tasks: []*task.Task{}}, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedTaskCount:  0,
@@ -254,7 +259,7 @@ func TestAPIServer_TaskGetAllTasks(t *testing.T) {
 
 			mockRepo := mocks.NewMockTaskRepository(ctrl)
 			mockHealthService := mocks.NewMockHealthService(ctrl)
-			taskController := controller.NewTask(mockRepo)
+			taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
 			var domainUserID user.UserID
 			if tt.userID != "" {
@@ -263,7 +268,7 @@ func TestAPIServer_TaskGetAllTasks(t *testing.T) {
 
 			tt.setupMock(mockRepo, domainUserID)
 
-			apiServer := NewAPIServer(*taskController, mockHealthService)
+			apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
@@ -275,7 +280,97 @@ func TestAPIServer_TaskGetAllTasks(t *testing.T) {
 			}
 
 			// Act
-			err := apiServer.TaskGetAllTasks(c)
+			err := apiServer.TaskGetAllTasks(c, generated.TaskGetAllTasksParams{})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+
+			if tt.expectedStatusCode == http.StatusOK {
+				var tasks []generated.Task
+
+				err = json.Unmarshal(rec.Body.Bytes(), &tasks)
+				require.NoError(t, err)
+				assert.Len(t, tasks, tt.expectedTaskCount)
+			}
+		})
+	}
+}
+
+func TestAPIServer_TaskSearchTasks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		userID             string
+		query              string
+		setupMock          func(*mocks.MockTaskRepository, user.UserID)
+		expectedStatusCode int
+		expectedTaskCount  int
+	}{
+		{
+			name:   "successful search",
+			userID: uuid.New().String(),
+			query:  "report",
+			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID) {
+				match := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Weekly report", userID)
+				mockRepo.EXPECT().Search(gomock.Any(), userID, gomock.Any()).Return([]*task.Task{match}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedTaskCount:  1,
+		},
+		{
+			name:   "empty query",
+			userID: uuid.New().String(),
+			query:  "",
+			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID) {
+				// No mock expectations - should fail validation
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "missing user_id in context",
+			userID: "",
+			query:  "report",
+			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID) {
+				// No mock expectations - should fail before repository call
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			mockHealthService := mocks.NewMockHealthService(ctrl)
+			taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
+
+			var domainUserID user.UserID
+			if tt.userID != "" {
+				domainUserID = createUserID(tt.userID)
+			}
+
+			tt.setupMock(mockRepo, domainUserID)
+
+			apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/tasks/search", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if tt.userID != "" {
+				c.Set("user_id", tt.userID)
+			}
+
+			// Act
+			err := apiServer.TaskSearchTasks(c, generated.TaskSearchTasksParams{Q: tt.query})
 
 			// Assert
 			assert.NoError(t, err)
@@ -354,7 +449,7 @@ func TestAPIServer_TaskCreateTask(t *testing.T) {
 
 			mockRepo := mocks.NewMockTaskRepository(ctrl)
 			mockHealthService := mocks.NewMockHealthService(ctrl)
-			taskController := controller.NewTask(mockRepo)
+			taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
 			var domainUserID user.UserID
 			if tt.userID != "" {
@@ -363,7 +458,7 @@ func TestAPIServer_TaskCreateTask(t *testing.T) {
 
 			tt.setupMock(mockRepo, domainUserID)
 
-			apiServer := NewAPIServer(*taskController, mockHealthService)
+			apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(tt.requestBody))
@@ -377,7 +472,7 @@ func TestAPIServer_TaskCreateTask(t *testing.T) {
 			}
 
 			// Act
-			err := apiServer.TaskCreateTask(c)
+			err := apiServer.TaskCreateTask(c, generated.TaskCreateTaskParams{IdempotencyKey: nil})
 
 			// Assert
 			assert.NoError(t, err)
@@ -414,7 +509,7 @@ func TestAPIServer_TaskGetTask(t *testing.T) {
 			taskID: testTaskID,
 			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID, taskID task.TaskID) {
 				existingTask := task.NewTaskWithoutValidation(taskID, "Test Task", userID)
-				mockRepo.EXPECT().FindById(gomock.Any(), userID, taskID).Return(existingTask, nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskID).Return(existingTask, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedTitle:      "Test Task",
@@ -424,7 +519,7 @@ func TestAPIServer_TaskGetTask(t *testing.T) {
 			userID: testUserID,
 			taskID: testTaskID,
 			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID, taskID task.TaskID) {
-				mockRepo.EXPECT().FindById(gomock.Any(), userID, taskID).Return(nil, task.ErrTaskNotFound)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskID).Return(nil, task.ErrTaskNotFound)
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -449,7 +544,7 @@ func TestAPIServer_TaskGetTask(t *testing.T) {
 
 			mockRepo := mocks.NewMockTaskRepository(ctrl)
 			mockHealthService := mocks.NewMockHealthService(ctrl)
-			taskController := controller.NewTask(mockRepo)
+			taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
 			var (
 				domainUserID user.UserID
@@ -466,7 +561,7 @@ func TestAPIServer_TaskGetTask(t *testing.T) {
 
 			tt.setupMock(mockRepo, domainUserID, domainTaskID)
 
-			apiServer := NewAPIServer(*taskController, mockHealthService)
+			apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodGet, "/tasks/"+tt.taskID, nil)
@@ -478,7 +573,7 @@ func TestAPIServer_TaskGetTask(t *testing.T) {
 			}
 
 			// Act
-			err := apiServer.TaskGetTask(c, apiTestUUID(tt.taskID))
+			err := apiServer.TaskGetTask(c, apiTestUUID(tt.taskID), generated.TaskGetTaskParams{})
 
 			// Assert
 			assert.NoError(t, err)
@@ -519,8 +614,8 @@ func TestAPIServer_TaskUpdateTask(t *testing.T) {
 			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID, taskID task.TaskID) {
 				existingTask := task.NewTaskWithoutValidation(taskID, "Original Task", userID)
 				updatedTask := task.NewTaskWithoutValidation(taskID, "Updated Task", userID)
-				mockRepo.EXPECT().FindById(gomock.Any(), userID, taskID).Return(existingTask, nil)
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(updatedTask, nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskID).Return(existingTask, nil)
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(updatedTask, nil)
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedTitle:      "Updated Task",
@@ -531,7 +626,7 @@ func TestAPIServer_TaskUpdateTask(t *testing.T) {
 			taskID:      testTaskID,
 			requestBody: `{"title": "Updated Task"}`,
 			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID, taskID task.TaskID) {
-				mockRepo.EXPECT().FindById(gomock.Any(), userID, taskID).Return(nil, task.ErrTaskNotFound)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskID).Return(nil, task.ErrTaskNotFound)
 			},
 			expectedStatusCode: http.StatusNotFound,
 		},
@@ -567,7 +662,7 @@ func TestAPIServer_TaskUpdateTask(t *testing.T) {
 
 			mockRepo := mocks.NewMockTaskRepository(ctrl)
 			mockHealthService := mocks.NewMockHealthService(ctrl)
-			taskController := controller.NewTask(mockRepo)
+			taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
 			var (
 				domainUserID user.UserID
@@ -584,7 +679,7 @@ func TestAPIServer_TaskUpdateTask(t *testing.T) {
 
 			tt.setupMock(mockRepo, domainUserID, domainTaskID)
 
-			apiServer := NewAPIServer(*taskController, mockHealthService)
+			apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodPut, "/tasks/"+tt.taskID, strings.NewReader(tt.requestBody))
@@ -598,7 +693,7 @@ func TestAPIServer_TaskUpdateTask(t *testing.T) {
 			}
 
 			// Act
-			err := apiServer.TaskUpdateTask(c, apiTestUUID(tt.taskID))
+			err := apiServer.TaskUpdateTask(c, apiTestUUID(tt.taskID), generated.TaskUpdateTaskParams{})
 
 			// Assert
 			assert.NoError(t, err)
@@ -633,7 +728,8 @@ func TestAPIServer_TaskDeleteTask(t *testing.T) {
 			userID: testUserID,
 			taskID: testTaskID,
 			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID, taskID task.TaskID) {
-				mockRepo.EXPECT().Delete(gomock.Any(), userID, taskID).Return(nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskID).Return(task.NewTaskWithoutValidation(taskID, "Test Task", userID), nil)
+				mockRepo.EXPECT().Delete(gomock.Any(), userID, taskID, nil).Return(nil)
 			},
 			expectedStatusCode: http.StatusNoContent,
 		},
@@ -642,7 +738,8 @@ func TestAPIServer_TaskDeleteTask(t *testing.T) {
 			userID: testUserID,
 			taskID: testTaskID,
 			setupMock: func(mockRepo *mocks.MockTaskRepository, userID user.UserID, taskID task.TaskID) {
-				mockRepo.EXPECT().Delete(gomock.Any(), userID, taskID).Return(nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskID).Return(nil, task.ErrTaskNotFound)
+				mockRepo.EXPECT().Delete(gomock.Any(), userID, taskID, nil).Return(nil)
 			},
 			expectedStatusCode: http.StatusNoContent,
 		},
@@ -667,7 +764,7 @@ func TestAPIServer_TaskDeleteTask(t *testing.T) {
 
 			mockRepo := mocks.NewMockTaskRepository(ctrl)
 			mockHealthService := mocks.NewMockHealthService(ctrl)
-			taskController := controller.NewTask(mockRepo)
+			taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
 			var (
 				domainUserID user.UserID
@@ -684,7 +781,7 @@ func TestAPIServer_TaskDeleteTask(t *testing.T) {
 
 			tt.setupMock(mockRepo, domainUserID, domainTaskID)
 
-			apiServer := NewAPIServer(*taskController, mockHealthService)
+			apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodDelete, "/tasks/"+tt.taskID, nil)
@@ -696,7 +793,7 @@ func TestAPIServer_TaskDeleteTask(t *testing.T) {
 			}
 
 			// Act
-			err := apiServer.TaskDeleteTask(c, apiTestUUID(tt.taskID))
+			err := apiServer.TaskDeleteTask(c, apiTestUUID(tt.taskID), generated.TaskDeleteTaskParams{})
 
 			// Assert
 			assert.NoError(t, err)
@@ -723,7 +820,7 @@ func TestAPIServer_IntegrationBehavior(t *testing.T) {
 
 		mockRepo := mocks.NewMockTaskRepository(ctrl)
 		mockHealthService := mocks.NewMockHealthService(ctrl)
-		taskController := controller.NewTask(mockRepo)
+		taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
 		healthStatus := service.HealthStatus{
 			Status:    "UP",
@@ -734,7 +831,7 @@ func TestAPIServer_IntegrationBehavior(t *testing.T) {
 		}
 		mockHealthService.EXPECT().CheckHealth(gomock.Any()).Return(healthStatus)
 
-		apiServer := NewAPIServer(*taskController, mockHealthService)
+		apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 		// Act & Assert
 		e := echo.New()
@@ -757,9 +854,9 @@ func TestAPIServer_IntegrationBehavior(t *testing.T) {
 
 		mockRepo := mocks.NewMockTaskRepository(ctrl)
 		mockHealthService := mocks.NewMockHealthService(ctrl)
-		taskController := controller.NewTask(mockRepo)
+		taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
-		apiServer := NewAPIServer(*taskController, mockHealthService)
+		apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 		e := echo.New()
 		req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
@@ -767,7 +864,7 @@ func TestAPIServer_IntegrationBehavior(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		// Act
-		err := apiServer.TaskGetAllTasks(c)
+		err := apiServer.TaskGetAllTasks(c, generated.TaskGetAllTasksParams{})
 
 		// Assert
 		assert.NoError(t, err)
@@ -787,10 +884,10 @@ func TestAPIServer_NilHandlerScenarios(t *testing.T) {
 
 		mockRepo := mocks.NewMockTaskRepository(ctrl)
 		mockHealthService := mocks.NewMockHealthService(ctrl)
-		taskController := controller.NewTask(mockRepo)
+		taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
 
 		// Act
-		apiServer := NewAPIServer(*taskController, mockHealthService)
+		apiServer := NewAPIServer(*taskController, *controller.NewTag(mocks.NewMockTagRepository(ctrl)), *controller.NewProject(mocks.NewMockProjectRepository(ctrl), mockRepo), *controller.NewWorkspace(mocks.NewMockWorkspaceRepository(ctrl)), *controller.NewComment(mocks.NewMockCommentRepository(ctrl), mocks.NewMockTaskRepository(ctrl)), *controller.NewAttachment(mocks.NewMockAttachmentRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBlobStore(ctrl), attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24}), *controller.NewWebhook(mocks.NewMockWebhookRepository(ctrl)), eventstream.NewBroker(10, 10, time.Second), mockHealthService)
 
 		// Assert
 		assert.NotNil(t, apiServer)
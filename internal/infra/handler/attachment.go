@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	openapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	attachmentDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+)
+
+// multipartOverhead is the room left in an upload request for the multipart
+// boundaries and part headers around the file itself.
+const multipartOverhead = 64 << 10
+
+// AttachmentHandler handles HTTP requests for attachment operations.
+type AttachmentHandler struct {
+	controller  controller.Attachment
+	uuidAdapter *UUIDAdapter
+}
+
+// NewAttachmentHandler creates a new AttachmentHandler with the provided controller.
+func NewAttachmentHandler(ctr controller.Attachment) *AttachmentHandler {
+	return &AttachmentHandler{
+		controller:  ctr,
+		uuidAdapter: NewUUIDAdapter(),
+	}
+}
+
+// isFileNameError checks if the error is caused by an invalid name or an empty file
+func isFileNameError(err error) bool {
+	return errors.Is(err, attachmentDomain.ErrFileNameEmpty) ||
+		errors.Is(err, attachmentDomain.ErrFileNameTooLong) ||
+		errors.Is(err, attachmentDomain.ErrFileEmpty)
+}
+
+// toAttachmentResponse converts a domain attachment to its API representation
+func toAttachmentResponse(attachment *attachmentDomain.Attachment) taskHandler.Attachment {
+	return taskHandler.Attachment{
+		Id:          attachment.ID().UUID(),
+		TaskId:      attachment.TaskID().UUID(),
+		UploaderId:  attachment.UploaderID().UUID(),
+		FileName:    attachment.FileName(),
+		ContentType: attachment.ContentType(),
+		Size:        attachment.Size(),
+		Sha256:      attachment.Checksum(),
+		CreatedAt:   attachment.CreatedAt(),
+	}
+}
+
+// extractUserID extracts user ID from JWT context
+func (h *AttachmentHandler) extractUserID(c echo.Context) (string, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return "", errors.New("user ID not found in token")
+	}
+
+	return userID, nil
+}
+
+func (h *AttachmentHandler) GetAttachments(c echo.Context, taskId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainTaskID, err := h.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	attachments, err := h.controller.GetAttachments(c.Request().Context(), domainUserID, domainTaskID)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Attachment, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		res = append(res, toAttachmentResponse(attachment))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// UploadAttachment reads the file from the "file" part of a multipart form.
+// The request body is capped a little above the maximum file size, so that an
+// oversized upload is cut off instead of being buffered in full.
+func (h *AttachmentHandler) UploadAttachment(c echo.Context, taskId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	req := c.Request()
+	req.Body = http.MaxBytesReader(c.Response(), req.Body, h.controller.MaxFileSize()+multipartOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		details := err.Error()
+
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return c.JSON(http.StatusRequestEntityTooLarge, NewPayloadTooLargeError("Payload too large", &details))
+		}
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainTaskID, err := h.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+	defer file.Close()
+
+	attachment, err := h.controller.UploadAttachment(req.Context(), domainUserID, domainTaskID, fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		switch {
+		case isFileNameError(err):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, taskDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		case errors.Is(err, attachmentDomain.ErrFileTooLarge), errors.Is(err, attachmentDomain.ErrQuotaExceeded):
+			return c.JSON(http.StatusRequestEntityTooLarge, NewPayloadTooLargeError("Payload too large", &details))
+		case errors.Is(err, attachmentDomain.ErrUnsupportedContentType):
+			return c.JSON(http.StatusUnsupportedMediaType, NewUnsupportedMediaTypeError("Unsupported media type", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	return c.JSON(http.StatusCreated, toAttachmentResponse(attachment))
+}
+
+// DownloadAttachment streams the content of the attachment straight from the
+// blob store. The detected media type is sent with nosniff, so that browsers
+// never render the file as anything else.
+func (h *AttachmentHandler) DownloadAttachment(c echo.Context, attachmentId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainAttachmentID, err := h.uuidAdapter.ToDomainAttachmentID(attachmentId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid attachment ID format", &details))
+	}
+
+	attachment, content, err := h.controller.DownloadAttachment(c.Request().Context(), domainUserID, domainAttachmentID)
+	if err != nil {
+		if errors.Is(err, attachmentDomain.ErrAttachmentNotFound) || errors.Is(err, attachmentDomain.ErrBlobNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Attachment not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+	defer content.Close()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(attachment.Size(), 10))
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName()}))
+	header.Set("ETag", `"`+attachment.Checksum()+`"`)
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+
+	return c.Stream(http.StatusOK, attachment.ContentType(), content)
+}
+
+func (h *AttachmentHandler) DeleteAttachment(c echo.Context, attachmentId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainAttachmentID, err := h.uuidAdapter.ToDomainAttachmentID(attachmentId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid attachment ID format", &details))
+	}
+
+	err = h.controller.DeleteAttachment(c.Request().Context(), domainUserID, domainAttachmentID)
+	if err != nil {
+		if errors.Is(err, attachmentDomain.ErrAttachmentNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Attachment not found"))
+		}
+
+		details := err.Error()
+		if errors.Is(err, attachmentDomain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
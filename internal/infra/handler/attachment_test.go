@@ -0,0 +1,470 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	attachmentDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=../../domain/attachment/repository.go -destination=mocks/mock_attachment_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=../../domain/attachment/blob_store.go -destination=mocks/mock_blob_store.go -package=mocks
+
+// testPNG is the start of a PNG image, enough for its type to be detected.
+var testPNG = []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR\x00\x00\x00\x01\x00\x00\x00\x01")
+
+type attachmentTestMocks struct {
+	attachmentRepo *mocks.MockAttachmentRepository
+	taskRepo       *mocks.MockTaskRepository
+	blobStore      *mocks.MockBlobStore
+}
+
+func setupAttachmentTestServer(ctrl *gomock.Controller) (*AttachmentHandler, attachmentTestMocks) {
+	m := attachmentTestMocks{
+		attachmentRepo: mocks.NewMockAttachmentRepository(ctrl),
+		taskRepo:       mocks.NewMockTaskRepository(ctrl),
+		blobStore:      mocks.NewMockBlobStore(ctrl),
+	}
+	attachmentController := controller.NewAttachment(m.attachmentRepo, m.taskRepo, m.blobStore, attachmentDomain.Limits{
+		MaxFileSize: 1024,
+		UserQuota:   4096,
+	})
+	handler := NewAttachmentHandler(*attachmentController)
+
+	return handler, m
+}
+
+// newMultipartRequest builds an upload request with the content as its "file" part.
+func newMultipartRequest(t *testing.T, target, fieldName, fileName string, content []byte) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile(fieldName, fileName)
+	require.NoError(t, err)
+
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, target, &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+
+	return req
+}
+
+func TestAttachmentGetAttachments(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	taskID := taskDomain.GenerateTaskID()
+	taskEntity := taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID)
+	attachments := []*attachmentDomain.Attachment{
+		attachmentDomain.NewAttachmentWithoutValidation(attachmentDomain.GenerateAttachmentID(), taskID, creatorID,
+			"diagram.png", "image/png", 2048, strings.Repeat("a", 64), time.Now()),
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func(m attachmentTestMocks)
+		expectedStatus int
+	}{
+		{
+			name: "attachments listed",
+			setupMock: func(m attachmentTestMocks) {
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(taskEntity, nil)
+				m.attachmentRepo.EXPECT().FindAllByTaskID(gomock.Any(), taskID).Return(attachments, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "task not accessible",
+			setupMock: func(m attachmentTestMocks) {
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(nil, taskDomain.ErrTaskNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, m := setupAttachmentTestServer(ctrl)
+			tt.setupMock(m)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID.String()+"/attachments", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", creatorID.String())
+
+			// Act
+			err := handler.GetAttachments(c, taskID.UUID())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var responseAttachments []generated.Attachment
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseAttachments))
+				require.Len(t, responseAttachments, 1)
+				assert.Equal(t, "diagram.png", responseAttachments[0].FileName)
+				assert.Equal(t, int64(2048), responseAttachments[0].Size)
+			}
+		})
+	}
+}
+
+func TestAttachmentUploadAttachment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	viewerID := user.GenerateUserID()
+	taskID := taskDomain.GenerateTaskID()
+	viewerRole := taskDomain.RoleViewer
+
+	tests := []struct {
+		name           string
+		userID         user.UserID
+		fieldName      string
+		fileName       string
+		content        []byte
+		setupMock      func(m attachmentTestMocks)
+		expectedStatus int
+	}{
+		{
+			name:      "image attached",
+			userID:    creatorID,
+			fieldName: "file",
+			fileName:  "diagram.png",
+			content:   testPNG,
+			setupMock: func(m attachmentTestMocks) {
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).
+					Return(taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				m.attachmentRepo.EXPECT().UsageByUserID(gomock.Any(), creatorID).Return(int64(0), nil)
+				m.blobStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(len(testPNG)), "image/png").
+					DoAndReturn(func(_ context.Context, _ string, content io.Reader, _ int64, _ string) error {
+						_, err := io.Copy(io.Discard, content)
+
+						return err
+					})
+				m.attachmentRepo.EXPECT().CreateWithinQuota(gomock.Any(), gomock.Any(), int64(4096)).
+					DoAndReturn(func(_ context.Context, created *attachmentDomain.Attachment, _ int64) (*attachmentDomain.Attachment, error) {
+						return created, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing file part",
+			userID:         creatorID,
+			fieldName:      "document",
+			fileName:       "diagram.png",
+			content:        testPNG,
+			setupMock:      func(attachmentTestMocks) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "file larger than the limit",
+			userID:         creatorID,
+			fieldName:      "file",
+			fileName:       "diagram.png",
+			content:        bytes.Repeat([]byte("a"), 2048),
+			setupMock:      func(attachmentTestMocks) {},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "request body larger than the limit",
+			userID:         creatorID,
+			fieldName:      "file",
+			fileName:       "diagram.png",
+			content:        bytes.Repeat([]byte("a"), 1024+multipartOverhead),
+			setupMock:      func(attachmentTestMocks) {},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:      "quota exceeded",
+			userID:    creatorID,
+			fieldName: "file",
+			fileName:  "diagram.png",
+			content:   testPNG,
+			setupMock: func(m attachmentTestMocks) {
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).
+					Return(taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				m.attachmentRepo.EXPECT().UsageByUserID(gomock.Any(), creatorID).Return(int64(4090), nil)
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:      "unsupported content",
+			userID:    creatorID,
+			fieldName: "file",
+			fileName:  "diagram.png",
+			content:   []byte("<html><script>alert(1)</script></html>"),
+			setupMock: func(m attachmentTestMocks) {
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).
+					Return(taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				m.attachmentRepo.EXPECT().UsageByUserID(gomock.Any(), creatorID).Return(int64(0), nil)
+			},
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:      "viewer cannot attach files",
+			userID:    viewerID,
+			fieldName: "file",
+			fileName:  "diagram.png",
+			content:   testPNG,
+			setupMock: func(m attachmentTestMocks) {
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), viewerID, taskID).
+					Return(taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&viewerRole)), nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:      "task not accessible",
+			userID:    viewerID,
+			fieldName: "file",
+			fileName:  "diagram.png",
+			content:   testPNG,
+			setupMock: func(m attachmentTestMocks) {
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), viewerID, taskID).Return(nil, taskDomain.ErrTaskNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, m := setupAttachmentTestServer(ctrl)
+			tt.setupMock(m)
+
+			e := echo.New()
+			req := newMultipartRequest(t, "/tasks/"+taskID.String()+"/attachments", tt.fieldName, tt.fileName, tt.content)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", tt.userID.String())
+
+			// Act
+			err := handler.UploadAttachment(c, taskID.UUID())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var responseAttachment generated.Attachment
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseAttachment))
+				assert.Equal(t, "diagram.png", responseAttachment.FileName)
+				assert.Equal(t, "image/png", responseAttachment.ContentType)
+				assert.Equal(t, int64(len(testPNG)), responseAttachment.Size)
+				assert.Len(t, responseAttachment.Sha256, 64)
+			}
+		})
+	}
+}
+
+func TestAttachmentDownloadAttachment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	taskID := taskDomain.GenerateTaskID()
+	attachmentID := attachmentDomain.GenerateAttachmentID()
+	checksum := strings.Repeat("b", 64)
+	stored := attachmentDomain.NewAttachmentWithoutValidation(attachmentID, taskID, creatorID,
+		"résumé.pdf", "application/pdf", 8, checksum, time.Now())
+
+	tests := []struct {
+		name           string
+		setupMock      func(m attachmentTestMocks)
+		expectedStatus int
+	}{
+		{
+			name: "content streamed",
+			setupMock: func(m attachmentTestMocks) {
+				m.attachmentRepo.EXPECT().FindById(gomock.Any(), attachmentID).Return(stored, nil)
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).
+					Return(taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				m.blobStore.EXPECT().Get(gomock.Any(), stored.Key()).Return(io.NopCloser(strings.NewReader("%PDF-1.7")), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "content missing",
+			setupMock: func(m attachmentTestMocks) {
+				m.attachmentRepo.EXPECT().FindById(gomock.Any(), attachmentID).Return(stored, nil)
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).
+					Return(taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID), nil)
+				m.blobStore.EXPECT().Get(gomock.Any(), stored.Key()).Return(nil, attachmentDomain.ErrBlobNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "task not accessible",
+			setupMock: func(m attachmentTestMocks) {
+				m.attachmentRepo.EXPECT().FindById(gomock.Any(), attachmentID).Return(stored, nil)
+				m.taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(nil, taskDomain.ErrTaskNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, m := setupAttachmentTestServer(ctrl)
+			tt.setupMock(m)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/attachments/"+attachmentID.String(), nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", creatorID.String())
+
+			// Act
+			err := handler.DownloadAttachment(c, attachmentID.UUID())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "%PDF-1.7", rec.Body.String())
+				assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, "8", rec.Header().Get(echo.HeaderContentLength))
+				assert.Equal(t, `attachment; filename*=utf-8''r%C3%A9sum%C3%A9.pdf`, rec.Header().Get(echo.HeaderContentDisposition))
+				assert.Equal(t, `"`+checksum+`"`, rec.Header().Get("ETag"))
+				assert.Equal(t, "nosniff", rec.Header().Get(echo.HeaderXContentTypeOptions))
+			}
+		})
+	}
+}
+
+func TestAttachmentDeleteAttachment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	uploaderID := user.GenerateUserID()
+	otherID := user.GenerateUserID()
+	taskID := taskDomain.GenerateTaskID()
+	attachmentID := attachmentDomain.GenerateAttachmentID()
+	editorRole := taskDomain.RoleEditor
+	stored := attachmentDomain.NewAttachmentWithoutValidation(attachmentID, taskID, uploaderID,
+		"diagram.png", "image/png", 2048, strings.Repeat("a", 64), time.Now())
+
+	tests := []struct {
+		name           string
+		userID         user.UserID
+		accessible     *taskDomain.Task
+		expectedStatus int
+	}{
+		{
+			name:           "uploader deletes the attachment",
+			userID:         uploaderID,
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "task creator deletes another user's attachment",
+			userID:         creatorID,
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "other collaborator cannot delete the attachment",
+			userID:         otherID,
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, m := setupAttachmentTestServer(ctrl)
+			m.attachmentRepo.EXPECT().FindById(gomock.Any(), attachmentID).Return(stored, nil)
+			m.taskRepo.EXPECT().FindAccessible(gomock.Any(), tt.userID, taskID).Return(tt.accessible, nil)
+
+			if tt.expectedStatus == http.StatusNoContent {
+				m.blobStore.EXPECT().Delete(gomock.Any(), stored.Key()).Return(nil)
+				m.attachmentRepo.EXPECT().Delete(gomock.Any(), attachmentID).Return(nil)
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/attachments/"+attachmentID.String(), nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", tt.userID.String())
+
+			// Act
+			err := handler.DeleteAttachment(c, attachmentID.UUID())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestAttachmentAuthenticationRequired(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, _ := setupAttachmentTestServer(ctrl)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/attachments/"+attachmentDomain.GenerateAttachmentID().String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Act
+	err := handler.DownloadAttachment(c, attachmentDomain.GenerateAttachmentID().UUID())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
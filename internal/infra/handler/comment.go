@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	commentDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/comment"
+	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+)
+
+// CommentHandler handles HTTP requests for comment operations.
+type CommentHandler struct {
+	controller  controller.Comment
+	uuidAdapter *UUIDAdapter
+}
+
+// NewCommentHandler creates a new CommentHandler with the provided controller.
+func NewCommentHandler(ctr controller.Comment) *CommentHandler {
+	return &CommentHandler{
+		controller:  ctr,
+		uuidAdapter: NewUUIDAdapter(),
+	}
+}
+
+// isCommentBodyError checks if the error is caused by an invalid comment body
+func isCommentBodyError(err error) bool {
+	return errors.Is(err, commentDomain.ErrBodyEmpty) ||
+		errors.Is(err, commentDomain.ErrBodyTooLong)
+}
+
+// toCommentResponse converts a domain comment to its API representation
+func toCommentResponse(comment *commentDomain.Comment) taskHandler.Comment {
+	return taskHandler.Comment{
+		Id:        comment.ID().UUID(),
+		TaskId:    comment.TaskID().UUID(),
+		AuthorId:  comment.AuthorID().UUID(),
+		Body:      comment.Body(),
+		CreatedAt: comment.CreatedAt(),
+		Edited:    comment.IsEdited(),
+		EditedAt:  comment.EditedAt(),
+		Deleted:   comment.IsDeleted(),
+	}
+}
+
+// extractUserID extracts user ID from JWT context
+func (h *CommentHandler) extractUserID(c echo.Context) (string, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return "", errors.New("user ID not found in token")
+	}
+
+	return userID, nil
+}
+
+func (h *CommentHandler) GetComments(c echo.Context, taskId openapiTypes.UUID, params taskHandler.CommentGetCommentsParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainTaskID, err := h.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	cursor := ""
+	if params.Cursor != nil {
+		cursor = *params.Cursor
+	}
+
+	pageRequest, err := commentDomain.NewPageRequest(limit, cursor)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	page, err := h.controller.GetComments(c.Request().Context(), domainUserID, domainTaskID, pageRequest)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+		if errors.Is(err, commentDomain.ErrInvalidCursor) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Comment, 0, len(page.Comments))
+
+	for _, comment := range page.Comments {
+		res = append(res, toCommentResponse(comment))
+	}
+
+	if page.HasNext() {
+		c.Response().Header().Set("Link", nextPageLink(c.Request().URL, page.NextCursor))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *CommentHandler) CreateComment(c echo.Context, taskId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.CommentCreate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainTaskID, err := h.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	comment, err := h.controller.CreateComment(c.Request().Context(), domainUserID, domainTaskID, req.Body)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+		if isCommentBodyError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusCreated, toCommentResponse(comment))
+}
+
+func (h *CommentHandler) UpdateComment(c echo.Context, commentId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.CommentUpdate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainCommentID, err := h.uuidAdapter.ToDomainCommentID(commentId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid comment ID format", &details))
+	}
+
+	comment, err := h.controller.UpdateComment(c.Request().Context(), domainUserID, domainCommentID, req.Body)
+	if err != nil {
+		if errors.Is(err, commentDomain.ErrCommentNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Comment not found"))
+		}
+
+		details := err.Error()
+
+		switch {
+		case isCommentBodyError(err):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, commentDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		case errors.Is(err, commentDomain.ErrCommentDeleted):
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	return c.JSON(http.StatusOK, toCommentResponse(comment))
+}
+
+func (h *CommentHandler) DeleteComment(c echo.Context, commentId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainCommentID, err := h.uuidAdapter.ToDomainCommentID(commentId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid comment ID format", &details))
+	}
+
+	err = h.controller.DeleteComment(c.Request().Context(), domainUserID, domainCommentID)
+	if err != nil {
+		if errors.Is(err, commentDomain.ErrCommentNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Comment not found"))
+		}
+
+		details := err.Error()
+
+		switch {
+		case errors.Is(err, commentDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		case errors.Is(err, commentDomain.ErrCommentDeleted):
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
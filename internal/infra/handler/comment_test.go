@@ -0,0 +1,459 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	commentDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/comment"
+	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=../../domain/comment/repository.go -destination=mocks/mock_comment_repository.go -package=mocks
+
+func setupCommentTestServer(ctrl *gomock.Controller) (*CommentHandler, *mocks.MockCommentRepository, *mocks.MockTaskRepository) {
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	commentController := controller.NewComment(mockCommentRepo, mockTaskRepo)
+	handler := NewCommentHandler(*commentController)
+
+	return handler, mockCommentRepo, mockTaskRepo
+}
+
+func TestCommentGetComments(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	taskID := taskDomain.GenerateTaskID()
+	deletedAt := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+	taskEntity := taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID)
+	pageLimit := 2
+	tooLargeLimit := commentDomain.MaxPageLimit + 1
+
+	comments := []*commentDomain.Comment{
+		commentDomain.NewCommentWithoutValidation(commentDomain.GenerateCommentID(), taskID, creatorID, "First", time.Now()),
+		commentDomain.NewCommentWithoutValidation(commentDomain.GenerateCommentID(), taskID, creatorID, "", time.Now(),
+			commentDomain.WithDeletedAt(&deletedAt)),
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		params         generated.CommentGetCommentsParams
+		setupMock      func(commentRepo *mocks.MockCommentRepository, taskRepo *mocks.MockTaskRepository)
+		expectedStatus int
+		expectedLink   string
+	}{
+		{
+			name:   "page with a following page",
+			query:  "?limit=2",
+			params: generated.CommentGetCommentsParams{Limit: &pageLimit, Cursor: nil},
+			setupMock: func(commentRepo *mocks.MockCommentRepository, taskRepo *mocks.MockTaskRepository) {
+				taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(taskEntity, nil)
+				commentRepo.EXPECT().FindPageByTaskID(gomock.Any(), taskID, gomock.Any()).
+					Return(commentDomain.Page{Comments: comments, NextCursor: "next"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedLink:   `</tasks/` + taskID.String() + `/comments?cursor=next&limit=2>; rel="next"`,
+		},
+		{
+			name:   "invalid cursor",
+			query:  "?cursor=bad",
+			params: generated.CommentGetCommentsParams{Limit: nil, Cursor: stringPtr("bad")},
+			setupMock: func(commentRepo *mocks.MockCommentRepository, taskRepo *mocks.MockTaskRepository) {
+				taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(taskEntity, nil)
+				commentRepo.EXPECT().FindPageByTaskID(gomock.Any(), taskID, gomock.Any()).
+					Return(commentDomain.Page{}, commentDomain.ErrInvalidCursor)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedLink:   "",
+		},
+		{
+			name:           "limit out of range",
+			query:          "?limit=201",
+			params:         generated.CommentGetCommentsParams{Limit: &tooLargeLimit, Cursor: nil},
+			setupMock:      func(*mocks.MockCommentRepository, *mocks.MockTaskRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedLink:   "",
+		},
+		{
+			name:   "task not accessible",
+			query:  "",
+			params: generated.CommentGetCommentsParams{Limit: nil, Cursor: nil},
+			setupMock: func(_ *mocks.MockCommentRepository, taskRepo *mocks.MockTaskRepository) {
+				taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(nil, taskDomain.ErrTaskNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedLink:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockCommentRepo, mockTaskRepo := setupCommentTestServer(ctrl)
+			tt.setupMock(mockCommentRepo, mockTaskRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID.String()+"/comments"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", creatorID.String())
+
+			// Act
+			err := handler.GetComments(c, taskID.UUID(), tt.params)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedLink, rec.Header().Get("Link"))
+
+			if tt.expectedStatus == http.StatusOK {
+				var responseComments []generated.Comment
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseComments))
+				require.Len(t, responseComments, 2)
+				assert.Equal(t, "First", responseComments[0].Body)
+				assert.False(t, responseComments[0].Deleted)
+				assert.True(t, responseComments[1].Deleted)
+				assert.Empty(t, responseComments[1].Body)
+			}
+		})
+	}
+}
+
+func TestCommentCreateComment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	taskID := taskDomain.GenerateTaskID()
+	taskEntity := taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(commentRepo *mocks.MockCommentRepository, taskRepo *mocks.MockTaskRepository)
+		expectedStatus int
+	}{
+		{
+			name: "comment created",
+			body: `{"body": " Looks good "}`,
+			setupMock: func(commentRepo *mocks.MockCommentRepository, taskRepo *mocks.MockTaskRepository) {
+				taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(taskEntity, nil)
+				commentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, created *commentDomain.Comment) (*commentDomain.Comment, error) {
+						return created, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "empty body",
+			body:           `{"body": "  "}`,
+			setupMock:      func(*mocks.MockCommentRepository, *mocks.MockTaskRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid JSON",
+			body:           `{"body": `,
+			setupMock:      func(*mocks.MockCommentRepository, *mocks.MockTaskRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "task not accessible",
+			body: `{"body": "Looks good"}`,
+			setupMock: func(_ *mocks.MockCommentRepository, taskRepo *mocks.MockTaskRepository) {
+				taskRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(nil, taskDomain.ErrTaskNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockCommentRepo, mockTaskRepo := setupCommentTestServer(ctrl)
+			tt.setupMock(mockCommentRepo, mockTaskRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/tasks/"+taskID.String()+"/comments", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", creatorID.String())
+
+			// Act
+			err := handler.CreateComment(c, taskID.UUID())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var created generated.Comment
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+				assert.Equal(t, "Looks good", created.Body)
+				assert.Equal(t, creatorID.String(), created.AuthorId.String())
+				assert.Equal(t, taskID.String(), created.TaskId.String())
+				assert.False(t, created.Edited)
+			}
+		})
+	}
+}
+
+func TestCommentUpdateComment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	authorID := user.GenerateUserID()
+	taskID := taskDomain.GenerateTaskID()
+	commentID := commentDomain.GenerateCommentID()
+	editorRole := taskDomain.RoleEditor
+	deletedAt := time.Now()
+
+	tests := []struct {
+		name           string
+		userID         user.UserID
+		body           string
+		stored         *commentDomain.Comment
+		accessible     *taskDomain.Task
+		repoError      error
+		expectedStatus int
+	}{
+		{
+			name:           "author edits the comment",
+			userID:         authorID,
+			body:           `{"body": "Done now"}`,
+			stored:         commentDomain.NewCommentWithoutValidation(commentID, taskID, authorID, "Done", time.Now()),
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			repoError:      nil,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "body too long",
+			userID:         authorID,
+			body:           `{"body": "` + strings.Repeat("a", commentDomain.MaxBodyLength+1) + `"}`,
+			stored:         commentDomain.NewCommentWithoutValidation(commentID, taskID, authorID, "Done", time.Now()),
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			repoError:      nil,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "task creator cannot edit another user's comment",
+			userID:         creatorID,
+			body:           `{"body": "Done now"}`,
+			stored:         commentDomain.NewCommentWithoutValidation(commentID, taskID, authorID, "Done", time.Now()),
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID),
+			repoError:      nil,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "deleted comment",
+			userID: authorID,
+			body:   `{"body": "Done now"}`,
+			stored: commentDomain.NewCommentWithoutValidation(commentID, taskID, authorID, "", time.Now(),
+				commentDomain.WithDeletedAt(&deletedAt)),
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			repoError:      nil,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "task of the comment not accessible",
+			userID:         authorID,
+			body:           `{"body": "Done now"}`,
+			stored:         commentDomain.NewCommentWithoutValidation(commentID, taskID, authorID, "Done", time.Now()),
+			accessible:     nil,
+			repoError:      nil,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "repository error",
+			userID:         authorID,
+			body:           `{"body": "Done now"}`,
+			stored:         commentDomain.NewCommentWithoutValidation(commentID, taskID, authorID, "Done", time.Now()),
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			repoError:      fmt.Errorf("database error"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockCommentRepo, mockTaskRepo := setupCommentTestServer(ctrl)
+			mockCommentRepo.EXPECT().FindById(gomock.Any(), commentID).Return(tt.stored, nil)
+
+			if tt.accessible != nil {
+				mockTaskRepo.EXPECT().FindAccessible(gomock.Any(), tt.userID, taskID).Return(tt.accessible, nil)
+			} else {
+				mockTaskRepo.EXPECT().FindAccessible(gomock.Any(), tt.userID, taskID).Return(nil, taskDomain.ErrTaskNotFound)
+			}
+
+			if tt.expectedStatus == http.StatusOK || tt.repoError != nil {
+				mockCommentRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, updated *commentDomain.Comment) (*commentDomain.Comment, error) {
+						return updated, tt.repoError
+					})
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/comments/"+commentID.String(), strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", tt.userID.String())
+
+			// Act
+			err := handler.UpdateComment(c, commentID.UUID())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var updated generated.Comment
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+				assert.Equal(t, "Done now", updated.Body)
+				assert.True(t, updated.Edited)
+				assert.NotNil(t, updated.EditedAt)
+			}
+		})
+	}
+}
+
+func TestCommentDeleteComment(t *testing.T) {
+	t.Parallel()
+
+	creatorID := user.GenerateUserID()
+	authorID := user.GenerateUserID()
+	otherID := user.GenerateUserID()
+	taskID := taskDomain.GenerateTaskID()
+	commentID := commentDomain.GenerateCommentID()
+	editorRole := taskDomain.RoleEditor
+	deletedAt := time.Now()
+
+	tests := []struct {
+		name           string
+		userID         user.UserID
+		deleted        bool
+		accessible     *taskDomain.Task
+		expectedStatus int
+	}{
+		{
+			name:           "author deletes the comment",
+			userID:         authorID,
+			deleted:        false,
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "task creator deletes another user's comment",
+			userID:         creatorID,
+			deleted:        false,
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "other collaborator cannot delete the comment",
+			userID:         otherID,
+			deleted:        false,
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "comment already deleted",
+			userID:         authorID,
+			deleted:        true,
+			accessible:     taskDomain.NewTaskWithoutValidation(taskID, "Report", creatorID, taskDomain.WithSharedRole(&editorRole)),
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockCommentRepo, mockTaskRepo := setupCommentTestServer(ctrl)
+
+			var opts []commentDomain.RestoreOption
+			if tt.deleted {
+				opts = append(opts, commentDomain.WithDeletedAt(&deletedAt))
+			}
+
+			stored := commentDomain.NewCommentWithoutValidation(commentID, taskID, authorID, "Done", time.Now(), opts...)
+			mockCommentRepo.EXPECT().FindById(gomock.Any(), commentID).Return(stored, nil)
+			mockTaskRepo.EXPECT().FindAccessible(gomock.Any(), tt.userID, taskID).Return(tt.accessible, nil)
+
+			if tt.expectedStatus == http.StatusNoContent {
+				mockCommentRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(stored, nil)
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/comments/"+commentID.String(), nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", tt.userID.String())
+
+			// Act
+			err := handler.DeleteComment(c, commentID.UUID())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestCommentAuthenticationRequired(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, _, _ := setupCommentTestServer(ctrl)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/comments/"+commentDomain.GenerateCommentID().String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Act
+	err := handler.DeleteComment(c, commentDomain.GenerateCommentID().UUID())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -12,8 +12,9 @@ import (
 func CORSMiddleware(cfg config.Config) echo.MiddlewareFunc {
 	return middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     cfg.AllowOrigins,
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Authorization", "Content-Type"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Authorization", "Content-Type", "If-Match", HeaderIdempotencyKey},
+		ExposeHeaders:    []string{"ETag", "Link", HeaderIdempotentReplayed},
 		AllowCredentials: true,
 		MaxAge:           int((12 * time.Hour).Seconds()),
 	})
@@ -38,8 +38,8 @@ func TestCORSMiddleware(t *testing.T) {
 			requestOrigin:            "http://localhost:3000",
 			requestHeaders:           "Authorization, Content-Type",
 			expectedAllowOrigin:      "http://localhost:3000",
-			expectedAllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
-			expectedAllowHeaders:     "Authorization,Content-Type",
+			expectedAllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+			expectedAllowHeaders:     "Authorization,Content-Type,If-Match,Idempotency-Key",
 			expectedAllowCredentials: "true",
 			expectedMaxAge:           "43200",
 			expectedStatusCode:       http.StatusNoContent,
@@ -227,6 +227,36 @@ func TestCORSMiddleware_MaxAge(t *testing.T) {
 	assert.Equal(t, 43200, expectedMaxAge)
 }
 
+func TestCORSMiddleware_ExposedHeaders(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cfg := config.Config{
+		AllowOrigins: []string{"https://example.com"},
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("Origin", "https://example.com")
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	next := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+
+	corsMiddleware := CORSMiddleware(cfg)
+	handler := corsMiddleware(next)
+
+	// Act
+	err := handler(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "ETag,Link,Idempotent-Replayed", rec.Header().Get("Access-Control-Expose-Headers"))
+}
+
 func TestCORSMiddleware_AllowedMethods(t *testing.T) {
 	t.Parallel()
 
@@ -258,7 +288,7 @@ func TestCORSMiddleware_AllowedMethods(t *testing.T) {
 
 	allowedMethods := rec.Header().Get("Access-Control-Allow-Methods")
 
-	expectedMethods := []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
+	expectedMethods := []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
 	for _, method := range expectedMethods {
 		assert.Contains(t, allowedMethods, method, "Expected method %s to be allowed", method)
 	}
@@ -287,6 +317,11 @@ func TestCORSMiddleware_AllowedHeaders(t *testing.T) {
 			requestHeaders:  "Content-Type",
 			expectedHeaders: []string{"Content-Type"},
 		},
+		{
+			name:            "conditional request header",
+			requestHeaders:  "If-Match",
+			expectedHeaders: []string{"If-Match"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -23,11 +23,41 @@ func NewUnauthorizedError(message string, details *string) generated.ErrorRespon
 	return NewError(401, message, details)
 }
 
+// NewForbiddenError creates a 403 Forbidden error
+func NewForbiddenError(message string, details *string) generated.ErrorResponse {
+	return NewError(403, message, details)
+}
+
 // NewNotFoundError creates a 404 Not Found error
 func NewNotFoundError(message string) generated.ErrorResponse {
 	return NewError(404, message, nil)
 }
 
+// NewConflictError creates a 409 Conflict error
+func NewConflictError(message string, details *string) generated.ErrorResponse {
+	return NewError(409, message, details)
+}
+
+// NewPreconditionFailedError creates a 412 Precondition Failed error
+func NewPreconditionFailedError(message string, details *string) generated.ErrorResponse {
+	return NewError(412, message, details)
+}
+
+// NewPayloadTooLargeError creates a 413 Payload Too Large error
+func NewPayloadTooLargeError(message string, details *string) generated.ErrorResponse {
+	return NewError(413, message, details)
+}
+
+// NewUnsupportedMediaTypeError creates a 415 Unsupported Media Type error
+func NewUnsupportedMediaTypeError(message string, details *string) generated.ErrorResponse {
+	return NewError(415, message, details)
+}
+
+// NewUnprocessableEntityError creates a 422 Unprocessable Entity error
+func NewUnprocessableEntityError(message string, details *string) generated.ErrorResponse {
+	return NewError(422, message, details)
+}
+
 // NewInternalServerError creates a 500 Internal Server Error
 func NewInternalServerError(message string, details *string) generated.ErrorResponse {
 	return NewError(500, message, details)
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
 )
@@ -303,6 +304,32 @@ func TestNewInternalServerError(t *testing.T) {
 	}
 }
 
+func TestNewPreconditionFailedError(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	result := NewPreconditionFailedError("Precondition failed", stringPtr("task has been modified since it was read"))
+
+	// Assert
+	assert.Equal(t, 412, result.Code)
+	assert.Equal(t, "Precondition failed", result.Message)
+	require.NotNil(t, result.Details)
+	assert.Equal(t, "task has been modified since it was read", *result.Details)
+}
+
+func TestNewForbiddenError(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	result := NewForbiddenError("Forbidden", stringPtr("user is not allowed to perform this action on the task"))
+
+	// Assert
+	assert.Equal(t, 403, result.Code)
+	assert.Equal(t, "Forbidden", result.Message)
+	require.NotNil(t, result.Details)
+	assert.Equal(t, "user is not allowed to perform this action on the task", *result.Details)
+}
+
 func TestErrorStruct_Structure(t *testing.T) {
 	t.Parallel()
 
@@ -366,6 +393,14 @@ func TestErrorFunctions_HTTPStatusCodes(t *testing.T) {
 			expectedCode:   401,
 			expectedStatus: "Unauthorized",
 		},
+		{
+			name: "forbidden",
+			errorFunc: func() generated.ErrorResponse {
+				return NewForbiddenError("test", nil)
+			},
+			expectedCode:   403,
+			expectedStatus: "Forbidden",
+		},
 		{
 			name: "not found",
 			errorFunc: func() generated.ErrorResponse {
@@ -374,6 +409,46 @@ func TestErrorFunctions_HTTPStatusCodes(t *testing.T) {
 			expectedCode:   404,
 			expectedStatus: "Not Found",
 		},
+		{
+			name: "conflict",
+			errorFunc: func() generated.ErrorResponse {
+				return NewConflictError("test", nil)
+			},
+			expectedCode:   409,
+			expectedStatus: "Conflict",
+		},
+		{
+			name: "precondition failed",
+			errorFunc: func() generated.ErrorResponse {
+				return NewPreconditionFailedError("test", nil)
+			},
+			expectedCode:   412,
+			expectedStatus: "Precondition Failed",
+		},
+		{
+			name: "payload too large",
+			errorFunc: func() generated.ErrorResponse {
+				return NewPayloadTooLargeError("test", nil)
+			},
+			expectedCode:   413,
+			expectedStatus: "Payload Too Large",
+		},
+		{
+			name: "unsupported media type",
+			errorFunc: func() generated.ErrorResponse {
+				return NewUnsupportedMediaTypeError("test", nil)
+			},
+			expectedCode:   415,
+			expectedStatus: "Unsupported Media Type",
+		},
+		{
+			name: "unprocessable entity",
+			errorFunc: func() generated.ErrorResponse {
+				return NewUnprocessableEntityError("test", nil)
+			},
+			expectedCode:   422,
+			expectedStatus: "Unprocessable Entity",
+		},
 		{
 			name: "internal server error",
 			errorFunc: func() generated.ErrorResponse {
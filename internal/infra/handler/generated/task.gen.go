@@ -24,6 +24,12 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CollaboratorRole.
+const (
+	Editor CollaboratorRole = "editor"
+	Viewer CollaboratorRole = "viewer"
+)
+
 // Defines values for HealthComponentStatus.
 const (
 	HealthComponentStatusDOWN HealthComponentStatus = "DOWN"
@@ -36,6 +42,143 @@ const (
 	HealthStatusStatusUP   HealthStatusStatus = "UP"
 )
 
+// Defines values for JsonPatchOperationOp.
+const (
+	Add     JsonPatchOperationOp = "add"
+	Copy    JsonPatchOperationOp = "copy"
+	Move    JsonPatchOperationOp = "move"
+	Remove  JsonPatchOperationOp = "remove"
+	Replace JsonPatchOperationOp = "replace"
+	Test    JsonPatchOperationOp = "test"
+)
+
+// Defines values for ProjectTaskDisposal.
+const (
+	Delete ProjectTaskDisposal = "delete"
+	Inbox  ProjectTaskDisposal = "inbox"
+)
+
+// Defines values for TaskChangeType.
+const (
+	AllDayChanged   TaskChangeType = "all_day_changed"
+	AssigneeChanged TaskChangeType = "assignee_changed"
+	Completed       TaskChangeType = "completed"
+	Created         TaskChangeType = "created"
+	Deleted         TaskChangeType = "deleted"
+	DueChanged      TaskChangeType = "due_changed"
+	ParentChanged   TaskChangeType = "parent_changed"
+	ProjectChanged  TaskChangeType = "project_changed"
+	Reopened        TaskChangeType = "reopened"
+	Restored        TaskChangeType = "restored"
+	StartChanged    TaskChangeType = "start_changed"
+	TagAdded        TaskChangeType = "tag_added"
+	TagRemoved      TaskChangeType = "tag_removed"
+	TitleChanged    TaskChangeType = "title_changed"
+)
+
+// Defines values for TaskInclude.
+const (
+	Children TaskInclude = "children"
+)
+
+// Defines values for WorkspaceRole.
+const (
+	Admin  WorkspaceRole = "admin"
+	Guest  WorkspaceRole = "guest"
+	Member WorkspaceRole = "member"
+	Owner  WorkspaceRole = "owner"
+)
+
+// Attachment The metadata of a file attached to a task
+type Attachment struct {
+	// ContentType The media type of the file, detected from its content
+	ContentType string `json:"contentType"`
+
+	// CreatedAt The time the file was attached
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FileName The name of the file without any directories
+	FileName string `json:"fileName"`
+
+	// Id The unique identifier of the attachment
+	Id openapi_types.UUID `json:"id"`
+
+	// Sha256 The hex-encoded SHA-256 digest of the file
+	Sha256 string `json:"sha256"`
+
+	// Size The size of the file in bytes
+	Size int64 `json:"size"`
+
+	// TaskId The task the file is attached to
+	TaskId openapi_types.UUID `json:"taskId"`
+
+	// UploaderId The user who attached the file
+	UploaderId openapi_types.UUID `json:"uploaderId"`
+}
+
+// AttachmentUpload defines model for attachmentUpload.
+type AttachmentUpload struct {
+	// File The file to attach. Only PNG, JPEG, GIF and WebP images and PDF documents are accepted
+	File openapi_types.File `json:"file"`
+}
+
+// Collaborator defines model for collaborator.
+type Collaborator struct {
+	// Role What a collaborator may do with a shared task. viewer may read it, editor may read and change it
+	Role CollaboratorRole `json:"role"`
+
+	// UserId The ID of the user the task is shared with
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// CollaboratorInvite defines model for collaboratorInvite.
+type CollaboratorInvite struct {
+	// Role What a collaborator may do with a shared task. viewer may read it, editor may read and change it
+	Role CollaboratorRole `json:"role"`
+}
+
+// CollaboratorRole What a collaborator may do with a shared task. viewer may read it, editor may read and change it
+type CollaboratorRole string
+
+// Comment A comment on a task
+type Comment struct {
+	// AuthorId The user who wrote the comment
+	AuthorId openapi_types.UUID `json:"authorId"`
+
+	// Body The text of the comment. Empty for a deleted comment
+	Body string `json:"body"`
+
+	// CreatedAt The time the comment was written
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Deleted Whether the comment was deleted
+	Deleted bool `json:"deleted"`
+
+	// Edited Whether the body was changed after the comment was written
+	Edited bool `json:"edited"`
+
+	// EditedAt The time the body was last changed. Not set on comments that were never edited
+	EditedAt *time.Time `json:"editedAt,omitempty"`
+
+	// Id The unique identifier of the comment
+	Id openapi_types.UUID `json:"id"`
+
+	// TaskId The task the comment is on
+	TaskId openapi_types.UUID `json:"taskId"`
+}
+
+// CommentCreate defines model for commentCreate.
+type CommentCreate struct {
+	// Body The text of the comment. Surrounding whitespace is removed
+	Body string `json:"body"`
+}
+
+// CommentUpdate defines model for commentUpdate.
+type CommentUpdate struct {
+	// Body The text of the comment. Surrounding whitespace is removed
+	Body string `json:"body"`
+}
+
 // ErrorResponse defines model for errorResponse.
 type ErrorResponse struct {
 	// Code Error code
@@ -77,53 +220,740 @@ type HealthStatus struct {
 // HealthStatusStatus Overall application health status
 type HealthStatusStatus string
 
+// JsonPatch A JSON Patch document (RFC 6902)
+type JsonPatch = []JsonPatchOperation
+
+// JsonPatchOperation A single JSON Patch operation
+type JsonPatchOperation struct {
+	// From JSON Pointer to the source location of a move or copy operation
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to perform
+	Op JsonPatchOperationOp `json:"op"`
+
+	// Path JSON Pointer to the target location, e.g. /dueAt
+	Path string `json:"path"`
+
+	// Value The value to add, replace or test
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// JsonPatchOperationOp The operation to perform
+type JsonPatchOperationOp string
+
+// Occurrence defines model for occurrence.
+type Occurrence struct {
+	// AllDay Whether only the calendar date of the start and due dates is meaningful
+	AllDay bool `json:"allDay"`
+
+	// DueAt The time the occurrence is due
+	DueAt *time.Time `json:"dueAt,omitempty"`
+
+	// StartAt The time work on the occurrence is planned to start
+	StartAt *time.Time `json:"startAt,omitempty"`
+}
+
+// Project defines model for project.
+type Project struct {
+	// Id The unique identifier for the project
+	Id openapi_types.UUID `json:"id"`
+
+	// Name The name of the project. Surrounding whitespace is removed
+	Name string `json:"name"`
+}
+
+// ProjectCreate defines model for projectCreate.
+type ProjectCreate struct {
+	// Name The name of the project. Surrounding whitespace is removed
+	Name string `json:"name"`
+}
+
+// ProjectTaskDisposal What happens to the tasks of a deleted project. inbox moves them to the inbox, delete moves them to the trash together with their subtasks
+type ProjectTaskDisposal string
+
+// ProjectUpdate defines model for projectUpdate.
+type ProjectUpdate struct {
+	// Name The name of the project. Surrounding whitespace is removed
+	Name string `json:"name"`
+}
+
+// Recurrence defines model for recurrence.
+type Recurrence struct {
+	// Rule The RFC 5545 recurrence rule of the series, without the RRULE: prefix
+	Rule string `json:"rule"`
+
+	// SeriesId The series the task is an occurrence of
+	SeriesId openapi_types.UUID `json:"seriesId"`
+
+	// Timezone The IANA time zone the dates of the rule are computed in
+	Timezone string `json:"timezone"`
+}
+
+// RecurrenceRule defines model for recurrenceRule.
+type RecurrenceRule struct {
+	// Rule An RFC 5545 recurrence rule. FREQ (DAILY, WEEKLY, MONTHLY or YEARLY), INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH and WKST are supported
+	Rule string `json:"rule"`
+
+	// Timezone The IANA time zone the dates of the rule are computed in
+	Timezone string `json:"timezone"`
+}
+
+// Tag defines model for tag.
+type Tag struct {
+	// Id The unique identifier for the tag
+	Id openapi_types.UUID `json:"id"`
+
+	// Name The name of the tag. Surrounding whitespace is removed
+	Name string `json:"name"`
+}
+
+// TagCreate defines model for tagCreate.
+type TagCreate struct {
+	// Name The name of the tag. Surrounding whitespace is removed
+	Name string `json:"name"`
+}
+
+// TagUpdate defines model for tagUpdate.
+type TagUpdate struct {
+	// Name The name of the tag. Surrounding whitespace is removed
+	Name string `json:"name"`
+}
+
 // Task defines model for task.
 type Task struct {
+	// AllDay Whether only the calendar date of the start and due dates is meaningful
+	AllDay bool `json:"allDay"`
+
+	// AssigneeId The user responsible for the task. Not set on unassigned tasks
+	AssigneeId *openapi_types.UUID `json:"assigneeId,omitempty"`
+
+	// Blocked Whether the task is blocked by a task that is not completed yet
+	Blocked bool `json:"blocked"`
+
+	// Children The subtasks of the task. Only set when the subtasks are requested with include=children
+	Children *[]Task `json:"children,omitempty"`
+
+	// Completed Whether the task has been completed
+	Completed bool `json:"completed"`
+
+	// CompletedAt The time the task was completed
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// DeletedAt The time the task was moved to the trash. Only set on tasks in the trash
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// DueAt The time the task is due. Offsets are accepted and normalised to UTC
+	DueAt *time.Time `json:"dueAt,omitempty"`
+
 	// Id The unique identifier for the task
 	Id openapi_types.UUID `json:"id"`
 
+	// ParentId The task this task is a subtask of. Not set on root tasks
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+
+	// ProjectId The project the task belongs to. Not set on tasks in the inbox
+	ProjectId *openapi_types.UUID `json:"projectId,omitempty"`
+
+	// Rank The position of the task in the manual order of the user's tasks. Ranks compare as plain strings; use the move endpoint to change it
+	Rank string `json:"rank"`
+
+	// Recurrence The series the task is an occurrence of. Not set on tasks that do not recur
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+
+	// Role What a collaborator may do with a shared task. viewer may read it, editor may read and change it
+	Role *CollaboratorRole `json:"role,omitempty"`
+
+	// Shared Whether the task is shared with the user by another user rather than created by them
+	Shared bool `json:"shared"`
+
+	// StartAt The time work on the task is planned to start. Must not be after dueAt
+	StartAt *time.Time `json:"startAt,omitempty"`
+
+	// Tags Names of the tags attached to the task, in alphabetical order
+	Tags []string `json:"tags"`
+
 	// Title The title of the task
 	Title string `json:"title"`
+
+	// WorkspaceId The workspace the task is scoped to. Not set on personal tasks
+	WorkspaceId *openapi_types.UUID `json:"workspaceId,omitempty"`
+}
+
+// TaskAssigneeUpdate The new assignee of a task
+type TaskAssigneeUpdate struct {
+	// AssigneeId The user to make responsible for the task. Must be the creator of the task or a collaborator on it. null leaves the task unassigned
+	AssigneeId *openapi_types.UUID `json:"assigneeId"`
+}
+
+// TaskAssignment A change of the assignee of a task
+type TaskAssignment struct {
+	// AssignedAt The time the change was made
+	AssignedAt time.Time `json:"assignedAt"`
+
+	// AssignedBy The user who made the change
+	AssignedBy openapi_types.UUID `json:"assignedBy"`
+
+	// AssigneeId The user the task was assigned to. Not set when the task was unassigned
+	AssigneeId *openapi_types.UUID `json:"assigneeId,omitempty"`
+}
+
+// TaskChange A change in the history of a task
+type TaskChange struct {
+	// ActorId The user who made the change
+	ActorId openapi_types.UUID `json:"actorId"`
+
+	// After The value after the change. Titles and tag names are given as they are, times in RFC 3339 format, flags as true or false and references as IDs. Not set when the change left no value
+	After *string `json:"after,omitempty"`
+
+	// Before The value before the change, in the same format as after. Not set when there was no value
+	Before *string `json:"before,omitempty"`
+
+	// OccurredAt The time the change was made
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// TaskId The changed task
+	TaskId openapi_types.UUID `json:"taskId"`
+
+	// Type What the change did. Every tag that was added or removed is a change of its own
+	Type TaskChangeType `json:"type"`
 }
 
+// TaskChangeType What the change did. Every tag that was added or removed is a change of its own
+type TaskChangeType string
+
 // TaskCreate defines model for taskCreate.
 type TaskCreate struct {
+	// AllDay Whether only the calendar date of the start and due dates is meaningful
+	AllDay *bool `json:"allDay,omitempty"`
+
+	// DueAt The time the task is due. Offsets are accepted and normalised to UTC
+	DueAt *time.Time `json:"dueAt,omitempty"`
+
+	// ParentId The task to create the task as a subtask of
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+
+	// ProjectId The project to create the task in. Without it the task is created in the inbox
+	ProjectId *openapi_types.UUID `json:"projectId,omitempty"`
+
+	// Recurrence Makes the task the first occurrence of a new series. The rule is counted from dueAt, or startAt if the task has no due date
+	Recurrence *RecurrenceRule `json:"recurrence,omitempty"`
+
+	// StartAt The time work on the task is planned to start. Must not be after dueAt
+	StartAt *time.Time `json:"startAt,omitempty"`
+
+	// Tags Names of existing tags to attach to the task
+	Tags *[]string `json:"tags,omitempty"`
+
 	// Title The title of the task
 	Title string `json:"title"`
 }
 
+// TaskInclude Related resources to embed in each returned task. children embeds the subtasks at every level
+type TaskInclude string
+
+// TaskMergePatch A JSON Merge Patch document (RFC 7396). A null value removes the field; id and completedAt are read-only
+type TaskMergePatch struct {
+	// AllDay Whether only the calendar date of the start and due dates is meaningful
+	AllDay *bool `json:"allDay"`
+
+	// Completed Whether the task has been completed
+	Completed *bool `json:"completed,omitempty"`
+
+	// DueAt The time the task is due. Offsets are accepted and normalised to UTC
+	DueAt *time.Time `json:"dueAt"`
+
+	// ParentId The task to move the task under. null makes the task a root task
+	ParentId *openapi_types.UUID `json:"parentId"`
+
+	// ProjectId The project to move the task into. null moves the task to the inbox
+	ProjectId *openapi_types.UUID `json:"projectId"`
+
+	// StartAt The time work on the task is planned to start. Must not be after dueAt
+	StartAt *time.Time `json:"startAt"`
+
+	// Tags Names of existing tags to attach to the task. Replaces all tags of the task
+	Tags *[]string `json:"tags,omitempty"`
+
+	// Title The title of the task
+	Title *string `json:"title,omitempty"`
+}
+
+// TaskMove Where to move a task to in the manual order. At least one neighbour must be given; with both, the task placed after must come before the task placed before
+type TaskMove struct {
+	// AfterId The task to place the moved task directly after
+	AfterId *openapi_types.UUID `json:"afterId,omitempty"`
+
+	// BeforeId The task to place the moved task directly before
+	BeforeId *openapi_types.UUID `json:"beforeId,omitempty"`
+}
+
+// TaskSeriesUpdate defines model for taskSeriesUpdate.
+type TaskSeriesUpdate struct {
+	// Recurrence The new rule of the series, counted from the task the series is edited through
+	Recurrence *RecurrenceRule `json:"recurrence,omitempty"`
+
+	// Title The title of the series, given to its open occurrences and to new ones
+	Title *string `json:"title,omitempty"`
+}
+
 // TaskUpdate defines model for taskUpdate.
 type TaskUpdate struct {
+	// AllDay Whether only the calendar date of the start and due dates is meaningful
+	AllDay *bool `json:"allDay,omitempty"`
+
+	// Completed Whether the task has been completed
+	Completed *bool `json:"completed,omitempty"`
+
+	// DueAt The time the task is due. Offsets are accepted and normalised to UTC
+	DueAt *time.Time `json:"dueAt,omitempty"`
+
+	// ParentId The task to move the task under
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+
+	// ProjectId The project to move the task into
+	ProjectId *openapi_types.UUID `json:"projectId,omitempty"`
+
+	// StartAt The time work on the task is planned to start. Must not be after dueAt
+	StartAt *time.Time `json:"startAt,omitempty"`
+
+	// Tags Names of existing tags to attach to the task. Replaces all tags of the task
+	Tags *[]string `json:"tags,omitempty"`
+
 	// Title The title of the task
 	Title *string `json:"title,omitempty"`
 }
 
+// Webhook An endpoint notified of changes to the tasks of its owner
+type Webhook struct {
+	// Active Whether the webhook is notified of events. Webhooks whose endpoint keeps failing are disabled
+	Active bool `json:"active"`
+
+	// CreatedAt The time the webhook was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DisabledAt The time the webhook was disabled. Not set on active webhooks
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+
+	// EventTypes The event types the webhook is notified of, such as task.created or task.title_changed. Every type of task change is an event type of its own, prefixed with task. No event types subscribes to all of them
+	EventTypes []string `json:"eventTypes"`
+
+	// FailureCount The number of delivery attempts that failed since the last one that succeeded
+	FailureCount int `json:"failureCount"`
+
+	// Id The unique identifier of the webhook
+	Id openapi_types.UUID `json:"id"`
+
+	// Url The http or https URL events are posted to. Surrounding whitespace is removed
+	Url string `json:"url"`
+}
+
+// WebhookCreate defines model for webhookCreate.
+type WebhookCreate struct {
+	// EventTypes The event types the webhook is notified of, such as task.created or task.title_changed. Every type of task change is an event type of its own, prefixed with task. No event types subscribes to all of them
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+
+	// Secret The secret payloads are signed with. Every delivery carries an X-Webhook-Signature header with the HMAC-SHA256 of the X-Webhook-Timestamp header, a dot and the body, keyed with the secret, in the form sha256=<hex>. The secret is never returned
+	Secret string `json:"secret"`
+
+	// Url The http or https URL events are posted to. Surrounding whitespace is removed
+	Url string `json:"url"`
+}
+
+// WebhookUpdate defines model for webhookUpdate.
+type WebhookUpdate struct {
+	// Active Enables or disables the webhook. Enabling a webhook also resets its failure count
+	Active *bool `json:"active,omitempty"`
+
+	// EventTypes Replaces the event types the webhook is notified of. An empty list subscribes to all of them
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+
+	// Secret The secret payloads are signed with. Every delivery carries an X-Webhook-Signature header with the HMAC-SHA256 of the X-Webhook-Timestamp header, a dot and the body, keyed with the secret, in the form sha256=<hex>. The secret is never returned
+	Secret *string `json:"secret,omitempty"`
+
+	// Url The http or https URL events are posted to. Surrounding whitespace is removed
+	Url *string `json:"url,omitempty"`
+}
+
+// Workspace defines model for workspace.
+type Workspace struct {
+	// Id The unique identifier for the workspace
+	Id openapi_types.UUID `json:"id"`
+
+	// Name The name of the workspace. Surrounding whitespace is removed
+	Name string `json:"name"`
+
+	// OwnerId The ID of the user who created the workspace and manages its members
+	OwnerId openapi_types.UUID `json:"ownerId"`
+}
+
+// WorkspaceCreate defines model for workspaceCreate.
+type WorkspaceCreate struct {
+	// Name The name of the workspace. Surrounding whitespace is removed
+	Name string `json:"name"`
+}
+
+// WorkspaceMember defines model for workspaceMember.
+type WorkspaceMember struct {
+	// Role What a member may do in a workspace. owner manages the members, admin and member create and work on tasks, guest may only read them
+	Role WorkspaceRole `json:"role"`
+
+	// UserId The ID of the member
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// WorkspaceMemberUpdate defines model for workspaceMemberUpdate.
+type WorkspaceMemberUpdate struct {
+	// Role What a member may do in a workspace. owner manages the members, admin and member create and work on tasks, guest may only read them
+	Role WorkspaceRole `json:"role"`
+}
+
+// WorkspaceRole What a member may do in a workspace. owner manages the members, admin and member create and work on tasks, guest may only read them
+type WorkspaceRole string
+
+// ProjectDeleteProjectParams defines parameters for ProjectDeleteProject.
+type ProjectDeleteProjectParams struct {
+	// Tasks What happens to the tasks of the project. inbox moves them to the inbox, delete moves them to the trash together with their subtasks
+	Tasks *ProjectTaskDisposal `form:"tasks,omitempty" json:"tasks,omitempty"`
+}
+
+// TaskGetAllTasksParams defines parameters for TaskGetAllTasks.
+type TaskGetAllTasksParams struct {
+	// Completed Filter tasks by completion state
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
+
+	// DueBefore Only return tasks due before this time (exclusive)
+	DueBefore *time.Time `form:"due_before,omitempty" json:"due_before,omitempty"`
+
+	// DueAfter Only return tasks due at or after this time (inclusive)
+	DueAfter *time.Time `form:"due_after,omitempty" json:"due_after,omitempty"`
+
+	// Limit Maximum number of tasks to return. Defaults to 50
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor taken from the Link header of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Comma-separated sort keys, each optionally prefixed with "-" for descending order. Supported fields are rank, created_at, updated_at and title. Defaults to rank, the manual order
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Filter Filter conditions of the form field:operator:value. Repeat the parameter to combine conditions
+	Filter *[]string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Tag Only return tasks carrying the tag with this name
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// AssignedTo Only return tasks assigned to this user. me stands for the authenticated user
+	AssignedTo *string `form:"assigned_to,omitempty" json:"assigned_to,omitempty"`
+
+	// Include Related resources to embed in each returned task. children embeds the subtasks at every level
+	Include *TaskInclude `form:"include,omitempty" json:"include,omitempty"`
+
+	// XWorkspaceID The workspace the request is made in. The user must be a member. The workspace can also be selected with the /workspaces/{workspaceId} path prefix
+	XWorkspaceID *openapi_types.UUID `json:"X-Workspace-ID,omitempty"`
+}
+
+// TaskCreateTaskParams defines parameters for TaskCreateTask.
+type TaskCreateTaskParams struct {
+	// IdempotencyKey Unique key that makes retries of the request safe. A repeated request with the same key replays the stored response
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+
+	// XWorkspaceID The workspace the request is made in. The user must be a member. The workspace can also be selected with the /workspaces/{workspaceId} path prefix
+	XWorkspaceID *openapi_types.UUID `json:"X-Workspace-ID,omitempty"`
+}
+
+// TaskStreamEventsParams defines parameters for TaskStreamEvents.
+type TaskStreamEventsParams struct {
+	// LastEventID The ID of the last event the client received, sent by the browser when it reconnects
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// TaskSearchTasksParams defines parameters for TaskSearchTasks.
+type TaskSearchTasksParams struct {
+	// Q Search text matched against task titles
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of tasks to return. Defaults to 50
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// TaskDeleteTaskParams defines parameters for TaskDeleteTask.
+type TaskDeleteTaskParams struct {
+	// Cascade Also move the subtasks of the task to the trash. Without it, deleting a task with subtasks fails with 409
+	Cascade *bool `form:"cascade,omitempty" json:"cascade,omitempty"`
+
+	// IfMatch ETag of the task as last seen by the client. The request fails with 412 if the task has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// TaskGetTaskParams defines parameters for TaskGetTask.
+type TaskGetTaskParams struct {
+	// Include Related resources to embed in each returned task. children embeds the subtasks at every level
+	Include *TaskInclude `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// TaskPatchTaskParams defines parameters for TaskPatchTask.
+type TaskPatchTaskParams struct {
+	// IfMatch ETag of the task as last seen by the client. The request fails with 412 if the task has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// TaskUpdateTaskParams defines parameters for TaskUpdateTask.
+type TaskUpdateTaskParams struct {
+	// IfMatch ETag of the task as last seen by the client. The request fails with 412 if the task has changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// CommentGetCommentsParams defines parameters for CommentGetComments.
+type CommentGetCommentsParams struct {
+	// Limit Maximum number of comments to return. Defaults to 50
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor taken from the Link header of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// TaskGetHistoryParams defines parameters for TaskGetHistory.
+type TaskGetHistoryParams struct {
+	// Limit Maximum number of changes to return. Defaults to 50
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor taken from the Link header of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// TaskGetOccurrencesParams defines parameters for TaskGetOccurrences.
+type TaskGetOccurrencesParams struct {
+	// Limit Maximum number of occurrences to return, between 1 and 100. Defaults to 10
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// TaskGetSubtasksParams defines parameters for TaskGetSubtasks.
+type TaskGetSubtasksParams struct {
+	// Include Related resources to embed in each returned task. children embeds the subtasks at every level
+	Include *TaskInclude `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// CommentUpdateCommentJSONRequestBody defines body for CommentUpdateComment for application/json ContentType.
+type CommentUpdateCommentJSONRequestBody = CommentUpdate
+
+// ProjectCreateProjectJSONRequestBody defines body for ProjectCreateProject for application/json ContentType.
+type ProjectCreateProjectJSONRequestBody = ProjectCreate
+
+// ProjectUpdateProjectJSONRequestBody defines body for ProjectUpdateProject for application/json ContentType.
+type ProjectUpdateProjectJSONRequestBody = ProjectUpdate
+
+// TagCreateTagJSONRequestBody defines body for TagCreateTag for application/json ContentType.
+type TagCreateTagJSONRequestBody = TagCreate
+
+// TagUpdateTagJSONRequestBody defines body for TagUpdateTag for application/json ContentType.
+type TagUpdateTagJSONRequestBody = TagUpdate
+
 // TaskCreateTaskJSONRequestBody defines body for TaskCreateTask for application/json ContentType.
 type TaskCreateTaskJSONRequestBody = TaskCreate
 
+// TaskPatchTaskApplicationJSONPatchPlusJSONRequestBody defines body for TaskPatchTask for application/json-patch+json ContentType.
+type TaskPatchTaskApplicationJSONPatchPlusJSONRequestBody = JsonPatch
+
+// TaskPatchTaskApplicationMergePatchPlusJSONRequestBody defines body for TaskPatchTask for application/merge-patch+json ContentType.
+type TaskPatchTaskApplicationMergePatchPlusJSONRequestBody = TaskMergePatch
+
 // TaskUpdateTaskJSONRequestBody defines body for TaskUpdateTask for application/json ContentType.
 type TaskUpdateTaskJSONRequestBody = TaskUpdate
 
+// TaskAssignTaskJSONRequestBody defines body for TaskAssignTask for application/json ContentType.
+type TaskAssignTaskJSONRequestBody = TaskAssigneeUpdate
+
+// AttachmentUploadAttachmentMultipartRequestBody defines body for AttachmentUploadAttachment for multipart/form-data ContentType.
+type AttachmentUploadAttachmentMultipartRequestBody = AttachmentUpload
+
+// TaskAddCollaboratorJSONRequestBody defines body for TaskAddCollaborator for application/json ContentType.
+type TaskAddCollaboratorJSONRequestBody = CollaboratorInvite
+
+// CommentCreateCommentJSONRequestBody defines body for CommentCreateComment for application/json ContentType.
+type CommentCreateCommentJSONRequestBody = CommentCreate
+
+// TaskMoveTaskJSONRequestBody defines body for TaskMoveTask for application/json ContentType.
+type TaskMoveTaskJSONRequestBody = TaskMove
+
+// TaskUpdateSeriesJSONRequestBody defines body for TaskUpdateSeries for application/json ContentType.
+type TaskUpdateSeriesJSONRequestBody = TaskSeriesUpdate
+
+// WebhookCreateWebhookJSONRequestBody defines body for WebhookCreateWebhook for application/json ContentType.
+type WebhookCreateWebhookJSONRequestBody = WebhookCreate
+
+// WebhookUpdateWebhookJSONRequestBody defines body for WebhookUpdateWebhook for application/json ContentType.
+type WebhookUpdateWebhookJSONRequestBody = WebhookUpdate
+
+// WorkspaceCreateWorkspaceJSONRequestBody defines body for WorkspaceCreateWorkspace for application/json ContentType.
+type WorkspaceCreateWorkspaceJSONRequestBody = WorkspaceCreate
+
+// WorkspaceSetMemberJSONRequestBody defines body for WorkspaceSetMember for application/json ContentType.
+type WorkspaceSetMemberJSONRequestBody = WorkspaceMemberUpdate
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Delete an attachment
+	// (DELETE /attachments/{attachmentId})
+	AttachmentDeleteAttachment(ctx echo.Context, attachmentId openapi_types.UUID) error
+	// Download an attachment
+	// (GET /attachments/{attachmentId})
+	AttachmentDownloadAttachment(ctx echo.Context, attachmentId openapi_types.UUID) error
+	// Delete a comment
+	// (DELETE /comments/{commentId})
+	CommentDeleteComment(ctx echo.Context, commentId openapi_types.UUID) error
+	// Edit a comment
+	// (PATCH /comments/{commentId})
+	CommentUpdateComment(ctx echo.Context, commentId openapi_types.UUID) error
 	// Get application health status
 	// (GET /health)
 	HealthGetHealth(ctx echo.Context) error
+	// List projects
+	// (GET /projects)
+	ProjectGetAllProjects(ctx echo.Context) error
+	// Create a project
+	// (POST /projects)
+	ProjectCreateProject(ctx echo.Context) error
+	// Delete a project
+	// (DELETE /projects/{projectId})
+	ProjectDeleteProject(ctx echo.Context, projectId openapi_types.UUID, params ProjectDeleteProjectParams) error
+	// Get a project
+	// (GET /projects/{projectId})
+	ProjectGetProject(ctx echo.Context, projectId openapi_types.UUID) error
+	// Rename a project
+	// (PUT /projects/{projectId})
+	ProjectUpdateProject(ctx echo.Context, projectId openapi_types.UUID) error
+	// List the tasks of a project
+	// (GET /projects/{projectId}/tasks)
+	ProjectGetProjectTasks(ctx echo.Context, projectId openapi_types.UUID) error
+	// List tags
+	// (GET /tags)
+	TagGetAllTags(ctx echo.Context) error
+	// Create a tag
+	// (POST /tags)
+	TagCreateTag(ctx echo.Context) error
+	// Delete a tag
+	// (DELETE /tags/{tagId})
+	TagDeleteTag(ctx echo.Context, tagId openapi_types.UUID) error
+	// Get a tag
+	// (GET /tags/{tagId})
+	TagGetTag(ctx echo.Context, tagId openapi_types.UUID) error
+	// Rename a tag
+	// (PUT /tags/{tagId})
+	TagUpdateTag(ctx echo.Context, tagId openapi_types.UUID) error
 	// Get all tasks
 	// (GET /tasks)
-	TaskGetAllTasks(ctx echo.Context) error
+	TaskGetAllTasks(ctx echo.Context, params TaskGetAllTasksParams) error
 	// Create a new task
 	// (POST /tasks)
-	TaskCreateTask(ctx echo.Context) error
+	TaskCreateTask(ctx echo.Context, params TaskCreateTaskParams) error
+	// Stream changes to tasks
+	// (GET /tasks/events)
+	TaskStreamEvents(ctx echo.Context, params TaskStreamEventsParams) error
+	// Search tasks
+	// (GET /tasks/search)
+	TaskSearchTasks(ctx echo.Context, params TaskSearchTasksParams) error
+	// Empty the trash
+	// (DELETE /tasks/trash)
+	TaskEmptyTrash(ctx echo.Context) error
+	// List tasks in the trash
+	// (GET /tasks/trash)
+	TaskGetTrash(ctx echo.Context) error
 	// Delete a task
 	// (DELETE /tasks/{taskId})
-	TaskDeleteTask(ctx echo.Context, taskId openapi_types.UUID) error
+	TaskDeleteTask(ctx echo.Context, taskId openapi_types.UUID, params TaskDeleteTaskParams) error
 	// Get a task
 	// (GET /tasks/{taskId})
-	TaskGetTask(ctx echo.Context, taskId openapi_types.UUID) error
+	TaskGetTask(ctx echo.Context, taskId openapi_types.UUID, params TaskGetTaskParams) error
+	// Partially update a task
+	// (PATCH /tasks/{taskId})
+	TaskPatchTask(ctx echo.Context, taskId openapi_types.UUID, params TaskPatchTaskParams) error
 	// Update a task
 	// (PUT /tasks/{taskId})
-	TaskUpdateTask(ctx echo.Context, taskId openapi_types.UUID) error
+	TaskUpdateTask(ctx echo.Context, taskId openapi_types.UUID, params TaskUpdateTaskParams) error
+	// Reassign a task
+	// (PUT /tasks/{taskId}/assignee)
+	TaskAssignTask(ctx echo.Context, taskId openapi_types.UUID) error
+	// List the assignment history of a task
+	// (GET /tasks/{taskId}/assignments)
+	TaskGetAssignments(ctx echo.Context, taskId openapi_types.UUID) error
+	// List the attachments of a task
+	// (GET /tasks/{taskId}/attachments)
+	AttachmentGetAttachments(ctx echo.Context, taskId openapi_types.UUID) error
+	// Attach a file to a task
+	// (POST /tasks/{taskId}/attachments)
+	AttachmentUploadAttachment(ctx echo.Context, taskId openapi_types.UUID) error
+	// List the collaborators of a task
+	// (GET /tasks/{taskId}/collaborators)
+	TaskGetCollaborators(ctx echo.Context, taskId openapi_types.UUID) error
+	// Remove a collaborator from a task
+	// (DELETE /tasks/{taskId}/collaborators/{userId})
+	TaskRemoveCollaborator(ctx echo.Context, taskId openapi_types.UUID, userId openapi_types.UUID) error
+	// Share a task with a user
+	// (PUT /tasks/{taskId}/collaborators/{userId})
+	TaskAddCollaborator(ctx echo.Context, taskId openapi_types.UUID, userId openapi_types.UUID) error
+	// List the comments on a task
+	// (GET /tasks/{taskId}/comments)
+	CommentGetComments(ctx echo.Context, taskId openapi_types.UUID, params CommentGetCommentsParams) error
+	// Comment on a task
+	// (POST /tasks/{taskId}/comments)
+	CommentCreateComment(ctx echo.Context, taskId openapi_types.UUID) error
+	// List the tasks blocking a task
+	// (GET /tasks/{taskId}/dependencies)
+	TaskGetDependencies(ctx echo.Context, taskId openapi_types.UUID) error
+	// Remove a dependency
+	// (DELETE /tasks/{taskId}/dependencies/{blockerId})
+	TaskRemoveDependency(ctx echo.Context, taskId openapi_types.UUID, blockerId openapi_types.UUID) error
+	// Add a dependency
+	// (PUT /tasks/{taskId}/dependencies/{blockerId})
+	TaskAddDependency(ctx echo.Context, taskId openapi_types.UUID, blockerId openapi_types.UUID) error
+	// List the changes made to a task
+	// (GET /tasks/{taskId}/history)
+	TaskGetHistory(ctx echo.Context, taskId openapi_types.UUID, params TaskGetHistoryParams) error
+	// Move a task in the manual order
+	// (POST /tasks/{taskId}/move)
+	TaskMoveTask(ctx echo.Context, taskId openapi_types.UUID) error
+	// Preview the upcoming occurrences of a recurring task
+	// (GET /tasks/{taskId}/occurrences)
+	TaskGetOccurrences(ctx echo.Context, taskId openapi_types.UUID, params TaskGetOccurrencesParams) error
+	// Restore a task from the trash
+	// (POST /tasks/{taskId}/restore)
+	TaskRestoreTask(ctx echo.Context, taskId openapi_types.UUID) error
+	// Edit the whole series of a recurring task
+	// (PUT /tasks/{taskId}/series)
+	TaskUpdateSeries(ctx echo.Context, taskId openapi_types.UUID) error
+	// List the subtasks of a task
+	// (GET /tasks/{taskId}/subtasks)
+	TaskGetSubtasks(ctx echo.Context, taskId openapi_types.UUID, params TaskGetSubtasksParams) error
+	// List webhooks
+	// (GET /webhooks)
+	WebhookGetAllWebhooks(ctx echo.Context) error
+	// Create a webhook
+	// (POST /webhooks)
+	WebhookCreateWebhook(ctx echo.Context) error
+	// Delete a webhook
+	// (DELETE /webhooks/{webhookId})
+	WebhookDeleteWebhook(ctx echo.Context, webhookId openapi_types.UUID) error
+	// Get a webhook
+	// (GET /webhooks/{webhookId})
+	WebhookGetWebhook(ctx echo.Context, webhookId openapi_types.UUID) error
+	// Update a webhook
+	// (PATCH /webhooks/{webhookId})
+	WebhookUpdateWebhook(ctx echo.Context, webhookId openapi_types.UUID) error
+	// List workspaces
+	// (GET /workspaces)
+	WorkspaceGetAllWorkspaces(ctx echo.Context) error
+	// Create a workspace
+	// (POST /workspaces)
+	WorkspaceCreateWorkspace(ctx echo.Context) error
+	// List the members of a workspace
+	// (GET /workspaces/{workspaceId}/members)
+	WorkspaceGetMembers(ctx echo.Context, workspaceId openapi_types.UUID) error
+	// Remove a member from a workspace
+	// (DELETE /workspaces/{workspaceId}/members/{userId})
+	WorkspaceRemoveMember(ctx echo.Context, workspaceId openapi_types.UUID, userId openapi_types.UUID) error
+	// Add or update a member of a workspace
+	// (PUT /workspaces/{workspaceId}/members/{userId})
+	WorkspaceSetMember(ctx echo.Context, workspaceId openapi_types.UUID, userId openapi_types.UUID) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -131,34 +961,489 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// HealthGetHealth converts echo context to params.
-func (w *ServerInterfaceWrapper) HealthGetHealth(ctx echo.Context) error {
+// AttachmentDeleteAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachmentDeleteAttachment(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HealthGetHealth(ctx)
+	err = w.Handler.AttachmentDeleteAttachment(ctx, attachmentId)
 	return err
 }
 
-// TaskGetAllTasks converts echo context to params.
-func (w *ServerInterfaceWrapper) TaskGetAllTasks(ctx echo.Context) error {
+// AttachmentDownloadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachmentDownloadAttachment(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TaskGetAllTasks(ctx)
+	err = w.Handler.AttachmentDownloadAttachment(ctx, attachmentId)
 	return err
 }
 
-// TaskCreateTask converts echo context to params.
-func (w *ServerInterfaceWrapper) TaskCreateTask(ctx echo.Context) error {
+// CommentDeleteComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentDeleteComment(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", ctx.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TaskCreateTask(ctx)
+	err = w.Handler.CommentDeleteComment(ctx, commentId)
+	return err
+}
+
+// CommentUpdateComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentUpdateComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", ctx.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter commentId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommentUpdateComment(ctx, commentId)
+	return err
+}
+
+// HealthGetHealth converts echo context to params.
+func (w *ServerInterfaceWrapper) HealthGetHealth(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HealthGetHealth(ctx)
+	return err
+}
+
+// ProjectGetAllProjects converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectGetAllProjects(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectGetAllProjects(ctx)
+	return err
+}
+
+// ProjectCreateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectCreateProject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectCreateProject(ctx)
+	return err
+}
+
+// ProjectDeleteProject converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectDeleteProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProjectDeleteProjectParams
+	// ------------- Optional query parameter "tasks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tasks", ctx.QueryParams(), &params.Tasks)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tasks: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectDeleteProject(ctx, projectId, params)
+	return err
+}
+
+// ProjectGetProject converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectGetProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectGetProject(ctx, projectId)
+	return err
+}
+
+// ProjectUpdateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectUpdateProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectUpdateProject(ctx, projectId)
+	return err
+}
+
+// ProjectGetProjectTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectGetProjectTasks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectGetProjectTasks(ctx, projectId)
+	return err
+}
+
+// TagGetAllTags converts echo context to params.
+func (w *ServerInterfaceWrapper) TagGetAllTags(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TagGetAllTags(ctx)
+	return err
+}
+
+// TagCreateTag converts echo context to params.
+func (w *ServerInterfaceWrapper) TagCreateTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TagCreateTag(ctx)
+	return err
+}
+
+// TagDeleteTag converts echo context to params.
+func (w *ServerInterfaceWrapper) TagDeleteTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tagId" -------------
+	var tagId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", ctx.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TagDeleteTag(ctx, tagId)
+	return err
+}
+
+// TagGetTag converts echo context to params.
+func (w *ServerInterfaceWrapper) TagGetTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tagId" -------------
+	var tagId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", ctx.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TagGetTag(ctx, tagId)
+	return err
+}
+
+// TagUpdateTag converts echo context to params.
+func (w *ServerInterfaceWrapper) TagUpdateTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tagId" -------------
+	var tagId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", ctx.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TagUpdateTag(ctx, tagId)
+	return err
+}
+
+// TaskGetAllTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetAllTasks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskGetAllTasksParams
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "due_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_after", ctx.QueryParams(), &params.DueAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
+	// ------------- Optional query parameter "assigned_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assigned_to", ctx.QueryParams(), &params.AssignedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assigned_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", ctx.QueryParams(), &params.Include)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Workspace-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Workspace-ID")]; found {
+		var XWorkspaceID openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Workspace-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Workspace-ID", valueList[0], &XWorkspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Workspace-ID: %s", err))
+		}
+
+		params.XWorkspaceID = &XWorkspaceID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskGetAllTasks(ctx, params)
+	return err
+}
+
+// TaskCreateTask converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskCreateTask(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskCreateTaskParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "X-Workspace-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Workspace-ID")]; found {
+		var XWorkspaceID openapi_types.UUID
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Workspace-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Workspace-ID", valueList[0], &XWorkspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Workspace-ID: %s", err))
+		}
+
+		params.XWorkspaceID = &XWorkspaceID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskCreateTask(ctx, params)
+	return err
+}
+
+// TaskStreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskStreamEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskStreamEventsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskStreamEvents(ctx, params)
+	return err
+}
+
+// TaskSearchTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskSearchTasks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskSearchTasksParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskSearchTasks(ctx, params)
+	return err
+}
+
+// TaskEmptyTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskEmptyTrash(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskEmptyTrash(ctx)
+	return err
+}
+
+// TaskGetTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetTrash(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskGetTrash(ctx)
 	return err
 }
 
@@ -175,13 +1460,336 @@ func (w *ServerInterfaceWrapper) TaskDeleteTask(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskDeleteTaskParams
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", ctx.QueryParams(), &params.Cascade)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cascade: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskDeleteTask(ctx, taskId, params)
+	return err
+}
+
+// TaskGetTask converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskGetTaskParams
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", ctx.QueryParams(), &params.Include)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskGetTask(ctx, taskId, params)
+	return err
+}
+
+// TaskPatchTask converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskPatchTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskPatchTaskParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskPatchTask(ctx, taskId, params)
+	return err
+}
+
+// TaskUpdateTask converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskUpdateTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskUpdateTaskParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskUpdateTask(ctx, taskId, params)
+	return err
+}
+
+// TaskAssignTask converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskAssignTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskAssignTask(ctx, taskId)
+	return err
+}
+
+// TaskGetAssignments converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetAssignments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskGetAssignments(ctx, taskId)
+	return err
+}
+
+// AttachmentGetAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachmentGetAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AttachmentGetAttachments(ctx, taskId)
+	return err
+}
+
+// AttachmentUploadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) AttachmentUploadAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AttachmentUploadAttachment(ctx, taskId)
+	return err
+}
+
+// TaskGetCollaborators converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetCollaborators(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskGetCollaborators(ctx, taskId)
+	return err
+}
+
+// TaskRemoveCollaborator converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskRemoveCollaborator(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskRemoveCollaborator(ctx, taskId, userId)
+	return err
+}
+
+// TaskAddCollaborator converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskAddCollaborator(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskAddCollaborator(ctx, taskId, userId)
+	return err
+}
+
+// CommentGetComments converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentGetComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CommentGetCommentsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CommentGetComments(ctx, taskId, params)
+	return err
+}
+
+// CommentCreateComment converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentCreateComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TaskDeleteTask(ctx, taskId)
+	err = w.Handler.CommentCreateComment(ctx, taskId)
 	return err
 }
 
-// TaskGetTask converts echo context to params.
-func (w *ServerInterfaceWrapper) TaskGetTask(ctx echo.Context) error {
+// TaskGetDependencies converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetDependencies(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "taskId" -------------
 	var taskId openapi_types.UUID
@@ -194,12 +1802,179 @@ func (w *ServerInterfaceWrapper) TaskGetTask(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TaskGetTask(ctx, taskId)
+	err = w.Handler.TaskGetDependencies(ctx, taskId)
 	return err
 }
 
-// TaskUpdateTask converts echo context to params.
-func (w *ServerInterfaceWrapper) TaskUpdateTask(ctx echo.Context) error {
+// TaskRemoveDependency converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskRemoveDependency(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	// ------------- Path parameter "blockerId" -------------
+	var blockerId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blockerId", ctx.Param("blockerId"), &blockerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blockerId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskRemoveDependency(ctx, taskId, blockerId)
+	return err
+}
+
+// TaskAddDependency converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskAddDependency(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	// ------------- Path parameter "blockerId" -------------
+	var blockerId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blockerId", ctx.Param("blockerId"), &blockerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blockerId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskAddDependency(ctx, taskId, blockerId)
+	return err
+}
+
+// TaskGetHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskGetHistoryParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskGetHistory(ctx, taskId, params)
+	return err
+}
+
+// TaskMoveTask converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskMoveTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskMoveTask(ctx, taskId)
+	return err
+}
+
+// TaskGetOccurrences converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetOccurrences(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskGetOccurrencesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskGetOccurrences(ctx, taskId, params)
+	return err
+}
+
+// TaskRestoreTask converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskRestoreTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskRestoreTask(ctx, taskId)
+	return err
+}
+
+// TaskUpdateSeries converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskUpdateSeries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", ctx.Param("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskUpdateSeries(ctx, taskId)
+	return err
+}
+
+// TaskGetSubtasks converts echo context to params.
+func (w *ServerInterfaceWrapper) TaskGetSubtasks(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "taskId" -------------
 	var taskId openapi_types.UUID
@@ -211,8 +1986,185 @@ func (w *ServerInterfaceWrapper) TaskUpdateTask(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TaskGetSubtasksParams
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", ctx.QueryParams(), &params.Include)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TaskGetSubtasks(ctx, taskId, params)
+	return err
+}
+
+// WebhookGetAllWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookGetAllWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookGetAllWebhooks(ctx)
+	return err
+}
+
+// WebhookCreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookCreateWebhook(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookCreateWebhook(ctx)
+	return err
+}
+
+// WebhookDeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookDeleteWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookDeleteWebhook(ctx, webhookId)
+	return err
+}
+
+// WebhookGetWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookGetWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookGetWebhook(ctx, webhookId)
+	return err
+}
+
+// WebhookUpdateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookUpdateWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookUpdateWebhook(ctx, webhookId)
+	return err
+}
+
+// WorkspaceGetAllWorkspaces converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspaceGetAllWorkspaces(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspaceGetAllWorkspaces(ctx)
+	return err
+}
+
+// WorkspaceCreateWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspaceCreateWorkspace(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspaceCreateWorkspace(ctx)
+	return err
+}
+
+// WorkspaceGetMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspaceGetMembers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspaceGetMembers(ctx, workspaceId)
+	return err
+}
+
+// WorkspaceRemoveMember converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspaceRemoveMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkspaceRemoveMember(ctx, workspaceId, userId)
+	return err
+}
+
+// WorkspaceSetMember converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkspaceSetMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TaskUpdateTask(ctx, taskId)
+	err = w.Handler.WorkspaceSetMember(ctx, workspaceId, userId)
 	return err
 }
 
@@ -244,43 +2196,282 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.DELETE(baseURL+"/attachments/:attachmentId", wrapper.AttachmentDeleteAttachment)
+	router.GET(baseURL+"/attachments/:attachmentId", wrapper.AttachmentDownloadAttachment)
+	router.DELETE(baseURL+"/comments/:commentId", wrapper.CommentDeleteComment)
+	router.PATCH(baseURL+"/comments/:commentId", wrapper.CommentUpdateComment)
 	router.GET(baseURL+"/health", wrapper.HealthGetHealth)
+	router.GET(baseURL+"/projects", wrapper.ProjectGetAllProjects)
+	router.POST(baseURL+"/projects", wrapper.ProjectCreateProject)
+	router.DELETE(baseURL+"/projects/:projectId", wrapper.ProjectDeleteProject)
+	router.GET(baseURL+"/projects/:projectId", wrapper.ProjectGetProject)
+	router.PUT(baseURL+"/projects/:projectId", wrapper.ProjectUpdateProject)
+	router.GET(baseURL+"/projects/:projectId/tasks", wrapper.ProjectGetProjectTasks)
+	router.GET(baseURL+"/tags", wrapper.TagGetAllTags)
+	router.POST(baseURL+"/tags", wrapper.TagCreateTag)
+	router.DELETE(baseURL+"/tags/:tagId", wrapper.TagDeleteTag)
+	router.GET(baseURL+"/tags/:tagId", wrapper.TagGetTag)
+	router.PUT(baseURL+"/tags/:tagId", wrapper.TagUpdateTag)
 	router.GET(baseURL+"/tasks", wrapper.TaskGetAllTasks)
 	router.POST(baseURL+"/tasks", wrapper.TaskCreateTask)
+	router.GET(baseURL+"/tasks/events", wrapper.TaskStreamEvents)
+	router.GET(baseURL+"/tasks/search", wrapper.TaskSearchTasks)
+	router.DELETE(baseURL+"/tasks/trash", wrapper.TaskEmptyTrash)
+	router.GET(baseURL+"/tasks/trash", wrapper.TaskGetTrash)
 	router.DELETE(baseURL+"/tasks/:taskId", wrapper.TaskDeleteTask)
 	router.GET(baseURL+"/tasks/:taskId", wrapper.TaskGetTask)
+	router.PATCH(baseURL+"/tasks/:taskId", wrapper.TaskPatchTask)
 	router.PUT(baseURL+"/tasks/:taskId", wrapper.TaskUpdateTask)
+	router.PUT(baseURL+"/tasks/:taskId/assignee", wrapper.TaskAssignTask)
+	router.GET(baseURL+"/tasks/:taskId/assignments", wrapper.TaskGetAssignments)
+	router.GET(baseURL+"/tasks/:taskId/attachments", wrapper.AttachmentGetAttachments)
+	router.POST(baseURL+"/tasks/:taskId/attachments", wrapper.AttachmentUploadAttachment)
+	router.GET(baseURL+"/tasks/:taskId/collaborators", wrapper.TaskGetCollaborators)
+	router.DELETE(baseURL+"/tasks/:taskId/collaborators/:userId", wrapper.TaskRemoveCollaborator)
+	router.PUT(baseURL+"/tasks/:taskId/collaborators/:userId", wrapper.TaskAddCollaborator)
+	router.GET(baseURL+"/tasks/:taskId/comments", wrapper.CommentGetComments)
+	router.POST(baseURL+"/tasks/:taskId/comments", wrapper.CommentCreateComment)
+	router.GET(baseURL+"/tasks/:taskId/dependencies", wrapper.TaskGetDependencies)
+	router.DELETE(baseURL+"/tasks/:taskId/dependencies/:blockerId", wrapper.TaskRemoveDependency)
+	router.PUT(baseURL+"/tasks/:taskId/dependencies/:blockerId", wrapper.TaskAddDependency)
+	router.GET(baseURL+"/tasks/:taskId/history", wrapper.TaskGetHistory)
+	router.POST(baseURL+"/tasks/:taskId/move", wrapper.TaskMoveTask)
+	router.GET(baseURL+"/tasks/:taskId/occurrences", wrapper.TaskGetOccurrences)
+	router.POST(baseURL+"/tasks/:taskId/restore", wrapper.TaskRestoreTask)
+	router.PUT(baseURL+"/tasks/:taskId/series", wrapper.TaskUpdateSeries)
+	router.GET(baseURL+"/tasks/:taskId/subtasks", wrapper.TaskGetSubtasks)
+	router.GET(baseURL+"/webhooks", wrapper.WebhookGetAllWebhooks)
+	router.POST(baseURL+"/webhooks", wrapper.WebhookCreateWebhook)
+	router.DELETE(baseURL+"/webhooks/:webhookId", wrapper.WebhookDeleteWebhook)
+	router.GET(baseURL+"/webhooks/:webhookId", wrapper.WebhookGetWebhook)
+	router.PATCH(baseURL+"/webhooks/:webhookId", wrapper.WebhookUpdateWebhook)
+	router.GET(baseURL+"/workspaces", wrapper.WorkspaceGetAllWorkspaces)
+	router.POST(baseURL+"/workspaces", wrapper.WorkspaceCreateWorkspace)
+	router.GET(baseURL+"/workspaces/:workspaceId/members", wrapper.WorkspaceGetMembers)
+	router.DELETE(baseURL+"/workspaces/:workspaceId/members/:userId", wrapper.WorkspaceRemoveMember)
+	router.PUT(baseURL+"/workspaces/:workspaceId/members/:userId", wrapper.WorkspaceSetMember)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xZ32/bNhD+Vwhuj3IsO3br6i1tui5D0GaNgwIr8nAWTzYbiVJJyqsX6H8fSEo2bcup",
-	"kzktkOUpiigdv/vxfXeUb2mcZ0UuUGhFo1uq4hlmYC9Rylx+RFXkQqG5Uci8QKk52uU4Z/YuQxVLXmie",
-	"CxrRt+YlYtcCit8gK1Kk0SAMA6oXBdKIcqFxipJWAWWogadq28oJY9xcQkosCtI86dmkZ2IOKWeEi6LU",
-	"pAAJGWqU5qF6K6UlF1OzU4ZKwXQn3mbZN/8aGPmIX0tUettiFVCJX0sukdHoM639bcxcL5/PJ18w1gbB",
-	"DCHVszdNsLfj6QUDlu5feI9oWWKwO1DLPLYFy6RLCIzr1y5ypacSL/88p8YRl+IxzwzkYaYM3i0HlAZd",
-	"tuRq6RJxLpL6wYCiKDMTnasLGtDTD5/em8CsAmxv3x3X2tTueF4uQW0Wp1/U64B/92GSPCFcMD7nrPSD",
-	"aPBv5Ac0TMAx4VeJCY3oL93V892aOd3NRFf3CeaHOUpIUwJFkfIYzN1DhDWgmmeoNGTF9p7jZsnEQs+w",
-	"2TCeYXyzRol+2B90wl6nNxz3wug4jMLwLxrQJJcZaBqZEGHH7LRvYn1cgZ+0toxrUDfbmeasxaMZklLw",
-	"ryUSzlBonnCUJMmldc/a8d1ioyF7kfQnndFLYJ1eLwk7MIGk87KPr3rAesM+hL6bZclZe4x1iu1o7FIT",
-	"3y0Al/aCjN39DL6do5jqGY36w+H3Iumg2J13Be2NRNAtCv5T8N4N9apgh4XqDLL9sW7AMlzFuJRcLy4N",
-	"wR2cCYJEeVIaO81/vzXl8cenMQ1cIzWW3OqqXmZaF7QyhrlI8hafQN2QS5RzlOTk4myZ3baVOUrl3uod",
-	"hUehiWJeoICC04geH4VHx0bGQM8s6lqazOUUbQcyIbYic8aWwvgOtbvwWoN9vR+GdSPRdQPzVKr7RRkc",
-	"t+stZ02EPf30et19+1KjnEbnqo3/12SuXa2qOjOwn4zX/cWma6PzegrNVa2ZC2N/eP84mUlqaGakZWCa",
-	"LmVFmCiUcx4bUYM58BQmqT9umFFIozRDQF0ddqrZ39f1Sa/F2aV95exjY38YHj9CTTjzZrRoaoNITEqF",
-	"bK0CbO+rtu78xCooxbIOPOGg0efrgKoyy0AuaETfob6zwWuYKiOVNV2vja2uUTa1k7pGGt6hPknTsX3u",
-	"v1DXpSAr9GLc7LnC/j63GquIX4hzSEs0XlZGernSXExb3n1bLzkL3nuui+/bhxs59LoQ6dEqqK28GCWj",
-	"BBh2Ri8RnZVXeAwdZDGb9IbxYMiOd1jp08oGe1UbXGOmvlcktumsWgdICYu2YjnnSttGZd2vAjoIew9R",
-	"ikHY85XiSqEkZ6dE5JokeSnMiYjo/AbFmkZcCSj1LJf8n5pGh5GGTbOHUb9GGMiqPZAEeIqsXfc2delx",
-	"dW+d2+vjwOfrapvsabqs+YbctmgMYYpc7SC0m9vqyUW6o+jrnC32iO9+/nvDYbU+p5mzZrUlIr2D7twW",
-	"cEvD2EJiRJVxjEolZZouHF3Ch9FlrbTcvJhwTJmR7MZnAoKRGIRh0QSJ1b+1WnsNjNRJOGCFbVh9VoSn",
-	"rwiOcQSIwL+bA8uGKiwbfvfW/DljlZuQUnSHo22tOLVrtVZ438IMmO0z09mpf2AiOie1bXMooZE9MtCA",
-	"CrCTt4NAN/XBj+l3zsYmABtaMthx8nFA2sj/zIynzgxXxAR2sCK4c/Z9eO1L1JLj/EdWf/jonfTEubf6",
-	"vPIUCTRwMnJ/nwY+WKs7S4cOCHfb8DPjW6bjnXQvyh10d1/1Hs740n1mfFS+P864Xn8g3WtcD3/MuF7W",
-	"n1gfaVy3KbMzez2d47cYkZH+cEjiGUiI6x/9nkf1Zz39v+upk4edkupsGeNtWnmex5AShnNM8yJDoWsg",
-	"NKClTOufLqJuNzXPzXKlo1E4Cml1Xf0bAAD//2KVIkZGIAAA",
+	"H4sIAAAAAAACA+19XW/bSLbgXyG8C8z0XsmW5I/YDhoLT+J0uydOPLZzc7s7jQYlliy2KVJNUnE0QYDd",
+	"nZd9W2BfF/uw7/dl3/Zh/00DF9h/seejqlgUixJlS7KS8GJuWpaKxVNV5/ucOufjVi8ajqJQhGmydfxx",
+	"K+kNxNClj26aur3BEH7BvzyR9GJ/lPpRuHW8dT0QzlCkruemrhP1Hdfp+4Fw+BHhOWkEX6VucrvV2BrF",
+	"0UjEqS9o1l4UpjDl9WQkyqb1fHgWfseJU/gGp244nkhFL4W5+3E0dPw0ceRU8ArxwR2OAphwyx+6N2Jn",
+	"FN7Atym9YytJYx/+/tTY6sXChRlOShaU+kOhX+jcuYleT+4VnVZnr9lqw/+uW61j+t9PMKAfxUMXZt6C",
+	"PRFNnMsGAk79yh2WrD2EX8xVO3d+OojGqeOGE8fzY9iAKMaNNOGBSYQIk0GUbvO6h+6HlyK8SQdbx539",
+	"fQsQvmd//Tj0fx8Lx/dgV/2+L2IFi4EK5pvbnV2xt3/wpCkOj7rNdsfbbbrwd3Ovc3DQ3ms/2Wu1Dsyd",
+	"GY99z7YpycDt7B/YYRqID00R9iIPTv7q+5MmDISduBFJam5UDqyj/uGB1zpsHx7u9Z54B/tHbqcvXLfV",
+	"2993vVZ7393t9vf67W6n2+oedjo9r73vHfTa+91Wv9VyW4dWEP2/l5wZ/pI7Mz90upM0f0h7h532rrET",
+	"fpge7GXvgT/FjYjxRUg0ZyXng78Z70lMelv0YNpVDmY8CiLXE3EZQOMEcORuEBmA2A6kAjit+eAAPLH4",
+	"fQxUAND8vMVDeLdykBpE1sixG3mKGt9MhvCLfl3U/Q3IDFefYf0bmh33IM/MaKXWnaETStXGbDuvw2Di",
+	"XLz6ruH8cHEK/3539gKI2nPeiu6FQ0wrob8vnr9wvKg3xrfCNzHQXq8nRinxIL1BXT9048ncLSLwbCvr",
+	"RUHgdqPYBXZSXFUc8ar+fSz68Ni/28lExI6UDzvmDJc4HrElKceUs+eKRghnUoXNgMRwGgAvsbpFsWZ3",
+	"YayRMDZ4kfP25ix876diWTs0BUolCC4jG4a9HbggExxzoDN0QUREtI3wk9xU3ONt570v7gSPAIT3QHg2",
+	"HJCy6in6DpGvN3DDG2AsxOXD8RCh5GfxC3rAANiQq9HQriacOPInJwrLFAJ3DCJuPoe5i6OUpbN62/I5",
+	"TGOrG3mTEt4rPmiJIyHYdk6Ho3TiwKywOE8EAtUTG3jMEW7GMVA5jj53496AqBuEBc4oj6sfBR5t9r1V",
+	"F7XfqL3cxX4KzG95yotcog0fBbw8LkCgHjAg6LtBIvTc3Qjw2w1xcsSveXPj8dDEjKmAtf3U8lrLwue8",
+	"du6+6jcHLmge8vXbzqsodRJB6C0BSGA4ECfQDKhz4j1AJxdmPYXOPU5hYe3tngSzX4VgKqkr6myA2Ufh",
+	"ChSVmZqB5i+Suk1a0liX4badIRP8z+i5ojRYkGlcjeM4GocegA6cDV6fjNweqXOxGEbvp1BlYdZhaP9t",
+	"4HqtebtF0M9Y9ZuR9zWtWgCY8SVAF4WJZdVoiBRXfYoPOfSbqfQbYBgaPhiyrh8kFmnpATLCRzdwCApH",
+	"jTR3BlQSN/BBhocjsAtHbgx6LvDAxEacQ5EkoFmWwat+Nqf/C6gCl7BPYF/NpTO5XjWNbTcHwg3SwTOl",
+	"HRX309gMVy//whiSxmPRKN8orXjZNou8DSGAwo9dREl6E4urv71E/U8e8TVy2eOt/WFC7Gx6AUnqpmPL",
+	"WeklObxERw7MFKc3F/DH89dvX+HGZBtMX8/eVzlV+X5eaaCmkdN05eQB/t4EE6nTB1p873tjcxOTgnaG",
+	"Dp6um8zVdqcP+tMim/kaxKQbBI47GgV+z8Vvl7Gt8AUcLswwHFkYlfpJcSr5QlhP79YqrNv71+3W8e4i",
+	"wtp+sCZcDfPQbCf+WxKFF27aG9i06x+uXr9y6FdtNDp/vnzxzDk4anW+gcmBzw6TeWenX/EaDp523yAF",
+	"N47dSQ6QbJQFogTWDZavAVikh0/jFjryinPwoxEyzBgtaOL20TgGYRFEEjnI44hSwyG2O5rk3lJAg2hk",
+	"F1T6IXwPfMYjNVANGBIxCnwRfRgFILKQ4/EX+GJ8HfJKm1k0ctNBtfWlbnwDWqRaH1hn2zfbzo43Fid5",
+	"tU1/VXgZSIVxiS+CfiJnhOc1HLkM3DmCfBpJI0RKAt2GjlGvB4JchD2LbAQSfu5OyjX4CH0gpBS4gQg9",
+	"FwQckI6iP6CHOCUzFNZIvySoHQxBT4cl9sdBJXWe92e2Lp+tAV8AT1jJvQO6+eGiujktYiYAd1F8i+ZC",
+	"ERA4FhBW5DunaUqhWtBimDpfeUq2w4XjpI+Fk61ucqCyhktTU+X8st1O/6DXFs1db89t7vX3u83D3hOv",
+	"2Rad/q67193vHXhVbI6wkv9cQrCo5vldHPWE9LHnlcpKZgfBNmNvy2yIDV3TvOVcg5X13AdNKnEDBr/v",
+	"jgP2cHejD1sNm+NqAHJehEnG/JLbhFm68qHoddIsxOnRrhZD9Qx935DjLb+nsZsM4I8b5jzkFINPfuwk",
+	"4y690ODzGag4m52VM0BlttDndnqxKOfi8bjMp42Kxf7+3r6TPe7gaM3BCcqGDlrhd5eXb16eHsPSRd//",
+	"kFvWi8vTv3379vT0ry9/fPqXH5+f/Pjt+WsrS6VZy3wM/GvOn+yGJmON+rm3HvTbvY57JJqH3bbX3Ot1",
+	"RPPI3d9ttr2O2O3vufvdg14lvwew2r+DHlXi7T55dcLsHscQdCzQ5E7RrqE1i9rYGDHez/tFThLf3bmO",
+	"bifRXH5O52UAZOzY7KO/lAdd5fhPwtLT33bwJJ0/Pz85e/ljw+ETbTjnr19df//yR1Qzfjw9uXz54zcN",
+	"5+zV9enlP5+8bDjPXr95dd1w4J8z+IuOH/9DD5mfOUby16tr2q1kPBpF8bQzrQSRDFLZt5DKJh+h7dxS",
+	"92YZchmnMaF80jsSBwdPjppP9jr7zb2WB/Swt9dtitaTfq/dP2q54snyZDK8fVHuh/rS9Gk+TBIDEA+T",
+	"wmtaxawFPEwQPf4CktvNNB/cJPFvQiFmhqOk68jvAgfI6AoDbUY4YBzKuTgIl6wgtNnY6oLFeDsvaKLk",
+	"ohzsdCcyFMeRCvglBLCRjbHyNRFppb3qDfzAAzlQIpilnpXhHG4QhcFxh+4GQjpy1TjkpTF7HmU0GDhq",
+	"Lxh74lv9pooODcIviwtDr7HChg1c2DEhwmxjqm2KGj3XCqW3UCzL9oKHeJ10MKM6DETwOeXZOCu0VumI",
+	"pO+dfrcDe3DdOlo4qljBZFc4DGMBsH4fwMpnRxDlh/i+wE94KW+un9lN6NZ1+wkD+U8E7QoibyZbyAHh",
+	"He57B/0OWL5PXLB82/1W0+26/eaTjjhqu157v+NWClSPYPFhOifyBhumlWJFaECPOT4VR/D5XhyqUgaR",
+	"tHnKAJU/Z2fcFUEU3qBtmIMyh3/KXlu+ZyF2w9sSQKPEV67HDCEZnqEbohc9ir0s5oqC4k+8/cm2cwnz",
+	"MqETzpKvBx7mFydPcTTPhC5NkG0jdA8iDucyMrIkR4xwta0LyBl2s3ikMbJxP7vKckAkULyIBAq9gGC6",
+	"ZzoRh/iqyTYjhyhLMkJBB5DgWJbbrnwOViJjwDgG/QaVePtizj0F2rRbb9s5HycpbVFXyASGoqNXc9RD",
+	"zVEXZFag5FlCLZgWZ8jkm1wOoYa7gajtBqOB2xWp31PIbUL489YgoheTZviLIZmLkEyJ4dRPy1wM9JNJ",
+	"Y7lNuaIPzjV/PzfNFSEjpbaM++gBeVzqgVbqTXMgUFQTijgWeeVe71B03IN+s93d9Zp7Yr/fPHKfdJud",
+	"3p53IA77LbfdvWcSA+2Uqbg0lJosDzjTASX30nRTpnifSBU3MyEsxoK4c5QqzJ45e+pWFW0Z0Gro3ooZ",
+	"WjPRQ1fmLyFdRnGOzVJ6VS7XDc7DB0IKx0HgAHW+NzlVpngvQ+XGV7hdnIED0XN869mGzN7+0nQ55vcq",
+	"7bryEVRIDOOZSdlzPbG8pDAFwV8mczL48LUGKCvJ36uGkabmm1lpBr1r60QPWy5WVcIi3NGGecBlKPWM",
+	"97McnaSeAvogEM9kFjbh/YK5qZhrOUiUi7PCmUbmH0Gx7Vwjs+QsamCN5PBgG+HGfw/H6RKPmOA3DSIL",
+	"0ifRq7m7u3vkMEQNpx+QUITBQO/IekgdoFlBfxGk/NDvZ88TC8LIHQ9EH0U8w5rboLexL/NYY4HOTKtZ",
+	"LwCamcFcHmG8saETo9DPw4tBKGmbinDGzAmsED6P4aE5EEpF8BHZzqzEQ5UdWlAglmXVpNbbSxTcMhbt",
+	"+d62c/peAMkhQnJeKJ6Jh5dZolg52tg+y1g/3m2K7kIjQiW1VaUR/CoXOKUZxAJomTkUaZrGMFAwjb9A",
+	"hfjVcyfGNwDfrwSW/Jy5ANnQNIZKk82cTjJd830iAwqZDusj2UFML2M2Q9S5nClf41BsKoeHpdyxxNf7",
+	"uWQrbIbro4q/IZJWVQa2m3c8PKqHoQidH247b2Ws0k9zm63Mw9V7HO5hsF9yuCi/1nPQsQ0tmO9hxXhL",
+	"zrTZ4ThQt2frHkSmCmThkqNxqK9XEmI2kEdJo9fx+3nfKIgOhflfnG0sPoCmhHERMpD1LS7TQs6bwptq",
+	"A0/zUXppGaM8Y297EahLERAxACunPDzaEjHsMn0I3JlYpOM41BeOlMOeRyVTjv7UESQUA/hPYEo55eb/",
+	"pUTen4v4RsxOhqQh1pTIJ7tHB99sOydsNbIaxWIukdQiAu+p48urUJkfX0YmXK+JIqGoM69djpTYpLYo",
+	"xIMDHWVv2FjJNcdgryrJyBNr+BQ81KIJc4Z5RutmHvRliLf54FcWd/kl+CEauLyCKOcwMVOqliHj5i5h",
+	"o2XFXOgfLju2nUvOwQVqCAIeWMLu1ypa2CPoVZctVjFyjsnRNrYTC42VrsY8SwgFeHSKXj3UXUL0Rfo3",
+	"gy4IHmco/YRkzz9lZ383SgeNDE9oW9W1QBoPXC1nLpvDpJ1d4Oj49DwGwUnUKmjDgk+WaAB+z+6LVai7",
+	"DPPDoNPrXgi8TiVHlhUnrkjfLEtaWZoOrFzXtqTEnHqbU5NlqAtYDF8GhC/jaHwzWISW1FvY1YR4jTY8",
+	"WOOG+i1dUxGBCAvLBxHeCnEbTDKXyz2pr2yPN8Ha/Yq1kuVpIY9sQxeVipWYxV+bOfnlqgR3ojuIoltr",
+	"SrHOdIATwrwZD+Fgp2DxdoD0ihIFFKIWfonOoZmKBENmu+mXgQUK8mUby7DgzwnGNxIjBeNWiFHi9F0/",
+	"wPNDLuH5CaqHldhR1aINCjjKBtOu3mUVbZAALwSFeigXAud9VuOS5VU0oFPAEj2JHUT6nUqCJTMOs+Ek",
+	"Y7wkzlk328qFh6Fm/DvnctZ+eVVmDDmIipZRqkv2TgP5GvIig041kVmfOQiTcRcX0GUcRnJm6hrmqdgE",
+	"UtYr2DaKEFQnbsTOcSyeoYJTEs4fD7ucmgQv8GnhwILEcKSqVeAUsKbED6XWGCjtm36Gfe0J4eWx0nq3",
+	"feHaFIo9LCjVKuWkj+OgpKZYmo4QL/C/ifPm8qXkA0Tgo4gSUNFaXixFmmY73tmR32yD5rJDhLKjUkZM",
+	"9tnaO6yUAoKLyFFIQ/G7qZOfV81K7nRZOKSmwYfQYCJglrTsihL+5ozcCRYRYySTOQe4ALUNmjR7MKtP",
+	"1oLzL00pmJpX8ICbwmHjPXXPuNLmfH9+8qx59f0JFsaTRJU9lt1y58caeMkuYlVelbVpgJSbmMlzDLCO",
+	"KCOdOVwy7dt341ZrF4yxD/RBcARBLhAxgQreKGfw1EWTdv9ItLr7He9gz93FtKjutEZxAH/7ob7gdvAF",
+	"0jSTs0SXGWRaaseV6DqnIcrrBHdAyu4cmQKS4QBSYjTpgp0WoVMfDSAkLslO2FSuot/M4hlan00rM49t",
+	"B3VCKqkFlldaE3FNxJtDxEVCVcmjy7iWl022/MTSqpfzNAyL7u/30TgRgyjw5l9QbmyRCVexSiQmmykV",
+	"Igchof7QDalsJrKuIQYa42RNVUdDri2qlvLLLOx42FXDdZxJ1Vt7GpZz2uz7VcXUkyxWNJTPdxPKg07t",
+	"QqlPe/G9qFoeNP9YWW1Q3jFVFRTT+U1kItTVFJTtcAKyxRviaCQwnkKmzeA32umGfLPh3OBlPXoFuY+p",
+	"jqiSzzKmrzw2NCsVC5MHSc9aIv0sh8exn06ucJ9ksTkBEiA+GXNBHf7rhTrZH95eo05Fo1FBoV+zo0b2",
+	"D/OidRr2Iwumod1xJWKUeicXZzrt3vYLfEr4qfZ2a7vFxYVE6I58+GoXvtqVxXMI6p2sgnCy8zH748z7",
+	"xGBQvYkCQM/p+2Sq8jYdgFH2vIFcAGytfizkWEy0c+m2EAhJ0K50qOP3ccRl2vFxVSmZxb76K1fBWikW",
+	"RjI+PkkGGiEUl92gW0m6hhIS8daJBpcXcWIWDjeK1R3/PJvecwXHffydKhIpUbZl7uWWSTesqjKFUY2p",
+	"OeT/S1YJjo4MxL/FVZkdgqpQQi6RJOmPg4B0x71W26huT5p6VsyMKmyRkW1WpvOoRGDbKAi49QalHuwC",
+	"eqz7yOxJfYtuRWjU2YNhIRe19P8OS/5kLncWu8lXNiSCyC9zeto9YKD3WtOuuSaS5PKSMejw0R076GW5",
+	"Lb6l6Pb4bl1YPPxs1UDvXd8D3WmJS9Z57aT3+tpdbCWLUCprFrLg7dq733btmas0cE1jwRIXXDb9PuhC",
+	"9wCeKmtkZ62qBTpZ3UXpW8yd5BnWPsO7VAnzVoJ6iYssmd8QLcSATKHy8y/ICpLxcIiF1RUPRvMth40c",
+	"6fnZ7I3xC0x7YzMbr1LAk2Eiy6DSvubZG0ajVZMHwiTd4iLrgYE28QQdsXzxYkK1vI2rUdPsUgr8uxDx",
+	"dw6HlqM+Qx49C1dHXj9rZJKbfkbVfG4b8h8WfdDKT6bOWnZDYNOd4H/GA5pcOMu3l1I8d+PbRN7C4LVz",
+	"NrU+W9xlswB2oetCtv9P6Ud84NtCs5BsucVdOb3mojPFNVbsxPHuwb043m3NhPHTFyt6a1ny5cgSRbKV",
+	"pAnMvKNqye98lJ8WsBh0xe0zFb5G0cIJYe4wwjSEbFSSS6G405chEnIqsonAmDlV0PsBNsIznoFhfqZr",
+	"0y8gebKC9haxo3ds9XaBBL42CtZgFGRn/jgWgZ0K1mISPNMvWz4Pt8691zq6H9hH5hmrPdKpfNmlQHNt",
+	"YR9mTpd8gmY3EDdAx9REv76WUKXWjkFlSjapb37hgta2qze5UB81SrHIiiHpsz5dSuY8W1lhqpy4UIzg",
+	"UOpXhK1XUHipa9wD9z2nbCaJyreV2WtWacN+2o2WNlT67C+yq8Yc1KyGOvkOHp/yHmaE8dNCltW9Xj6L",
+	"6ch866LYbN2P/bRs7IcQsueGyOHEB8xjcqhDCKaaxCBjOGyUkSt2wJCHsUQinZq11gw+c80gSssZVy35",
+	"N0Ty1xK/VOKfolydLe/REOWmKAiwdHHmhSs3lflOpPxh617iJLfpuQY2Ru8Zo0/Qoj19VNcZ7BHzaerv",
+	"XIsYe83NyseW681jc5cY3W2AhfDwyfKQ83ujgQ1hht/DlBP3PfxONxqtKCrDm6frQVFc6+4KcIKnJ6ai",
+	"6BKgB27t5TCA+gZ9KnzziFgwDjUe5Mk3R61AYTObIynileTKtCtv7SSl1HvBA2DykyC4UKMfqBJWKtCr",
+	"uoMUkvDKpe6fEnUNKeFroly8kdTwL1ShqmVWXma9xKTQUYanCusVNpGJCvKgFNM5F+tCt6ZZhc2V7/NS",
+	"yeZqL/vltv2Xq9b5dKsxutRNQcphKxhdtclVc4iVcohnMlXNaD9VZBKmbNz5qK++Vg6w6DY+Z9LnxOnJ",
+	"uRLq3LdoHAbknsIx36qASMKXxAuOKkmg/KaMRy3gqMoWbXFUZVd8H+KoanxcpKFTbrdW2sqJFgykTlF6",
+	"uWL1W7ZaeTN9IV6a63BVMS6keG15XGgZvLa8b5aulGHBSWorW7TGaxb8qJF6hTGrcPRY564lR0kEZJbk",
+	"aMyzozaVbf+yQj//DJ3zhOqeZpnrNf3X9L+h9E8eljnEPxqXEz/H2Daa/ldm6z5OfHEG37kGrhMLTpCs",
+	"7dyaydZMdjOY7CXR5D3Nc3lZd74z+yIz3JIvThN7QP87u2/dZqzXbKRmI5seB5jqHD6Po6gSbVbeAfoC",
+	"B8GuuaXSOij0ZsHgF5eEqwNfXy/CM24q9EYMKg94Xasex9fU73kVyn/WRnnNQS6iHbvSv9rgluoqNKXw",
+	"79f6/vIF9TLS0PR5+VkSOKwKGOo6EtEoVVC9dkB39xAgGb/AHELJwWtuVxLE4171eYanZPnOR/h3gaAd",
+	"bj338OIIk59yeQSutsqWQoGF8gTMQhcwIxhuiwlBIK/+WhJywq/rStIy1HrctVWo9IV5a3ovCb3Y6L0x",
+	"S2PfbMpsrVrjqcMrNW1/FmEVO2HLcMr0XTLu2ympdZsqjydUTnHiy7vLiPXJIOIyGNhMQdVjm2YRHJjY",
+	"OC6xElvscYIwM2yx1QZgalus5sBWDlybjnWAaZbpmI8hTQsfrHmbFJMYkSKwZmuPvEt0PFiTaYTV9qja",
+	"It4foVq75IGiy4noqlUZoK6TRHGqcz+3nbfYh3lIPal0Aqm+qJOv+qMrCTsv/fA2Vzk4FsG377ZC8SF9",
+	"t8WtTPN1HrOuPvyWpAci0uPr0rKrIRf53bZIz+RW+cQrxNFe+AHVIaLXdCeqaw9uBF5SESUpmvnuxaVp",
+	"mtOVp4tpqK+5/iSuRgLhmV2yYeOpzcWfQUwE4wTO4JsSiLBRsm6PNQOkKo0tqoIJGBTFupKThtUPK8Cq",
+	"Go0tHdRz94M/HA+N3hEMcBrJBWw7z0XfHQcpfbffKgEy8Id+OhtAj+dBvkTFavG9WIi5RZWk+a92sduE",
+	"ZXtHLtZXBmaRUMcBkJhZEUyTenS4U7z3o3FChFyGojRZJfws30y8a+s2E4E0RF4Z5Aa3YpI0uH1qRANd",
+	"0JCmmhu822q+26Iq0Tih4Oq/skve1XiEvcKoipwIZE3y2A1vG8oP/is2tB9zC59fXVmzB2ub5s+On5lu",
+	"w5cr5NXMZmlwdVT7biXcvOwheyVZCcgij0qjaSZM979pqcfMq6L4mNq4UvMkIXuvazZFHZ+jYdcPhTHZ",
+	"VOl4XMoxik/XD5Nj3XyNKCs9DtJjvgzYybfeoVbmo4BkKVsAtr3o00Jm78YCxenTCZ0E7sJWFc5SMJmm",
+	"1AfzeKmjVFkW/c0DD7QImmwYLzPT8RZkggg9pEZzIWCyKotelLs5sIdlaKhe8Cu1J3sI8KvufWyD3peN",
+	"mO99dcHs5mxZ03WulDorG2TCUE8/YJDUGV0rouoygaopzT9lE/SwrBm2kuhiF4GAK1jqPgRZletk56P+",
+	"fOZ9ctC2luzuPlXvaeeYn2db9y/Nt+oVzbPnFeXiUj16ch18Kxl7WVwrlTNTU19FihCMu9nEykilxc3g",
+	"jnGWZ0+zZnKsl6nnsh6PcqVGu0X5jWyPaLYvbB1mzbqwbcGWd7jvHfQ73ebhE9drttv9VhOMg37zSUcc",
+	"tV2vvd9xW0aJbKMdudMmvlQOBbNKo7N22WVrCcrBYf+wD+cLoAjBoByJXbcpvJ7Xbe/39va93RJQOluf",
+	"SOFfUeIUBeSVSpQv7olqhsWyePHMOewcHjoBaiHyQg4q7qR6UEujhGqGIq+8m2Uc5OtrUicPtmr+I2sq",
+	"34rJD7+d/Rb557+dTF49a92dX7U+vPrnv304fx79Hf7/7vxF5L989sMIx7z6bTB8/d1Pg5++e5O+fu4F",
+	"P8HY87c/3r28DoLzzmn609vL33767uzDq7fnrVdv//b3s7CFr+wckFb37X6LO4g8zVkiFQp2LiUFF40v",
+	"qeoB3/JDIAS/vtS0QT4fzYpX4/nJCSEvElyVh1inI/WHXDEh1USi9pvY3fRGJDxznAA/NLOMpswaMjMS",
+	"1c67XGuzOifMzq2mZ0I1NggFqk+ug40wnEgWYgRdgztH2BwWKuWJysDN9Fe84U48YIJxh8Mh2IrYSCUl",
+	"V4s0OJRWlLh9rMONvZmFVAb5h6zZEnqdcLIYS+FNsnYMrDny0ZmMu+Ud9Ha7bdHc6z9xm3vdQ9E86nW8",
+	"5r7bFk/6u92j3l6rTMc580CviABxe5PmX8VktpIz3bHVbM1UwQtQq4qPG9ZROL3+HDtUhGzuduzRaMuy",
+	"yylBGkXTJhWHnNh6fl9InQe9EthhFrUiWBarPzkXqE9sgCaibF80vOLApy5lNkosUsgMX+LyNBJupUwe",
+	"Cm7LxKdFC5RhKsB7MgpqNWVzKg4SqkVZE2zZ9khrFFSF0Cw8uJZKgyZErMaEUz3QCgByzQTZrolF+Veg",
+	"yy0n4OdnLIMEuVk4GMuIkXLjOp7f74sYuZYirRWHA8+m4IoFQUP8zgJOQ+0WEMmNj0qhIa+T1AcdD/Bo",
+	"FEc3wFyTWhWekX2KiSWyovCUOqwDiTvcnbI0npjrAGNra6/xWolUwDouyNe8wlM9le3pubkod1GleHAI",
+	"TBjbgKreyPJ57e03ynyg+KHyyXhGjUzrdpPMc9mdON+dXjtyVR/Jf+h9ekqD8bF8lRGGg1wV2GGQl2Gm",
+	"z6Ab9MTpgYTGrq+oX8dCIkaSieqXbpI2aYWgf6nIDAwU/nuzbSzl6g79JBFesQo0tWFVaM3lp4EzAYxB",
+	"dIPw4HQAg9z8bec1mhF3fiKkho7ng07nWAHmckNcfnVDBuXYHqFT5DUlg2gc4EtcT1eehlO5cVEbVoVV",
+	"Az8UslEsqTlU8jFOAfnwZQOfA73o24mRqyogrZYNYxKjw2LJTNTJXfbfzRYgtxl2lGDrcpS4G0d33HcT",
+	"NDDfPLV858UnB62Dlvq/vU6Zhp074MX88PN9r6n4kDL9NfkYc2wK+PmxMw3nu5DGHysieRcibh87H9/B",
+	"8Hdbx++q9JRsv9tqvGNVjx55C1xFWUYYPaKftY/znVTV34Xvwtl+sWJyp8ROVVvYxj8M/WB5qVV5usxM",
+	"Oo4ET/FzcrrlSXmp/Lxk/vrW25cviZnn5RDf7p7K5HEC0/UG5fKYflbZpUjCiSm0ZqT7bDugAMsQ+xC7",
+	"ICgdDI3fJvIih98NMhP/UJ10QKn1WCa55FkCk3mAkuAHd+SCdBDsRuH5gAljx3PiCBjSTig+r4L6PCAG",
+	"EfzeDXvCnrHDC6yUscNDGVoFAQkwumoI+gHvT0mI8veZWbAPdDp9BqknD48N8p5PBffO8ct7BvfuE737",
+	"f//pf//bv/6vP/7xr3/843/+8Y//+8c//usf/+W//9v/+D9//Of/ttoAWn6hDRAwiURDp+/HymOxDGnG",
+	"ZOkQatRemFpurUFuSc46T1hRYctZ9xcvRDx0Ef5ANS9KsiiNMnh4FpswOKXsA/lzhVuDVGcT6cL/Su4N",
+	"1og71RMCESaHVIU4YMW0aRM3G84wSlJpiWtM9iSfL8k7LkHbR6yVk0v5yBNfTR5fUxUOC+8tZfHKkzaL",
+	"y5+risdS7TaqHm87RAcytTCh8GmX4mIcWh6DjRJkjrDROL7JtQQGbRnNGNhxoDE/8qzUpi65zw+aXxfc",
+	"fAitXFfJhTlc/nIrTp9g/Bgv8eczK6egMvbwre5qKqtMo97pZh1PszkQL6UfEKMKJQnhbtJz52VnartC",
+	"/jL/BgP2Ws4tAl2d6LtLsIGSdNCx946j6sqxbwLd7ji+MQW6itl8BoHug9U41Zx5l5K17EkG/Sbp6A91",
+	"21nLE2CLWLMy9RfNRh+rLZqMXaw+UqlzeAZubKZzIMgN3bceAc96Ai/xop7Ec6MY/Mrv5xVeKkP8xBgo",
+	"dIirlQQqsaDduddq2x3raqmr2jDy/L6vaBsd9ncU1HHzovWC3Ph8/0GJ3hXtR47ZEBKArE3wvXTvDelQ",
+	"M5Zaw5hVCcMWdyythcEq870lOKe9vV+jDP8CblWstt6HPfvqhI9MVvzIZVyh+mBJ0BzHlB2gqNA4+AZl",
+	"NIzRBW0QpU0/mJfMXac/l115h7NazZ336YlrRmovO2LnoiVtrKkzH2Uz/3D1+pVzLsCaci7IJ/1nvL/x",
+	"ZPfo4BtMrKCfjR8Ojlqdb8zALIaCObmRDgCDOaDvotlBqQYnlNNEl3FAo4dlYIoDK/UjGYlROhWFP1Vm",
+	"iIvKFF7elH2z/USPk4K3QZF+tAF8yvgBezHq9YgJ9AQnccCmwiKfEuVfvCnkfOzw78SPsUUopZzhW+WD",
+	"9rgTbcb9BdBI8p41SZ8vx9SqmnLcpB3+pwIj+Bl1CdxlzmSjrT/e2lE3fGX0SeY4yNuxuJ/0EJfSMx7j",
+	"62VEiZU4AYJzwbogUKUJ8xBprwzoj8U7ZfJiWzgOgk+LiHOicQXCmgvnzEiwVklc5QnWjyjul9W6igPM",
+	"U/V7Ovv76y3go5mu40W9MeVtUUg+QK6itIHaKfFFOSVYlr73xZ1Km/rM1L3luE4Y7VMl2FDHSB3N+1fu",
+	"ROHXZxF5WkLmE+xJXpYxL7keODoJLoGulZHay1LiZdlr799vU/bNTZGPc8axTgqcIbOpps0MPSTP9RJd",
+	"zAS4rs+JzStBONYWFdfSr6WN6twPezqd2bLNmm7yJhzFEUp3qviEYZp0sooFG8aEuiJdG4wFg/ECzDKf",
+	"6u+w7jXLerSVrVyGRaZqZmF0KjeqJ6/Z6poB+RkwAZ3nsNpmqgjmfY0z3pDaOluddbaY1fBYhT5re2UT",
+	"7JX6Vmdtm2y2bVKr4HWgc5669Wa2klVMoNqRFd1oc6wa2DlV8HCZiKQY9FG1VlVJWKrAH4Fw39MF4y6I",
+	"ZnMoqxOkbaHtAmwQXeBRnE8uwmQGGELwkN+eL2cyRvC9/x4WUJwmbE6E8Cj9waanndCMD4niMkwr09RW",
+	"qNOcyNPdMN2GlMusWCEfzZej1Sia0uZ8aqC/iV109b0XBYHbjWL+NWQ0Xod7VoM5oMQakNqoZJrxtloT",
+	"+kw1IQWa0nhMrPuKPbP30n5Wmet2Z6g8sDbpm+WbIbVuM12DXuoGC2s3w5nFJDD9PJF5TlLZUNdVFYPM",
+	"awQwy0BVirdpIRZ1hyfPMfopzSeQvVeHpRXjjaUsrMesVndZx1WSbPlV+7tmZ6+vOuc0hijwyLuVXYus",
+	"E67qhKtNbclsYDPoFcBBuGLbAqwwTd3eYDYrNC+cAXdxVYkaqhLv461+nsW4YVAgpSVzvxMNNvJAYw1f",
+	"GQ/Mjq8y/8s2ax7jW5orN1fXhEuMvHkDX8ottJU4Uc/JIbVHt2bvXyN7zxNrga8b5F9eNpjZI3nr+lQF",
+	"K8oF+8oqsFyXeCfo7o3BtRN954gyWLF6GHxmyFg88FxZogGaoHBsXI5WN4xRGQ+GYHnKVc/8oYtqGr74",
+	"4vkLnTkmK7X3emIEE207p+qFMNWYflb1VWR1YKxg/vs4yoSXVtG1/LI4CzPp8maEFciyvzdN2JQ5C4fj",
+	"IPUB0nQHp2kinVT31rlTy193aVxTwhWJ7QUetz691fQ+zCBg7DL7INalTWoH3KIOOGSP6H/LWGp3nHIB",
+	"Vmagn2kg8n5n194t0hqVq8A0LYr6J7MZeD4m6U6oSmQaRU7gxjfLTmorgCWLWPEPCSCyqghbDu/ScgRn",
+	"C0dZvUDt6PoTAGlLkA6FT54xgIeABbzBAIcJba0EFpVA1jMMpW229mex7XNa2hxHp8LOJN8nIkuZWI37",
+	"8lkOxIX0KQnb52/DmztY1YrPK+DmtstaKdwPlWqV3FHRW5BmXm3w1gbvZhu8BbxewJWZe3bnI3KzObWA",
+	"rtJoxEwua9yoqtQohpjne5yNQuVw3DDP/J5OB+1xbMTNGFX9nGEigvclicN0aVWYDHHz+GFjNgi9PPAW",
+	"GPhQlsyTLUVvzG2U+19fLvvsrSYu86KLUU0LzuWIgRzqrEwcRHGeWdTiYWaMnzlufsvIc7jgxZErZJJJ",
+	"CaundEVd/9poCiPTHOWVEtmmJKcbv1ad320eU0RdGl2ai+h5Nd9/pHxIE/az8L2//nzIPBubr+8vOTiX",
+	"eTLNBHm85SRxeU15h0Rs2bW5XB8i1a+lzkD8YjIQc6wc/tX9Jku4aG3nfQm1ukkKmsVAXeXDrWLfDavn",
+	"qajBJobDZ0ENoPHmPQDhD8V0kspzWS1ZP34rxAgn8GNnFGC3NNVqgr/DOw3bzlvdQVo/l2sinW+v2HPj",
+	"mAsfYfNq1YmJdsPs67xkh5tsk0Q+t+Gm5cpU6Emhd3aD2lIUwH49crHPrWyTnbogRrIAt3naclNHsXjv",
+	"R+OEsLKsAC5Ntux+TkvxWw4XSTyyUeRU1tHqOrvb6bK0uftOlc5UmiHVreDrVvC1K7tWcaZd2Rm/K3gp",
+	"FO8sz9qiInCsKHN/Q1ORqZi2NV9paDhcpxX933wnKGmQIqEALFEjuG3nMz3os8iDeoiJbqx63RlQWs4W",
+	"8VX1vrS2CF8av1cYSBd468SnmuvXXL/Qxjhj0jN4vcWo9cRIhJ4Ie74wDVtrssRzc+xCPLcbRL3bLyJZ",
+	"omr/oGuj6TNY+7QB9S2vmql8lqokIzLhcNZMp5LPzGQvOx+ZDRSzIsqSETTDmWwgu2lUqdOhaT+Zo3vq",
+	"rVl9bkK2q3VmQl1aakF2bCDPKnMTvJLX1Cy6JDPBM1lltVyESypcJBWUjG3GVIoCELwrHF15Hf7oox/z",
+	"Lopvc54AzPKmLgfbzonnyWKW4gNIDvxsHCOZhpi1rgIYnOhQlo9Qs/4Vs34jIXfpoXxSdv00EUF/TcF7",
+	"DuhF48DLv70WbLVge2w7wyirZXJZ1TduycWEDJbL9MC+OXTkTnrrKfU+D4Rajhcv23jeHCFuMa5kxY1q",
+	"+QgyrZCKAZk3sOfnJOQKHsZaaShWGCJ/PxXHxEYySmXAL7lHLZayGuBlkJT+9TE0IbadZ/kyOEM3HMNe",
+	"8gUSDJdy1i0XWczlOsjnHinVAbMw6Rt1DCVXjL7XP39O2Q4qC7VOdtiMZAc8diaUyvkOJRS/vpwHG30+",
+	"MOVB0prKeDi/PquzEur4VO1K/nyyEqa40gK+ZGo/h/WgrfkKF5gdadys8IB791JgSKx7oJrD3E//oPWT",
+	"CPURunzRFekd1uCkWe4i416FbGCe7/ZhFfnnMPL+ZZ1lj73PrqQzrnqTCjln5/XldaZQtcwoIVgp14S9",
+	"JkIXjfXViJ9XEVZYuBl0o3HM5fAbGUZLEElZAOxmr0yDK0xnT02ZwbW/pvbXfKFVnnMkS3Qcu6BTS8qd",
+	"Jhv+eh0uG02LrK7LIg53cRTesCXekO4rtttDMAsjsLQC0U9NoTmsdZ+i7nPOERqzOobp46ik+2QtwKoU",
+	"UvGof5gUYsajHJToR8g7DAHnJkZTsbzLDs9023kFAgXDOUjgYH6VKD7fifS1AWZl9afQI41ulaoOZ4/m",
+	"Asntm3KDNDS2t8lP1G618p6R9jI8I23TM9Ke6xlZiwMi246qDojxCKZBtDF2suEAoWDb9cy7iFmcIK9k",
+	"Xx2JhBiCRFfoEiNUBo7RWWg1qnCia1CaVJlZxoraav/iBL1S04r9H2OUG1TwRfO49XR18CKRKGf6OK4l",
+	"taUtKHqIZWFEG/eaPsDKrgtgzyg3Te+FLeeNBj2kJRS/ZVMTbO/flIm1js0y52t+XYVf04JiNxmsjHHn",
+	"3lBztOn8MCIdZX7o8BhvWBXWJYVUWR++U8+XRofU3MzyjdPSb9s5AUvzTrZWhQHcPVFGlAqGCgV/ZfHO",
+	"LBMNq3dKM4pWwVHnyOmPQUEX6OhNnpJ36c6Jx4FqNJ/Y3sJmuIJ42wFydC7eXKOpe3Fy/ez7XKYb+65w",
+	"GiqTG+kW0IZmXN6Q+UrJ+g02ilboHublb16/v6wQBKKxbmzM+7w004M1BjpJQsksPHjsvLg8/Ru3kZT7",
+	"sT7jg8mQCtTl4Ku9sLUXtjbOauNss1QZ1DTYKz3ASl1S3bivRZaMu/TNvDuPV2rcQhYZDEX7Y71ZXJci",
+	"IOkFgjQax9JtKYZdQVxXYDFvdmJKS2rb6Q38wANIeZRU4+SCMR+Qs+AC+E9Q4tXk2/titl9znnA+k5Ns",
+	"4BVOThbINqVu1Vdn3nymmTcmDs/KurkT3UEUzeCMb3kANr4Lgrdq9DooV4JWOQABu/anxFEL+joItsZ/",
+	"C/7fZViqMF7hUnkFnEtxA89iSwq80hZ6o8hHmY6eCdSxUcC7jmwWf/H66lpZauRhzDXu1XluulBKd2IU",
+	"eH8uAv+98p5ggBY2mpwfIK5jXyny4gNvnw/b0XV7t1G/39AZ93I11NnMTzDR1uOYGpq1GnYsJpjQ9KhC",
+	"NOx0zUVm5B9bq3EJ3JmvWnc9G81FivgmV73iejbqsACH4YSz1pQpqFoufG4fIOLEYESKOKkTiGuuuIJ6",
+	"MepG1p2m8yJjNLWBnY/y05yWFlzANMkxpXxvcuRIeM2J7wcrzlfGjXi+jBstYIRlS7MYYHo1q790q5iK",
+	"J2u7FplKbTbYzQa1c6uwHKxz12wizyaY+uawicY8M+Hzod7WOpSME80Yo+5vole7DmoesMk8AAh4LgMY",
+	"URZFMQ/D6GXS90XgJVPUncV5ORKM5gzowO/lM3SPIZHVRic4btu5KKgO7NDQZo+amg0oWAd+h5cSuX2K",
+	"CHkYNWcu0zo4ULnRfGtlRtnjBGkrGGU6OlsbZbWAqAXExggIZhhVbMkovk1Gbm9GpdG3aoj0LmdPrMW/",
+	"rF5X1cOcrSiLs1N3maGQ1wgafMmDXX4kBWoPy1fkdzbxVxOFxrJy3zP7ZxCT9Ggnugszz3Gx9HqDSrF0",
+	"BaxX8IUeinNIVCxqOmpe6e/VQK1Iuci/bu0+34yyLVxeb/GK/b76PcgIVLU07uaO905qBaPmSWvy+hrU",
+	"bmNLeWm981F/pjoMxFCqyfBzOXYxE8qAzmZEZcBsfr1wDSzvRFXFQu5xYUcs7dV/i/ywbq0+x0bIOO+K",
+	"KvxlJ6Qz+agKaq6NpMo7VDK5ZlHl6SoGBSyHX1XqyM71dA1tXt+Y0FMaRWFQI8v1ZJevyuLyPCLrWaIK",
+	"bpdqYwzAudLZNo9rzql9q7XNR2y+zruXL26+lOIr+kDzSGmcrywM5Bhd39dTAFfxFw1jncQ/K4nf7K6d",
+	"oW3Gu11ZIkHn8q88f3+KqRQEP3KZoRtikTm07ZQStjQRKYlmJV60zISN1U7XHrUqVd3lZslO8/OkYEmd",
+	"9xPPrNcqr8Hp52Y0nee3W+WdxMQSeUdTZEyRYjvlIu9KmQm1vFtbqCdvlTxSyGfaNJplCq1CitvQlHM1",
+	"9ak+duP5WqLXEr02er+G6u9YlUIF0nT0qIrpSy/CN9tE5suoB5B5eIMrGlGHQB4Lk43jAAYM0nR0vLMT",
+	"4LhBlKTHh63D1tanXz79f+V33A4qsgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/idempotency"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+const (
+	// HeaderIdempotencyKey carries the client-chosen key that marks retries of a request.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed is set on responses that replay a stored response.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+)
+
+// IdempotencyMiddleware makes retries of non-idempotent requests safe.
+// The first request with an Idempotency-Key is processed normally and its
+// response is stored; repeats of the same request within the key TTL get the
+// stored response instead of being processed again.
+// It must run after AuthenticationMiddleware because keys are scoped per user.
+type IdempotencyMiddleware struct {
+	repo idempotency.IdempotencyRepository
+	ttl  time.Duration
+}
+
+// NewIdempotencyMiddleware creates a new IdempotencyMiddleware with the provided repository and configuration.
+func NewIdempotencyMiddleware(repo idempotency.IdempotencyRepository, cfg config.IdempotencyConfig) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		repo: repo,
+		ttl:  cfg.KeyTTLDuration(),
+	}
+}
+
+// MiddlewareFunc returns an Echo middleware function that handles the Idempotency-Key header.
+// Requests without the header are passed through unchanged.
+// A key reused with a different request, or while the first request is still
+// being processed, is rejected with 409 Conflict.
+// Responses with a 5xx status are not stored, so the request can be retried with the same key.
+func (m *IdempotencyMiddleware) MiddlewareFunc() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			values, found := c.Request().Header[http.CanonicalHeaderKey(HeaderIdempotencyKey)]
+			if !found {
+				return next(c)
+			}
+
+			if len(values) != 1 {
+				return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", strPtr("expected one value for "+HeaderIdempotencyKey)))
+			}
+
+			key, err := idempotency.NewKey(values[0])
+			if err != nil {
+				details := err.Error()
+
+				return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+			}
+
+			userIDValue, ok := c.Get("user_id").(string)
+			if !ok || userIDValue == "" {
+				return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", strPtr("user ID not found in token")))
+			}
+
+			userID, err := user.NewUserID(userIDValue)
+			if err != nil {
+				details := err.Error()
+
+				return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+			}
+
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				details := err.Error()
+
+				return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+			}
+
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			fingerprint := idempotency.Fingerprint(c.Request().Method, c.Request().URL.Path, body)
+
+			existing, err := m.repo.Reserve(c.Request().Context(), idempotency.Record{
+				UserID:      userID,
+				Key:         key,
+				Fingerprint: fingerprint,
+				Response:    nil,
+				ExpiresAt:   time.Now().Add(m.ttl),
+			})
+			if err != nil {
+				details := err.Error()
+				if errors.Is(err, idempotency.ErrRequestInProgress) {
+					return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+				}
+
+				return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+			}
+
+			if existing != nil {
+				return replay(c, existing, fingerprint)
+			}
+
+			return m.process(c, next, userID, key)
+		}
+	}
+}
+
+// replay answers a repeated request from the record stored for its key.
+func replay(c echo.Context, record *idempotency.Record, fingerprint string) error {
+	if !record.Matches(fingerprint) {
+		details := idempotency.ErrKeyReused.Error()
+
+		return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+	}
+
+	if !record.IsCompleted() {
+		details := idempotency.ErrRequestInProgress.Error()
+
+		return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+	}
+
+	c.Response().Header().Set(HeaderIdempotentReplayed, "true")
+
+	return c.Blob(record.Response.StatusCode, record.Response.ContentType, record.Response.Body)
+}
+
+// process runs the request for a freshly reserved key and stores its response.
+func (m *IdempotencyMiddleware) process(c echo.Context, next echo.HandlerFunc, userID user.UserID, key idempotency.Key) error {
+	writer := c.Response().Writer
+	recorder := &responseRecorder{ResponseWriter: writer, body: bytes.Buffer{}}
+	c.Response().Writer = recorder
+
+	err := next(c)
+
+	c.Response().Writer = writer
+
+	// The client may have gone away; finish the bookkeeping regardless so the key is not stuck.
+	ctx := context.WithoutCancel(c.Request().Context())
+	status := c.Response().Status
+
+	if err != nil || !c.Response().Committed || status >= http.StatusInternalServerError {
+		if releaseErr := m.repo.Release(ctx, userID, key); releaseErr != nil {
+			c.Logger().Errorf("failed to release idempotency key: %v", releaseErr)
+		}
+
+		return err
+	}
+
+	response := idempotency.Response{
+		StatusCode:  status,
+		ContentType: c.Response().Header().Get(echo.HeaderContentType),
+		Body:        recorder.body.Bytes(),
+	}
+
+	if err := m.repo.Complete(ctx, userID, key, response); err != nil {
+		c.Logger().Errorf("failed to store idempotent response: %v", err)
+
+		if releaseErr := m.repo.Release(ctx, userID, key); releaseErr != nil {
+			c.Logger().Errorf("failed to release idempotency key: %v", releaseErr)
+		}
+	}
+
+	return nil
+}
+
+// responseRecorder copies the response body while it is written to the client.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped writer for http.ResponseController.
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/idempotency"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=../../domain/idempotency/repository.go -destination=mocks/mock_idempotency_repository.go -package=mocks
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const (
+		requestBody  = `{"title":"Task"}`
+		responseBody = `{"id":"1"}`
+	)
+
+	fingerprint := idempotency.Fingerprint(http.MethodPost, "/tasks", []byte(requestBody))
+	storedResponse := &idempotency.Response{
+		StatusCode:  http.StatusCreated,
+		ContentType: echo.MIMEApplicationJSON,
+		Body:        []byte(`{"id":"stored"}`),
+	}
+
+	tests := []struct {
+		name             string
+		key              *string
+		handlerStatus    int
+		handlerError     error
+		setupMock        func(repo *mocks.MockIdempotencyRepository)
+		expectedStatus   int
+		expectedCalls    int
+		expectedBody     string
+		expectedReplayed string
+	}{
+		{
+			name:             "request without key is passed through",
+			key:              nil,
+			handlerStatus:    http.StatusCreated,
+			handlerError:     nil,
+			setupMock:        func(_ *mocks.MockIdempotencyRepository) {},
+			expectedStatus:   http.StatusCreated,
+			expectedCalls:    1,
+			expectedBody:     responseBody,
+			expectedReplayed: "",
+		},
+		{
+			name:             "invalid key",
+			key:              stringPtr("  "),
+			handlerStatus:    http.StatusCreated,
+			handlerError:     nil,
+			setupMock:        func(_ *mocks.MockIdempotencyRepository) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedCalls:    0,
+			expectedBody:     "",
+			expectedReplayed: "",
+		},
+		{
+			name:          "first request stores the response",
+			key:           stringPtr("retry-1"),
+			handlerStatus: http.StatusCreated,
+			handlerError:  nil,
+			setupMock: func(repo *mocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().Complete(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _, _ any, response idempotency.Response) error {
+						assert.Equal(t, http.StatusCreated, response.StatusCode)
+						assert.Contains(t, response.ContentType, echo.MIMEApplicationJSON)
+						assert.JSONEq(t, responseBody, string(response.Body))
+
+						return nil
+					})
+			},
+			expectedStatus:   http.StatusCreated,
+			expectedCalls:    1,
+			expectedBody:     responseBody,
+			expectedReplayed: "",
+		},
+		{
+			name:          "repeated request replays the stored response",
+			key:           stringPtr("retry-1"),
+			handlerStatus: http.StatusCreated,
+			handlerError:  nil,
+			setupMock: func(repo *mocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+					Return(&idempotency.Record{Fingerprint: fingerprint, Response: storedResponse}, nil) //nolint:exhaustruct
+			},
+			expectedStatus:   http.StatusCreated,
+			expectedCalls:    0,
+			expectedBody:     `{"id":"stored"}`,
+			expectedReplayed: "true",
+		},
+		{
+			name:          "key reused with a different body",
+			key:           stringPtr("retry-1"),
+			handlerStatus: http.StatusCreated,
+			handlerError:  nil,
+			setupMock: func(repo *mocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+					Return(&idempotency.Record{Fingerprint: "other", Response: storedResponse}, nil) //nolint:exhaustruct
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedCalls:    0,
+			expectedBody:     "",
+			expectedReplayed: "",
+		},
+		{
+			name:          "first request still in progress",
+			key:           stringPtr("retry-1"),
+			handlerStatus: http.StatusCreated,
+			handlerError:  nil,
+			setupMock: func(repo *mocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+					Return(&idempotency.Record{Fingerprint: fingerprint, Response: nil}, nil) //nolint:exhaustruct
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedCalls:    0,
+			expectedBody:     "",
+			expectedReplayed: "",
+		},
+		{
+			name:          "server error releases the key",
+			key:           stringPtr("retry-1"),
+			handlerStatus: http.StatusInternalServerError,
+			handlerError:  nil,
+			setupMock: func(repo *mocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().Release(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedCalls:    1,
+			expectedBody:     "",
+			expectedReplayed: "",
+		},
+		{
+			name:          "handler error releases the key",
+			key:           stringPtr("retry-1"),
+			handlerStatus: 0,
+			handlerError:  errors.New("handler failed"),
+			setupMock: func(repo *mocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().Release(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedCalls:    1,
+			expectedBody:     "",
+			expectedReplayed: "",
+		},
+		{
+			name:          "repository failure",
+			key:           stringPtr("retry-1"),
+			handlerStatus: http.StatusCreated,
+			handlerError:  nil,
+			setupMock: func(repo *mocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedCalls:    0,
+			expectedBody:     "",
+			expectedReplayed: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockIdempotencyRepository(ctrl)
+			tt.setupMock(repo)
+
+			middleware := NewIdempotencyMiddleware(repo, config.IdempotencyConfig{KeyTTL: 3600})
+
+			calls := 0
+			next := func(c echo.Context) error {
+				calls++
+
+				if tt.handlerError != nil {
+					return tt.handlerError
+				}
+
+				if tt.handlerStatus >= http.StatusInternalServerError {
+					return c.JSON(tt.handlerStatus, NewInternalServerError("Internal server error", nil))
+				}
+
+				return c.JSONBlob(tt.handlerStatus, []byte(responseBody))
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			if tt.key != nil {
+				req.Header.Set(HeaderIdempotencyKey, *tt.key)
+			}
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", uuid.New().String())
+
+			// Act
+			err := middleware.MiddlewareFunc()(next)(c)
+
+			// Assert
+			if tt.handlerError != nil {
+				require.ErrorIs(t, err, tt.handlerError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedCalls, calls)
+			assert.Equal(t, tt.expectedReplayed, rec.Header().Get(HeaderIdempotentReplayed))
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestIdempotencyMiddleware_ReservesKeyWithTTL(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIdempotencyRepository(ctrl)
+	userID := uuid.New().String()
+	before := time.Now()
+
+	repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, record idempotency.Record) (*idempotency.Record, error) {
+			assert.Equal(t, userID, record.UserID.String())
+			assert.Equal(t, "retry-1", record.Key.String())
+			assert.WithinDuration(t, before.Add(time.Hour), record.ExpiresAt, time.Minute)
+
+			return nil, nil
+		})
+	repo.EXPECT().Complete(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	middleware := NewIdempotencyMiddleware(repo, config.IdempotencyConfig{KeyTTL: 3600})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(`{}`))
+	req.Header.Set(HeaderIdempotencyKey, "retry-1")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", userID)
+
+	// Act
+	err := middleware.MiddlewareFunc()(func(c echo.Context) error {
+		return c.NoContent(http.StatusCreated)
+	})(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
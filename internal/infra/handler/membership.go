@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	workspaceDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+)
+
+// HeaderWorkspaceID selects the workspace a request is made in.
+const HeaderWorkspaceID = "X-Workspace-ID"
+
+// workspaceMemberKey is the context key the membership of the user in the
+// selected workspace is stored under.
+const workspaceMemberKey = "workspace_member"
+
+// WorkspaceMiddleware resolves the workspace a request is made in and checks
+// that the user is a member of it. The workspace is taken from the
+// workspaceId path parameter of routes under the /workspaces/{workspaceId}
+// prefix, or else from the X-Workspace-ID header. Requests that select no
+// workspace are passed through unchanged.
+// It must run after AuthenticationMiddleware because membership is checked for
+// the authenticated user.
+type WorkspaceMiddleware struct {
+	controller controller.Workspace
+}
+
+// NewWorkspaceMiddleware creates a new WorkspaceMiddleware with the provided controller.
+func NewWorkspaceMiddleware(ctr controller.Workspace) *WorkspaceMiddleware {
+	return &WorkspaceMiddleware{
+		controller: ctr,
+	}
+}
+
+// MiddlewareFunc returns an Echo middleware function that stores the membership
+// of the user in the selected workspace in the context.
+// A workspace the user is not a member of is reported as not found, so that
+// its existence is not revealed.
+func (m *WorkspaceMiddleware) MiddlewareFunc() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			workspaceIDValue := c.Param("workspaceId")
+			headerValue := c.Request().Header.Get(HeaderWorkspaceID)
+
+			if workspaceIDValue == "" {
+				workspaceIDValue = headerValue
+			} else if headerValue != "" && headerValue != workspaceIDValue {
+				return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", strPtr(HeaderWorkspaceID+" does not match the workspace in the path")))
+			}
+
+			if workspaceIDValue == "" {
+				return next(c)
+			}
+
+			workspaceID, err := workspaceDomain.NewWorkspaceID(workspaceIDValue)
+			if err != nil {
+				details := err.Error()
+
+				return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid workspace ID format", &details))
+			}
+
+			userIDValue, ok := c.Get("user_id").(string)
+			if !ok || userIDValue == "" {
+				return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", strPtr("user ID not found in token")))
+			}
+
+			userID, err := user.NewUserID(userIDValue)
+			if err != nil {
+				details := err.Error()
+
+				return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+			}
+
+			member, err := m.controller.GetMembership(c.Request().Context(), userID, workspaceID)
+			if err != nil {
+				if errors.Is(err, workspaceDomain.ErrWorkspaceNotFound) {
+					return c.JSON(http.StatusNotFound, NewNotFoundError("Workspace not found"))
+				}
+
+				details := err.Error()
+
+				return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+			}
+
+			c.Set(workspaceMemberKey, member)
+
+			return next(c)
+		}
+	}
+}
+
+// workspaceMember returns the membership stored by WorkspaceMiddleware, or nil
+// if the request selects no workspace.
+func workspaceMember(c echo.Context) *workspaceDomain.Member {
+	member, ok := c.Get(workspaceMemberKey).(workspaceDomain.Member)
+	if !ok {
+		return nil
+	}
+
+	return &member
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	workspaceDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=../../domain/workspace/repository.go -destination=mocks/mock_workspace_repository.go -package=mocks
+
+func TestWorkspaceMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	workspaceID := workspaceDomain.GenerateWorkspaceID()
+
+	member, err := workspaceDomain.NewMember(workspaceID, userID, workspaceDomain.RoleMember)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		pathID         string
+		headerID       string
+		userID         string
+		setupMock      func(repo *mocks.MockWorkspaceRepository)
+		expectedStatus int
+		expectedCalls  int
+		expectedMember *workspaceDomain.Member
+	}{
+		{
+			name:           "request without workspace is passed through",
+			pathID:         "",
+			headerID:       "",
+			userID:         testUserID,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  1,
+			expectedMember: nil,
+		},
+		{
+			name:     "workspace selected by header",
+			pathID:   "",
+			headerID: workspaceID.String(),
+			userID:   testUserID,
+			setupMock: func(repo *mocks.MockWorkspaceRepository) {
+				repo.EXPECT().FindMember(gomock.Any(), workspaceID, userID).Return(member, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  1,
+			expectedMember: &member,
+		},
+		{
+			name:     "workspace selected by path",
+			pathID:   workspaceID.String(),
+			headerID: "",
+			userID:   testUserID,
+			setupMock: func(repo *mocks.MockWorkspaceRepository) {
+				repo.EXPECT().FindMember(gomock.Any(), workspaceID, userID).Return(member, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  1,
+			expectedMember: &member,
+		},
+		{
+			name:           "header does not match the path",
+			pathID:         workspaceID.String(),
+			headerID:       uuid.New().String(),
+			userID:         testUserID,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCalls:  0,
+			expectedMember: nil,
+		},
+		{
+			name:           "invalid workspace ID",
+			pathID:         "",
+			headerID:       "not-a-uuid",
+			userID:         testUserID,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCalls:  0,
+			expectedMember: nil,
+		},
+		{
+			name:           "missing user",
+			pathID:         "",
+			headerID:       workspaceID.String(),
+			userID:         "",
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCalls:  0,
+			expectedMember: nil,
+		},
+		{
+			name:     "user is not a member",
+			pathID:   "",
+			headerID: workspaceID.String(),
+			userID:   testUserID,
+			setupMock: func(repo *mocks.MockWorkspaceRepository) {
+				repo.EXPECT().FindMember(gomock.Any(), workspaceID, userID).
+					Return(workspaceDomain.Member{}, workspaceDomain.ErrWorkspaceNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCalls:  0,
+			expectedMember: nil,
+		},
+		{
+			name:     "repository failure",
+			pathID:   "",
+			headerID: workspaceID.String(),
+			userID:   testUserID,
+			setupMock: func(repo *mocks.MockWorkspaceRepository) {
+				repo.EXPECT().FindMember(gomock.Any(), workspaceID, userID).
+					Return(workspaceDomain.Member{}, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCalls:  0,
+			expectedMember: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockWorkspaceRepository(ctrl)
+			tt.setupMock(repo)
+
+			middleware := NewWorkspaceMiddleware(*controller.NewWorkspace(repo))
+
+			calls := 0
+
+			var stored *workspaceDomain.Member
+
+			next := func(c echo.Context) error {
+				calls++
+				stored = workspaceMember(c)
+
+				return c.NoContent(http.StatusOK)
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+
+			if tt.headerID != "" {
+				req.Header.Set(HeaderWorkspaceID, tt.headerID)
+			}
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if tt.pathID != "" {
+				c.SetParamNames("workspaceId")
+				c.SetParamValues(tt.pathID)
+			}
+
+			if tt.userID != "" {
+				c.Set("user_id", tt.userID)
+			}
+
+			// Act
+			err := middleware.MiddlewareFunc()(next)(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedCalls, calls)
+			assert.Equal(t, tt.expectedMember, stored)
+		})
+	}
+}
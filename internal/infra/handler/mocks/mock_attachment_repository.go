@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../../domain/attachment/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../../domain/attachment/repository.go -destination=mocks/mock_attachment_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	attachment "github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	task "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	user "github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentRepository is a mock of AttachmentRepository interface.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentRepositoryMockRecorder is the mock recorder for MockAttachmentRepository.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock instance.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateWithinQuota mocks base method.
+func (m *MockAttachmentRepository) CreateWithinQuota(ctx context.Context, arg1 *attachment.Attachment, quota int64) (*attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithinQuota", ctx, arg1, quota)
+	ret0, _ := ret[0].(*attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithinQuota indicates an expected call of CreateWithinQuota.
+func (mr *MockAttachmentRepositoryMockRecorder) CreateWithinQuota(ctx, arg1, quota any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithinQuota", reflect.TypeOf((*MockAttachmentRepository)(nil).CreateWithinQuota), ctx, arg1, quota)
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentRepository) Delete(ctx context.Context, id attachment.AttachmentID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentRepository)(nil).Delete), ctx, id)
+}
+
+// FindAllByTaskID mocks base method.
+func (m *MockAttachmentRepository) FindAllByTaskID(ctx context.Context, taskID task.TaskID) ([]*attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]*attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByTaskID indicates an expected call of FindAllByTaskID.
+func (mr *MockAttachmentRepositoryMockRecorder) FindAllByTaskID(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByTaskID", reflect.TypeOf((*MockAttachmentRepository)(nil).FindAllByTaskID), ctx, taskID)
+}
+
+// FindById mocks base method.
+func (m *MockAttachmentRepository) FindById(ctx context.Context, id attachment.AttachmentID) (*attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockAttachmentRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockAttachmentRepository)(nil).FindById), ctx, id)
+}
+
+// FindDetached mocks base method.
+func (m *MockAttachmentRepository) FindDetached(ctx context.Context, limit int) ([]*attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDetached", ctx, limit)
+	ret0, _ := ret[0].([]*attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDetached indicates an expected call of FindDetached.
+func (mr *MockAttachmentRepositoryMockRecorder) FindDetached(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDetached", reflect.TypeOf((*MockAttachmentRepository)(nil).FindDetached), ctx, limit)
+}
+
+// UsageByUserID mocks base method.
+func (m *MockAttachmentRepository) UsageByUserID(ctx context.Context, userID user.UserID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsageByUserID", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsageByUserID indicates an expected call of UsageByUserID.
+func (mr *MockAttachmentRepositoryMockRecorder) UsageByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsageByUserID", reflect.TypeOf((*MockAttachmentRepository)(nil).UsageByUserID), ctx, userID)
+}
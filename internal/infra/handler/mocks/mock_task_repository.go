@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	task "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
 	user "github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
@@ -42,6 +43,64 @@ func (m *MockTaskRepository) EXPECT() *MockTaskRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddCollaborator mocks base method.
+func (m *MockTaskRepository) AddCollaborator(ctx context.Context, collaborator task.Collaborator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollaborator", ctx, collaborator)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCollaborator indicates an expected call of AddCollaborator.
+func (mr *MockTaskRepositoryMockRecorder) AddCollaborator(ctx, collaborator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockTaskRepository)(nil).AddCollaborator), ctx, collaborator)
+}
+
+// AddDependency mocks base method.
+func (m *MockTaskRepository) AddDependency(ctx context.Context, creatorID user.UserID, dependency task.Dependency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDependency", ctx, creatorID, dependency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDependency indicates an expected call of AddDependency.
+func (mr *MockTaskRepositoryMockRecorder) AddDependency(ctx, creatorID, dependency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDependency", reflect.TypeOf((*MockTaskRepository)(nil).AddDependency), ctx, creatorID, dependency)
+}
+
+// Assign mocks base method.
+func (m *MockTaskRepository) Assign(ctx context.Context, arg1 *task.Task, assignment task.Assignment) (*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, arg1, assignment)
+	ret0, _ := ret[0].(*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockTaskRepositoryMockRecorder) Assign(ctx, arg1, assignment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockTaskRepository)(nil).Assign), ctx, arg1, assignment)
+}
+
+// CompleteOccurrence mocks base method.
+func (m *MockTaskRepository) CompleteOccurrence(ctx context.Context, actorID user.UserID, arg2, next *task.Task) (*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOccurrence", ctx, actorID, arg2, next)
+	ret0, _ := ret[0].(*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteOccurrence indicates an expected call of CompleteOccurrence.
+func (mr *MockTaskRepositoryMockRecorder) CompleteOccurrence(ctx, actorID, arg2, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOccurrence", reflect.TypeOf((*MockTaskRepository)(nil).CompleteOccurrence), ctx, actorID, arg2, next)
+}
+
 // Create mocks base method.
 func (m *MockTaskRepository) Create(ctx context.Context, arg1 *task.Task) (*task.Task, error) {
 	m.ctrl.T.Helper()
@@ -58,32 +117,106 @@ func (mr *MockTaskRepositoryMockRecorder) Create(ctx, arg1 any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockTaskRepository) Delete(ctx context.Context, creatorID user.UserID, id task.TaskID) error {
+func (m *MockTaskRepository) Delete(ctx context.Context, creatorID user.UserID, id task.TaskID, expectedVersion *int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, creatorID, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, creatorID, id, expectedVersion)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTaskRepositoryMockRecorder) Delete(ctx, creatorID, id any) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) Delete(ctx, creatorID, id, expectedVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepository)(nil).Delete), ctx, creatorID, id, expectedVersion)
+}
+
+// DeleteTree mocks base method.
+func (m *MockTaskRepository) DeleteTree(ctx context.Context, creatorID user.UserID, id task.TaskID, expectedVersion *int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTree", ctx, creatorID, id, expectedVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTree indicates an expected call of DeleteTree.
+func (mr *MockTaskRepositoryMockRecorder) DeleteTree(ctx, creatorID, id, expectedVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTree", reflect.TypeOf((*MockTaskRepository)(nil).DeleteTree), ctx, creatorID, id, expectedVersion)
+}
+
+// EmptyTrash mocks base method.
+func (m *MockTaskRepository) EmptyTrash(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyTrash", ctx, creatorID)
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmptyTrash indicates an expected call of EmptyTrash.
+func (mr *MockTaskRepositoryMockRecorder) EmptyTrash(ctx, creatorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepository)(nil).Delete), ctx, creatorID, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyTrash", reflect.TypeOf((*MockTaskRepository)(nil).EmptyTrash), ctx, creatorID)
+}
+
+// FindAccessible mocks base method.
+func (m *MockTaskRepository) FindAccessible(ctx context.Context, userID user.UserID, id task.TaskID) (*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccessible", ctx, userID, id)
+	ret0, _ := ret[0].(*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccessible indicates an expected call of FindAccessible.
+func (mr *MockTaskRepositoryMockRecorder) FindAccessible(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccessible", reflect.TypeOf((*MockTaskRepository)(nil).FindAccessible), ctx, userID, id)
 }
 
 // FindAllByUserID mocks base method.
-func (m *MockTaskRepository) FindAllByUserID(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+func (m *MockTaskRepository) FindAllByUserID(ctx context.Context, creatorID user.UserID, filter task.Filter) ([]*task.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAllByUserID", ctx, creatorID)
+	ret := m.ctrl.Call(m, "FindAllByUserID", ctx, creatorID, filter)
 	ret0, _ := ret[0].([]*task.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAllByUserID indicates an expected call of FindAllByUserID.
-func (mr *MockTaskRepositoryMockRecorder) FindAllByUserID(ctx, creatorID any) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) FindAllByUserID(ctx, creatorID, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockTaskRepository)(nil).FindAllByUserID), ctx, creatorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockTaskRepository)(nil).FindAllByUserID), ctx, creatorID, filter)
+}
+
+// FindAssignments mocks base method.
+func (m *MockTaskRepository) FindAssignments(ctx context.Context, id task.TaskID) ([]task.Assignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAssignments", ctx, id)
+	ret0, _ := ret[0].([]task.Assignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAssignments indicates an expected call of FindAssignments.
+func (mr *MockTaskRepositoryMockRecorder) FindAssignments(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAssignments", reflect.TypeOf((*MockTaskRepository)(nil).FindAssignments), ctx, id)
+}
+
+// FindBlockers mocks base method.
+func (m *MockTaskRepository) FindBlockers(ctx context.Context, creatorID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBlockers", ctx, creatorID, id)
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBlockers indicates an expected call of FindBlockers.
+func (mr *MockTaskRepositoryMockRecorder) FindBlockers(ctx, creatorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlockers", reflect.TypeOf((*MockTaskRepository)(nil).FindBlockers), ctx, creatorID, id)
 }
 
 // FindById mocks base method.
@@ -101,17 +234,224 @@ func (mr *MockTaskRepositoryMockRecorder) FindById(ctx, creatorID, id any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTaskRepository)(nil).FindById), ctx, creatorID, id)
 }
 
+// FindCollaborators mocks base method.
+func (m *MockTaskRepository) FindCollaborators(ctx context.Context, id task.TaskID) ([]task.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCollaborators", ctx, id)
+	ret0, _ := ret[0].([]task.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCollaborators indicates an expected call of FindCollaborators.
+func (mr *MockTaskRepositoryMockRecorder) FindCollaborators(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCollaborators", reflect.TypeOf((*MockTaskRepository)(nil).FindCollaborators), ctx, id)
+}
+
+// FindHistory mocks base method.
+func (m *MockTaskRepository) FindHistory(ctx context.Context, id task.TaskID, page task.PageRequest) (task.HistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHistory", ctx, id, page)
+	ret0, _ := ret[0].(task.HistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHistory indicates an expected call of FindHistory.
+func (mr *MockTaskRepositoryMockRecorder) FindHistory(ctx, id, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHistory", reflect.TypeOf((*MockTaskRepository)(nil).FindHistory), ctx, id, page)
+}
+
+// FindLineage mocks base method.
+func (m *MockTaskRepository) FindLineage(ctx context.Context, creatorID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLineage", ctx, creatorID, id)
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLineage indicates an expected call of FindLineage.
+func (mr *MockTaskRepositoryMockRecorder) FindLineage(ctx, creatorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLineage", reflect.TypeOf((*MockTaskRepository)(nil).FindLineage), ctx, creatorID, id)
+}
+
+// FindPageByUserID mocks base method.
+func (m *MockTaskRepository) FindPageByUserID(ctx context.Context, creatorID user.UserID, filter task.Filter, page task.PageRequest) (*task.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPageByUserID", ctx, creatorID, filter, page)
+	ret0, _ := ret[0].(*task.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPageByUserID indicates an expected call of FindPageByUserID.
+func (mr *MockTaskRepositoryMockRecorder) FindPageByUserID(ctx, creatorID, filter, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPageByUserID", reflect.TypeOf((*MockTaskRepository)(nil).FindPageByUserID), ctx, creatorID, filter, page)
+}
+
+// FindSubtasks mocks base method.
+func (m *MockTaskRepository) FindSubtasks(ctx context.Context, creatorID user.UserID, ids []task.TaskID) ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubtasks", ctx, creatorID, ids)
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubtasks indicates an expected call of FindSubtasks.
+func (mr *MockTaskRepositoryMockRecorder) FindSubtasks(ctx, creatorID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubtasks", reflect.TypeOf((*MockTaskRepository)(nil).FindSubtasks), ctx, creatorID, ids)
+}
+
+// FindTrashByUserID mocks base method.
+func (m *MockTaskRepository) FindTrashByUserID(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrashByUserID", ctx, creatorID)
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrashByUserID indicates an expected call of FindTrashByUserID.
+func (mr *MockTaskRepositoryMockRecorder) FindTrashByUserID(ctx, creatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrashByUserID", reflect.TypeOf((*MockTaskRepository)(nil).FindTrashByUserID), ctx, creatorID)
+}
+
+// Move mocks base method.
+func (m *MockTaskRepository) Move(ctx context.Context, creatorID user.UserID, id task.TaskID, placement task.Placement) (*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, creatorID, id, placement)
+	ret0, _ := ret[0].(*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockTaskRepositoryMockRecorder) Move(ctx, creatorID, id, placement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockTaskRepository)(nil).Move), ctx, creatorID, id, placement)
+}
+
+// PurgeDeletedBefore mocks base method.
+func (m *MockTaskRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockTaskRepositoryMockRecorder) PurgeDeletedBefore(ctx, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockTaskRepository)(nil).PurgeDeletedBefore), ctx, cutoff)
+}
+
+// RebalanceRanks mocks base method.
+func (m *MockTaskRepository) RebalanceRanks(ctx context.Context, maxLength int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceRanks", ctx, maxLength)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceRanks indicates an expected call of RebalanceRanks.
+func (mr *MockTaskRepositoryMockRecorder) RebalanceRanks(ctx, maxLength any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceRanks", reflect.TypeOf((*MockTaskRepository)(nil).RebalanceRanks), ctx, maxLength)
+}
+
+// RemoveCollaborator mocks base method.
+func (m *MockTaskRepository) RemoveCollaborator(ctx context.Context, id task.TaskID, userID user.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockTaskRepositoryMockRecorder) RemoveCollaborator(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockTaskRepository)(nil).RemoveCollaborator), ctx, id, userID)
+}
+
+// RemoveDependency mocks base method.
+func (m *MockTaskRepository) RemoveDependency(ctx context.Context, creatorID user.UserID, dependency task.Dependency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDependency", ctx, creatorID, dependency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDependency indicates an expected call of RemoveDependency.
+func (mr *MockTaskRepositoryMockRecorder) RemoveDependency(ctx, creatorID, dependency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDependency", reflect.TypeOf((*MockTaskRepository)(nil).RemoveDependency), ctx, creatorID, dependency)
+}
+
+// Restore mocks base method.
+func (m *MockTaskRepository) Restore(ctx context.Context, creatorID user.UserID, id task.TaskID) (*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, creatorID, id)
+	ret0, _ := ret[0].(*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTaskRepositoryMockRecorder) Restore(ctx, creatorID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTaskRepository)(nil).Restore), ctx, creatorID, id)
+}
+
+// Search mocks base method.
+func (m *MockTaskRepository) Search(ctx context.Context, userID user.UserID, query task.SearchQuery) ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, userID, query)
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTaskRepositoryMockRecorder) Search(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTaskRepository)(nil).Search), ctx, userID, query)
+}
+
 // Update mocks base method.
-func (m *MockTaskRepository) Update(ctx context.Context, arg1 *task.Task) (*task.Task, error) {
+func (m *MockTaskRepository) Update(ctx context.Context, actorID user.UserID, arg2 *task.Task) (*task.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, arg1)
+	ret := m.ctrl.Call(m, "Update", ctx, actorID, arg2)
 	ret0, _ := ret[0].(*task.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTaskRepositoryMockRecorder) Update(ctx, arg1 any) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) Update(ctx, actorID, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepository)(nil).Update), ctx, actorID, arg2)
+}
+
+// UpdateSeries mocks base method.
+func (m *MockTaskRepository) UpdateSeries(ctx context.Context, series *task.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeries", ctx, series)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSeries indicates an expected call of UpdateSeries.
+func (mr *MockTaskRepositoryMockRecorder) UpdateSeries(ctx, series any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepository)(nil).Update), ctx, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeries", reflect.TypeOf((*MockTaskRepository)(nil).UpdateSeries), ctx, series)
 }
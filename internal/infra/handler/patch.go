@@ -0,0 +1,601 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	projectDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+)
+
+const (
+	// mediaTypeMergePatch is the media type of JSON Merge Patch documents (RFC 7396).
+	mediaTypeMergePatch = "application/merge-patch+json"
+	// mediaTypeJSONPatch is the media type of JSON Patch documents (RFC 6902).
+	mediaTypeJSONPatch = "application/json-patch+json"
+)
+
+var (
+	// errUnsupportedPatchType is returned for patch documents of any other media type.
+	errUnsupportedPatchType = errors.New("content type must be " + mediaTypeMergePatch + " or " + mediaTypeJSONPatch)
+	// errInvalidPatch is returned for patch documents that are not well-formed.
+	errInvalidPatch = errors.New("invalid patch document")
+	// errPatchConflict is returned when a patch cannot be applied to the current task.
+	errPatchConflict = errors.New("patch cannot be applied")
+	// errInvalidPatchedTask is returned when the patched representation is not a valid task.
+	errInvalidPatchedTask = errors.New("patched task is invalid")
+)
+
+// jsonPatchOperation is a single RFC 6902 operation. Value is kept raw so that
+// an explicit null can be told apart from a missing value.
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// patchTask applies a patch document to the representation of a task and
+// returns the difference between the patched and the current task as an update.
+func patchTask(current *taskDomain.Task, mediaType string, patch []byte) (controller.TaskUpdate, error) {
+	raw, err := json.Marshal(toTaskResponse(current))
+	if err != nil {
+		return controller.TaskUpdate{}, err
+	}
+
+	// Patches are applied in place, so keep a separate copy to compare against.
+	var original, document any
+	if err := json.Unmarshal(raw, &original); err != nil {
+		return controller.TaskUpdate{}, err
+	}
+
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return controller.TaskUpdate{}, err
+	}
+
+	var patched any
+
+	switch mediaType {
+	case mediaTypeMergePatch:
+		var mergePatch any
+		if err := json.Unmarshal(patch, &mergePatch); err != nil {
+			return controller.TaskUpdate{}, fmt.Errorf("%w: %s", errInvalidPatch, err.Error())
+		}
+
+		patched = applyMergePatch(document, mergePatch)
+	case mediaTypeJSONPatch:
+		var operations []jsonPatchOperation
+		if err := json.Unmarshal(patch, &operations); err != nil {
+			return controller.TaskUpdate{}, fmt.Errorf("%w: %s", errInvalidPatch, err.Error())
+		}
+
+		patched, err = applyJSONPatch(document, operations)
+		if err != nil {
+			return controller.TaskUpdate{}, err
+		}
+	default:
+		return controller.TaskUpdate{}, errUnsupportedPatchType
+	}
+
+	return diffTask(current, original, patched)
+}
+
+// diffTask checks the patched representation and converts the fields that
+// differ from the current task into an update.
+func diffTask(current *taskDomain.Task, original, patched any) (controller.TaskUpdate, error) {
+	update := controller.TaskUpdate{} //nolint:exhaustruct
+
+	fields, ok := patched.(map[string]any)
+	if !ok {
+		return update, fmt.Errorf("%w: task must be a JSON object", errInvalidPatchedTask)
+	}
+
+	originalFields, _ := original.(map[string]any)
+
+	for name, value := range fields {
+		switch name {
+		case "id", "completedAt", "deletedAt", "blocked", "recurrence", "rank", "shared", "role", "workspaceId", "assigneeId":
+			if !reflect.DeepEqual(value, originalFields[name]) {
+				return update, fmt.Errorf("%w: %s is read-only", errInvalidPatchedTask, name)
+			}
+		case "title", "completed", "startAt", "dueAt", "allDay", "tags", "parentId", "projectId":
+		default:
+			return update, fmt.Errorf("%w: unknown field %s", errInvalidPatchedTask, name)
+		}
+	}
+
+	title, ok := fields["title"].(string)
+	if !ok {
+		return update, fmt.Errorf("%w: title must be a string", errInvalidPatchedTask)
+	}
+
+	if title != current.Title() {
+		update.Title = &title
+	}
+
+	completed, ok := fields["completed"].(bool)
+	if !ok {
+		return update, fmt.Errorf("%w: completed must be a boolean", errInvalidPatchedTask)
+	}
+
+	if completed != current.IsCompleted() {
+		update.Completed = &completed
+	}
+
+	// A missing allDay is treated like false, as on creation.
+	allDay := false
+	if value, found := fields["allDay"]; found && value != nil {
+		if allDay, ok = value.(bool); !ok {
+			return update, fmt.Errorf("%w: allDay must be a boolean", errInvalidPatchedTask)
+		}
+	}
+
+	if allDay != current.Schedule().IsAllDay() {
+		update.AllDay = &allDay
+	}
+
+	startAt, err := patchedTime(fields, "startAt")
+	if err != nil {
+		return update, err
+	}
+
+	update.StartAt, update.ClearStartAt = diffTime(current.Schedule().StartAt(), startAt)
+
+	dueAt, err := patchedTime(fields, "dueAt")
+	if err != nil {
+		return update, err
+	}
+
+	update.DueAt, update.ClearDueAt = diffTime(current.Schedule().DueAt(), dueAt)
+
+	tags, err := patchedTags(fields)
+	if err != nil {
+		return update, err
+	}
+
+	if !slices.Equal(tags, current.Tags()) {
+		update.Tags = &tags
+	}
+
+	parentID, err := patchedParent(fields)
+	if err != nil {
+		return update, err
+	}
+
+	switch {
+	case parentID == nil:
+		update.ClearParent = current.ParentID() != nil
+	case current.ParentID() == nil || *current.ParentID() != *parentID:
+		update.ParentID = parentID
+	}
+
+	projectID, err := patchedProject(fields)
+	if err != nil {
+		return update, err
+	}
+
+	switch {
+	case projectID == nil:
+		update.ClearProject = current.ProjectID() != nil
+	case current.ProjectID() == nil || *current.ProjectID() != *projectID:
+		update.ProjectID = projectID
+	}
+
+	return update, nil
+}
+
+// patchedParent reads the optional parent task ID from the patched representation.
+func patchedParent(fields map[string]any) (*taskDomain.TaskID, error) {
+	value, found := fields["parentId"]
+	if !found || value == nil {
+		return nil, nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: parentId must be a UUID string", errInvalidPatchedTask)
+	}
+
+	parentID, err := taskDomain.NewTaskID(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parentId must be a UUID string", errInvalidPatchedTask)
+	}
+
+	return &parentID, nil
+}
+
+// patchedProject reads the optional project ID from the patched representation.
+func patchedProject(fields map[string]any) (*projectDomain.ProjectID, error) {
+	value, found := fields["projectId"]
+	if !found || value == nil {
+		return nil, nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: projectId must be a UUID string", errInvalidPatchedTask)
+	}
+
+	projectID, err := projectDomain.NewProjectID(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: projectId must be a UUID string", errInvalidPatchedTask)
+	}
+
+	return &projectID, nil
+}
+
+// patchedTime reads an optional RFC 3339 timestamp from the patched representation.
+func patchedTime(fields map[string]any, name string) (*time.Time, error) {
+	value, found := fields[name]
+	if !found || value == nil {
+		return nil, nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be a date-time string", errInvalidPatchedTask, name)
+	}
+
+	parsed, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be a date-time string", errInvalidPatchedTask, name)
+	}
+
+	return &parsed, nil
+}
+
+// patchedTags reads the tag names from the patched representation.
+// A missing or null list removes all tags.
+func patchedTags(fields map[string]any) ([]string, error) {
+	value, found := fields["tags"]
+	if !found || value == nil {
+		return []string{}, nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: tags must be an array of strings", errInvalidPatchedTask)
+	}
+
+	tags := make([]string, len(items))
+	for i, item := range items {
+		if tags[i], ok = item.(string); !ok {
+			return nil, fmt.Errorf("%w: tags must be an array of strings", errInvalidPatchedTask)
+		}
+	}
+
+	return tags, nil
+}
+
+// diffTime returns the new value to set, or clear = true if the value was removed.
+func diffTime(current, patched *time.Time) (value *time.Time, clear bool) {
+	switch {
+	case patched == nil:
+		return nil, current != nil
+	case current == nil || !current.Equal(*patched):
+		return patched, false
+	default:
+		return nil, false
+	}
+}
+
+// applyMergePatch applies a JSON Merge Patch to a document as specified by RFC 7396.
+func applyMergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+		} else {
+			targetObject[name] = applyMergePatch(targetObject[name], value)
+		}
+	}
+
+	return targetObject
+}
+
+// applyJSONPatch applies the operations of a JSON Patch to a document in
+// order, as specified by RFC 6902. The patch is atomic: if any operation fails
+// an error is returned and the result must be discarded.
+func applyJSONPatch(document any, operations []jsonPatchOperation) (any, error) {
+	var err error
+
+	for i, operation := range operations {
+		document, err = applyJSONPatchOperation(document, operation)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	return document, nil
+}
+
+func applyJSONPatchOperation(document any, operation jsonPatchOperation) (any, error) {
+	if operation.Path == nil {
+		return nil, fmt.Errorf("%w: %s requires a path", errInvalidPatch, operation.Op)
+	}
+
+	path, err := parseJSONPointer(*operation.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch operation.Op {
+	case "add", "replace", "test":
+		if operation.Value == nil {
+			return nil, fmt.Errorf("%w: %s requires a value", errInvalidPatch, operation.Op)
+		}
+
+		var value any
+		if err := json.Unmarshal(operation.Value, &value); err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidPatch, err.Error())
+		}
+
+		switch operation.Op {
+		case "add":
+			return addValue(document, path, value)
+		case "replace":
+			return replaceValue(document, path, value)
+		default:
+			current, err := getValue(document, path)
+			if err != nil {
+				return nil, err
+			}
+
+			if !reflect.DeepEqual(current, value) {
+				return nil, fmt.Errorf("%w: test failed at %s", errPatchConflict, *operation.Path)
+			}
+
+			return document, nil
+		}
+	case "remove":
+		return removeValue(document, path)
+	case "move", "copy":
+		if operation.From == nil {
+			return nil, fmt.Errorf("%w: %s requires from", errInvalidPatch, operation.Op)
+		}
+
+		from, err := parseJSONPointer(*operation.From)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := getValue(document, from)
+		if err != nil {
+			return nil, err
+		}
+
+		if operation.Op == "copy" {
+			return addValue(document, path, deepCopy(value))
+		}
+
+		if len(path) > len(from) && isPrefix(from, path) {
+			return nil, fmt.Errorf("%w: cannot move a value into itself", errPatchConflict)
+		}
+
+		document, err = removeValue(document, from)
+		if err != nil {
+			return nil, err
+		}
+
+		return addValue(document, path, value)
+	default:
+		return nil, fmt.Errorf("%w: unknown operation %q", errInvalidPatch, operation.Op)
+	}
+}
+
+// parseJSONPointer splits a JSON Pointer (RFC 6901) into unescaped reference tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("%w: JSON pointer %q must start with /", errInvalidPatch, pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+func isPrefix(prefix, tokens []string) bool {
+	for i := range prefix {
+		if prefix[i] != tokens[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func getValue(document any, path []string) (any, error) {
+	for _, token := range path {
+		switch container := document.(type) {
+		case map[string]any:
+			value, found := container[token]
+			if !found {
+				return nil, fmt.Errorf("%w: %s does not exist", errPatchConflict, token)
+			}
+
+			document = value
+		case []any:
+			index, err := arrayIndex(token, len(container)-1)
+			if err != nil {
+				return nil, err
+			}
+
+			document = container[index]
+		default:
+			return nil, fmt.Errorf("%w: %s does not exist", errPatchConflict, token)
+		}
+	}
+
+	return document, nil
+}
+
+func addValue(document any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return updateParent(document, path, func(parent any, token string) (any, error) {
+		switch container := parent.(type) {
+		case map[string]any:
+			container[token] = value
+
+			return container, nil
+		case []any:
+			if token == "-" {
+				return append(container, value), nil
+			}
+
+			index, err := arrayIndex(token, len(container))
+			if err != nil {
+				return nil, err
+			}
+
+			container = append(container, nil)
+			copy(container[index+1:], container[index:])
+			container[index] = value
+
+			return container, nil
+		default:
+			return nil, fmt.Errorf("%w: cannot add %s to a scalar", errPatchConflict, token)
+		}
+	})
+}
+
+func removeValue(document any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("%w: cannot remove the whole document", errPatchConflict)
+	}
+
+	return updateParent(document, path, func(parent any, token string) (any, error) {
+		switch container := parent.(type) {
+		case map[string]any:
+			if _, found := container[token]; !found {
+				return nil, fmt.Errorf("%w: %s does not exist", errPatchConflict, token)
+			}
+
+			delete(container, token)
+
+			return container, nil
+		case []any:
+			index, err := arrayIndex(token, len(container)-1)
+			if err != nil {
+				return nil, err
+			}
+
+			return append(container[:index], container[index+1:]...), nil
+		default:
+			return nil, fmt.Errorf("%w: %s does not exist", errPatchConflict, token)
+		}
+	})
+}
+
+func replaceValue(document any, path []string, value any) (any, error) {
+	if _, err := getValue(document, path); err != nil {
+		return nil, err
+	}
+
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return updateParent(document, path, func(parent any, token string) (any, error) {
+		switch container := parent.(type) {
+		case map[string]any:
+			container[token] = value
+
+			return container, nil
+		case []any:
+			index, err := arrayIndex(token, len(container)-1)
+			if err != nil {
+				return nil, err
+			}
+
+			container[index] = value
+
+			return container, nil
+		default:
+			return nil, fmt.Errorf("%w: %s does not exist", errPatchConflict, token)
+		}
+	})
+}
+
+// updateParent walks to the container holding the last token of path, lets fn
+// modify it and stores the result back, since changing the length of an array
+// produces a new slice.
+func updateParent(document any, path []string, fn func(parent any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return fn(document, path[0])
+	}
+
+	child, err := getValue(document, path[:1])
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := updateParent(child, path[1:], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch container := document.(type) {
+	case map[string]any:
+		container[path[0]] = updated
+	case []any:
+		index, _ := strconv.Atoi(path[0])
+		container[index] = updated
+	}
+
+	return document, nil
+}
+
+// arrayIndex parses an array index token that must not exceed maxIndex.
+func arrayIndex(token string, maxIndex int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.TrimLeft(token, "0123456789") != "" {
+		return 0, fmt.Errorf("%w: %q is not an array index", errPatchConflict, token)
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil || index > maxIndex {
+		return 0, fmt.Errorf("%w: index %s is out of range", errPatchConflict, token)
+	}
+
+	return index, nil
+}
+
+func deepCopy(value any) any {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var copied any
+	if err := json.NewDecoder(bytes.NewReader(raw)).Decode(&copied); err != nil {
+		return value
+	}
+
+	return copied
+}
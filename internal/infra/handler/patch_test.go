@@ -0,0 +1,464 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	projectDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+)
+
+func decodeJSON(t *testing.T, raw string) any {
+	t.Helper()
+
+	var value any
+	require.NoError(t, json.Unmarshal([]byte(raw), &value))
+
+	return value
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	t.Parallel()
+
+	// Examples from RFC 7396, Appendix A.
+	tests := []struct {
+		name     string
+		target   string
+		patch    string
+		expected string
+	}{
+		{name: "replace member", target: `{"a":"b"}`, patch: `{"a":"c"}`, expected: `{"a":"c"}`},
+		{name: "add member", target: `{"a":"b"}`, patch: `{"b":"c"}`, expected: `{"a":"b","b":"c"}`},
+		{name: "remove member", target: `{"a":"b"}`, patch: `{"a":null}`, expected: `{}`},
+		{name: "remove one of several", target: `{"a":"b","b":"c"}`, patch: `{"a":null}`, expected: `{"b":"c"}`},
+		{name: "replace array", target: `{"a":["b"]}`, patch: `{"a":"c"}`, expected: `{"a":"c"}`},
+		{name: "nested remove", target: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, expected: `{"a":{"b":"d"}}`},
+		{name: "arrays are replaced", target: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, expected: `{"a":[1]}`},
+		{name: "non-object patch replaces target", target: `{"a":"foo"}`, patch: `"bar"`, expected: `"bar"`},
+		{name: "null inside new object is dropped", target: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, expected: `{"a":{"bb":{}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result := applyMergePatch(decodeJSON(t, tt.target), decodeJSON(t, tt.patch))
+
+			// Assert
+			assert.Equal(t, decodeJSON(t, tt.expected), result)
+		})
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	t.Parallel()
+
+	// Mostly examples from RFC 6902, Appendix A.
+	tests := []struct {
+		name          string
+		document      string
+		patch         string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:     "add object member",
+			document: `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz","value":"qux"}]`,
+			expected: `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			name:     "add array element",
+			document: `{"foo":["bar","baz"]}`,
+			patch:    `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			expected: `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:     "append to array",
+			document: `{"foo":["bar"]}`,
+			patch:    `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			expected: `{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			name:     "add null value",
+			document: `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz","value":null}]`,
+			expected: `{"baz":null,"foo":"bar"}`,
+		},
+		{
+			name:     "remove array element",
+			document: `{"foo":["bar","qux","baz"]}`,
+			patch:    `[{"op":"remove","path":"/foo/1"}]`,
+			expected: `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:     "replace value",
+			document: `{"baz":"qux","foo":"bar"}`,
+			patch:    `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			expected: `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:     "move value",
+			document: `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch:    `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			expected: `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:     "move array element",
+			document: `{"foo":["all","grass","cows","eat"]}`,
+			patch:    `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			expected: `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name:     "copy value",
+			document: `{"foo":{"bar":1}}`,
+			patch:    `[{"op":"copy","from":"/foo","path":"/baz"},{"op":"replace","path":"/baz/bar","value":2}]`,
+			expected: `{"baz":{"bar":2},"foo":{"bar":1}}`,
+		},
+		{
+			name:     "escaped pointer tokens",
+			document: `{"/":9,"~1":10}`,
+			patch:    `[{"op":"test","path":"/~01","value":10},{"op":"remove","path":"/~1"}]`,
+			expected: `{"~1":10}`,
+		},
+		{
+			name:     "successful test",
+			document: `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch:    `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			expected: `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			name:          "failed test",
+			document:      `{"baz":"qux"}`,
+			patch:         `[{"op":"test","path":"/baz","value":"bar"}]`,
+			expectedError: errPatchConflict,
+		},
+		{
+			name:          "add to nonexistent parent",
+			document:      `{"foo":"bar"}`,
+			patch:         `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			expectedError: errPatchConflict,
+		},
+		{
+			name:          "replace nonexistent member",
+			document:      `{"foo":"bar"}`,
+			patch:         `[{"op":"replace","path":"/baz","value":"qux"}]`,
+			expectedError: errPatchConflict,
+		},
+		{
+			name:          "array index out of range",
+			document:      `{"foo":["bar"]}`,
+			patch:         `[{"op":"add","path":"/foo/2","value":"qux"}]`,
+			expectedError: errPatchConflict,
+		},
+		{
+			name:          "leading zero array index",
+			document:      `{"foo":["bar","baz"]}`,
+			patch:         `[{"op":"remove","path":"/foo/01"}]`,
+			expectedError: errPatchConflict,
+		},
+		{
+			name:          "move into own child",
+			document:      `{"foo":{"bar":1}}`,
+			patch:         `[{"op":"move","from":"/foo","path":"/foo/baz"}]`,
+			expectedError: errPatchConflict,
+		},
+		{
+			name:          "unknown operation",
+			document:      `{"foo":"bar"}`,
+			patch:         `[{"op":"merge","path":"/foo","value":"baz"}]`,
+			expectedError: errInvalidPatch,
+		},
+		{
+			name:          "missing value",
+			document:      `{"foo":"bar"}`,
+			patch:         `[{"op":"add","path":"/baz"}]`,
+			expectedError: errInvalidPatch,
+		},
+		{
+			name:          "missing path",
+			document:      `{"foo":"bar"}`,
+			patch:         `[{"op":"remove"}]`,
+			expectedError: errInvalidPatch,
+		},
+		{
+			name:          "pointer without leading slash",
+			document:      `{"foo":"bar"}`,
+			patch:         `[{"op":"remove","path":"foo"}]`,
+			expectedError: errInvalidPatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var operations []jsonPatchOperation
+			require.NoError(t, json.Unmarshal([]byte(tt.patch), &operations))
+
+			// Act
+			result, err := applyJSONPatch(decodeJSON(t, tt.document), operations)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, decodeJSON(t, tt.expected), result)
+		})
+	}
+}
+
+func TestPatchTask(t *testing.T) {
+	t.Parallel()
+
+	startAt := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+	dueAt := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	newDueAt := time.Date(2024, 2, 2, 9, 0, 0, 0, time.UTC)
+	title := "Renamed"
+	allDay := true
+	parentID := createTaskID(uuid.New().String())
+	projectID := projectDomain.GenerateProjectID()
+
+	current := task.NewTaskWithoutValidation(
+		createTaskID(uuid.New().String()),
+		"Task",
+		createUserID(uuid.New().String()),
+		task.WithSchedule(task.NewScheduleWithoutValidation(&startAt, &dueAt, false)),
+	)
+
+	tests := []struct {
+		name          string
+		mediaType     string
+		patch         string
+		verify        func(t *testing.T, update controller.TaskUpdate)
+		expectedError error
+	}{
+		{
+			name:      "empty merge patch changes nothing",
+			mediaType: mediaTypeMergePatch,
+			patch:     `{}`,
+			verify: func(t *testing.T, update controller.TaskUpdate) {
+				t.Helper()
+				assert.Nil(t, update.Title)
+				assert.Nil(t, update.StartAt)
+				assert.Nil(t, update.DueAt)
+				assert.False(t, update.ClearStartAt)
+				assert.False(t, update.ClearDueAt)
+				assert.Nil(t, update.AllDay)
+			},
+		},
+		{
+			name:      "merge patch null clears a date",
+			mediaType: mediaTypeMergePatch,
+			patch:     `{"title":"Renamed","startAt":null,"dueAt":"2024-02-02T18:00:00+09:00"}`,
+			verify: func(t *testing.T, update controller.TaskUpdate) {
+				t.Helper()
+				assert.Equal(t, &title, update.Title)
+				assert.Nil(t, update.StartAt)
+				assert.True(t, update.ClearStartAt)
+				require.NotNil(t, update.DueAt)
+				assert.True(t, newDueAt.Equal(*update.DueAt))
+				assert.False(t, update.ClearDueAt)
+			},
+		},
+		{
+			name:      "json patch remove clears a date",
+			mediaType: mediaTypeJSONPatch,
+			patch:     `[{"op":"remove","path":"/dueAt"},{"op":"replace","path":"/allDay","value":true}]`,
+			verify: func(t *testing.T, update controller.TaskUpdate) {
+				t.Helper()
+				assert.False(t, update.ClearStartAt)
+				assert.True(t, update.ClearDueAt)
+				assert.Equal(t, &allDay, update.AllDay)
+			},
+		},
+		{
+			name:      "merge patch sets the parent",
+			mediaType: mediaTypeMergePatch,
+			patch:     `{"parentId":"` + parentID.String() + `"}`,
+			verify: func(t *testing.T, update controller.TaskUpdate) {
+				t.Helper()
+				assert.Equal(t, &parentID, update.ParentID)
+				assert.False(t, update.ClearParent)
+			},
+		},
+		{
+			name:          "invalid parent ID",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `{"parentId":"not-a-uuid"}`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:      "merge patch moves the task into a project",
+			mediaType: mediaTypeMergePatch,
+			patch:     `{"projectId":"` + projectID.String() + `"}`,
+			verify: func(t *testing.T, update controller.TaskUpdate) {
+				t.Helper()
+				assert.Equal(t, &projectID, update.ProjectID)
+				assert.False(t, update.ClearProject)
+			},
+		},
+		{
+			name:          "invalid project ID",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `{"projectId":42}`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:          "wrong type for title",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `{"title":42}`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:          "invalid date",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `{"dueAt":"tomorrow"}`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:          "unknown field",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `{"priority":"high"}`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:          "read-only field",
+			mediaType:     mediaTypeJSONPatch,
+			patch:         `[{"op":"add","path":"/completedAt","value":"2024-02-01T09:00:00Z"}]`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:          "rank is read-only",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `{"rank":"a"}`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:          "assignee is read-only",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `{"assigneeId":"123e4567-e89b-12d3-a456-426614174003"}`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:          "patch replaces the whole document",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `"title"`,
+			expectedError: errInvalidPatchedTask,
+		},
+		{
+			name:          "malformed merge patch",
+			mediaType:     mediaTypeMergePatch,
+			patch:         `{"title":`,
+			expectedError: errInvalidPatch,
+		},
+		{
+			name:          "unsupported media type",
+			mediaType:     "application/json",
+			patch:         `{}`,
+			expectedError: errUnsupportedPatchType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			update, err := patchTask(current, tt.mediaType, []byte(tt.patch))
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Nil(t, update.Completed)
+			tt.verify(t, update)
+		})
+	}
+}
+
+func TestPatchTaskClearsParent(t *testing.T) {
+	t.Parallel()
+
+	parentID := createTaskID(uuid.New().String())
+	current := task.NewTaskWithoutValidation(
+		createTaskID(uuid.New().String()),
+		"Subtask",
+		createUserID(uuid.New().String()),
+		task.WithParentID(&parentID),
+	)
+
+	tests := []struct {
+		name      string
+		mediaType string
+		patch     string
+	}{
+		{name: "merge patch null", mediaType: mediaTypeMergePatch, patch: `{"parentId":null}`},
+		{name: "json patch remove", mediaType: mediaTypeJSONPatch, patch: `[{"op":"remove","path":"/parentId"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			update, err := patchTask(current, tt.mediaType, []byte(tt.patch))
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, update.ClearParent)
+			assert.Nil(t, update.ParentID)
+		})
+	}
+}
+
+func TestPatchTaskMovesToInbox(t *testing.T) {
+	t.Parallel()
+
+	projectID := projectDomain.GenerateProjectID()
+	current := task.NewTaskWithoutValidation(
+		createTaskID(uuid.New().String()),
+		"Task",
+		createUserID(uuid.New().String()),
+		task.WithProjectID(&projectID),
+	)
+
+	tests := []struct {
+		name      string
+		mediaType string
+		patch     string
+	}{
+		{name: "merge patch null", mediaType: mediaTypeMergePatch, patch: `{"projectId":null}`},
+		{name: "json patch remove", mediaType: mediaTypeJSONPatch, patch: `[{"op":"remove","path":"/projectId"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			update, err := patchTask(current, tt.mediaType, []byte(tt.patch))
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, update.ClearProject)
+			assert.Nil(t, update.ProjectID)
+		})
+	}
+}
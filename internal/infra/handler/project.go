@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	projectDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+)
+
+// ProjectHandler handles HTTP requests for project operations.
+type ProjectHandler struct {
+	controller  controller.Project
+	uuidAdapter *UUIDAdapter
+}
+
+// NewProjectHandler creates a new ProjectHandler with the provided controller.
+func NewProjectHandler(ctr controller.Project) *ProjectHandler {
+	return &ProjectHandler{
+		controller:  ctr,
+		uuidAdapter: NewUUIDAdapter(),
+	}
+}
+
+// isProjectNameError checks if the error is caused by an invalid project name
+func isProjectNameError(err error) bool {
+	return errors.Is(err, projectDomain.ErrNameEmpty) ||
+		errors.Is(err, projectDomain.ErrNameTooLong)
+}
+
+// toProjectResponse converts a domain project to its API representation
+func toProjectResponse(project *projectDomain.Project) taskHandler.Project {
+	return taskHandler.Project{
+		Id:   project.ID().UUID(),
+		Name: project.Name(),
+	}
+}
+
+// extractUserID extracts user ID from JWT context
+func (p *ProjectHandler) extractUserID(c echo.Context) (string, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return "", errors.New("user ID not found in token")
+	}
+
+	return userID, nil
+}
+
+func (p *ProjectHandler) GetAllProjects(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := p.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	projects, err := p.controller.GetAllProjects(c.Request().Context(), domainUserID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Project, 0, len(projects))
+
+	for _, project := range projects {
+		res = append(res, toProjectResponse(project))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (p *ProjectHandler) CreateProject(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := p.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.ProjectCreate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	project, err := p.controller.CreateProject(c.Request().Context(), domainUserID, req.Name)
+	if err != nil {
+		details := err.Error()
+		if isProjectNameError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusCreated, toProjectResponse(project))
+}
+
+func (p *ProjectHandler) GetProject(c echo.Context, projectId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := p.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainProjectID, err := p.uuidAdapter.ToDomainProjectID(projectId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid project ID format", &details))
+	}
+
+	project, err := p.controller.GetProjectById(c.Request().Context(), domainUserID, domainProjectID)
+	if err != nil {
+		if errors.Is(err, projectDomain.ErrProjectNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Project not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusOK, toProjectResponse(project))
+}
+
+func (p *ProjectHandler) UpdateProject(c echo.Context, projectId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := p.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.ProjectUpdate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainProjectID, err := p.uuidAdapter.ToDomainProjectID(projectId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid project ID format", &details))
+	}
+
+	project, err := p.controller.RenameProject(c.Request().Context(), domainUserID, domainProjectID, req.Name)
+	if err != nil {
+		if errors.Is(err, projectDomain.ErrProjectNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Project not found"))
+		}
+
+		details := err.Error()
+		if isProjectNameError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusOK, toProjectResponse(project))
+}
+
+func (p *ProjectHandler) DeleteProject(c echo.Context, projectId openapiTypes.UUID, params taskHandler.ProjectDeleteProjectParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := p.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainProjectID, err := p.uuidAdapter.ToDomainProjectID(projectId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid project ID format", &details))
+	}
+
+	disposalName := ""
+	if params.Tasks != nil {
+		disposalName = string(*params.Tasks)
+	}
+
+	disposal, err := projectDomain.ParseTaskDisposal(disposalName)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	err = p.controller.DeleteProject(c.Request().Context(), domainUserID, domainProjectID, disposal)
+	if err != nil {
+		if errors.Is(err, projectDomain.ErrProjectNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Project not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (p *ProjectHandler) GetProjectTasks(c echo.Context, projectId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := p.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainProjectID, err := p.uuidAdapter.ToDomainProjectID(projectId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid project ID format", &details))
+	}
+
+	tasks, err := p.controller.GetProjectTasks(c.Request().Context(), domainUserID, domainProjectID)
+	if err != nil {
+		if errors.Is(err, projectDomain.ErrProjectNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Project not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Task, 0, len(tasks))
+
+	for _, task := range tasks {
+		res = append(res, toTaskResponse(task))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
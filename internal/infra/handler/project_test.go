@@ -0,0 +1,478 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	projectDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+func setupProjectTestServer(ctrl *gomock.Controller) (*ProjectHandler, *mocks.MockProjectRepository, *mocks.MockTaskRepository) {
+	mockRepo := mocks.NewMockProjectRepository(ctrl)
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	projectController := controller.NewProject(mockRepo, mockTaskRepo)
+	handler := NewProjectHandler(*projectController)
+
+	return handler, mockRepo, mockTaskRepo
+}
+
+func createProjectID(s string) projectDomain.ProjectID {
+	projectID, err := projectDomain.NewProjectID(s)
+	if err != nil {
+		panic("failed to create project ID: " + err.Error())
+	}
+
+	return projectID
+}
+
+func TestProjectGetAllProjects(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo, _ := setupProjectTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+
+	projects := []*projectDomain.Project{
+		projectDomain.NewProjectWithoutValidation(projectDomain.GenerateProjectID(), "Home", userID),
+		projectDomain.NewProjectWithoutValidation(projectDomain.GenerateProjectID(), "Work", userID),
+	}
+	mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return(projects, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/projects", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.GetAllProjects(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var responseProjects []generated.Project
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseProjects)
+	require.NoError(t, err)
+	require.Len(t, responseProjects, 2)
+	assert.Equal(t, "Home", responseProjects[0].Name)
+	assert.Equal(t, projects[1].ID().String(), responseProjects[1].Id.String())
+}
+
+func TestProjectCreateProject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(repo *mocks.MockProjectRepository)
+		expectedStatus int
+	}{
+		{
+			name: "project created",
+			body: `{"name": " Work "}`,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, created *projectDomain.Project) (*projectDomain.Project, error) {
+						return created, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "empty name",
+			body:           `{"name": "  "}`,
+			setupMock:      func(*mocks.MockProjectRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid JSON",
+			body:           `{"name": `,
+			setupMock:      func(*mocks.MockProjectRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo, _ := setupProjectTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/projects", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", uuid.New().String())
+
+			// Act
+			err := handler.CreateProject(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var created generated.Project
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+				assert.Equal(t, "Work", created.Name)
+			}
+		})
+	}
+}
+
+func TestProjectGetProject(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	projectID := uuid.New().String()
+	userID := createUserID(testUserID)
+	projectDomainID := createProjectID(projectID)
+
+	tests := []struct {
+		name           string
+		setupMock      func(repo *mocks.MockProjectRepository)
+		expectedStatus int
+	}{
+		{
+			name: "project found",
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).
+					Return(projectDomain.NewProjectWithoutValidation(projectDomainID, "Work", userID), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "project not found",
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).Return(nil, projectDomain.ErrProjectNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "repository error",
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).Return(nil, fmt.Errorf("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo, _ := setupProjectTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/projects/"+projectID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.GetProject(c, testUUID(projectID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestProjectUpdateProject(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	projectID := uuid.New().String()
+	userID := createUserID(testUserID)
+	projectDomainID := createProjectID(projectID)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(repo *mocks.MockProjectRepository)
+		expectedStatus int
+	}{
+		{
+			name: "project renamed",
+			body: `{"name": "Office"}`,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).
+					Return(projectDomain.NewProjectWithoutValidation(projectDomainID, "Work", userID), nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, renamed *projectDomain.Project) (*projectDomain.Project, error) {
+						return renamed, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "project not found",
+			body: `{"name": "Office"}`,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).Return(nil, projectDomain.ErrProjectNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "name too long",
+			body: `{"name": "` + strings.Repeat("a", projectDomain.MaxNameLength+1) + `"}`,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).
+					Return(projectDomain.NewProjectWithoutValidation(projectDomainID, "Work", userID), nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo, _ := setupProjectTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/projects/"+projectID, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.UpdateProject(c, testUUID(projectID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var renamed generated.Project
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &renamed))
+				assert.Equal(t, "Office", renamed.Name)
+			}
+		})
+	}
+}
+
+func TestProjectDeleteProject(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	projectID := uuid.New().String()
+	userID := createUserID(testUserID)
+	projectDomainID := createProjectID(projectID)
+
+	inbox := generated.Inbox
+	deleteTasks := generated.Delete
+	unknown := generated.ProjectTaskDisposal("archive")
+
+	tests := []struct {
+		name           string
+		tasks          *generated.ProjectTaskDisposal
+		setupMock      func(repo *mocks.MockProjectRepository)
+		expectedStatus int
+	}{
+		{
+			name:  "tasks moved to the inbox by default",
+			tasks: nil,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().Delete(gomock.Any(), userID, projectDomainID, projectDomain.MoveTasksToInbox).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:  "tasks moved to the inbox",
+			tasks: &inbox,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().Delete(gomock.Any(), userID, projectDomainID, projectDomain.MoveTasksToInbox).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:  "tasks deleted",
+			tasks: &deleteTasks,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().Delete(gomock.Any(), userID, projectDomainID, projectDomain.DeleteTasks).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "unknown disposal",
+			tasks:          &unknown,
+			setupMock:      func(*mocks.MockProjectRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "project not found",
+			tasks: nil,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().Delete(gomock.Any(), userID, projectDomainID, projectDomain.MoveTasksToInbox).
+					Return(projectDomain.ErrProjectNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:  "repository error",
+			tasks: nil,
+			setupMock: func(repo *mocks.MockProjectRepository) {
+				repo.EXPECT().Delete(gomock.Any(), userID, projectDomainID, projectDomain.MoveTasksToInbox).
+					Return(fmt.Errorf("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo, _ := setupProjectTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/projects/"+projectID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.DeleteProject(c, testUUID(projectID), generated.ProjectDeleteProjectParams{Tasks: tt.tasks})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestProjectGetProjectTasks(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	projectID := uuid.New().String()
+	userID := createUserID(testUserID)
+	projectDomainID := createProjectID(projectID)
+
+	t.Run("tasks of the project are listed", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo, mockTaskRepo := setupProjectTestServer(ctrl)
+
+		projectTask := taskDomain.NewTaskWithoutValidation(taskDomain.GenerateTaskID(), "Task", userID, taskDomain.WithProjectID(&projectDomainID))
+		mockRepo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).
+			Return(projectDomain.NewProjectWithoutValidation(projectDomainID, "Work", userID), nil)
+		mockTaskRepo.EXPECT().FindAllByUserID(gomock.Any(), userID, taskDomain.Filter{ProjectID: &projectDomainID}).
+			Return([]*taskDomain.Task{projectTask}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/projects/"+projectID+"/tasks", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetProjectTasks(c, testUUID(projectID))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var responseTasks []generated.Task
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseTasks))
+		require.Len(t, responseTasks, 1)
+		require.NotNil(t, responseTasks[0].ProjectId)
+		assert.Equal(t, projectID, responseTasks[0].ProjectId.String())
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo, _ := setupProjectTestServer(ctrl)
+		mockRepo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).Return(nil, projectDomain.ErrProjectNotFound)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/projects/"+projectID+"/tasks", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetProjectTasks(c, testUUID(projectID))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestProjectAuthenticationRequired(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, _, _ := setupProjectTestServer(ctrl)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/projects", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Act
+	err := handler.GetAllProjects(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	tagDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+)
+
+// TagHandler handles HTTP requests for tag operations.
+type TagHandler struct {
+	controller  controller.Tag
+	uuidAdapter *UUIDAdapter
+}
+
+// NewTagHandler creates a new TagHandler with the provided controller.
+func NewTagHandler(ctr controller.Tag) *TagHandler {
+	return &TagHandler{
+		controller:  ctr,
+		uuidAdapter: NewUUIDAdapter(),
+	}
+}
+
+// isTagNameError checks if the error is caused by an invalid tag name
+func isTagNameError(err error) bool {
+	return errors.Is(err, tagDomain.ErrNameEmpty) ||
+		errors.Is(err, tagDomain.ErrNameTooLong)
+}
+
+// toTagResponse converts a domain tag to its API representation
+func toTagResponse(tag *tagDomain.Tag) taskHandler.Tag {
+	return taskHandler.Tag{
+		Id:   tag.ID().UUID(),
+		Name: tag.Name(),
+	}
+}
+
+// extractUserID extracts user ID from JWT context
+func (t *TagHandler) extractUserID(c echo.Context) (string, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return "", errors.New("user ID not found in token")
+	}
+
+	return userID, nil
+}
+
+func (t *TagHandler) GetAllTags(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	tags, err := t.controller.GetAllTags(c.Request().Context(), domainUserID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Tag, 0, len(tags))
+
+	for _, tag := range tags {
+		res = append(res, toTagResponse(tag))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (t *TagHandler) CreateTag(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.TagCreate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	tag, err := t.controller.CreateTag(c.Request().Context(), domainUserID, req.Name)
+	if err != nil {
+		details := err.Error()
+		if isTagNameError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		if errors.Is(err, tagDomain.ErrNameTaken) {
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusCreated, toTagResponse(tag))
+}
+
+func (t *TagHandler) GetTag(c echo.Context, tagId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainTagID, err := t.uuidAdapter.ToDomainTagID(tagId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid tag ID format", &details))
+	}
+
+	tag, err := t.controller.GetTagById(c.Request().Context(), domainUserID, domainTagID)
+	if err != nil {
+		if errors.Is(err, tagDomain.ErrTagNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Tag not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusOK, toTagResponse(tag))
+}
+
+func (t *TagHandler) UpdateTag(c echo.Context, tagId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.TagUpdate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainTagID, err := t.uuidAdapter.ToDomainTagID(tagId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid tag ID format", &details))
+	}
+
+	tag, err := t.controller.RenameTag(c.Request().Context(), domainUserID, domainTagID, req.Name)
+	if err != nil {
+		if errors.Is(err, tagDomain.ErrTagNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Tag not found"))
+		}
+
+		details := err.Error()
+		if isTagNameError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		if errors.Is(err, tagDomain.ErrNameTaken) {
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusOK, toTagResponse(tag))
+}
+
+func (t *TagHandler) DeleteTag(c echo.Context, tagId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainTagID, err := t.uuidAdapter.ToDomainTagID(tagId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid tag ID format", &details))
+	}
+
+	err = t.controller.DeleteTag(c.Request().Context(), domainUserID, domainTagID)
+	if err != nil {
+		if errors.Is(err, tagDomain.ErrTagNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Tag not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,388 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	tagDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+func setupTagTestServer(ctrl *gomock.Controller) (*TagHandler, *mocks.MockTagRepository) {
+	mockRepo := mocks.NewMockTagRepository(ctrl)
+	tagController := controller.NewTag(mockRepo)
+	handler := NewTagHandler(*tagController)
+
+	return handler, mockRepo
+}
+
+func createTagID(s string) tagDomain.TagID {
+	tagID, err := tagDomain.NewTagID(s)
+	if err != nil {
+		panic("failed to create tag ID: " + err.Error())
+	}
+
+	return tagID
+}
+
+func TestTagGetAllTags(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTagTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+
+	tags := []*tagDomain.Tag{
+		tagDomain.NewTagWithoutValidation(tagDomain.GenerateTagID(), "home", userID),
+		tagDomain.NewTagWithoutValidation(tagDomain.GenerateTagID(), "work", userID),
+	}
+	mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return(tags, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.GetAllTags(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var responseTags []generated.Tag
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseTags)
+	require.NoError(t, err)
+	require.Len(t, responseTags, 2)
+	assert.Equal(t, "home", responseTags[0].Name)
+	assert.Equal(t, tags[1].ID().String(), responseTags[1].Id.String())
+}
+
+func TestTagCreateTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(repo *mocks.MockTagRepository)
+		expectedStatus int
+	}{
+		{
+			name: "tag created",
+			body: `{"name": " work "}`,
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, created *tagDomain.Tag) (*tagDomain.Tag, error) {
+						return created, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "empty name",
+			body:           `{"name": "  "}`,
+			setupMock:      func(*mocks.MockTagRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "name already taken",
+			body: `{"name": "work"}`,
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, tagDomain.ErrNameTaken)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "invalid JSON",
+			body:           `{"name": `,
+			setupMock:      func(*mocks.MockTagRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTagTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/tags", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", uuid.New().String())
+
+			// Act
+			err := handler.CreateTag(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var created generated.Tag
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+				assert.Equal(t, "work", created.Name)
+			}
+		})
+	}
+}
+
+func TestTagGetTag(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	tagID := uuid.New().String()
+	userID := createUserID(testUserID)
+	tagDomainID := createTagID(tagID)
+
+	tests := []struct {
+		name           string
+		setupMock      func(repo *mocks.MockTagRepository)
+		expectedStatus int
+	}{
+		{
+			name: "tag found",
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, tagDomainID).
+					Return(tagDomain.NewTagWithoutValidation(tagDomainID, "work", userID), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "tag not found",
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, tagDomainID).Return(nil, tagDomain.ErrTagNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "repository error",
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, tagDomainID).Return(nil, fmt.Errorf("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTagTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/tags/"+tagID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.GetTag(c, testUUID(tagID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestTagUpdateTag(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	tagID := uuid.New().String()
+	userID := createUserID(testUserID)
+	tagDomainID := createTagID(tagID)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(repo *mocks.MockTagRepository)
+		expectedStatus int
+	}{
+		{
+			name: "tag renamed",
+			body: `{"name": "office"}`,
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, tagDomainID).
+					Return(tagDomain.NewTagWithoutValidation(tagDomainID, "work", userID), nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, renamed *tagDomain.Tag) (*tagDomain.Tag, error) {
+						return renamed, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "tag not found",
+			body: `{"name": "office"}`,
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, tagDomainID).Return(nil, tagDomain.ErrTagNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "name too long",
+			body: `{"name": "` + strings.Repeat("a", tagDomain.MaxNameLength+1) + `"}`,
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, tagDomainID).
+					Return(tagDomain.NewTagWithoutValidation(tagDomainID, "work", userID), nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "name already taken",
+			body: `{"name": "home"}`,
+			setupMock: func(repo *mocks.MockTagRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, tagDomainID).
+					Return(tagDomain.NewTagWithoutValidation(tagDomainID, "work", userID), nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, tagDomain.ErrNameTaken)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTagTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/tags/"+tagID, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.UpdateTag(c, testUUID(tagID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var renamed generated.Tag
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &renamed))
+				assert.Equal(t, "office", renamed.Name)
+			}
+		})
+	}
+}
+
+func TestTagDeleteTag(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	tagID := uuid.New().String()
+	userID := createUserID(testUserID)
+	tagDomainID := createTagID(tagID)
+
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "tag deleted",
+			mockError:      nil,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "tag not found",
+			mockError:      tagDomain.ErrTagNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "repository error",
+			mockError:      fmt.Errorf("database connection failed"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTagTestServer(ctrl)
+			mockRepo.EXPECT().Delete(gomock.Any(), userID, tagDomainID).Return(tt.mockError)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/tags/"+tagID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.DeleteTag(c, testUUID(tagID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestTagAuthenticationRequired(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, _ := setupTagTestServer(ctrl)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tags", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Act
+	err := handler.GetAllTags(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -2,14 +2,23 @@ package handler
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	openapiTypes "github.com/oapi-codegen/runtime/types"
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	projectDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	tagDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
 	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	workspaceDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
 	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
 )
 
@@ -31,12 +40,227 @@ func NewTaskHandler(ctr controller.Task) *TaskHandler {
 func isDomainValidationError(err error) bool {
 	return errors.Is(err, taskDomain.ErrTitleEmpty) ||
 		errors.Is(err, taskDomain.ErrTitleTooLong) ||
+		errors.Is(err, taskDomain.ErrStartAfterDue) ||
+		errors.Is(err, taskDomain.ErrAllDayWithoutDate) ||
+		errors.Is(err, taskDomain.ErrParentNotFound) ||
+		errors.Is(err, taskDomain.ErrParentCycle) ||
+		errors.Is(err, taskDomain.ErrTreeTooDeep) ||
+		errors.Is(err, taskDomain.ErrInvalidRecurrenceRule) ||
+		errors.Is(err, taskDomain.ErrInvalidTimezone) ||
+		errors.Is(err, taskDomain.ErrRecurrenceWithoutDate) ||
+		errors.Is(err, tagDomain.ErrNameEmpty) ||
+		errors.Is(err, tagDomain.ErrNameTooLong) ||
+		errors.Is(err, tagDomain.ErrTagNotFound) ||
+		errors.Is(err, projectDomain.ErrProjectNotFound) ||
 		errors.Is(err, user.ErrUserIDEmpty) ||
 		errors.Is(err, taskDomain.ErrTaskIDEmpty) ||
 		errors.Is(err, taskDomain.ErrInvalidTaskIDFormat) ||
 		errors.Is(err, user.ErrInvalidUserIDFormat)
 }
 
+// toTaskResponse converts a domain task to its API representation
+func toTaskResponse(task *taskDomain.Task) taskHandler.Task {
+	tags := task.Tags()
+	if tags == nil {
+		tags = []string{}
+	}
+
+	var parentID *openapiTypes.UUID
+	if task.ParentID() != nil {
+		id := task.ParentID().UUID()
+		parentID = &id
+	}
+
+	var projectID *openapiTypes.UUID
+	if task.ProjectID() != nil {
+		id := task.ProjectID().UUID()
+		projectID = &id
+	}
+
+	var workspaceID *openapiTypes.UUID
+	if task.WorkspaceID() != nil {
+		id := task.WorkspaceID().UUID()
+		workspaceID = &id
+	}
+
+	var assigneeID *openapiTypes.UUID
+	if task.AssigneeID() != nil {
+		id := task.AssigneeID().UUID()
+		assigneeID = &id
+	}
+
+	var recurrence *taskHandler.Recurrence
+	if series := task.Series(); series != nil {
+		recurrence = &taskHandler.Recurrence{
+			Rule:     series.Recurrence().Rule(),
+			SeriesId: series.ID().UUID(),
+			Timezone: series.Recurrence().Timezone(),
+		}
+	}
+
+	return taskHandler.Task{
+		Id:          task.ID().UUID(),
+		Title:       task.Title(),
+		Completed:   task.IsCompleted(),
+		CompletedAt: task.CompletedAt(),
+		StartAt:     task.Schedule().StartAt(),
+		DueAt:       task.Schedule().DueAt(),
+		AllDay:      task.Schedule().IsAllDay(),
+		DeletedAt:   task.DeletedAt(),
+		Tags:        tags,
+		ParentId:    parentID,
+		Children:    nil,
+		Blocked:     task.IsBlocked(),
+		Recurrence:  recurrence,
+		ProjectId:   projectID,
+		Rank:        task.Rank().String(),
+		Shared:      task.IsShared(),
+		Role:        toCollaboratorRoleResponse(task.SharedRole()),
+		WorkspaceId: workspaceID,
+		AssigneeId:  assigneeID,
+	}
+}
+
+// toCollaboratorRoleResponse converts the shared role of a task to its API
+// representation. It returns nil for a task the user created.
+func toCollaboratorRoleResponse(role *taskDomain.Role) *taskHandler.CollaboratorRole {
+	if role == nil {
+		return nil
+	}
+
+	res := taskHandler.CollaboratorRole(*role)
+
+	return &res
+}
+
+// toCollaboratorResponse converts a domain collaborator to its API representation
+func toCollaboratorResponse(collaborator taskDomain.Collaborator) taskHandler.Collaborator {
+	return taskHandler.Collaborator{
+		UserId: collaborator.UserID().UUID(),
+		Role:   taskHandler.CollaboratorRole(collaborator.Role()),
+	}
+}
+
+// toAssignmentResponse converts a domain assignment to its API representation
+func toAssignmentResponse(assignment taskDomain.Assignment) taskHandler.TaskAssignment {
+	var assigneeID *openapiTypes.UUID
+	if assignment.AssigneeID() != nil {
+		id := assignment.AssigneeID().UUID()
+		assigneeID = &id
+	}
+
+	return taskHandler.TaskAssignment{
+		AssigneeId: assigneeID,
+		AssignedBy: assignment.AssignedBy().UUID(),
+		AssignedAt: assignment.AssignedAt(),
+	}
+}
+
+// toChangeResponse converts a domain change to its API representation
+func toChangeResponse(change taskDomain.Change) taskHandler.TaskChange {
+	return taskHandler.TaskChange{
+		TaskId:     change.TaskID().UUID(),
+		Type:       taskHandler.TaskChangeType(change.Type()),
+		ActorId:    change.ActorID().UUID(),
+		Before:     change.Before(),
+		After:      change.After(),
+		OccurredAt: change.OccurredAt(),
+	}
+}
+
+// toRecurrenceInput converts a recurrence rule of a request to its controller input
+func toRecurrenceInput(rule *taskHandler.RecurrenceRule) *controller.TaskRecurrence {
+	if rule == nil {
+		return nil
+	}
+
+	return &controller.TaskRecurrence{
+		Rule:     rule.Rule,
+		Timezone: rule.Timezone,
+	}
+}
+
+// toTaskTreeResponse converts a domain task to its API representation with
+// its subtasks from tree nested in Children. Nesting stops after
+// taskDomain.MaxTreeDepth levels.
+func toTaskTreeResponse(task *taskDomain.Task, tree taskDomain.Tree, level int) taskHandler.Task {
+	res := toTaskResponse(task)
+	children := make([]taskHandler.Task, 0, len(tree.Children(task.ID())))
+
+	if level < taskDomain.MaxTreeDepth {
+		for _, child := range tree.Children(task.ID()) {
+			children = append(children, toTaskTreeResponse(child, tree, level+1))
+		}
+	}
+
+	res.Children = &children
+
+	return res
+}
+
+// includesChildren reports whether the include parameter requests subtasks
+func includesChildren(include *taskHandler.TaskInclude) bool {
+	return include != nil && *include == taskHandler.Children
+}
+
+// nextPageLink builds an RFC 8288 Link header value pointing at the page after
+// the current one. All other query parameters of the request are preserved.
+func nextPageLink(requestURL *url.URL, nextCursor string) string {
+	query := requestURL.Query()
+	query.Set("cursor", nextCursor)
+
+	next := url.URL{
+		Path:     requestURL.Path,
+		RawQuery: query.Encode(),
+	}
+
+	return "<" + next.String() + `>; rel="next"`
+}
+
+// errMultipleEntityTags is returned for If-Match headers that list several entity tags
+var errMultipleEntityTags = errors.New("If-Match with more than one entity tag is not supported")
+
+// entityTag formats a task version as a strong ETag
+func entityTag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseIfMatch extracts the task version required by an If-Match header.
+// It returns a nil version when the header is absent or "*", and ok = false
+// when the header can never match the ETag of a task, such as a weak tag.
+func parseIfMatch(ifMatch *string) (version *int64, ok bool, err error) {
+	if ifMatch == nil {
+		return nil, true, nil
+	}
+
+	value := strings.TrimSpace(*ifMatch)
+	if value == "*" {
+		return nil, true, nil
+	}
+
+	if strings.Contains(value, ",") {
+		return nil, false, errMultipleEntityTags
+	}
+
+	// If-Match uses strong comparison, so weak tags never match.
+	unquoted, found := strings.CutPrefix(value, `"`)
+	if !found {
+		return nil, false, nil
+	}
+
+	unquoted, found = strings.CutSuffix(unquoted, `"`)
+	if !found {
+		return nil, false, nil
+	}
+
+	parsed, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return nil, false, nil //nolint:nilerr // a foreign entity tag is a failed precondition, not a malformed request
+	}
+
+	return &parsed, true, nil
+}
+
 // extractUserID extracts user ID from JWT context
 func (t *TaskHandler) extractUserID(c echo.Context) (string, error) {
 	userID, ok := c.Get("user_id").(string)
@@ -44,10 +268,1037 @@ func (t *TaskHandler) extractUserID(c echo.Context) (string, error) {
 		return "", errors.New("user ID not found in token")
 	}
 
-	return userID, nil
+	return userID, nil
+}
+
+// toDomainOptionalTaskID converts an optional task UUID, such as a parent or a
+// neighbour, to a domain TaskID
+func (t *TaskHandler) toDomainOptionalTaskID(taskID *openapiTypes.UUID) (*taskDomain.TaskID, error) {
+	if taskID == nil {
+		return nil, nil
+	}
+
+	id, err := t.uuidAdapter.ToDomainTaskID(*taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+var (
+	// errInvalidParentID is returned for an update with a malformed parent ID.
+	errInvalidParentID = errors.New("invalid parent ID format")
+	// errInvalidProjectID is returned for an update with a malformed project ID.
+	errInvalidProjectID = errors.New("invalid project ID format")
+)
+
+// toTaskUpdate converts the body of PUT /tasks/{taskId}, which the task.update
+// method of task sockets takes as well, to the changes of the controller.
+// Omitted fields are left unchanged; clearing them is up to PATCH.
+func (t *TaskHandler) toTaskUpdate(req taskHandler.TaskUpdate) (controller.TaskUpdate, error) {
+	parentID, err := t.toDomainOptionalTaskID(req.ParentId)
+	if err != nil {
+		return controller.TaskUpdate{}, fmt.Errorf("%w: %w", errInvalidParentID, err)
+	}
+
+	projectID, err := t.toDomainProjectID(req.ProjectId)
+	if err != nil {
+		return controller.TaskUpdate{}, fmt.Errorf("%w: %w", errInvalidProjectID, err)
+	}
+
+	return controller.TaskUpdate{
+		Title:        req.Title,
+		Completed:    req.Completed,
+		StartAt:      req.StartAt,
+		DueAt:        req.DueAt,
+		AllDay:       req.AllDay,
+		Tags:         req.Tags,
+		ParentID:     parentID,
+		ProjectID:    projectID,
+		ClearStartAt: false,
+		ClearDueAt:   false,
+		ClearParent:  false,
+		ClearProject: false,
+	}, nil
+}
+
+// toDomainProjectID converts an optional project UUID to a domain ProjectID
+func (t *TaskHandler) toDomainProjectID(projectID *openapiTypes.UUID) (*projectDomain.ProjectID, error) {
+	if projectID == nil {
+		return nil, nil
+	}
+
+	id, err := t.uuidAdapter.ToDomainProjectID(*projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// toDomainAssigneeID converts the assigned_to query parameter to a domain
+// UserID. The value me stands for the authenticated user.
+func (t *TaskHandler) toDomainAssigneeID(value string, userID user.UserID) (user.UserID, error) {
+	if value == "me" {
+		return userID, nil
+	}
+
+	return user.NewUserID(value)
+}
+
+func (t *TaskHandler) GetAllTasks(c echo.Context, params taskHandler.TaskGetAllTasksParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert string userID to domain CreatorID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	filter := taskDomain.Filter{
+		Completed:  params.Completed,
+		DueBefore:  params.DueBefore,
+		DueAfter:   params.DueAfter,
+		Tag:        nil,
+		Conditions: nil,
+	}
+
+	// Within a workspace only the tasks scoped to it are listed
+	if member := workspaceMember(c); member != nil {
+		workspaceID := member.WorkspaceID()
+		filter.WorkspaceID = &workspaceID
+	}
+
+	if params.Tag != nil {
+		name, err := tagDomain.NormalizeName(*params.Tag)
+		if err != nil {
+			details := err.Error()
+
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		filter.Tag = &name
+	}
+
+	if params.AssignedTo != nil {
+		assigneeID, err := t.toDomainAssigneeID(*params.AssignedTo, domainUserID)
+		if err != nil {
+			details := err.Error()
+
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid assignee ID format", &details))
+		}
+
+		filter.AssigneeID = &assigneeID
+	}
+
+	if params.Filter != nil {
+		for _, expr := range *params.Filter {
+			condition, err := taskDomain.ParseCondition(expr)
+			if err != nil {
+				details := err.Error()
+
+				return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+			}
+
+			filter.Conditions = append(filter.Conditions, condition)
+		}
+	}
+
+	sortExpr := ""
+	if params.Sort != nil {
+		sortExpr = *params.Sort
+	}
+
+	sort, err := taskDomain.ParseSort(sortExpr)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	cursor := ""
+	if params.Cursor != nil {
+		cursor = *params.Cursor
+	}
+
+	pageRequest, err := taskDomain.NewPageRequest(limit, cursor, sort)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	page, err := t.controller.GetTaskPage(c.Request().Context(), domainUserID, filter, pageRequest)
+	if err != nil {
+		details := err.Error()
+		if errors.Is(err, taskDomain.ErrInvalidCursor) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Task, 0, len(page.Tasks))
+
+	if includesChildren(params.Include) {
+		tree, err := t.controller.ExpandSubtasks(c.Request().Context(), domainUserID, page.Tasks)
+		if err != nil {
+			details := err.Error()
+
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+
+		for _, task := range page.Tasks {
+			res = append(res, toTaskTreeResponse(task, tree, 1))
+		}
+	} else {
+		for _, task := range page.Tasks {
+			res = append(res, toTaskResponse(task))
+		}
+	}
+
+	if page.HasNext() {
+		c.Response().Header().Set("Link", nextPageLink(c.Request().URL, page.NextCursor))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (t *TaskHandler) SearchTasks(c echo.Context, params taskHandler.TaskSearchTasksParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert string userID to domain CreatorID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	query, err := taskDomain.NewSearchQuery(params.Q, limit)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	tasks, err := t.controller.SearchTasks(c.Request().Context(), domainUserID, query)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Task, 0, len(tasks))
+
+	for _, task := range tasks {
+		res = append(res, toTaskResponse(task))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (t *TaskHandler) CreateTask(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.TaskCreate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	if req.Title == "" {
+		details := "title field is required and cannot be empty"
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	// Convert string userID to domain CreatorID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	parentID, err := t.toDomainOptionalTaskID(req.ParentId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid parent ID format", &details))
+	}
+
+	projectID, err := t.toDomainProjectID(req.ProjectId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid project ID format", &details))
+	}
+
+	input := controller.TaskCreate{
+		Title:      req.Title,
+		StartAt:    req.StartAt,
+		DueAt:      req.DueAt,
+		AllDay:     req.AllDay != nil && *req.AllDay,
+		Tags:       nil,
+		ParentID:   parentID,
+		ProjectID:  projectID,
+		Recurrence: toRecurrenceInput(req.Recurrence),
+		Workspace:  workspaceMember(c),
+	}
+
+	if req.Tags != nil {
+		input.Tags = *req.Tags
+	}
+
+	task, err := t.controller.CreateTask(c.Request().Context(), domainUserID, input)
+	if err != nil {
+		details := err.Error()
+		if isDomainValidationError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		if errors.Is(err, workspaceDomain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusCreated, toTaskResponse(task))
+}
+
+func (t *TaskHandler) DeleteTask(c echo.Context, taskId openapiTypes.UUID, params taskHandler.TaskDeleteTaskParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	expectedVersion, ok, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	if !ok {
+		details := taskDomain.ErrVersionMismatch.Error()
+
+		return c.JSON(http.StatusPreconditionFailed, NewPreconditionFailedError("Precondition failed", &details))
+	}
+
+	cascade := params.Cascade != nil && *params.Cascade
+
+	err = t.controller.DeleteTask(c.Request().Context(), domainUserID, domainTaskID, expectedVersion, cascade)
+	if err != nil {
+		details := err.Error()
+		if errors.Is(err, taskDomain.ErrVersionMismatch) {
+			return c.JSON(http.StatusPreconditionFailed, NewPreconditionFailedError("Precondition failed", &details))
+		}
+
+		if errors.Is(err, taskDomain.ErrHasSubtasks) {
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		}
+
+		if errors.Is(err, taskDomain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (t *TaskHandler) GetTrash(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert string userID to domain CreatorID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	tasks, err := t.controller.GetTrash(c.Request().Context(), domainUserID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Task, 0, len(tasks))
+
+	for _, task := range tasks {
+		res = append(res, toTaskResponse(task))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (t *TaskHandler) EmptyTrash(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert string userID to domain CreatorID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	err = t.controller.EmptyTrash(c.Request().Context(), domainUserID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (t *TaskHandler) RestoreTask(c echo.Context, taskId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	task, err := t.controller.RestoreTask(c.Request().Context(), domainUserID, domainTaskID)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found in trash"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	c.Response().Header().Set("ETag", entityTag(task.Version()))
+
+	return c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+func (t *TaskHandler) GetTask(c echo.Context, taskId openapiTypes.UUID, params taskHandler.TaskGetTaskParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	task, err := t.controller.GetTaskById(c.Request().Context(), domainUserID, domainTaskID)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	if task == nil {
+		return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+	}
+
+	c.Response().Header().Set("ETag", entityTag(task.Version()))
+
+	if !includesChildren(params.Include) {
+		return c.JSON(http.StatusOK, toTaskResponse(task))
+	}
+
+	tree, err := t.controller.ExpandSubtasks(c.Request().Context(), domainUserID, []*taskDomain.Task{task})
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusOK, toTaskTreeResponse(task, tree, 1))
+}
+
+func (t *TaskHandler) GetSubtasks(c echo.Context, taskId openapiTypes.UUID, params taskHandler.TaskGetSubtasksParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	tree, err := t.controller.GetSubtasks(c.Request().Context(), domainUserID, domainTaskID)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	subtasks := tree.Children(domainTaskID)
+	res := make([]taskHandler.Task, 0, len(subtasks))
+
+	for _, subtask := range subtasks {
+		if includesChildren(params.Include) {
+			// The subtasks are the second level below the requested task.
+			res = append(res, toTaskTreeResponse(subtask, tree, 2))
+		} else {
+			res = append(res, toTaskResponse(subtask))
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (t *TaskHandler) UpdateTask(c echo.Context, taskId openapiTypes.UUID, params taskHandler.TaskUpdateTaskParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.TaskUpdate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	expectedVersion, ok, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	if !ok {
+		details := taskDomain.ErrVersionMismatch.Error()
+
+		return c.JSON(http.StatusPreconditionFailed, NewPreconditionFailedError("Precondition failed", &details))
+	}
+
+	update, err := t.toTaskUpdate(req)
+	if err != nil {
+		details := err.Error()
+		if errors.Is(err, errInvalidParentID) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid parent ID format", &details))
+		}
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid project ID format", &details))
+	}
+
+	task, err := t.controller.UpdateTask(c.Request().Context(), domainUserID, domainTaskID, update, expectedVersion)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+		if errors.Is(err, taskDomain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		}
+
+		if errors.Is(err, taskDomain.ErrVersionMismatch) {
+			return c.JSON(http.StatusPreconditionFailed, NewPreconditionFailedError("Precondition failed", &details))
+		}
+
+		if isDomainValidationError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	c.Response().Header().Set("ETag", entityTag(task.Version()))
+
+	return c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+func (t *TaskHandler) PatchTask(c echo.Context, taskId openapiTypes.UUID, params taskHandler.TaskPatchTaskParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	mediaType, _, err := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if err != nil || (mediaType != mediaTypeMergePatch && mediaType != mediaTypeJSONPatch) {
+		details := errUnsupportedPatchType.Error()
+
+		return c.JSON(http.StatusUnsupportedMediaType, NewUnsupportedMediaTypeError("Unsupported media type", &details))
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	expectedVersion, ok, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	if !ok {
+		details := taskDomain.ErrVersionMismatch.Error()
+
+		return c.JSON(http.StatusPreconditionFailed, NewPreconditionFailedError("Precondition failed", &details))
+	}
+
+	current, err := t.controller.GetTaskById(c.Request().Context(), domainUserID, domainTaskID)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	if expectedVersion != nil && *expectedVersion != current.Version() {
+		details := taskDomain.ErrVersionMismatch.Error()
+
+		return c.JSON(http.StatusPreconditionFailed, NewPreconditionFailedError("Precondition failed", &details))
+	}
+
+	update, err := patchTask(current, mediaType, body)
+	if err != nil {
+		details := err.Error()
+
+		switch {
+		case errors.Is(err, errInvalidPatch):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, errPatchConflict):
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		case errors.Is(err, errInvalidPatchedTask):
+			return c.JSON(http.StatusUnprocessableEntity, NewUnprocessableEntityError("Unprocessable entity", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	// The patch was computed against the version just read, so apply it only
+	// if the task has not changed in between.
+	readVersion := current.Version()
+
+	task, err := t.controller.UpdateTask(c.Request().Context(), domainUserID, domainTaskID, update, &readVersion)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+		if errors.Is(err, taskDomain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		}
+
+		if errors.Is(err, taskDomain.ErrVersionMismatch) {
+			return c.JSON(http.StatusPreconditionFailed, NewPreconditionFailedError("Precondition failed", &details))
+		}
+
+		if isDomainValidationError(err) {
+			return c.JSON(http.StatusUnprocessableEntity, NewUnprocessableEntityError("Unprocessable entity", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	c.Response().Header().Set("ETag", entityTag(task.Version()))
+
+	return c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+func (t *TaskHandler) GetCollaborators(c echo.Context, taskId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	collaborators, err := t.controller.GetCollaborators(c.Request().Context(), domainUserID, domainTaskID)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Collaborator, 0, len(collaborators))
+
+	for _, collaborator := range collaborators {
+		res = append(res, toCollaboratorResponse(collaborator))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (t *TaskHandler) AddCollaborator(c echo.Context, taskId openapiTypes.UUID, userId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.CollaboratorInvite
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	collaboratorID, err := t.uuidAdapter.ToDomainUserID(userId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid collaborator ID format", &details))
+	}
+
+	collaborator, err := t.controller.AddCollaborator(c.Request().Context(), domainUserID, domainTaskID, collaboratorID, string(req.Role))
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		switch {
+		case errors.Is(err, taskDomain.ErrInvalidRole), errors.Is(err, taskDomain.ErrShareWithCreator):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, taskDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	return c.JSON(http.StatusOK, toCollaboratorResponse(collaborator))
+}
+
+func (t *TaskHandler) RemoveCollaborator(c echo.Context, taskId openapiTypes.UUID, userId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	collaboratorID, err := t.uuidAdapter.ToDomainUserID(userId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid collaborator ID format", &details))
+	}
+
+	err = t.controller.RemoveCollaborator(c.Request().Context(), domainUserID, domainTaskID, collaboratorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, taskDomain.ErrTaskNotFound):
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		case errors.Is(err, taskDomain.ErrCollaboratorNotFound):
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Collaborator not found"))
+		}
+
+		details := err.Error()
+		if errors.Is(err, taskDomain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (t *TaskHandler) AssignTask(c echo.Context, taskId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.TaskAssigneeUpdate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	var assigneeID *user.UserID
+
+	if req.AssigneeId != nil {
+		id, err := t.uuidAdapter.ToDomainUserID(*req.AssigneeId)
+		if err != nil {
+			details := err.Error()
+
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid assignee ID format", &details))
+		}
+
+		assigneeID = &id
+	}
+
+	task, err := t.controller.AssignTask(c.Request().Context(), domainUserID, domainTaskID, assigneeID)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		switch {
+		case errors.Is(err, taskDomain.ErrAssigneeWithoutAccess):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, taskDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		case errors.Is(err, taskDomain.ErrVersionMismatch):
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	c.Response().Header().Set("ETag", entityTag(task.Version()))
+
+	return c.JSON(http.StatusOK, toTaskResponse(task))
 }
 
-func (t *TaskHandler) GetAllTasks(c echo.Context) error {
+func (t *TaskHandler) GetAssignments(c echo.Context, taskId openapiTypes.UUID) error {
 	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
 	userID, err := t.extractUserID(c)
 	if err != nil {
@@ -56,7 +1307,7 @@ func (t *TaskHandler) GetAllTasks(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
 	}
 
-	// Convert string userID to domain CreatorID
+	// Convert userID to domain UserID
 	domainUserID, err := user.NewUserID(userID)
 	if err != nil {
 		details := err.Error()
@@ -64,26 +1315,35 @@ func (t *TaskHandler) GetAllTasks(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
 	}
 
-	tasks, err := t.controller.GetAllTasks(c.Request().Context(), domainUserID)
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	assignments, err := t.controller.GetAssignments(c.Request().Context(), domainUserID, domainTaskID)
 	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
 		details := err.Error()
 
 		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
 	}
 
-	res := make([]taskHandler.Task, 0, len(tasks))
+	res := make([]taskHandler.TaskAssignment, 0, len(assignments))
 
-	for _, task := range tasks {
-		res = append(res, taskHandler.Task{
-			Id:    task.ID().UUID(),
-			Title: task.Title(),
-		})
+	for _, assignment := range assignments {
+		res = append(res, toAssignmentResponse(assignment))
 	}
 
 	return c.JSON(http.StatusOK, res)
 }
 
-func (t *TaskHandler) CreateTask(c echo.Context) error {
+func (t *TaskHandler) GetHistory(c echo.Context, taskId openapiTypes.UUID, params taskHandler.TaskGetHistoryParams) error {
 	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
 	userID, err := t.extractUserID(c)
 	if err != nil {
@@ -92,47 +1352,67 @@ func (t *TaskHandler) CreateTask(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
 	}
 
-	var req taskHandler.TaskCreate
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
 
-	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
 		details := err.Error()
 
-		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
 	}
 
-	if req.Title == "" {
-		details := "title field is required and cannot be empty"
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
 
-		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	cursor := ""
+	if params.Cursor != nil {
+		cursor = *params.Cursor
 	}
 
-	// Convert string userID to domain CreatorID
-	domainUserID, err := user.NewUserID(userID)
+	pageRequest, err := taskDomain.NewPageRequest(limit, cursor, nil)
 	if err != nil {
 		details := err.Error()
 
-		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
 	}
 
-	task, err := t.controller.CreateTask(c.Request().Context(), domainUserID, req.Title)
+	page, err := t.controller.GetHistory(c.Request().Context(), domainUserID, domainTaskID, pageRequest)
 	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
 		details := err.Error()
-		if isDomainValidationError(err) {
+		if errors.Is(err, taskDomain.ErrInvalidCursor) {
 			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
 		}
 
 		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
 	}
 
-	res := taskHandler.Task{
-		Id:    task.ID().UUID(),
-		Title: task.Title(),
+	res := make([]taskHandler.TaskChange, 0, len(page.Changes))
+
+	for _, change := range page.Changes {
+		res = append(res, toChangeResponse(change))
+	}
+
+	if page.HasNext() {
+		c.Response().Header().Set("Link", nextPageLink(c.Request().URL, page.NextCursor))
 	}
 
-	return c.JSON(http.StatusCreated, res)
+	return c.JSON(http.StatusOK, res)
 }
 
-func (t *TaskHandler) DeleteTask(c echo.Context, taskId openapiTypes.UUID) error {
+func (t *TaskHandler) GetDependencies(c echo.Context, taskId openapiTypes.UUID) error {
 	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
 	userID, err := t.extractUserID(c)
 	if err != nil {
@@ -157,17 +1437,27 @@ func (t *TaskHandler) DeleteTask(c echo.Context, taskId openapiTypes.UUID) error
 		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
 	}
 
-	err = t.controller.DeleteTask(c.Request().Context(), domainUserID, domainTaskID)
+	blockers, err := t.controller.GetBlockers(c.Request().Context(), domainUserID, domainTaskID)
 	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
 		details := err.Error()
 
 		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
 	}
 
-	return c.JSON(http.StatusNoContent, nil)
+	res := make([]taskHandler.Task, 0, len(blockers))
+
+	for _, blocker := range blockers {
+		res = append(res, toTaskResponse(blocker))
+	}
+
+	return c.JSON(http.StatusOK, res)
 }
 
-func (t *TaskHandler) GetTask(c echo.Context, taskId openapiTypes.UUID) error {
+func (t *TaskHandler) AddDependency(c echo.Context, taskId openapiTypes.UUID, blockerId openapiTypes.UUID) error {
 	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
 	userID, err := t.extractUserID(c)
 	if err != nil {
@@ -192,7 +1482,14 @@ func (t *TaskHandler) GetTask(c echo.Context, taskId openapiTypes.UUID) error {
 		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
 	}
 
-	task, err := t.controller.GetTaskById(c.Request().Context(), domainUserID, domainTaskID)
+	domainBlockerID, err := t.uuidAdapter.ToDomainTaskID(blockerId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid blocker ID format", &details))
+	}
+
+	err = t.controller.AddDependency(c.Request().Context(), domainUserID, domainTaskID, domainBlockerID)
 	if err != nil {
 		if errors.Is(err, taskDomain.ErrTaskNotFound) {
 			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
@@ -200,22 +1497,75 @@ func (t *TaskHandler) GetTask(c echo.Context, taskId openapiTypes.UUID) error {
 
 		details := err.Error()
 
-		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		switch {
+		case errors.Is(err, taskDomain.ErrSelfDependency):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, taskDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		case errors.Is(err, taskDomain.ErrDependencyCycle):
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
 	}
 
-	if task == nil {
-		return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (t *TaskHandler) RemoveDependency(c echo.Context, taskId openapiTypes.UUID, blockerId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
 	}
 
-	res := taskHandler.Task{
-		Id:    task.ID().UUID(),
-		Title: task.Title(),
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
 	}
 
-	return c.JSON(http.StatusOK, res)
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	domainBlockerID, err := t.uuidAdapter.ToDomainTaskID(blockerId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid blocker ID format", &details))
+	}
+
+	err = t.controller.RemoveDependency(c.Request().Context(), domainUserID, domainTaskID, domainBlockerID)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		if errors.Is(err, taskDomain.ErrDependencyNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Dependency not found"))
+		}
+
+		details := err.Error()
+		if errors.Is(err, taskDomain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.NoContent(http.StatusNoContent)
 }
 
-func (t *TaskHandler) UpdateTask(c echo.Context, taskId openapiTypes.UUID) error {
+func (t *TaskHandler) MoveTask(c echo.Context, taskId openapiTypes.UUID) error {
 	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
 	userID, err := t.extractUserID(c)
 	if err != nil {
@@ -224,7 +1574,7 @@ func (t *TaskHandler) UpdateTask(c echo.Context, taskId openapiTypes.UUID) error
 		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
 	}
 
-	var req taskHandler.TaskUpdate
+	var req taskHandler.TaskMove
 
 	if err := c.Bind(&req); err != nil {
 		details := err.Error()
@@ -248,7 +1598,21 @@ func (t *TaskHandler) UpdateTask(c echo.Context, taskId openapiTypes.UUID) error
 		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
 	}
 
-	task, err := t.controller.GetTaskById(c.Request().Context(), domainUserID, domainTaskID)
+	afterID, err := t.toDomainOptionalTaskID(req.AfterId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid neighbour ID format", &details))
+	}
+
+	beforeID, err := t.toDomainOptionalTaskID(req.BeforeId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid neighbour ID format", &details))
+	}
+
+	task, err := t.controller.MoveTask(c.Request().Context(), domainUserID, domainTaskID, afterID, beforeID)
 	if err != nil {
 		if errors.Is(err, taskDomain.ErrTaskNotFound) {
 			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
@@ -256,21 +1620,139 @@ func (t *TaskHandler) UpdateTask(c echo.Context, taskId openapiTypes.UUID) error
 
 		details := err.Error()
 
-		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		switch {
+		case errors.Is(err, taskDomain.ErrPlacementWithoutNeighbour),
+			errors.Is(err, taskDomain.ErrInvalidPlacement),
+			errors.Is(err, taskDomain.ErrNeighbourNotFound):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, taskDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		case errors.Is(err, taskDomain.ErrRankOrder), errors.Is(err, taskDomain.ErrRankTooLong):
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
 	}
 
-	if task == nil {
-		return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+	c.Response().Header().Set("ETag", entityTag(task.Version()))
+
+	return c.JSON(http.StatusOK, toTaskResponse(task))
+}
+
+func (t *TaskHandler) GetOccurrences(c echo.Context, taskId openapiTypes.UUID, params taskHandler.TaskGetOccurrencesParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
+	}
+
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	schedules, err := t.controller.GetOccurrences(c.Request().Context(), domainUserID, domainTaskID, limit)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
+		details := err.Error()
+
+		switch {
+		case errors.Is(err, taskDomain.ErrInvalidOccurrenceLimit):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, taskDomain.ErrNotRecurring):
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	res := make([]taskHandler.Occurrence, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		res = append(res, taskHandler.Occurrence{
+			StartAt: schedule.StartAt(),
+			DueAt:   schedule.DueAt(),
+			AllDay:  schedule.IsAllDay(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (t *TaskHandler) UpdateSeries(c echo.Context, taskId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := t.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.TaskSeriesUpdate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	// Convert userID to domain UserID
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Convert UUID taskId to domain TaskID
+	domainTaskID, err := t.uuidAdapter.ToDomainTaskID(taskId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid task ID format", &details))
 	}
 
-	title := task.Title()
-	if req.Title != nil {
-		title = *req.Title
+	update := controller.SeriesUpdate{
+		Title:      req.Title,
+		Recurrence: toRecurrenceInput(req.Recurrence),
 	}
 
-	task, err = t.controller.UpdateTask(c.Request().Context(), domainUserID, domainTaskID, title)
+	task, err := t.controller.UpdateSeries(c.Request().Context(), domainUserID, domainTaskID, update)
 	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Task not found"))
+		}
+
 		details := err.Error()
+		if errors.Is(err, taskDomain.ErrForbidden) {
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		}
+
+		if errors.Is(err, taskDomain.ErrNotRecurring) {
+			return c.JSON(http.StatusConflict, NewConflictError("Conflict", &details))
+		}
+
 		if isDomainValidationError(err) {
 			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
 		}
@@ -278,10 +1760,7 @@ func (t *TaskHandler) UpdateTask(c echo.Context, taskId openapiTypes.UUID) error
 		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
 	}
 
-	res := taskHandler.Task{
-		Id:    task.ID().UUID(),
-		Title: task.Title(),
-	}
+	c.Response().Header().Set("ETag", entityTag(task.Version()))
 
-	return c.JSON(http.StatusOK, res)
+	return c.JSON(http.StatusOK, toTaskResponse(task))
 }
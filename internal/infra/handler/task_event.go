@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	openapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
+	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+)
+
+// Types of the events sent to task event streams.
+const (
+	streamEventCreated = "created"
+	streamEventUpdated = "updated"
+	streamEventDeleted = "deleted"
+	// streamEventReset tells a client whose stream could not be resumed to
+	// load its tasks again.
+	streamEventReset = "reset"
+)
+
+var errLastEventIDNotNumber = errors.New("Last-Event-ID must be a number")
+
+// deletedTaskData is the data of a deleted event, which only names the task.
+type deletedTaskData struct {
+	Id openapiTypes.UUID `json:"id"`
+}
+
+// TaskEventHandler streams the changes to the tasks of a user as Server-Sent Events.
+type TaskEventHandler struct {
+	broker *eventstream.Broker
+}
+
+// NewTaskEventHandler creates a new TaskEventHandler that streams the events of the broker.
+func NewTaskEventHandler(broker *eventstream.Broker) *TaskEventHandler {
+	return &TaskEventHandler{
+		broker: broker,
+	}
+}
+
+// extractUserID extracts user ID from JWT context
+func (h *TaskEventHandler) extractUserID(c echo.Context) (string, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return "", errors.New("user ID not found in token")
+	}
+
+	return userID, nil
+}
+
+// writeStreamEvent writes an event in the text/event-stream format. The data
+// is JSON, which holds no line breaks, so it fits on a single data line.
+func writeStreamEvent(w io.Writer, event eventstream.Event) error {
+	_, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Data)
+
+	return err
+}
+
+func (h *TaskEventHandler) StreamEvents(c echo.Context, params taskHandler.TaskStreamEventsParams) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Without Last-Event-ID a new stream is started
+	var lastEventID *uint64
+
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		id, err := strconv.ParseUint(*params.LastEventID, 10, 64)
+		if err != nil {
+			details := errLastEventIDNotNumber.Error()
+
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid Last-Event-ID", &details))
+		}
+
+		lastEventID = &id
+	}
+
+	sub, missed, err := h.broker.Subscribe(domainUserID, lastEventID)
+
+	reset := errors.Is(err, eventstream.ErrEventsExpired)
+	if reset {
+		sub, missed, err = h.broker.Subscribe(domainUserID, nil)
+	}
+
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+	defer sub.Close()
+
+	// The stream stays open for as long as the client listens, so the write
+	// timeout of the server must not cut it off.
+	err = http.NewResponseController(c.Response()).SetWriteDeadline(time.Time{})
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	// Keep reverse proxies such as nginx from buffering the stream
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+
+	if reset {
+		if _, err := fmt.Fprintf(response, "event: %s\ndata: {}\n\n", streamEventReset); err != nil {
+			return err
+		}
+	}
+
+	for _, event := range missed {
+		if err := writeStreamEvent(response, event); err != nil {
+			return err
+		}
+	}
+
+	response.Flush()
+
+	heartbeat := time.NewTicker(h.broker.HeartbeatInterval())
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-sub.Dropped():
+			// The client fell behind; it reconnects and resumes from the log
+			return nil
+		case event := <-sub.Events():
+			if err := writeStreamEvent(response, event); err != nil {
+				return err
+			}
+
+			heartbeat.Reset(h.broker.HeartbeatInterval())
+		case <-heartbeat.C:
+			if _, err := io.WriteString(response, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+		}
+
+		response.Flush()
+	}
+}
+
+// TaskStreamPublisher turns the events of saved tasks into events of the task
+// event streams of the users who can see them.
+type TaskStreamPublisher struct {
+	controller controller.Task
+	broker     *eventstream.Broker
+}
+
+// NewTaskStreamPublisher creates a new TaskStreamPublisher that reads tasks
+// through the controller and publishes their events to the broker.
+func NewTaskStreamPublisher(ctr controller.Task, broker *eventstream.Broker) *TaskStreamPublisher {
+	return &TaskStreamPublisher{
+		controller: ctr,
+		broker:     broker,
+	}
+}
+
+// Handle publishes the event of a created, updated or deleted task to its
+// creator, its collaborators and its assignee, together with the task as each
+// of them sees it. Other events are covered by TaskUpdated and are ignored.
+func (p *TaskStreamPublisher) Handle(ctx context.Context, event taskDomain.Event) error {
+	var eventType string
+
+	switch event.(type) {
+	case taskDomain.TaskCreated:
+		eventType = streamEventCreated
+	case taskDomain.TaskUpdated:
+		eventType = streamEventUpdated
+	case taskDomain.TaskDeleted:
+		eventType = streamEventDeleted
+	default:
+		return nil
+	}
+
+	audience, err := p.controller.GetAudience(ctx, event.UserID(), event.TaskID())
+	if err != nil {
+		return err
+	}
+
+	if eventType == streamEventDeleted {
+		data, err := json.Marshal(deletedTaskData{Id: event.TaskID().UUID()})
+		if err != nil {
+			return err
+		}
+
+		for _, recipient := range audience {
+			p.broker.Publish(recipient, eventType, data)
+		}
+
+		return nil
+	}
+
+	for _, recipient := range audience {
+		if err := p.publishTask(ctx, recipient, eventType, event.TaskID()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishTask publishes the task with the given ID as the recipient sees it,
+// with their role if it is shared with them.
+func (p *TaskStreamPublisher) publishTask(ctx context.Context, recipient user.UserID, eventType string, id taskDomain.TaskID) error {
+	taskEntity, err := p.controller.GetTaskById(ctx, recipient, id)
+	if err != nil {
+		if errors.Is(err, taskDomain.ErrTaskNotFound) {
+			// The task was deleted or unshared in the meantime, which is
+			// streamed on its own
+			return nil
+		}
+
+		return err
+	}
+
+	data, err := json.Marshal(toTaskResponse(taskEntity))
+	if err != nil {
+		return err
+	}
+
+	p.broker.Publish(recipient, eventType, data)
+
+	return nil
+}
@@ -0,0 +1,479 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventbus"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+// streamMessage is a message read from a task event stream. Heartbeats are
+// comments and have no event.
+type streamMessage struct {
+	ID      string
+	Event   string
+	Data    string
+	Comment string
+}
+
+// serveTaskEvents starts a server streaming the events of the broker to the
+// user. Without a user ID, requests are unauthenticated.
+func serveTaskEvents(t *testing.T, broker *eventstream.Broker, userID string) *httptest.Server {
+	t.Helper()
+
+	h := NewTaskEventHandler(broker)
+
+	e := echo.New()
+	e.GET("/tasks/events", func(c echo.Context) error {
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+
+		var params generated.TaskStreamEventsParams
+		if lastEventID := c.Request().Header.Get("Last-Event-ID"); lastEventID != "" {
+			params.LastEventID = &lastEventID
+		}
+
+		return h.StreamEvents(c, params)
+	})
+
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// openTaskEvents requests the event stream of the server, resuming after
+// lastEventID unless it is empty.
+func openTaskEvents(t *testing.T, server *httptest.Server, lastEventID string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/tasks/events", nil)
+	require.NoError(t, err)
+
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	return resp
+}
+
+// readStreamMessage reads the next message of the stream.
+func readStreamMessage(t *testing.T, reader *bufio.Reader) streamMessage {
+	t.Helper()
+
+	var message streamMessage
+
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return message
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		switch field {
+		case "":
+			message.Comment = value
+		case "id":
+			message.ID = value
+		case "event":
+			message.Event = value
+		case "data":
+			message.Data = value
+		}
+	}
+}
+
+func TestTaskEventHandler_StreamEvents(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	broker := eventstream.NewBroker(10, 10, time.Minute)
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	server := serveTaskEvents(t, broker, testUserID)
+
+	resp := openTaskEvents(t, server, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+
+	// Act
+	broker.Publish(createUserID(uuid.New().String()), streamEventCreated, []byte(`{"title":"Other"}`))
+	created := broker.Publish(userID, streamEventCreated, []byte(`{"title":"Draft"}`))
+
+	// Assert
+	message := readStreamMessage(t, bufio.NewReader(resp.Body))
+	assert.Equal(t, streamMessage{
+		ID:    strconv.FormatUint(created.ID, 10),
+		Event: streamEventCreated,
+		Data:  `{"title":"Draft"}`,
+	}, message)
+}
+
+func TestTaskEventHandler_StreamEventsResume(t *testing.T) {
+	t.Parallel()
+
+	broker := eventstream.NewBroker(2, 10, time.Minute)
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	server := serveTaskEvents(t, broker, testUserID)
+
+	first := broker.Publish(userID, streamEventCreated, []byte(`{"title":"Draft"}`))
+	second := broker.Publish(userID, streamEventUpdated, []byte(`{"title":"Report"}`))
+
+	t.Run("missed events are replayed", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		resp := openTaskEvents(t, server, strconv.FormatUint(first.ID, 10))
+
+		// Assert
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		message := readStreamMessage(t, bufio.NewReader(resp.Body))
+		assert.Equal(t, strconv.FormatUint(second.ID, 10), message.ID)
+		assert.Equal(t, streamEventUpdated, message.Event)
+	})
+
+	t.Run("expired events reset the stream", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		resp := openTaskEvents(t, server, "1")
+
+		// Assert
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		message := readStreamMessage(t, bufio.NewReader(resp.Body))
+		assert.Equal(t, streamMessage{Event: streamEventReset, Data: "{}"}, message)
+	})
+
+	t.Run("invalid Last-Event-ID", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		resp := openTaskEvents(t, server, "latest")
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestTaskEventHandler_StreamEventsHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	broker := eventstream.NewBroker(10, 10, 10*time.Millisecond)
+	server := serveTaskEvents(t, broker, uuid.New().String())
+
+	// Act
+	resp := openTaskEvents(t, server, "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	message := readStreamMessage(t, bufio.NewReader(resp.Body))
+	assert.Equal(t, streamMessage{Comment: "heartbeat"}, message)
+}
+
+func TestTaskEventHandler_StreamEventsUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	broker := eventstream.NewBroker(10, 10, time.Minute)
+	server := serveTaskEvents(t, broker, "")
+
+	// Act
+	resp := openTaskEvents(t, server, "")
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestTaskStreamPublisher_Handle(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+
+	newTask := func() *task.Task {
+		taskEntity, err := task.NewTask(createTaskID(uuid.New().String()), "Draft", userID)
+		require.NoError(t, err)
+
+		return taskEntity
+	}
+
+	tests := []struct {
+		name          string
+		setupEvent    func(mockRepo *mocks.MockTaskRepository) task.Event
+		expectedType  string
+		expectedTitle string
+	}{
+		{
+			name: "created task",
+			setupEvent: func(mockRepo *mocks.MockTaskRepository) task.Event {
+				taskEntity := newTask()
+				mockRepo.EXPECT().FindCollaborators(gomock.Any(), taskEntity.ID()).Return(nil, nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskEntity.ID()).Return(taskEntity, nil).Times(2)
+
+				return taskEntity.PullEvents()[0]
+			},
+			expectedType:  streamEventCreated,
+			expectedTitle: "Draft",
+		},
+		{
+			name: "updated task",
+			setupEvent: func(mockRepo *mocks.MockTaskRepository) task.Event {
+				taskEntity := newTask()
+				taskEntity.PullEvents()
+				require.NoError(t, taskEntity.UpdateTitle("Report"))
+				mockRepo.EXPECT().FindCollaborators(gomock.Any(), taskEntity.ID()).Return(nil, nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskEntity.ID()).Return(taskEntity, nil).Times(2)
+
+				events := taskEntity.PullEvents()
+
+				return events[len(events)-1]
+			},
+			expectedType:  streamEventUpdated,
+			expectedTitle: "Report",
+		},
+		{
+			name: "deleted task",
+			setupEvent: func(mockRepo *mocks.MockTaskRepository) task.Event {
+				taskEntity := newTask()
+				taskEntity.PullEvents()
+				taskEntity.MoveToTrash(time.Now())
+				mockRepo.EXPECT().FindCollaborators(gomock.Any(), taskEntity.ID()).Return(nil, nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskEntity.ID()).Return(nil, task.ErrTaskNotFound)
+
+				return taskEntity.PullEvents()[0]
+			},
+			expectedType: streamEventDeleted,
+		},
+		{
+			name: "task deleted before it was read",
+			setupEvent: func(mockRepo *mocks.MockTaskRepository) task.Event {
+				taskEntity := newTask()
+				mockRepo.EXPECT().FindCollaborators(gomock.Any(), taskEntity.ID()).Return(nil, nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskEntity.ID()).Return(nil, task.ErrTaskNotFound).Times(2)
+
+				return taskEntity.PullEvents()[0]
+			},
+		},
+		{
+			name: "title change is covered by the update",
+			setupEvent: func(_ *mocks.MockTaskRepository) task.Event {
+				taskEntity := newTask()
+				taskEntity.PullEvents()
+				require.NoError(t, taskEntity.UpdateTitle("Report"))
+
+				return taskEntity.PullEvents()[0]
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			mockTagRepo := mocks.NewMockTagRepository(ctrl)
+			mockProjectRepo := mocks.NewMockProjectRepository(ctrl)
+			taskController := controller.NewTask(mockRepo, mockTagRepo, mockProjectRepo, eventbus.New())
+
+			broker := eventstream.NewBroker(10, 10, time.Minute)
+			publisher := NewTaskStreamPublisher(*taskController, broker)
+
+			sub, _, err := broker.Subscribe(userID, nil)
+			require.NoError(t, err)
+			defer sub.Close()
+
+			event := tt.setupEvent(mockRepo)
+
+			// Act
+			err = publisher.Handle(context.Background(), event)
+
+			// Assert
+			require.NoError(t, err)
+
+			if tt.expectedType == "" {
+				assert.Empty(t, sub.Events())
+
+				return
+			}
+
+			require.Len(t, sub.Events(), 1)
+
+			published := <-sub.Events()
+			assert.Equal(t, tt.expectedType, published.Type)
+
+			if tt.expectedType == streamEventDeleted {
+				assert.JSONEq(t, `{"id":"`+event.TaskID().String()+`"}`, string(published.Data))
+
+				return
+			}
+
+			var taskResponse generated.Task
+
+			require.NoError(t, json.Unmarshal(published.Data, &taskResponse))
+			assert.Equal(t, event.TaskID().String(), taskResponse.Id.String())
+			assert.Equal(t, tt.expectedTitle, taskResponse.Title)
+		})
+	}
+}
+
+func TestTaskStreamPublisher_HandleSharedTask(t *testing.T) {
+	t.Parallel()
+
+	creatorID := createUserID(uuid.New().String())
+	viewerID := createUserID(uuid.New().String())
+	editorID := createUserID(uuid.New().String())
+	outsiderID := createUserID(uuid.New().String())
+	taskID := createTaskID(uuid.New().String())
+	viewer := task.RoleViewer
+	editor := task.RoleEditor
+
+	// The editor is the assignee as well and is only notified once
+	taskFor := func(role *task.Role) *task.Task {
+		return task.NewTaskWithoutValidation(taskID, "Shared", creatorID, task.WithAssigneeID(&editorID), task.WithSharedRole(role))
+	}
+
+	newCollaborator := func(userID user.UserID, role task.Role) task.Collaborator {
+		collaborator, err := task.NewCollaborator(taskID, userID, role)
+		require.NoError(t, err)
+
+		return collaborator
+	}
+
+	tests := []struct {
+		name         string
+		event        func() task.Event
+		expectedType string
+	}{
+		{
+			name: "updated task",
+			event: func() task.Event {
+				taskEntity := taskFor(nil)
+				require.NoError(t, taskEntity.UpdateTitle("Report"))
+
+				return taskEntity.PullEvents()[1]
+			},
+			expectedType: streamEventUpdated,
+		},
+		{
+			name: "deleted task",
+			event: func() task.Event {
+				taskEntity := taskFor(nil)
+				taskEntity.MoveToTrash(time.Now())
+
+				return taskEntity.PullEvents()[0]
+			},
+			expectedType: streamEventDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			taskController := controller.NewTask(mockRepo, mocks.NewMockTagRepository(ctrl), mocks.NewMockProjectRepository(ctrl), eventbus.New())
+
+			broker := eventstream.NewBroker(10, 10, time.Minute)
+			publisher := NewTaskStreamPublisher(*taskController, broker)
+
+			mockRepo.EXPECT().FindCollaborators(gomock.Any(), taskID).
+				Return([]task.Collaborator{newCollaborator(viewerID, viewer), newCollaborator(editorID, editor)}, nil)
+			mockRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(taskFor(nil), nil).AnyTimes()
+			mockRepo.EXPECT().FindAccessible(gomock.Any(), viewerID, taskID).Return(taskFor(&viewer), nil).AnyTimes()
+			mockRepo.EXPECT().FindAccessible(gomock.Any(), editorID, taskID).Return(taskFor(&editor), nil).AnyTimes()
+
+			expectedRoles := map[user.UserID]*task.Role{creatorID: nil, viewerID: &viewer, editorID: &editor}
+			subscriptions := make(map[user.UserID]*eventstream.Subscription, len(expectedRoles))
+
+			for recipient := range expectedRoles {
+				sub, _, err := broker.Subscribe(recipient, nil)
+				require.NoError(t, err)
+				t.Cleanup(sub.Close)
+
+				subscriptions[recipient] = sub
+			}
+
+			outsider, _, err := broker.Subscribe(outsiderID, nil)
+			require.NoError(t, err)
+			t.Cleanup(outsider.Close)
+
+			// Act
+			err = publisher.Handle(context.Background(), tt.event())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Empty(t, outsider.Events())
+
+			for recipient, role := range expectedRoles {
+				sub := subscriptions[recipient]
+				require.Len(t, sub.Events(), 1)
+
+				published := <-sub.Events()
+				assert.Equal(t, tt.expectedType, published.Type)
+
+				if tt.expectedType == streamEventDeleted {
+					assert.JSONEq(t, `{"id":"`+taskID.String()+`"}`, string(published.Data))
+
+					continue
+				}
+
+				var taskResponse generated.Task
+
+				require.NoError(t, json.Unmarshal(published.Data, &taskResponse))
+				assert.Equal(t, "Shared", taskResponse.Title)
+
+				if role == nil {
+					assert.Nil(t, taskResponse.Role)
+				} else {
+					require.NotNil(t, taskResponse.Role)
+					assert.Equal(t, string(*role), string(*taskResponse.Role))
+				}
+			}
+		})
+	}
+}
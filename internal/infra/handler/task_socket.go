@@ -0,0 +1,496 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	openapiTypes "github.com/oapi-codegen/runtime/types"
+	"golang.org/x/net/websocket"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	workspaceDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
+	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+)
+
+const rpcVersion = "2.0"
+
+// JSON-RPC error codes. The codes from -32700 to -32600 are defined by
+// JSON-RPC; the others end in the HTTP status of the REST endpoints.
+const (
+	rpcCodeParseError         = -32700
+	rpcCodeInvalidRequest     = -32600
+	rpcCodeMethodNotFound     = -32601
+	rpcCodeInvalidParams      = -32602
+	rpcCodeInternalError      = -32603
+	rpcCodeForbidden          = -32003
+	rpcCodeNotFound           = -32004
+	rpcCodeConflict           = -32009
+	rpcCodePreconditionFailed = -32012
+)
+
+// Methods of the task socket. Changes are notified with the method task.
+// followed by the type of the event, such as task.created.
+const (
+	socketMethodCreate       = "task.create"
+	socketMethodUpdate       = "task.update"
+	socketMethodDelete       = "task.delete"
+	socketNotificationPrefix = "task."
+)
+
+// rpcRequest is a JSON-RPC request. A request without ID is a notification,
+// which is carried out but not answered.
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// rpcResponse is the response to a JSON-RPC request, which holds either a
+// result or an error.
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int     `json:"code"`
+	Message string  `json:"message"`
+	Data    *string `json:"data,omitempty"`
+}
+
+// rpcNotification notifies a socket of a change to a task.
+type rpcNotification struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// socketUpdateParams are the params of task.update. A version only updates the
+// task while it is at that version, like If-Match does for PUT /tasks/{taskId}.
+type socketUpdateParams struct {
+	Id      openapiTypes.UUID `json:"id"`
+	Version *int64            `json:"version,omitempty"`
+	taskHandler.TaskUpdate
+}
+
+// socketDeleteParams are the params of task.delete.
+type socketDeleteParams struct {
+	Id      openapiTypes.UUID `json:"id"`
+	Version *int64            `json:"version,omitempty"`
+	Cascade bool              `json:"cascade,omitempty"`
+}
+
+// pingCodec sends empty ping frames, which clients answer with pongs.
+var pingCodec = websocket.Codec{
+	Marshal: func(any) ([]byte, byte, error) {
+		return nil, websocket.PingFrame, nil
+	},
+	Unmarshal: nil,
+}
+
+// TaskSocketHandler syncs the tasks of a user over WebSocket. Clients create,
+// update and delete tasks with JSON-RPC requests, and every socket of the user
+// is notified of the changes to their tasks, including those made elsewhere.
+type TaskSocketHandler struct {
+	tasks          *TaskHandler
+	broker         *eventstream.Broker
+	pingInterval   time.Duration
+	pongTimeout    time.Duration
+	maxMessageSize int
+}
+
+// NewTaskSocketHandler creates a new TaskSocketHandler that applies requests
+// through the controller and notifies sockets of the events of the broker.
+func NewTaskSocketHandler(ctr controller.Task, broker *eventstream.Broker, cfg config.WebSocketConfig) *TaskSocketHandler {
+	return &TaskSocketHandler{
+		tasks:          NewTaskHandler(ctr),
+		broker:         broker,
+		pingInterval:   cfg.PingIntervalDuration(),
+		pongTimeout:    cfg.PongTimeoutDuration(),
+		maxMessageSize: cfg.MaxMessageSize,
+	}
+}
+
+// Serve upgrades the request of an authenticated user to a task socket.
+// Origins are not checked, since sockets are opened with a bearer token
+// rather than with cookies another site could make use of.
+func (h *TaskSocketHandler) Serve(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.tasks.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	// Subscribe before the handshake so that no change made after the socket
+	// was opened is missed
+	sub, _, err := h.broker.Subscribe(domainUserID, nil)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+	defer sub.Close()
+
+	server := websocket.Server{ //nolint:exhaustruct
+		Handler: func(conn *websocket.Conn) {
+			h.serveConn(c.Request().Context(), conn, domainUserID, sub)
+		},
+	}
+	server.ServeHTTP(keepAliveResponse{Response: c.Response(), timeout: h.pongTimeout}, c.Request())
+
+	return nil
+}
+
+// serveConn answers the requests of the socket and notifies it of the events
+// of the subscription until either side closes it.
+//
+// Requests are handled one at a time, and the next one is only read once the
+// response to the previous one has been written, so a client that does not
+// take its responses is not read from either. A client that falls too far
+// behind on notifications, or does not take a message within the pong
+// timeout, is disconnected; it loads its tasks again when it reconnects.
+func (h *TaskSocketHandler) serveConn(ctx context.Context, conn *websocket.Conn, userID user.UserID, sub *eventstream.Subscription) {
+	conn.MaxPayloadBytes = h.maxMessageSize
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses := make(chan rpcResponse)
+
+	var wg sync.WaitGroup
+
+	wg.Go(func() {
+		defer cancel()
+
+		h.readRequests(ctx, conn, userID, responses)
+	})
+
+	h.writeMessages(ctx, conn, sub, responses)
+
+	// Closing the socket ends the read of the next request
+	cancel()
+
+	_ = conn.Close()
+
+	wg.Wait()
+}
+
+// readRequests handles the requests read from the socket and hands their
+// responses to the writer until the socket is closed.
+func (h *TaskSocketHandler) readRequests(ctx context.Context, conn *websocket.Conn, userID user.UserID, responses chan<- rpcResponse) {
+	for {
+		var (
+			message  []byte
+			response rpcResponse
+			ok       bool
+		)
+
+		err := websocket.Message.Receive(conn, &message)
+
+		switch {
+		case errors.Is(err, websocket.ErrFrameTooLarge):
+			// The rest of the message is skipped on the next read
+			response, ok = newRPCErrorResponse(nil, &rpcError{Code: rpcCodeInvalidRequest, Message: "Message too large", Data: nil}), true
+		case err != nil:
+			return
+		default:
+			response, ok = h.handleRequest(ctx, userID, message)
+		}
+
+		if !ok {
+			continue
+		}
+
+		select {
+		case responses <- response:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// writeMessages writes the responses, the notifications of the subscription
+// and pings to the socket until the context is done, the subscription is
+// dropped or a write fails.
+func (h *TaskSocketHandler) writeMessages(ctx context.Context, conn *websocket.Conn, sub *eventstream.Subscription, responses <-chan rpcResponse) {
+	ping := time.NewTicker(h.pingInterval)
+	defer ping.Stop()
+
+	for {
+		var err error
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-sub.Dropped():
+			return
+		case event := <-sub.Events():
+			err = h.send(conn, websocket.JSON, rpcNotification{
+				JSONRPC: rpcVersion,
+				Method:  socketNotificationPrefix + event.Type,
+				Params:  event.Data,
+			})
+		case response := <-responses:
+			err = h.send(conn, websocket.JSON, response)
+		case <-ping.C:
+			err = h.send(conn, pingCodec, nil)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// send writes a message, giving the client the pong timeout to take it.
+func (h *TaskSocketHandler) send(conn *websocket.Conn, codec websocket.Codec, v any) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(h.pongTimeout)); err != nil {
+		return err
+	}
+
+	return codec.Send(conn, v)
+}
+
+// handleRequest carries out a JSON-RPC request and returns its response. It
+// returns false for notifications, which are not answered.
+func (h *TaskSocketHandler) handleRequest(ctx context.Context, userID user.UserID, message []byte) (rpcResponse, bool) {
+	var req rpcRequest
+
+	if err := json.Unmarshal(message, &req); err != nil {
+		details := err.Error()
+
+		return newRPCErrorResponse(nil, &rpcError{Code: rpcCodeParseError, Message: "Parse error", Data: &details}), true
+	}
+
+	if req.JSONRPC != rpcVersion || req.Method == "" {
+		details := `jsonrpc must be "2.0" and method is required`
+
+		return newRPCErrorResponse(req.ID, &rpcError{Code: rpcCodeInvalidRequest, Message: "Invalid request", Data: &details}), true
+	}
+
+	var (
+		result any
+		rpcErr *rpcError
+	)
+
+	switch req.Method {
+	case socketMethodCreate:
+		result, rpcErr = h.createTask(ctx, userID, req.Params)
+	case socketMethodUpdate:
+		result, rpcErr = h.updateTask(ctx, userID, req.Params)
+	case socketMethodDelete:
+		result, rpcErr = h.deleteTask(ctx, userID, req.Params)
+	default:
+		details := "unknown method " + req.Method
+		rpcErr = &rpcError{Code: rpcCodeMethodNotFound, Message: "Method not found", Data: &details}
+	}
+
+	if req.ID == nil {
+		return rpcResponse{}, false //nolint:exhaustruct
+	}
+
+	if rpcErr != nil {
+		return newRPCErrorResponse(req.ID, rpcErr), true
+	}
+
+	return rpcResponse{JSONRPC: rpcVersion, ID: req.ID, Result: result, Error: nil}, true
+}
+
+func (h *TaskSocketHandler) createTask(ctx context.Context, userID user.UserID, params json.RawMessage) (any, *rpcError) {
+	var req taskHandler.TaskCreate
+
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, newInvalidParamsError(err)
+	}
+
+	if req.Title == "" {
+		return nil, newInvalidParamsError(errors.New("title field is required and cannot be empty"))
+	}
+
+	parentID, err := h.tasks.toDomainOptionalTaskID(req.ParentId)
+	if err != nil {
+		return nil, newInvalidParamsError(err)
+	}
+
+	projectID, err := h.tasks.toDomainProjectID(req.ProjectId)
+	if err != nil {
+		return nil, newInvalidParamsError(err)
+	}
+
+	input := controller.TaskCreate{
+		Title:      req.Title,
+		StartAt:    req.StartAt,
+		DueAt:      req.DueAt,
+		AllDay:     req.AllDay != nil && *req.AllDay,
+		Tags:       nil,
+		ParentID:   parentID,
+		ProjectID:  projectID,
+		Recurrence: toRecurrenceInput(req.Recurrence),
+		Workspace:  nil,
+	}
+
+	if req.Tags != nil {
+		input.Tags = *req.Tags
+	}
+
+	task, err := h.tasks.controller.CreateTask(ctx, userID, input)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return toTaskResponse(task), nil
+}
+
+func (h *TaskSocketHandler) updateTask(ctx context.Context, userID user.UserID, params json.RawMessage) (any, *rpcError) {
+	var req socketUpdateParams
+
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, newInvalidParamsError(err)
+	}
+
+	domainTaskID, err := h.tasks.uuidAdapter.ToDomainTaskID(req.Id)
+	if err != nil {
+		return nil, newInvalidParamsError(err)
+	}
+
+	update, err := h.tasks.toTaskUpdate(req.TaskUpdate)
+	if err != nil {
+		return nil, newInvalidParamsError(err)
+	}
+
+	task, err := h.tasks.controller.UpdateTask(ctx, userID, domainTaskID, update, req.Version)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return toTaskResponse(task), nil
+}
+
+func (h *TaskSocketHandler) deleteTask(ctx context.Context, userID user.UserID, params json.RawMessage) (any, *rpcError) {
+	var req socketDeleteParams
+
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, newInvalidParamsError(err)
+	}
+
+	domainTaskID, err := h.tasks.uuidAdapter.ToDomainTaskID(req.Id)
+	if err != nil {
+		return nil, newInvalidParamsError(err)
+	}
+
+	err = h.tasks.controller.DeleteTask(ctx, userID, domainTaskID, req.Version, req.Cascade)
+	if err != nil {
+		return nil, toRPCError(err)
+	}
+
+	return deletedTaskData{Id: req.Id}, nil
+}
+
+func newRPCErrorResponse(id json.RawMessage, rpcErr *rpcError) rpcResponse {
+	return rpcResponse{JSONRPC: rpcVersion, ID: id, Result: nil, Error: rpcErr}
+}
+
+func newInvalidParamsError(err error) *rpcError {
+	details := err.Error()
+
+	return &rpcError{Code: rpcCodeInvalidParams, Message: "Invalid params", Data: &details}
+}
+
+// toRPCError converts an error of the task controller to a JSON-RPC error,
+// along the lines of the statuses the REST endpoints respond with.
+func toRPCError(err error) *rpcError {
+	details := err.Error()
+
+	switch {
+	case errors.Is(err, taskDomain.ErrTaskNotFound):
+		return &rpcError{Code: rpcCodeNotFound, Message: "Task not found", Data: nil}
+	case errors.Is(err, taskDomain.ErrForbidden), errors.Is(err, workspaceDomain.ErrForbidden):
+		return &rpcError{Code: rpcCodeForbidden, Message: "Forbidden", Data: &details}
+	case errors.Is(err, taskDomain.ErrVersionMismatch):
+		return &rpcError{Code: rpcCodePreconditionFailed, Message: "Precondition failed", Data: &details}
+	case errors.Is(err, taskDomain.ErrHasSubtasks):
+		return &rpcError{Code: rpcCodeConflict, Message: "Conflict", Data: &details}
+	case isDomainValidationError(err):
+		return &rpcError{Code: rpcCodeInvalidParams, Message: "Invalid params", Data: &details}
+	default:
+		return &rpcError{Code: rpcCodeInternalError, Message: "Internal error", Data: &details}
+	}
+}
+
+// keepAliveResponse hands over the connection of a socket with a read
+// deadline that is pushed back whenever the client sends anything, pongs
+// included, so that a client that has gone away is disconnected.
+type keepAliveResponse struct {
+	*echo.Response
+	timeout time.Duration
+}
+
+// Hijack takes over the connection of the response.
+func (r keepAliveResponse) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := r.Response.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keepAlive := &keepAliveConn{Conn: conn, timeout: r.timeout}
+	if err := keepAlive.SetReadDeadline(time.Now().Add(r.timeout)); err != nil {
+		_ = conn.Close()
+
+		return nil, nil, err
+	}
+
+	// What the server has read ahead is read before the rest of the connection
+	buffered, err := rw.Peek(rw.Reader.Buffered())
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, nil, err
+	}
+
+	reader := bufio.NewReader(io.MultiReader(bytes.NewReader(bytes.Clone(buffered)), keepAlive))
+
+	return keepAlive, bufio.NewReadWriter(reader, rw.Writer), nil
+}
+
+type keepAliveConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+// Read reads from the connection and pushes its read deadline back if
+// anything was read.
+func (c *keepAliveConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		if err := c.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+			return n, err
+		}
+	}
+
+	return n, err
+}
@@ -0,0 +1,376 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/websocket"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventbus"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+// socketMessage is a response or a notification read from a task socket.
+type socketMessage struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+func setupTestTaskSocket(ctrl *gomock.Controller, cfg config.WebSocketConfig) (*TaskSocketHandler, *mocks.MockTaskRepository, *eventstream.Broker) {
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockTagRepo := mocks.NewMockTagRepository(ctrl)
+	mockProjectRepo := mocks.NewMockProjectRepository(ctrl)
+	taskController := controller.NewTask(mockRepo, mockTagRepo, mockProjectRepo, eventbus.New())
+	broker := eventstream.NewBroker(10, 10, time.Minute)
+
+	return NewTaskSocketHandler(*taskController, broker, cfg), mockRepo, broker
+}
+
+// serveTaskSocket starts a server opening task sockets for the user.
+func serveTaskSocket(t *testing.T, h *TaskSocketHandler, userID string) *httptest.Server {
+	t.Helper()
+
+	e := echo.New()
+	e.GET("/ws", func(c echo.Context) error {
+		c.Set("user_id", userID)
+
+		return h.Serve(c)
+	})
+
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func dialTaskSocket(t *testing.T, server *httptest.Server) *websocket.Conn {
+	t.Helper()
+
+	conn, err := websocket.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", "", server.URL)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+// receiveSocketMessage reads the next message of the socket, answering the
+// pings that come before it.
+func receiveSocketMessage(t *testing.T, conn *websocket.Conn) socketMessage {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	var message socketMessage
+
+	require.NoError(t, websocket.JSON.Receive(conn, &message))
+
+	return message
+}
+
+var defaultTestWebSocketConfig = config.WebSocketConfig{PingInterval: 30, PongTimeout: 60, MaxMessageSize: 1024}
+
+func TestTaskSocketHandler_Requests(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskID := uuid.New().String()
+
+	tests := []struct {
+		name           string
+		request        string
+		setupMock      func(mockRepo *mocks.MockTaskRepository)
+		expectedID     string
+		expectedCode   int
+		expectedResult func(t *testing.T, result json.RawMessage)
+	}{
+		{
+			name:    "create task",
+			request: `{"jsonrpc":"2.0","id":1,"method":"task.create","params":{"title":"New Task"}}`,
+			setupMock: func(mockRepo *mocks.MockTaskRepository) {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(task.NewTaskWithoutValidation(createTaskID(taskID), "New Task", userID), nil)
+			},
+			expectedID: "1",
+			expectedResult: func(t *testing.T, result json.RawMessage) {
+				t.Helper()
+
+				var taskResponse generated.Task
+
+				require.NoError(t, json.Unmarshal(result, &taskResponse))
+				assert.Equal(t, taskID, taskResponse.Id.String())
+				assert.Equal(t, "New Task", taskResponse.Title)
+			},
+		},
+		{
+			name:    "update task",
+			request: `{"jsonrpc":"2.0","id":"update","method":"task.update","params":{"id":"` + taskID + `","completed":true}}`,
+			setupMock: func(mockRepo *mocks.MockTaskRepository) {
+				existing := task.NewTaskWithoutValidation(createTaskID(taskID), "Task", userID)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, createTaskID(taskID)).Return(existing, nil)
+				mockRepo.EXPECT().Update(gomock.Any(), userID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ any, taskEntity *task.Task) (*task.Task, error) {
+						return taskEntity, nil
+					})
+			},
+			expectedID: `"update"`,
+			expectedResult: func(t *testing.T, result json.RawMessage) {
+				t.Helper()
+
+				var taskResponse generated.Task
+
+				require.NoError(t, json.Unmarshal(result, &taskResponse))
+				assert.True(t, taskResponse.Completed)
+			},
+		},
+		{
+			name:    "update keeps omitted fields",
+			request: `{"jsonrpc":"2.0","id":"keep","method":"task.update","params":{"id":"` + taskID + `","title":"Renamed"}}`,
+			setupMock: func(mockRepo *mocks.MockTaskRepository) {
+				dueAt := time.Date(2024, 1, 20, 17, 0, 0, 0, time.UTC)
+				parentID := createTaskID(uuid.New().String())
+				existing := task.NewTaskWithoutValidation(createTaskID(taskID), "Task", userID,
+					task.WithSchedule(task.NewScheduleWithoutValidation(nil, &dueAt, false)),
+					task.WithParentID(&parentID))
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, createTaskID(taskID)).Return(existing, nil)
+				mockRepo.EXPECT().Update(gomock.Any(), userID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ any, taskEntity *task.Task) (*task.Task, error) {
+						return taskEntity, nil
+					})
+			},
+			expectedID: `"keep"`,
+			expectedResult: func(t *testing.T, result json.RawMessage) {
+				t.Helper()
+
+				var taskResponse generated.Task
+
+				require.NoError(t, json.Unmarshal(result, &taskResponse))
+				assert.Equal(t, "Renamed", taskResponse.Title)
+				assert.NotNil(t, taskResponse.DueAt)
+				assert.NotNil(t, taskResponse.ParentId)
+			},
+		},
+		{
+			name:    "update missing task",
+			request: `{"jsonrpc":"2.0","id":2,"method":"task.update","params":{"id":"` + taskID + `","title":"Renamed"}}`,
+			setupMock: func(mockRepo *mocks.MockTaskRepository) {
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, createTaskID(taskID)).Return(nil, task.ErrTaskNotFound)
+			},
+			expectedID:   "2",
+			expectedCode: rpcCodeNotFound,
+		},
+		{
+			name:    "delete task",
+			request: `{"jsonrpc":"2.0","id":3,"method":"task.delete","params":{"id":"` + taskID + `"}}`,
+			setupMock: func(mockRepo *mocks.MockTaskRepository) {
+				existing := task.NewTaskWithoutValidation(createTaskID(taskID), "Task", userID)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, createTaskID(taskID)).Return(existing, nil)
+				mockRepo.EXPECT().Delete(gomock.Any(), userID, createTaskID(taskID), nil).Return(nil)
+			},
+			expectedID: "3",
+			expectedResult: func(t *testing.T, result json.RawMessage) {
+				t.Helper()
+				assert.JSONEq(t, `{"id":"`+taskID+`"}`, string(result))
+			},
+		},
+		{
+			name:    "delete task at another version",
+			request: `{"jsonrpc":"2.0","id":4,"method":"task.delete","params":{"id":"` + taskID + `","version":2}}`,
+			setupMock: func(mockRepo *mocks.MockTaskRepository) {
+				existing := task.NewTaskWithoutValidation(createTaskID(taskID), "Task", userID)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, createTaskID(taskID)).Return(existing, nil)
+				mockRepo.EXPECT().Delete(gomock.Any(), userID, createTaskID(taskID), gomock.Any()).Return(task.ErrVersionMismatch)
+			},
+			expectedID:   "4",
+			expectedCode: rpcCodePreconditionFailed,
+		},
+		{
+			name:         "empty title",
+			request:      `{"jsonrpc":"2.0","id":5,"method":"task.create","params":{"title":""}}`,
+			setupMock:    func(*mocks.MockTaskRepository) {},
+			expectedID:   "5",
+			expectedCode: rpcCodeInvalidParams,
+		},
+		{
+			name:         "unknown method",
+			request:      `{"jsonrpc":"2.0","id":6,"method":"task.archive","params":{}}`,
+			setupMock:    func(*mocks.MockTaskRepository) {},
+			expectedID:   "6",
+			expectedCode: rpcCodeMethodNotFound,
+		},
+		{
+			name:         "missing jsonrpc version",
+			request:      `{"id":7,"method":"task.create","params":{"title":"New Task"}}`,
+			setupMock:    func(*mocks.MockTaskRepository) {},
+			expectedID:   "7",
+			expectedCode: rpcCodeInvalidRequest,
+		},
+		{
+			name:         "malformed JSON",
+			request:      `{"jsonrpc":"2.0","id":8,`,
+			setupMock:    func(*mocks.MockTaskRepository) {},
+			expectedID:   "null",
+			expectedCode: rpcCodeParseError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			h, mockRepo, _ := setupTestTaskSocket(ctrl, defaultTestWebSocketConfig)
+			tt.setupMock(mockRepo)
+
+			conn := dialTaskSocket(t, serveTaskSocket(t, h, testUserID))
+
+			// Act
+			require.NoError(t, websocket.Message.Send(conn, tt.request))
+
+			// Assert
+			response := receiveSocketMessage(t, conn)
+			assert.JSONEq(t, tt.expectedID, string(response.ID))
+
+			if tt.expectedCode != 0 {
+				require.NotNil(t, response.Error)
+				assert.Equal(t, tt.expectedCode, response.Error.Code)
+				assert.Nil(t, response.Result)
+
+				return
+			}
+
+			assert.Nil(t, response.Error)
+			tt.expectedResult(t, response.Result)
+		})
+	}
+}
+
+func TestTaskSocketHandler_Notifications(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, _, broker := setupTestTaskSocket(ctrl, defaultTestWebSocketConfig)
+
+	testUserID := uuid.New().String()
+	otherUserID := uuid.New().String()
+	server := serveTaskSocket(t, h, testUserID)
+
+	first := dialTaskSocket(t, server)
+	second := dialTaskSocket(t, server)
+	other := dialTaskSocket(t, serveTaskSocket(t, h, otherUserID))
+
+	// Act
+	broker.Publish(createUserID(testUserID), streamEventCreated, []byte(`{"title":"Draft"}`))
+	broker.Publish(createUserID(otherUserID), streamEventDeleted, []byte(`{"id":"other"}`))
+
+	// Assert
+	for _, conn := range []*websocket.Conn{first, second} {
+		notification := receiveSocketMessage(t, conn)
+		assert.Equal(t, "task.created", notification.Method)
+		assert.JSONEq(t, `{"title":"Draft"}`, string(notification.Params))
+		assert.Nil(t, notification.ID)
+	}
+
+	// The socket of the other user only got the change to their own task
+	notification := receiveSocketMessage(t, other)
+	assert.Equal(t, "task.deleted", notification.Method)
+}
+
+func TestTaskSocketHandler_MessageTooLarge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, _, _ := setupTestTaskSocket(ctrl, config.WebSocketConfig{PingInterval: 30, PongTimeout: 60, MaxMessageSize: 64})
+	conn := dialTaskSocket(t, serveTaskSocket(t, h, uuid.New().String()))
+
+	// Act
+	title := strings.Repeat("a", 100)
+	require.NoError(t, websocket.Message.Send(conn, `{"jsonrpc":"2.0","id":1,"method":"task.create","params":{"title":"`+title+`"}}`))
+
+	// Assert
+	response := receiveSocketMessage(t, conn)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, rpcCodeInvalidRequest, response.Error.Code)
+
+	// The socket stays open for the next request
+	require.NoError(t, websocket.Message.Send(conn, `{"jsonrpc":"2.0","id":2,"method":"task.archive"}`))
+
+	response = receiveSocketMessage(t, conn)
+	assert.JSONEq(t, "2", string(response.ID))
+	require.NotNil(t, response.Error)
+	assert.Equal(t, rpcCodeMethodNotFound, response.Error.Code)
+}
+
+func TestTaskSocketHandler_KeepAlive(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h, _, _ := setupTestTaskSocket(ctrl, defaultTestWebSocketConfig)
+	h.pingInterval = 20 * time.Millisecond
+	h.pongTimeout = 200 * time.Millisecond
+
+	server := serveTaskSocket(t, h, uuid.New().String())
+	silent := dialTaskSocket(t, server)
+	answering := dialTaskSocket(t, server)
+
+	// The answering client keeps reading, which answers the pings
+	messages := make(chan socketMessage, 1)
+
+	go func() {
+		var message socketMessage
+		if err := websocket.JSON.Receive(answering, &message); err == nil {
+			messages <- message
+		}
+
+		close(messages)
+	}()
+
+	// Act
+	time.Sleep(500 * time.Millisecond)
+
+	// Assert
+	require.NoError(t, silent.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	var message socketMessage
+
+	assert.Error(t, websocket.JSON.Receive(silent, &message), "silent client was not disconnected")
+
+	require.NoError(t, websocket.Message.Send(answering, `{"jsonrpc":"2.0","id":1,"method":"task.archive"}`))
+
+	select {
+	case response, ok := <-messages:
+		require.True(t, ok, "answering client was disconnected")
+		assert.JSONEq(t, "1", string(response.ID))
+	case <-time.After(5 * time.Second):
+		t.Fatal("no response to the answering client")
+	}
+}
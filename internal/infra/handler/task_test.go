@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -17,19 +18,40 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	projectDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	tagDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventbus"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
 )
 
 //go:generate go run go.uber.org/mock/mockgen -source=../../domain/task/repository.go -destination=mocks/mock_task_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=../../domain/tag/repository.go -destination=mocks/mock_tag_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=../../domain/project/repository.go -destination=mocks/mock_project_repository.go -package=mocks
 
 func setupTestServer(ctrl *gomock.Controller) (*TaskHandler, *mocks.MockTaskRepository) {
+	handler, mockRepo, _ := setupTestServerWithTags(ctrl)
+
+	return handler, mockRepo
+}
+
+func setupTestServerWithTags(ctrl *gomock.Controller) (*TaskHandler, *mocks.MockTaskRepository, *mocks.MockTagRepository) {
+	handler, mockRepo, mockTagRepo, _ := setupTestServerWithProjects(ctrl)
+
+	return handler, mockRepo, mockTagRepo
+}
+
+func setupTestServerWithProjects(ctrl *gomock.Controller) (*TaskHandler, *mocks.MockTaskRepository, *mocks.MockTagRepository, *mocks.MockProjectRepository) {
 	mockRepo := mocks.NewMockTaskRepository(ctrl)
-	taskController := controller.NewTask(mockRepo)
+	mockTagRepo := mocks.NewMockTagRepository(ctrl)
+	mockProjectRepo := mocks.NewMockProjectRepository(ctrl)
+	taskController := controller.NewTask(mockRepo, mockTagRepo, mockProjectRepo, eventbus.New())
 	handler := NewTaskHandler(*taskController)
 
-	return handler, mockRepo
+	return handler, mockRepo, mockTagRepo, mockProjectRepo
 }
 
 func TestTaskGetAllTasks(t *testing.T) {
@@ -45,7 +67,7 @@ func TestTaskGetAllTasks(t *testing.T) {
 
 	task1 := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Task 1", userID)
 	task2 := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Task 2", userID)
-	mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return([]*task.Task{task1, task2}, nil)
+	mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{}, gomock.Any()).Return(&task.Page{Tasks: []*task.Task{task1, task2}}, nil)
 
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
@@ -53,171 +75,2992 @@ func TestTaskGetAllTasks(t *testing.T) {
 	c := e.NewContext(req, rec)
 	c.Set("user_id", testUserID)
 
-	// Act
-	err := handler.GetAllTasks(c)
+	// Act
+	err := handler.GetAllTasks(c, generated.TaskGetAllTasksParams{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var tasks []generated.Task
+
+	err = json.Unmarshal(rec.Body.Bytes(), &tasks)
+	require.NoError(t, err)
+	assert.Len(t, tasks, 2)
+}
+
+// testUUID converts string to openapi_types.UUID for tests
+func testUUID(s string) types.UUID {
+	return types.UUID(uuid.MustParse(s))
+}
+
+func TestTaskCreateTask(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+	userID := createUserID(testUserID)
+
+	createdTask := task.NewTaskWithoutValidation(createTaskID(taskID), "New Task", userID)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(createdTask, nil)
+
+	e := echo.New()
+	requestBody := `{"title": "New Task"}`
+	req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(requestBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.CreateTask(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var responseTask generated.Task
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseTask)
+	require.NoError(t, err)
+	assert.Equal(t, taskID, responseTask.Id.String())
+	assert.Equal(t, "New Task", responseTask.Title)
+}
+
+func TestTaskGetTask(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskDomainID := createTaskID(taskID)
+
+	existingTask := task.NewTaskWithoutValidation(taskDomainID, "Test Task", userID)
+	mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.GetTask(c, testUUID(taskID), generated.TaskGetTaskParams{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var responseTask generated.Task
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseTask)
+	require.NoError(t, err)
+	assert.Equal(t, taskID, responseTask.Id.String())
+	assert.Equal(t, "Test Task", responseTask.Title)
+}
+
+func TestTaskUpdateTask(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskDomainID := createTaskID(taskID)
+
+	existingTask := task.NewTaskWithoutValidation(taskDomainID, "Original Task", userID)
+	updatedTask := task.NewTaskWithoutValidation(taskDomainID, "Updated Task", userID)
+
+	mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(updatedTask, nil)
+
+	e := echo.New()
+	requestBody := `{"title": "Updated Task"}`
+	req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(requestBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var responseTask generated.Task
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseTask)
+	require.NoError(t, err)
+	assert.Equal(t, taskID, responseTask.Id.String())
+	assert.Equal(t, "Updated Task", responseTask.Title)
+}
+
+func TestTaskGetAllTasksCompletedFilter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	completedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	completed := true
+
+	doneTask := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Done Task", userID, task.WithCompletedAt(&completedAt))
+	mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{Completed: &completed}, gomock.Any()).Return(&task.Page{Tasks: []*task.Task{doneTask}}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tasks?completed=true", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.GetAllTasks(c, generated.TaskGetAllTasksParams{Completed: &completed})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var tasks []generated.Task
+
+	err = json.Unmarshal(rec.Body.Bytes(), &tasks)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.True(t, tasks[0].Completed)
+	require.NotNil(t, tasks[0].CompletedAt)
+	assert.True(t, completedAt.Equal(*tasks[0].CompletedAt))
+}
+
+func TestTaskCreateTaskWithTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		body           string
+		knownTags      []string
+		expectCreate   bool
+		expectedStatus int
+	}{
+		{
+			name:           "existing tags are attached",
+			body:           `{"title": "New Task", "tags": ["work", "home"]}`,
+			knownTags:      []string{"home", "work"},
+			expectCreate:   true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "unknown tag is a bad request",
+			body:           `{"title": "New Task", "tags": ["work", "missing"]}`,
+			knownTags:      []string{"work"},
+			expectCreate:   false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "empty tag name is a bad request",
+			body:           `{"title": "New Task", "tags": [" "]}`,
+			knownTags:      nil,
+			expectCreate:   false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo, mockTagRepo := setupTestServerWithTags(ctrl)
+
+			testUserID := uuid.New().String()
+			userID := createUserID(testUserID)
+
+			if tt.knownTags != nil {
+				known := make([]*tagDomain.Tag, 0, len(tt.knownTags))
+				for _, name := range tt.knownTags {
+					known = append(known, tagDomain.NewTagWithoutValidation(tagDomain.GenerateTagID(), name, userID))
+				}
+
+				mockTagRepo.EXPECT().FindByNames(gomock.Any(), userID, gomock.Any()).Return(known, nil)
+			}
+
+			if tt.expectCreate {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, created *task.Task) (*task.Task, error) {
+						return created, nil
+					})
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.CreateTask(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectCreate {
+				var created generated.Task
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+				assert.Equal(t, tt.knownTags, created.Tags)
+			}
+		})
+	}
+}
+
+func TestTaskGetAllTasksTagFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tag name is normalized", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		userID := createUserID(testUserID)
+		tagName := "work"
+
+		taggedTask := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Tagged Task", userID, task.WithTags([]string{"work"}))
+		mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{Tag: &tagName}, gomock.Any()).
+			Return(&task.Page{Tasks: []*task.Task{taggedTask}}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks?tag=+work+", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetAllTasks(c, generated.TaskGetAllTasksParams{Tag: stringPtr(" work ")})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var tasks []generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+		require.Len(t, tasks, 1)
+		assert.Equal(t, []string{"work"}, tasks[0].Tags)
+	})
+
+	t.Run("empty tag name is a bad request", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, _ := setupTestServer(ctrl)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks?tag=", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", uuid.New().String())
+
+		// Act
+		err := handler.GetAllTasks(c, generated.TaskGetAllTasksParams{Tag: stringPtr("")})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTaskUpdateTaskCompletion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskDomainID := createTaskID(taskID)
+
+	existingTask := task.NewTaskWithoutValidation(taskDomainID, "Original Task", userID)
+
+	mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ user.UserID, taskEntity *task.Task) (*task.Task, error) {
+		return taskEntity, nil
+	})
+
+	e := echo.New()
+	requestBody := `{"completed": true}`
+	req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(requestBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var responseTask generated.Task
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseTask)
+	require.NoError(t, err)
+	assert.Equal(t, "Original Task", responseTask.Title)
+	assert.True(t, responseTask.Completed)
+	assert.NotNil(t, responseTask.CompletedAt)
+}
+
+func TestTaskUpdateTaskKeepsOmittedFields(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskDomainID := createTaskID(taskID)
+	parentID := createTaskID(uuid.New().String())
+	startAt := time.Date(2024, 1, 18, 9, 0, 0, 0, time.UTC)
+	dueAt := time.Date(2024, 1, 20, 17, 0, 0, 0, time.UTC)
+
+	projectID, err := projectDomain.NewProjectID(uuid.New().String())
+	require.NoError(t, err)
+
+	existingTask := task.NewTaskWithoutValidation(taskDomainID, "Original Task", userID,
+		task.WithSchedule(task.NewScheduleWithoutValidation(&startAt, &dueAt, false)),
+		task.WithParentID(&parentID),
+		task.WithProjectID(&projectID))
+
+	mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), userID, gomock.Any()).DoAndReturn(func(_ context.Context, _ user.UserID, taskEntity *task.Task) (*task.Task, error) {
+		return taskEntity, nil
+	})
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(`{"title": "Updated Task"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err = handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
+
+	// Assert
+	assert.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var responseTask generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseTask))
+	assert.Equal(t, "Updated Task", responseTask.Title)
+	assert.Equal(t, &startAt, responseTask.StartAt)
+	assert.Equal(t, &dueAt, responseTask.DueAt)
+	require.NotNil(t, responseTask.ParentId)
+	assert.Equal(t, parentID.String(), responseTask.ParentId.String())
+	require.NotNil(t, responseTask.ProjectId)
+	assert.Equal(t, projectID.String(), responseTask.ProjectId.String())
+}
+
+func TestTaskCreateTaskWithSchedule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectCreate   bool
+		expectedStatus int
+	}{
+		{
+			name:           "due date with offset",
+			requestBody:    `{"title": "New Task", "startAt": "2024-01-18T09:00:00+09:00", "dueAt": "2024-01-20T17:00:00+09:00"}`,
+			expectCreate:   true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "start after due",
+			requestBody:    `{"title": "New Task", "startAt": "2024-01-21T09:00:00Z", "dueAt": "2024-01-20T17:00:00Z"}`,
+			expectCreate:   false,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "all-day without dates",
+			requestBody:    `{"title": "New Task", "allDay": true}`,
+			expectCreate:   false,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTestServer(ctrl)
+
+			testUserID := uuid.New().String()
+
+			if tt.expectCreate {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, taskEntity *task.Task) (*task.Task, error) {
+					return taskEntity, nil
+				})
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.CreateTask(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var responseTask generated.Task
+
+			err = json.Unmarshal(rec.Body.Bytes(), &responseTask)
+			require.NoError(t, err)
+			require.NotNil(t, responseTask.DueAt)
+			assert.Equal(t, time.Date(2024, 1, 20, 8, 0, 0, 0, time.UTC), responseTask.DueAt.UTC())
+			require.NotNil(t, responseTask.StartAt)
+			assert.False(t, responseTask.AllDay)
+		})
+	}
+}
+
+func TestTaskGetAllTasksPagination(t *testing.T) {
+	t.Parallel()
+
+	t.Run("next page link preserves the query", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		userID := createUserID(testUserID)
+		limit := 1
+		completed := false
+		pageRequest, err := task.NewPageRequest(limit, "", task.DefaultSort)
+		require.NoError(t, err)
+
+		task1 := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Task 1", userID)
+		mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{Completed: &completed}, pageRequest).
+			Return(&task.Page{Tasks: []*task.Task{task1}, NextCursor: "next-cursor"}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks?completed=false&limit=1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err = handler.GetAllTasks(c, generated.TaskGetAllTasksParams{Completed: &completed, Limit: &limit})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `</tasks?completed=false&cursor=next-cursor&limit=1>; rel="next"`, rec.Header().Get("Link"))
+	})
+
+	t.Run("last page has no link", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		userID := createUserID(testUserID)
+
+		mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{}, gomock.Any()).Return(&task.Page{Tasks: []*task.Task{}}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetAllTasks(c, generated.TaskGetAllTasksParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Link"))
+	})
+
+	t.Run("invalid limit and cursor are bad requests", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		userID := createUserID(testUserID)
+		tooLarge := task.MaxPageLimit + 1
+		cursor := "garbage"
+
+		mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{}, gomock.Any()).Return(nil, task.ErrInvalidCursor)
+
+		e := echo.New()
+
+		for _, params := range []generated.TaskGetAllTasksParams{{Limit: &tooLarge}, {Cursor: &cursor}} {
+			req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.GetAllTasks(c, params)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
+
+func TestTaskGetAllTasksSortAndFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sort and filter are parsed into the query", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		userID := createUserID(testUserID)
+		sortExpr := "-updated_at,title"
+		filterExprs := []string{"title:contains:report", "due_at:lt:2024-02-01T00:00:00Z"}
+
+		expectedSort := task.Sort{
+			{Field: task.SortByUpdatedAt, Descending: true},
+			{Field: task.SortByTitle, Descending: false},
+		}
+		pageRequest, err := task.NewPageRequest(0, "", expectedSort)
+		require.NoError(t, err)
+
+		expectedFilter := task.Filter{
+			Conditions: []task.Condition{
+				{Field: task.FilterByTitle, Operator: task.OpContains, Value: "report"},
+				{Field: task.FilterByDueAt, Operator: task.OpLess, Value: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		}
+
+		mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, expectedFilter, pageRequest).
+			Return(&task.Page{Tasks: []*task.Task{}}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err = handler.GetAllTasks(c, generated.TaskGetAllTasksParams{Sort: &sortExpr, Filter: &filterExprs})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("unknown fields are bad requests", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, _ := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		unknownSort := "-priority"
+		unknownFilter := []string{"priority:eq:high"}
+
+		e := echo.New()
+
+		for _, params := range []generated.TaskGetAllTasksParams{{Sort: &unknownSort}, {Filter: &unknownFilter}} {
+			req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.GetAllTasks(c, params)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var response generated.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, http.StatusBadRequest, response.Code)
+			require.NotNil(t, response.Details)
+			assert.Contains(t, *response.Details, "priority")
+		}
+	})
+}
+
+func TestTaskSearchTasks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns matching tasks", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		userID := createUserID(testUserID)
+		query, err := task.NewSearchQuery("レポート", 0)
+		require.NoError(t, err)
+
+		match := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "週次レポートを書く", userID)
+		mockRepo.EXPECT().Search(gomock.Any(), userID, query).Return([]*task.Task{match}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/search", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err = handler.SearchTasks(c, generated.TaskSearchTasksParams{Q: " レポート "})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var tasks []generated.Task
+
+		err = json.Unmarshal(rec.Body.Bytes(), &tasks)
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, "週次レポートを書く", tasks[0].Title)
+	})
+
+	t.Run("empty query is a bad request", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, _ := setupTestServer(ctrl)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/search", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", uuid.New().String())
+
+		// Act
+		err := handler.SearchTasks(c, generated.TaskSearchTasksParams{Q: "   "})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTaskConditionalRequests(t *testing.T) {
+	t.Parallel()
+
+	ifMatch := func(s string) *string { return &s }
+
+	t.Run("get returns the version as ETag", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		taskID := uuid.New().String()
+		userID := createUserID(testUserID)
+		taskDomainID := createTaskID(taskID)
+
+		existingTask := task.NewTaskWithoutValidation(taskDomainID, "Task", userID, task.WithVersion(3))
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetTask(c, testUUID(taskID), generated.TaskGetTaskParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+	})
+
+	t.Run("put with matching If-Match returns the new ETag", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		taskID := uuid.New().String()
+		userID := createUserID(testUserID)
+		taskDomainID := createTaskID(taskID)
+
+		existingTask := task.NewTaskWithoutValidation(taskDomainID, "Task", userID, task.WithVersion(3))
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), existingTask).
+			Return(task.NewTaskWithoutValidation(taskDomainID, "Renamed", userID, task.WithVersion(4)), nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(`{"title": "Renamed"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{IfMatch: ifMatch(`"3"`)})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+	})
+
+	t.Run("put with stale If-Match is a failed precondition", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		taskID := uuid.New().String()
+		userID := createUserID(testUserID)
+		taskDomainID := createTaskID(taskID)
+
+		existingTask := task.NewTaskWithoutValidation(taskDomainID, "Task", userID, task.WithVersion(3))
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(`{"title": "Renamed"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{IfMatch: ifMatch(`"2"`)})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	})
+
+	t.Run("delete with stale If-Match is a failed precondition", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		testUserID := uuid.New().String()
+		taskID := uuid.New().String()
+		userID := createUserID(testUserID)
+		taskDomainID := createTaskID(taskID)
+		expectedVersion := int64(2)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Test Task", userID), nil)
+		mockRepo.EXPECT().Delete(gomock.Any(), userID, taskDomainID, &expectedVersion).Return(task.ErrVersionMismatch)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/tasks/"+taskID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.DeleteTask(c, testUUID(taskID), generated.TaskDeleteTaskParams{IfMatch: ifMatch(`"2"`)})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	})
+
+	t.Run("weak If-Match never matches", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, _ := setupTestServer(ctrl)
+
+		taskID := uuid.New().String()
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/tasks/"+taskID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", uuid.New().String())
+
+		// Act
+		err := handler.DeleteTask(c, testUUID(taskID), generated.TaskDeleteTaskParams{IfMatch: ifMatch(`W/"3"`)})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	})
+}
+
+func TestParseIfMatch(t *testing.T) {
+	t.Parallel()
+
+	version := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name            string
+		ifMatch         *string
+		expectedVersion *int64
+		expectedOK      bool
+		expectError     bool
+	}{
+		{name: "absent", ifMatch: nil, expectedVersion: nil, expectedOK: true},
+		{name: "any", ifMatch: stringPtr("*"), expectedVersion: nil, expectedOK: true},
+		{name: "strong tag", ifMatch: stringPtr(`"12"`), expectedVersion: version(12), expectedOK: true},
+		{name: "weak tag", ifMatch: stringPtr(`W/"12"`), expectedVersion: nil, expectedOK: false},
+		{name: "unquoted", ifMatch: stringPtr("12"), expectedVersion: nil, expectedOK: false},
+		{name: "foreign tag", ifMatch: stringPtr(`"abc"`), expectedVersion: nil, expectedOK: false},
+		{name: "several tags", ifMatch: stringPtr(`"1", "2"`), expectedVersion: nil, expectedOK: false, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			version, ok, err := parseIfMatch(tt.ifMatch)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedVersion, version)
+		})
+	}
+}
+
+func TestTaskDeleteTask(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskDomainID := createTaskID(taskID)
+
+	mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Test Task", userID), nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), userID, taskDomainID, nil).Return(nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/tasks/"+taskID, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.DeleteTask(c, testUUID(taskID), generated.TaskDeleteTaskParams{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestTaskGetTrash(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+	userID := createUserID(testUserID)
+	deletedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	trashed := task.NewTaskWithoutValidation(createTaskID(taskID), "Deleted Task", userID, task.WithDeletedAt(&deletedAt))
+	mockRepo.EXPECT().FindTrashByUserID(gomock.Any(), userID).Return([]*task.Task{trashed}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/tasks/trash", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.GetTrash(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var responseTasks []generated.Task
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseTasks)
+	require.NoError(t, err)
+	require.Len(t, responseTasks, 1)
+	assert.Equal(t, taskID, responseTasks[0].Id.String())
+	require.NotNil(t, responseTasks[0].DeletedAt)
+	assert.True(t, deletedAt.Equal(*responseTasks[0].DeletedAt))
+}
+
+func TestTaskRestoreTask(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskDomainID := createTaskID(taskID)
+
+	tests := []struct {
+		name           string
+		setupMock      func(repo *mocks.MockTaskRepository)
+		expectedStatus int
+		expectedETag   string
+	}{
+		{
+			name: "task restored",
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				restored := task.NewTaskWithoutValidation(taskDomainID, "Restored Task", userID, task.WithVersion(4))
+				repo.EXPECT().Restore(gomock.Any(), userID, taskDomainID).Return(restored, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedETag:   `"4"`,
+		},
+		{
+			name: "task not in the trash",
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().Restore(gomock.Any(), userID, taskDomainID).Return(nil, task.ErrTaskNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedETag:   "",
+		},
+		{
+			name: "repository error",
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().Restore(gomock.Any(), userID, taskDomainID).Return(nil, fmt.Errorf("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedETag:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/tasks/"+taskID+"/restore", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.RestoreTask(c, testUUID(taskID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedETag, rec.Header().Get("ETag"))
+		})
+	}
+}
+
+func TestTaskEmptyTrash(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+
+	deletedAt := time.Now()
+	trashed := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Trashed Task", userID, task.WithDeletedAt(&deletedAt))
+	mockRepo.EXPECT().EmptyTrash(gomock.Any(), userID).Return([]*task.Task{trashed}, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/tasks/trash", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.EmptyTrash(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestTaskSubtasks(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	parentID := uuid.New().String()
+	parentDomainID := createTaskID(parentID)
+	childDomainID := createTaskID(uuid.New().String())
+
+	parent := task.NewTaskWithoutValidation(parentDomainID, "Parent Task", userID)
+	child := task.NewTaskWithoutValidation(childDomainID, "Child Task", userID, task.WithParentID(&parentDomainID))
+	grandchild := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Grandchild Task", userID, task.WithParentID(&childDomainID))
+
+	t.Run("create subtask", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindLineage(gomock.Any(), userID, parentDomainID).Return([]*task.Task{parent}, nil)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, taskEntity *task.Task) (*task.Task, error) {
+			return taskEntity, nil
+		})
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(`{"title":"Child Task","parentId":"`+parentID+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.CreateTask(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+		require.NotNil(t, created.ParentId)
+		assert.Equal(t, parentID, created.ParentId.String())
+	})
+
+	t.Run("create subtask under unknown parent", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindLineage(gomock.Any(), userID, parentDomainID).Return(nil, task.ErrTaskNotFound)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(`{"title":"Child Task","parentId":"`+parentID+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.CreateTask(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("get task with children", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, parentDomainID).Return(parent, nil)
+		mockRepo.EXPECT().FindSubtasks(gomock.Any(), userID, []task.TaskID{parentDomainID}).Return([]*task.Task{child, grandchild}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+parentID+"?include=children", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		include := generated.Children
+
+		// Act
+		err := handler.GetTask(c, testUUID(parentID), generated.TaskGetTaskParams{Include: &include})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.NotNil(t, response.Children)
+		require.Len(t, *response.Children, 1)
+		assert.Equal(t, "Child Task", (*response.Children)[0].Title)
+		require.NotNil(t, (*response.Children)[0].Children)
+		require.Len(t, *(*response.Children)[0].Children, 1)
+		assert.Equal(t, "Grandchild Task", (*(*response.Children)[0].Children)[0].Title)
+	})
+
+	t.Run("get subtasks", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, parentDomainID).Return(parent, nil)
+		mockRepo.EXPECT().FindSubtasks(gomock.Any(), userID, []task.TaskID{parentDomainID}).Return([]*task.Task{child, grandchild}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+parentID+"/subtasks", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetSubtasks(c, testUUID(parentID), generated.TaskGetSubtasksParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var subtasks []generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &subtasks))
+		require.Len(t, subtasks, 1)
+		assert.Equal(t, childDomainID.String(), subtasks[0].Id.String())
+		assert.Nil(t, subtasks[0].Children)
+	})
+
+	t.Run("get subtasks of unknown task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, parentDomainID).Return(nil, task.ErrTaskNotFound)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+parentID+"/subtasks", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetSubtasks(c, testUUID(parentID), generated.TaskGetSubtasksParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("delete task with subtasks", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, parentDomainID).Return(task.NewTaskWithoutValidation(parentDomainID, "Test Task", userID), nil)
+		mockRepo.EXPECT().Delete(gomock.Any(), userID, parentDomainID, nil).Return(task.ErrHasSubtasks)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/tasks/"+parentID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.DeleteTask(c, testUUID(parentID), generated.TaskDeleteTaskParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("cascade delete", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, parentDomainID).Return(task.NewTaskWithoutValidation(parentDomainID, "Test Task", userID), nil)
+		mockRepo.EXPECT().FindSubtasks(gomock.Any(), userID, []task.TaskID{parentDomainID}).Return(nil, nil)
+		mockRepo.EXPECT().DeleteTree(gomock.Any(), userID, parentDomainID, nil).Return(nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/tasks/"+parentID+"?cascade=true", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		cascade := true
+
+		// Act
+		err := handler.DeleteTask(c, testUUID(parentID), generated.TaskDeleteTaskParams{Cascade: &cascade})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("move task under its own subtask", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, parentDomainID).Return(parent, nil)
+		mockRepo.EXPECT().FindSubtasks(gomock.Any(), userID, []task.TaskID{parentDomainID}).Return([]*task.Task{child}, nil)
+		mockRepo.EXPECT().FindLineage(gomock.Any(), userID, childDomainID).Return([]*task.Task{child, parent}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/tasks/"+parentID, strings.NewReader(`{"parentId":"`+childDomainID.String()+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.UpdateTask(c, testUUID(parentID), generated.TaskUpdateTaskParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTaskDependencies(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskID := uuid.New().String()
+	taskDomainID := createTaskID(taskID)
+	blockerID := uuid.New().String()
+	blockerDomainID := createTaskID(blockerID)
+
+	dependency, err := task.NewDependency(blockerDomainID, taskDomainID)
+	require.NoError(t, err)
+
+	t.Run("add dependency", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name           string
+			blockerID      string
+			setupMock      func(repo *mocks.MockTaskRepository)
+			expectedStatus int
+		}{
+			{
+				name:      "dependency added",
+				blockerID: blockerID,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Task", userID), nil)
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, blockerDomainID).Return(task.NewTaskWithoutValidation(blockerDomainID, "Blocker", userID), nil)
+					repo.EXPECT().AddDependency(gomock.Any(), userID, dependency).Return(nil)
+				},
+				expectedStatus: http.StatusNoContent,
+			},
+			{
+				name:      "cycle",
+				blockerID: blockerID,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Task", userID), nil)
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, blockerDomainID).Return(task.NewTaskWithoutValidation(blockerDomainID, "Blocker", userID), nil)
+					repo.EXPECT().AddDependency(gomock.Any(), userID, dependency).Return(task.ErrDependencyCycle)
+				},
+				expectedStatus: http.StatusConflict,
+			},
+			{
+				name:      "unknown task",
+				blockerID: blockerID,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(nil, task.ErrTaskNotFound)
+				},
+				expectedStatus: http.StatusNotFound,
+			},
+			{
+				name:      "unknown blocker",
+				blockerID: blockerID,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Task", userID), nil)
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, blockerDomainID).Return(nil, task.ErrTaskNotFound)
+				},
+				expectedStatus: http.StatusNotFound,
+			},
+			{
+				name:           "task blocks itself",
+				blockerID:      taskID,
+				setupMock:      func(_ *mocks.MockTaskRepository) {},
+				expectedStatus: http.StatusBadRequest,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				// Arrange
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				handler, mockRepo := setupTestServer(ctrl)
+				tt.setupMock(mockRepo)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID+"/dependencies/"+tt.blockerID, nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.Set("user_id", testUserID)
+
+				// Act
+				err := handler.AddDependency(c, testUUID(taskID), testUUID(tt.blockerID))
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			})
+		}
+	})
+
+	t.Run("remove unknown dependency", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Task", userID), nil)
+		mockRepo.EXPECT().RemoveDependency(gomock.Any(), userID, dependency).Return(task.ErrDependencyNotFound)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/tasks/"+taskID+"/dependencies/"+blockerID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.RemoveDependency(c, testUUID(taskID), testUUID(blockerID))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("list blockers of a blocked task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		blocked := task.NewTaskWithoutValidation(taskDomainID, "Blocked Task", userID, task.WithBlocked(true))
+		blocker := task.NewTaskWithoutValidation(blockerDomainID, "Blocker Task", userID)
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(blocked, nil)
+		mockRepo.EXPECT().FindBlockers(gomock.Any(), userID, taskDomainID).Return([]*task.Task{blocker}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID+"/dependencies", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetDependencies(c, testUUID(taskID))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var blockers []generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &blockers))
+		require.Len(t, blockers, 1)
+		assert.Equal(t, blockerID, blockers[0].Id.String())
+		assert.False(t, blockers[0].Blocked)
+	})
+
+	t.Run("blocked flag is returned", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		blocked := task.NewTaskWithoutValidation(taskDomainID, "Blocked Task", userID, task.WithBlocked(true))
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(blocked, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.GetTask(c, testUUID(taskID), generated.TaskGetTaskParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.True(t, response.Blocked)
+	})
+}
+
+func TestTaskRecurrence(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskID := uuid.New().String()
+	taskDomainID := createTaskID(taskID)
+	due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+
+	newRecurringTask := func(t *testing.T) *task.Task {
+		t.Helper()
+
+		taskEntity := task.NewTaskWithoutValidation(taskDomainID, "Weekly report", userID,
+			task.WithSchedule(task.NewScheduleWithoutValidation(nil, &due, false)))
+		require.NoError(t, taskEntity.Repeat(task.GenerateSeriesID(), "FREQ=WEEKLY;BYDAY=MO", "UTC"))
+
+		return taskEntity
+	}
+
+	t.Run("create recurring task", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name           string
+			requestBody    string
+			expectCreate   bool
+			expectedStatus int
+		}{
+			{
+				name:           "weekly rule",
+				requestBody:    `{"title": "Weekly report", "dueAt": "2024-01-15T17:00:00Z", "recurrence": {"rule": "RRULE:FREQ=WEEKLY;BYDAY=MO", "timezone": "Asia/Tokyo"}}`,
+				expectCreate:   true,
+				expectedStatus: http.StatusCreated,
+			},
+			{
+				name:           "invalid rule",
+				requestBody:    `{"title": "Weekly report", "dueAt": "2024-01-15T17:00:00Z", "recurrence": {"rule": "FREQ=HOURLY", "timezone": "UTC"}}`,
+				expectCreate:   false,
+				expectedStatus: http.StatusBadRequest,
+			},
+			{
+				name:           "unknown time zone",
+				requestBody:    `{"title": "Weekly report", "dueAt": "2024-01-15T17:00:00Z", "recurrence": {"rule": "FREQ=WEEKLY", "timezone": "Mars/Olympus"}}`,
+				expectCreate:   false,
+				expectedStatus: http.StatusBadRequest,
+			},
+			{
+				name:           "without dates",
+				requestBody:    `{"title": "Weekly report", "recurrence": {"rule": "FREQ=WEEKLY", "timezone": "UTC"}}`,
+				expectCreate:   false,
+				expectedStatus: http.StatusBadRequest,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				// Arrange
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				handler, mockRepo := setupTestServer(ctrl)
+
+				if tt.expectCreate {
+					mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, taskEntity *task.Task) (*task.Task, error) {
+						return taskEntity, nil
+					})
+				}
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(tt.requestBody))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.Set("user_id", testUserID)
+
+				// Act
+				err := handler.CreateTask(c)
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+
+				if tt.expectedStatus != http.StatusCreated {
+					return
+				}
+
+				var responseTask generated.Task
+
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseTask))
+				require.NotNil(t, responseTask.Recurrence)
+				assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO", responseTask.Recurrence.Rule)
+				assert.Equal(t, "Asia/Tokyo", responseTask.Recurrence.Timezone)
+			})
+		}
+	})
+
+	t.Run("list occurrences", func(t *testing.T) {
+		t.Parallel()
+
+		three := 3
+		tooLarge := task.MaxOccurrenceLimit + 1
+
+		tests := []struct {
+			name           string
+			limit          *int
+			found          *task.Task
+			expectFind     bool
+			expectedStatus int
+			expectedCount  int
+		}{
+			{
+				name:           "default limit",
+				limit:          nil,
+				found:          newRecurringTask(t),
+				expectFind:     true,
+				expectedStatus: http.StatusOK,
+				expectedCount:  task.DefaultOccurrenceLimit,
+			},
+			{
+				name:           "explicit limit",
+				limit:          &three,
+				found:          newRecurringTask(t),
+				expectFind:     true,
+				expectedStatus: http.StatusOK,
+				expectedCount:  3,
+			},
+			{
+				name:           "limit too large",
+				limit:          &tooLarge,
+				expectFind:     false,
+				expectedStatus: http.StatusBadRequest,
+			},
+			{
+				name:           "task does not recur",
+				limit:          nil,
+				found:          task.NewTaskWithoutValidation(taskDomainID, "One-off", userID),
+				expectFind:     true,
+				expectedStatus: http.StatusConflict,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				// Arrange
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				handler, mockRepo := setupTestServer(ctrl)
+
+				if tt.expectFind {
+					mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(tt.found, nil)
+				}
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID+"/occurrences", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.Set("user_id", testUserID)
+
+				// Act
+				err := handler.GetOccurrences(c, testUUID(taskID), generated.TaskGetOccurrencesParams{Limit: tt.limit})
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+
+				if tt.expectedStatus != http.StatusOK {
+					return
+				}
+
+				var occurrences []generated.Occurrence
+
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &occurrences))
+				require.Len(t, occurrences, tt.expectedCount)
+				require.NotNil(t, occurrences[0].DueAt)
+				assert.Equal(t, time.Date(2024, 1, 22, 17, 0, 0, 0, time.UTC), occurrences[0].DueAt.UTC())
+			})
+		}
+	})
+
+	t.Run("update series", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name           string
+			requestBody    string
+			found          *task.Task
+			expectUpdate   bool
+			expectedStatus int
+		}{
+			{
+				name:           "rename and change rule",
+				requestBody:    `{"title": "Team report", "recurrence": {"rule": "FREQ=WEEKLY;BYDAY=FR", "timezone": "UTC"}}`,
+				found:          newRecurringTask(t),
+				expectUpdate:   true,
+				expectedStatus: http.StatusOK,
+			},
+			{
+				name:           "invalid rule",
+				requestBody:    `{"recurrence": {"rule": "FREQ=WEEKLY;BYDAY=XX", "timezone": "UTC"}}`,
+				found:          newRecurringTask(t),
+				expectUpdate:   false,
+				expectedStatus: http.StatusBadRequest,
+			},
+			{
+				name:           "task does not recur",
+				requestBody:    `{"title": "Team report"}`,
+				found:          task.NewTaskWithoutValidation(taskDomainID, "One-off", userID),
+				expectUpdate:   false,
+				expectedStatus: http.StatusConflict,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				// Arrange
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				handler, mockRepo := setupTestServer(ctrl)
+
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(tt.found, nil)
+
+				if tt.expectUpdate {
+					mockRepo.EXPECT().UpdateSeries(gomock.Any(), tt.found.Series()).Return(nil)
+					mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(tt.found, nil)
+				}
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID+"/series", strings.NewReader(tt.requestBody))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.Set("user_id", testUserID)
+
+				// Act
+				err := handler.UpdateSeries(c, testUUID(taskID))
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+
+				if tt.expectedStatus != http.StatusOK {
+					return
+				}
+
+				var responseTask generated.Task
+
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseTask))
+				require.NotNil(t, responseTask.Recurrence)
+				assert.Equal(t, "FREQ=WEEKLY;BYDAY=FR", responseTask.Recurrence.Rule)
+			})
+		}
+	})
+
+	t.Run("completing an occurrence creates the next one", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		current := newRecurringTask(t)
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(current, nil)
+		mockRepo.EXPECT().CompleteOccurrence(gomock.Any(), gomock.Any(), current, gomock.Any()).DoAndReturn(func(_ context.Context, _ user.UserID, taskEntity *task.Task, next *task.Task) (*task.Task, error) {
+			require.NotNil(t, next)
+			assert.Equal(t, time.Date(2024, 1, 22, 17, 0, 0, 0, time.UTC), *next.Schedule().DueAt())
+
+			return taskEntity, nil
+		})
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(`{"completed": true}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestTaskProjects(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	projectID := uuid.New().String()
+	projectDomainID, err := projectDomain.NewProjectID(projectID)
+	require.NoError(t, err)
+
+	t.Run("create task in a project", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo, _, mockProjectRepo := setupTestServerWithProjects(ctrl)
+
+		mockProjectRepo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).
+			Return(projectDomain.NewProjectWithoutValidation(projectDomainID, "Work", userID), nil)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, taskEntity *task.Task) (*task.Task, error) {
+			return taskEntity, nil
+		})
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(`{"title":"Task","projectId":"`+projectID+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.CreateTask(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+		require.NotNil(t, created.ProjectId)
+		assert.Equal(t, projectID, created.ProjectId.String())
+	})
+
+	t.Run("create task in an unknown project", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, _, _, mockProjectRepo := setupTestServerWithProjects(ctrl)
+
+		mockProjectRepo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).Return(nil, projectDomain.ErrProjectNotFound)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(`{"title":"Task","projectId":"`+projectID+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.CreateTask(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("move task into a project", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo, _, mockProjectRepo := setupTestServerWithProjects(ctrl)
+
+		taskID := uuid.New().String()
+		taskDomainID := createTaskID(taskID)
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).
+			Return(task.NewTaskWithoutValidation(taskDomainID, "Task", userID), nil)
+		mockProjectRepo.EXPECT().FindById(gomock.Any(), userID, projectDomainID).
+			Return(projectDomain.NewProjectWithoutValidation(projectDomainID, "Work", userID), nil)
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ user.UserID, taskEntity *task.Task) (*task.Task, error) {
+			return taskEntity, nil
+		})
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(`{"projectId":"`+projectID+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", testUserID)
+
+		// Act
+		err := handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var updated generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+		require.NotNil(t, updated.ProjectId)
+		assert.Equal(t, projectID, updated.ProjectId.String())
+	})
+}
+
+func TestTaskMoveTask(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+	taskID := uuid.New().String()
+	taskDomainID := createTaskID(taskID)
+	afterID := uuid.New().String()
+	afterDomainID := createTaskID(afterID)
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(repo *mocks.MockTaskRepository)
+		expectedStatus int
+	}{
+		{
+			name: "task moved",
+			body: `{"afterId":"` + afterID + `"}`,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				placement, _ := task.NewPlacement(taskDomainID, &afterDomainID, nil)
+				moved := task.NewTaskWithoutValidation(taskDomainID, "Task", userID, task.WithRank("i00001"), task.WithVersion(3))
+				gomock.InOrder(
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Task", userID), nil),
+					repo.EXPECT().Move(gomock.Any(), userID, taskDomainID, placement).Return(moved, nil),
+					repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(moved, nil),
+				)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "no neighbour",
+			body:           `{}`,
+			setupMock:      func(_ *mocks.MockTaskRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "task placed after itself",
+			body:           `{"afterId":"` + taskID + `"}`,
+			setupMock:      func(_ *mocks.MockTaskRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "neighbour not found",
+			body: `{"afterId":"` + afterID + `"}`,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Task", userID), nil)
+				repo.EXPECT().Move(gomock.Any(), userID, taskDomainID, gomock.Any()).Return(nil, task.ErrNeighbourNotFound)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown task",
+			body: `{"afterId":"` + afterID + `"}`,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(nil, task.ErrTaskNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "neighbours in the wrong order",
+			body: `{"afterId":"` + afterID + `","beforeId":"` + uuid.New().String() + `"}`,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(task.NewTaskWithoutValidation(taskDomainID, "Task", userID), nil)
+				repo.EXPECT().Move(gomock.Any(), userID, taskDomainID, gomock.Any()).Return(nil, task.ErrRankOrder)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "invalid neighbour ID",
+			body:           `{"afterId":"not-a-uuid"}`,
+			setupMock:      func(_ *mocks.MockTaskRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/tasks/"+taskID+"/move", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.MoveTask(c, testUUID(taskID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response generated.Task
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "i00001", response.Rank)
+				assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestTaskCollaborators(t *testing.T) {
+	t.Parallel()
+
+	creatorUserID := uuid.New().String()
+	creatorID := createUserID(creatorUserID)
+	collaboratorUserID := uuid.New().String()
+	collaboratorID := createUserID(collaboratorUserID)
+	taskID := uuid.New().String()
+	taskDomainID := createTaskID(taskID)
+
+	viewer := task.RoleViewer
+	owned := func() *task.Task {
+		return task.NewTaskWithoutValidation(taskDomainID, "Shared Task", creatorID)
+	}
+	shared := func() *task.Task {
+		return task.NewTaskWithoutValidation(taskDomainID, "Shared Task", creatorID, task.WithSharedRole(&viewer))
+	}
+
+	t.Run("add collaborator", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name           string
+			userID         string
+			body           string
+			setupMock      func(repo *mocks.MockTaskRepository)
+			expectedStatus int
+		}{
+			{
+				name:   "task shared",
+				userID: creatorUserID,
+				body:   `{"role": "editor"}`,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskDomainID).Return(owned(), nil)
+					repo.EXPECT().AddCollaborator(gomock.Any(), gomock.Any()).Return(nil)
+				},
+				expectedStatus: http.StatusOK,
+			},
+			{
+				name:           "unknown role",
+				userID:         creatorUserID,
+				body:           `{"role": "owner"}`,
+				setupMock:      func(_ *mocks.MockTaskRepository) {},
+				expectedStatus: http.StatusBadRequest,
+			},
+			{
+				name:   "collaborator cannot share",
+				userID: collaboratorUserID,
+				body:   `{"role": "viewer"}`,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(), nil)
+				},
+				expectedStatus: http.StatusForbidden,
+			},
+			{
+				name:   "task of another user",
+				userID: creatorUserID,
+				body:   `{"role": "viewer"}`,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskDomainID).Return(nil, task.ErrTaskNotFound)
+				},
+				expectedStatus: http.StatusNotFound,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				// Arrange
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				handler, mockRepo := setupTestServer(ctrl)
+				tt.setupMock(mockRepo)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID+"/collaborators/"+collaboratorUserID, strings.NewReader(tt.body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.Set("user_id", tt.userID)
+
+				// Act
+				err := handler.AddCollaborator(c, testUUID(taskID), testUUID(collaboratorUserID))
+
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+
+				if tt.expectedStatus == http.StatusOK {
+					var collaborator generated.Collaborator
+
+					require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &collaborator))
+					assert.Equal(t, testUUID(collaboratorUserID), collaborator.UserId)
+					assert.Equal(t, generated.Editor, collaborator.Role)
+				}
+			})
+		}
+	})
+
+	t.Run("remove unknown collaborator", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskDomainID).Return(owned(), nil)
+		mockRepo.EXPECT().RemoveCollaborator(gomock.Any(), taskDomainID, collaboratorID).Return(task.ErrCollaboratorNotFound)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/tasks/"+taskID+"/collaborators/"+collaboratorUserID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", creatorUserID)
+
+		// Act
+		err := handler.RemoveCollaborator(c, testUUID(taskID), testUUID(collaboratorUserID))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("list collaborators", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		collaborator, err := task.NewCollaborator(taskDomainID, collaboratorID, task.RoleViewer)
+		require.NoError(t, err)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(), nil)
+		mockRepo.EXPECT().FindCollaborators(gomock.Any(), taskDomainID).Return([]task.Collaborator{collaborator}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID+"/collaborators", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", collaboratorUserID)
+
+		// Act
+		err = handler.GetCollaborators(c, testUUID(taskID))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var collaborators []generated.Collaborator
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &collaborators))
+		require.Len(t, collaborators, 1)
+		assert.Equal(t, generated.Viewer, collaborators[0].Role)
+	})
+
+	t.Run("shared task is flagged", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(), nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", collaboratorUserID)
+
+		// Act
+		err := handler.GetTask(c, testUUID(taskID), generated.TaskGetTaskParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var responseTask generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseTask))
+		assert.True(t, responseTask.Shared)
+		require.NotNil(t, responseTask.Role)
+		assert.Equal(t, generated.Viewer, *responseTask.Role)
+	})
+
+	t.Run("viewer cannot update the task", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupTestServer(ctrl)
+
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(), nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(`{"title": "Renamed"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", collaboratorUserID)
+
+		// Act
+		err := handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestTaskCollaboratorAccess(t *testing.T) {
+	t.Parallel()
+
+	creatorID := createUserID(uuid.New().String())
+	collaboratorUserID := uuid.New().String()
+	collaboratorID := createUserID(collaboratorUserID)
+	taskID := uuid.New().String()
+	taskDomainID := createTaskID(taskID)
+	blockerID := uuid.New().String()
+	blockerDomainID := createTaskID(blockerID)
+	due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+
+	shared := func(t *testing.T, role task.Role) *task.Task {
+		t.Helper()
+
+		taskEntity := task.NewTaskWithoutValidation(taskDomainID, "Weekly report", creatorID,
+			task.WithSchedule(task.NewScheduleWithoutValidation(nil, &due, false)),
+			task.WithSharedRole(&role))
+		require.NoError(t, taskEntity.Repeat(task.GenerateSeriesID(), "FREQ=WEEKLY;BYDAY=MO", "UTC"))
+
+		return taskEntity
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		setupMock      func(t *testing.T, repo *mocks.MockTaskRepository)
+		act            func(handler *TaskHandler, c echo.Context) error
+		expectedStatus int
+	}{
+		{
+			name:   "viewer reads subtasks",
+			method: http.MethodGet,
+			path:   "/subtasks",
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleViewer), nil)
+				repo.EXPECT().FindSubtasks(gomock.Any(), creatorID, []task.TaskID{taskDomainID}).Return([]*task.Task{}, nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.GetSubtasks(c, testUUID(taskID), generated.TaskGetSubtasksParams{})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "viewer reads dependencies",
+			method: http.MethodGet,
+			path:   "/dependencies",
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleViewer), nil)
+				repo.EXPECT().FindBlockers(gomock.Any(), creatorID, taskDomainID).Return([]*task.Task{}, nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.GetDependencies(c, testUUID(taskID))
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "viewer reads occurrences",
+			method: http.MethodGet,
+			path:   "/occurrences",
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleViewer), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.GetOccurrences(c, testUUID(taskID), generated.TaskGetOccurrencesParams{})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "editor moves the task",
+			method: http.MethodPost,
+			path:   "/move",
+			body:   `{"afterId":"` + blockerID + `"}`,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleEditor), nil).Times(2)
+				repo.EXPECT().Move(gomock.Any(), creatorID, taskDomainID, gomock.Any()).Return(shared(t, task.RoleEditor), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.MoveTask(c, testUUID(taskID))
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "viewer cannot move the task",
+			method: http.MethodPost,
+			path:   "/move",
+			body:   `{"afterId":"` + blockerID + `"}`,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleViewer), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.MoveTask(c, testUUID(taskID))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "editor adds a dependency",
+			method: http.MethodPut,
+			path:   "/dependencies/" + blockerID,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleEditor), nil)
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, blockerDomainID).Return(task.NewTaskWithoutValidation(blockerDomainID, "Blocker", collaboratorID), nil)
+				repo.EXPECT().AddDependency(gomock.Any(), creatorID, gomock.Any()).Return(nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.AddDependency(c, testUUID(taskID), testUUID(blockerID))
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "viewer cannot add a dependency",
+			method: http.MethodPut,
+			path:   "/dependencies/" + blockerID,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleViewer), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.AddDependency(c, testUUID(taskID), testUUID(blockerID))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "editor removes a dependency",
+			method: http.MethodDelete,
+			path:   "/dependencies/" + blockerID,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleEditor), nil)
+				repo.EXPECT().RemoveDependency(gomock.Any(), creatorID, gomock.Any()).Return(nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.RemoveDependency(c, testUUID(taskID), testUUID(blockerID))
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "viewer cannot remove a dependency",
+			method: http.MethodDelete,
+			path:   "/dependencies/" + blockerID,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleViewer), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.RemoveDependency(c, testUUID(taskID), testUUID(blockerID))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "editor updates the series",
+			method: http.MethodPut,
+			path:   "/series",
+			body:   `{"title": "Team report"}`,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleEditor), nil).Times(2)
+				repo.EXPECT().UpdateSeries(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.UpdateSeries(c, testUUID(taskID))
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "viewer cannot update the series",
+			method: http.MethodPut,
+			path:   "/series",
+			body:   `{"title": "Team report"}`,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleViewer), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.UpdateSeries(c, testUUID(taskID))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "editor cannot delete the task",
+			method: http.MethodDelete,
+			setupMock: func(t *testing.T, repo *mocks.MockTaskRepository) {
+				t.Helper()
+
+				repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(t, task.RoleEditor), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.DeleteTask(c, testUUID(taskID), generated.TaskDeleteTaskParams{})
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTestServer(ctrl)
+			tt.setupMock(t, mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(tt.method, "/tasks/"+taskID+tt.path, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", collaboratorUserID)
+
+			// Act
+			err := tt.act(handler, c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestTaskWorkspaceAccess(t *testing.T) {
+	t.Parallel()
+
+	creatorID := createUserID(uuid.New().String())
+	memberUserID := uuid.New().String()
+	memberID := createUserID(memberUserID)
+	taskID := uuid.New().String()
+	taskDomainID := createTaskID(taskID)
+	workspaceID := workspace.GenerateWorkspaceID()
+
+	inWorkspace := func(role workspace.Role) *task.Task {
+		return task.NewTaskWithoutValidation(taskDomainID, "Team task", creatorID,
+			task.WithWorkspaceID(&workspaceID),
+			task.WithWorkspaceRole(&role))
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		setupMock      func(repo *mocks.MockTaskRepository)
+		act            func(handler *TaskHandler, c echo.Context) error
+		expectedStatus int
+	}{
+		{
+			name:   "member reads the task of another member",
+			method: http.MethodGet,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), memberID, taskDomainID).Return(inWorkspace(workspace.RoleMember), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.GetTask(c, testUUID(taskID), generated.TaskGetTaskParams{})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "member updates the task of another member",
+			method: http.MethodPut,
+			body:   `{"title": "Renamed"}`,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), memberID, taskDomainID).Return(inWorkspace(workspace.RoleMember), nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(inWorkspace(workspace.RoleMember), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "guest reads the task",
+			method: http.MethodGet,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), memberID, taskDomainID).Return(inWorkspace(workspace.RoleGuest), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.GetTask(c, testUUID(taskID), generated.TaskGetTaskParams{})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "guest cannot update the task",
+			method: http.MethodPut,
+			body:   `{"title": "Renamed"}`,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), memberID, taskDomainID).Return(inWorkspace(workspace.RoleGuest), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "member cannot delete the task of another member",
+			method: http.MethodDelete,
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), memberID, taskDomainID).Return(inWorkspace(workspace.RoleMember), nil)
+			},
+			act: func(handler *TaskHandler, c echo.Context) error {
+				return handler.DeleteTask(c, testUUID(taskID), generated.TaskDeleteTaskParams{})
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(tt.method, "/tasks/"+taskID, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", memberUserID)
+
+			// Act
+			err := tt.act(handler, c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestTaskAssignees(t *testing.T) {
+	t.Parallel()
+
+	creatorUserID := uuid.New().String()
+	creatorID := createUserID(creatorUserID)
+	collaboratorUserID := uuid.New().String()
+	collaboratorID := createUserID(collaboratorUserID)
+	taskID := uuid.New().String()
+	taskDomainID := createTaskID(taskID)
 
-	// Assert
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, rec.Code)
+	viewer := task.RoleViewer
+	owned := func() *task.Task {
+		return task.NewTaskWithoutValidation(taskDomainID, "Report", creatorID, task.WithAssigneeID(&creatorID))
+	}
+	shared := func() *task.Task {
+		return task.NewTaskWithoutValidation(taskDomainID, "Report", creatorID, task.WithSharedRole(&viewer))
+	}
 
-	var tasks []generated.Task
+	collaboratorUUID := testUUID(collaboratorUserID)
 
-	err = json.Unmarshal(rec.Body.Bytes(), &tasks)
+	collaborator, err := task.NewCollaborator(taskDomainID, collaboratorID, task.RoleEditor)
 	require.NoError(t, err)
-	assert.Len(t, tasks, 2)
-}
-
-// testUUID converts string to openapi_types.UUID for tests
-func testUUID(s string) types.UUID {
-	return types.UUID(uuid.MustParse(s))
-}
 
-func TestTaskCreateTask(t *testing.T) {
-	t.Parallel()
+	t.Run("reassign task", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name             string
+			userID           string
+			body             string
+			setupMock        func(repo *mocks.MockTaskRepository)
+			expectedStatus   int
+			expectedAssignee *types.UUID
+		}{
+			{
+				name:   "task assigned to a collaborator",
+				userID: creatorUserID,
+				body:   `{"assigneeId": "` + collaboratorUserID + `"}`,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskDomainID).Return(owned(), nil)
+					repo.EXPECT().FindCollaborators(gomock.Any(), taskDomainID).Return([]task.Collaborator{collaborator}, nil)
+					repo.EXPECT().Assign(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, taskEntity *task.Task, assignment task.Assignment) (*task.Task, error) {
+							assert.Equal(t, creatorID, assignment.AssignedBy())
+
+							return task.NewTaskWithoutValidation(taskEntity.ID(), taskEntity.Title(), taskEntity.UserID(),
+								task.WithAssigneeID(taskEntity.AssigneeID()), task.WithVersion(2)), nil
+						})
+				},
+				expectedStatus:   http.StatusOK,
+				expectedAssignee: &collaboratorUUID,
+			},
+			{
+				name:   "task unassigned",
+				userID: creatorUserID,
+				body:   `{"assigneeId": null}`,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskDomainID).Return(owned(), nil)
+					repo.EXPECT().Assign(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, taskEntity *task.Task, _ task.Assignment) (*task.Task, error) {
+							return taskEntity, nil
+						})
+				},
+				expectedStatus:   http.StatusOK,
+				expectedAssignee: nil,
+			},
+			{
+				name:   "assignee without access",
+				userID: creatorUserID,
+				body:   `{"assigneeId": "` + uuid.New().String() + `"}`,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskDomainID).Return(owned(), nil)
+					repo.EXPECT().FindCollaborators(gomock.Any(), taskDomainID).Return([]task.Collaborator{collaborator}, nil)
+				},
+				expectedStatus:   http.StatusBadRequest,
+				expectedAssignee: nil,
+			},
+			{
+				name:   "viewer cannot reassign",
+				userID: collaboratorUserID,
+				body:   `{"assigneeId": "` + collaboratorUserID + `"}`,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(), nil)
+				},
+				expectedStatus:   http.StatusForbidden,
+				expectedAssignee: nil,
+			},
+			{
+				name:   "task of another user",
+				userID: creatorUserID,
+				body:   `{"assigneeId": null}`,
+				setupMock: func(repo *mocks.MockTaskRepository) {
+					repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskDomainID).Return(nil, task.ErrTaskNotFound)
+				},
+				expectedStatus:   http.StatusNotFound,
+				expectedAssignee: nil,
+			},
+		}
 
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
 
-	handler, mockRepo := setupTestServer(ctrl)
+				// Arrange
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
 
-	testUserID := uuid.New().String()
-	taskID := uuid.New().String()
-	userID := createUserID(testUserID)
+				handler, mockRepo := setupTestServer(ctrl)
+				tt.setupMock(mockRepo)
 
-	createdTask := task.NewTaskWithoutValidation(createTaskID(taskID), "New Task", userID)
-	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(createdTask, nil)
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID+"/assignee", strings.NewReader(tt.body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.Set("user_id", tt.userID)
 
-	e := echo.New()
-	requestBody := `{"title": "New Task"}`
-	req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(requestBody))
-	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				// Act
+				err := handler.AssignTask(c, testUUID(taskID))
 
-	rec := httptest.NewRecorder()
-	c := e.NewContext(req, rec)
-	c.Set("user_id", testUserID)
+				// Assert
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
 
-	// Act
-	err := handler.CreateTask(c)
+				if tt.expectedStatus == http.StatusOK {
+					var responseTask generated.Task
 
-	// Assert
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusCreated, rec.Code)
+					require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responseTask))
+					assert.Equal(t, tt.expectedAssignee, responseTask.AssigneeId)
+					assert.NotEmpty(t, rec.Header().Get("ETag"))
+				}
+			})
+		}
+	})
 
-	var responseTask generated.Task
+	t.Run("list assignments", func(t *testing.T) {
+		t.Parallel()
 
-	err = json.Unmarshal(rec.Body.Bytes(), &responseTask)
-	require.NoError(t, err)
-	assert.Equal(t, taskID, responseTask.Id.String())
-	assert.Equal(t, "New Task", responseTask.Title)
-}
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-func TestTaskGetTask(t *testing.T) {
-	t.Parallel()
+		handler, mockRepo := setupTestServer(ctrl)
 
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+		assignedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+		assigned, err := task.NewAssignment(taskDomainID, &collaboratorID, creatorID, assignedAt)
+		require.NoError(t, err)
 
-	handler, mockRepo := setupTestServer(ctrl)
+		unassigned, err := task.NewAssignment(taskDomainID, nil, collaboratorID, assignedAt.Add(time.Hour))
+		require.NoError(t, err)
 
-	testUserID := uuid.New().String()
-	taskID := uuid.New().String()
-	userID := createUserID(testUserID)
-	taskDomainID := createTaskID(taskID)
+		mockRepo.EXPECT().FindAccessible(gomock.Any(), collaboratorID, taskDomainID).Return(shared(), nil)
+		mockRepo.EXPECT().FindAssignments(gomock.Any(), taskDomainID).Return([]task.Assignment{assigned, unassigned}, nil)
 
-	existingTask := task.NewTaskWithoutValidation(taskDomainID, "Test Task", userID)
-	mockRepo.EXPECT().FindById(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID+"/assignments", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", collaboratorUserID)
 
-	e := echo.New()
-	req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID, nil)
-	rec := httptest.NewRecorder()
-	c := e.NewContext(req, rec)
-	c.Set("user_id", testUserID)
+		// Act
+		err = handler.GetAssignments(c, testUUID(taskID))
 
-	// Act
-	err := handler.GetTask(c, testUUID(taskID))
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
 
-	// Assert
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, rec.Code)
+		var assignments []generated.TaskAssignment
 
-	var responseTask generated.Task
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &assignments))
+		require.Len(t, assignments, 2)
+		assert.Equal(t, &collaboratorUUID, assignments[0].AssigneeId)
+		assert.Equal(t, testUUID(creatorUserID), assignments[0].AssignedBy)
+		assert.True(t, assignedAt.Equal(assignments[0].AssignedAt))
+		assert.Nil(t, assignments[1].AssigneeId)
+		assert.Equal(t, testUUID(collaboratorUserID), assignments[1].AssignedBy)
+	})
 
-	err = json.Unmarshal(rec.Body.Bytes(), &responseTask)
-	require.NoError(t, err)
-	assert.Equal(t, taskID, responseTask.Id.String())
-	assert.Equal(t, "Test Task", responseTask.Title)
-}
+	t.Run("tasks assigned to me", func(t *testing.T) {
+		t.Parallel()
 
-func TestTaskUpdateTask(t *testing.T) {
-	t.Parallel()
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+		handler, mockRepo := setupTestServer(ctrl)
 
-	handler, mockRepo := setupTestServer(ctrl)
+		mockRepo.EXPECT().FindPageByUserID(gomock.Any(), collaboratorID, task.Filter{AssigneeID: &collaboratorID}, gomock.Any()).
+			Return(&task.Page{Tasks: []*task.Task{}}, nil)
 
-	testUserID := uuid.New().String()
-	taskID := uuid.New().String()
-	userID := createUserID(testUserID)
-	taskDomainID := createTaskID(taskID)
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks?assigned_to=me", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", collaboratorUserID)
 
-	existingTask := task.NewTaskWithoutValidation(taskDomainID, "Original Task", userID)
-	updatedTask := task.NewTaskWithoutValidation(taskDomainID, "Updated Task", userID)
+		// Act
+		err := handler.GetAllTasks(c, generated.TaskGetAllTasksParams{AssignedTo: stringPtr("me")})
 
-	mockRepo.EXPECT().FindById(gomock.Any(), userID, taskDomainID).Return(existingTask, nil)
-	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(updatedTask, nil)
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
 
-	e := echo.New()
-	requestBody := `{"title": "Updated Task"}`
-	req := httptest.NewRequest(http.MethodPut, "/tasks/"+taskID, strings.NewReader(requestBody))
-	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	t.Run("invalid assignee filter", func(t *testing.T) {
+		t.Parallel()
 
-	rec := httptest.NewRecorder()
-	c := e.NewContext(req, rec)
-	c.Set("user_id", testUserID)
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	// Act
-	err := handler.UpdateTask(c, testUUID(taskID))
+		handler, _ := setupTestServer(ctrl)
 
-	// Assert
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, rec.Code)
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/tasks?assigned_to=someone", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", collaboratorUserID)
 
-	var responseTask generated.Task
+		// Act
+		err := handler.GetAllTasks(c, generated.TaskGetAllTasksParams{AssignedTo: stringPtr("someone")})
 
-	err = json.Unmarshal(rec.Body.Bytes(), &responseTask)
-	require.NoError(t, err)
-	assert.Equal(t, taskID, responseTask.Id.String())
-	assert.Equal(t, "Updated Task", responseTask.Title)
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
 }
 
-func TestTaskDeleteTask(t *testing.T) {
+func TestTaskHistory(t *testing.T) {
 	t.Parallel()
 
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	creatorID := user.GenerateUserID()
+	taskID := task.GenerateTaskID()
+	taskEntity := task.NewTaskWithoutValidation(taskID, "Report", creatorID)
+	occurredAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	pageLimit := 2
+	tooLargeLimit := task.MaxPageLimit + 1
 
-	handler, mockRepo := setupTestServer(ctrl)
+	previousTitle := "Draft"
+	title := "Report"
+	renamed, err := task.NewChange(taskID, task.ChangeTitleChanged, creatorID, &previousTitle, &title, occurredAt)
+	require.NoError(t, err)
 
-	testUserID := uuid.New().String()
-	taskID := uuid.New().String()
-	userID := createUserID(testUserID)
-	taskDomainID := createTaskID(taskID)
+	changes := []task.Change{task.CreatedChange(taskEntity, creatorID, occurredAt), renamed}
+
+	tests := []struct {
+		name           string
+		query          string
+		params         generated.TaskGetHistoryParams
+		setupMock      func(repo *mocks.MockTaskRepository)
+		expectedStatus int
+		expectedLink   string
+	}{
+		{
+			name:   "page with a following page",
+			query:  "?limit=2",
+			params: generated.TaskGetHistoryParams{Limit: &pageLimit, Cursor: nil},
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(taskEntity, nil)
+				repo.EXPECT().FindHistory(gomock.Any(), taskID, gomock.Any()).
+					Return(task.HistoryPage{Changes: changes, NextCursor: "next"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedLink:   `</tasks/` + taskID.String() + `/history?cursor=next&limit=2>; rel="next"`,
+		},
+		{
+			name:   "invalid cursor",
+			query:  "?cursor=bad",
+			params: generated.TaskGetHistoryParams{Limit: nil, Cursor: stringPtr("bad")},
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(taskEntity, nil)
+				repo.EXPECT().FindHistory(gomock.Any(), taskID, gomock.Any()).
+					Return(task.HistoryPage{}, task.ErrInvalidCursor)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedLink:   "",
+		},
+		{
+			name:           "limit out of range",
+			query:          "?limit=201",
+			params:         generated.TaskGetHistoryParams{Limit: &tooLargeLimit, Cursor: nil},
+			setupMock:      func(*mocks.MockTaskRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedLink:   "",
+		},
+		{
+			name:   "task not accessible",
+			query:  "",
+			params: generated.TaskGetHistoryParams{Limit: nil, Cursor: nil},
+			setupMock: func(repo *mocks.MockTaskRepository) {
+				repo.EXPECT().FindAccessible(gomock.Any(), creatorID, taskID).Return(nil, task.ErrTaskNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedLink:   "",
+		},
+	}
 
-	mockRepo.EXPECT().Delete(gomock.Any(), userID, taskDomainID).Return(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-	e := echo.New()
-	req := httptest.NewRequest(http.MethodDelete, "/tasks/"+taskID, nil)
-	rec := httptest.NewRecorder()
-	c := e.NewContext(req, rec)
-	c.Set("user_id", testUserID)
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
 
-	// Act
-	err := handler.DeleteTask(c, testUUID(taskID))
+			handler, mockRepo := setupTestServer(ctrl)
+			tt.setupMock(mockRepo)
 
-	// Assert
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusNoContent, rec.Code)
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/tasks/"+taskID.String()+"/history"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", creatorID.String())
+
+			// Act
+			err := handler.GetHistory(c, taskID.UUID(), tt.params)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedLink, rec.Header().Get("Link"))
+
+			if tt.expectedStatus == http.StatusOK {
+				var history []generated.TaskChange
+
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &history))
+				require.Len(t, history, 2)
+				assert.Equal(t, generated.Created, history[0].Type)
+				assert.Nil(t, history[0].Before)
+				assert.Equal(t, &title, history[0].After)
+				assert.Equal(t, generated.TitleChanged, history[1].Type)
+				assert.Equal(t, creatorID.UUID(), history[1].ActorId)
+				assert.Equal(t, &previousTitle, history[1].Before)
+				assert.True(t, occurredAt.Equal(history[1].OccurredAt))
+			}
+		})
+	}
 }
 
 func TestAuthenticationRequired(t *testing.T) {
@@ -234,7 +3077,7 @@ func TestAuthenticationRequired(t *testing.T) {
 	c := e.NewContext(req, rec)
 
 	// Act
-	err := handler.GetAllTasks(c)
+	err := handler.GetAllTasks(c, generated.TaskGetAllTasksParams{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -311,7 +3154,7 @@ func TestTaskNotFound(t *testing.T) {
 		{
 			name: "get non-existent task",
 			setupMock: func() {
-				mockRepo.EXPECT().FindById(gomock.Any(), userID, taskDomainID).Return(nil, task.ErrTaskNotFound)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(nil, task.ErrTaskNotFound)
 			},
 			operation: func() error {
 				e := echo.New()
@@ -320,7 +3163,7 @@ func TestTaskNotFound(t *testing.T) {
 				c := e.NewContext(req, rec)
 				c.Set("user_id", testUserID)
 
-				err := handler.GetTask(c, testUUID(nonExistentTaskID))
+				err := handler.GetTask(c, testUUID(nonExistentTaskID), generated.TaskGetTaskParams{})
 				assert.NoError(t, err)
 				assert.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -330,7 +3173,7 @@ func TestTaskNotFound(t *testing.T) {
 		{
 			name: "update non-existent task",
 			setupMock: func() {
-				mockRepo.EXPECT().FindById(gomock.Any(), userID, taskDomainID).Return(nil, task.ErrTaskNotFound)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(nil, task.ErrTaskNotFound)
 			},
 			operation: func() error {
 				e := echo.New()
@@ -341,7 +3184,7 @@ func TestTaskNotFound(t *testing.T) {
 				c := e.NewContext(req, rec)
 				c.Set("user_id", testUserID)
 
-				err := handler.UpdateTask(c, testUUID(nonExistentTaskID))
+				err := handler.UpdateTask(c, testUUID(nonExistentTaskID), generated.TaskUpdateTaskParams{})
 				assert.NoError(t, err)
 				assert.Equal(t, http.StatusNotFound, rec.Code)
 
@@ -351,7 +3194,8 @@ func TestTaskNotFound(t *testing.T) {
 		{
 			name: "delete non-existent task",
 			setupMock: func() {
-				mockRepo.EXPECT().Delete(gomock.Any(), userID, taskDomainID).Return(nil)
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(nil, task.ErrTaskNotFound)
+				mockRepo.EXPECT().Delete(gomock.Any(), userID, taskDomainID, nil).Return(nil)
 			},
 			operation: func() error {
 				e := echo.New()
@@ -360,7 +3204,7 @@ func TestTaskNotFound(t *testing.T) {
 				c := e.NewContext(req, rec)
 				c.Set("user_id", testUserID)
 
-				err := handler.DeleteTask(c, testUUID(nonExistentTaskID))
+				err := handler.DeleteTask(c, testUUID(nonExistentTaskID), generated.TaskDeleteTaskParams{})
 				assert.NoError(t, err)
 				assert.Equal(t, http.StatusNoContent, rec.Code)
 
@@ -429,7 +3273,7 @@ func TestInvalidJSONRequest(t *testing.T) {
 			case http.MethodPut:
 				parts := strings.Split(tt.url, "/")
 				taskID := parts[len(parts)-1]
-				err = handler.UpdateTask(c, testUUID(taskID))
+				err = handler.UpdateTask(c, testUUID(taskID), generated.TaskUpdateTaskParams{})
 			}
 
 			// Assert
@@ -458,7 +3302,7 @@ func TestTaskRepositoryErrors(t *testing.T) {
 			name:      "database connection timeout on GetAllTasks",
 			operation: "GetAllTasks",
 			setupMock: func(mockRepo *mocks.MockTaskRepository) {
-				mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return(nil, context.DeadlineExceeded)
+				mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{}, gomock.Any()).Return(nil, context.DeadlineExceeded)
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedErrorType:  "timeout",
@@ -467,7 +3311,7 @@ func TestTaskRepositoryErrors(t *testing.T) {
 			name:      "database connection error on GetAllTasks",
 			operation: "GetAllTasks",
 			setupMock: func(mockRepo *mocks.MockTaskRepository) {
-				mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return(nil, fmt.Errorf("database connection failed"))
+				mockRepo.EXPECT().FindPageByUserID(gomock.Any(), userID, task.Filter{}, gomock.Any()).Return(nil, fmt.Errorf("database connection failed"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedErrorType:  "database",
@@ -497,7 +3341,7 @@ func TestTaskRepositoryErrors(t *testing.T) {
 			operation: "GetTask",
 			taskID:    uuid.New().String(),
 			setupMock: func(mockRepo *mocks.MockTaskRepository) {
-				mockRepo.EXPECT().FindById(gomock.Any(), userID, gomock.Any()).Return(nil, fmt.Errorf("network I/O timeout"))
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, gomock.Any()).Return(nil, fmt.Errorf("network I/O timeout"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedErrorType:  "network",
@@ -509,8 +3353,8 @@ func TestTaskRepositoryErrors(t *testing.T) {
 			requestBody: `{"title": "Updated Task"}`,
 			setupMock: func(mockRepo *mocks.MockTaskRepository) {
 				existingTask := task.NewTaskWithoutValidation(createTaskID(uuid.New().String()), "Original Task", userID)
-				mockRepo.EXPECT().FindById(gomock.Any(), userID, gomock.Any()).Return(existingTask, nil)
-				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("optimistic locking failed"))
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, gomock.Any()).Return(existingTask, nil)
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("optimistic locking failed"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedErrorType:  "conflict",
@@ -520,7 +3364,8 @@ func TestTaskRepositoryErrors(t *testing.T) {
 			operation: "DeleteTask",
 			taskID:    uuid.New().String(),
 			setupMock: func(mockRepo *mocks.MockTaskRepository) {
-				mockRepo.EXPECT().Delete(gomock.Any(), userID, gomock.Any()).Return(fmt.Errorf("FOREIGN KEY constraint failed"))
+				mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, gomock.Any()).Return(task.NewTaskWithoutValidation(task.GenerateTaskID(), "Test Task", userID), nil)
+				mockRepo.EXPECT().Delete(gomock.Any(), userID, gomock.Any(), nil).Return(fmt.Errorf("FOREIGN KEY constraint failed"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedErrorType:  "constraint",
@@ -567,15 +3412,15 @@ func TestTaskRepositoryErrors(t *testing.T) {
 			// Act
 			switch tt.operation {
 			case "GetAllTasks":
-				err = handler.GetAllTasks(c)
+				err = handler.GetAllTasks(c, generated.TaskGetAllTasksParams{})
 			case "CreateTask":
 				err = handler.CreateTask(c)
 			case "GetTask":
-				err = handler.GetTask(c, testUUID(tt.taskID))
+				err = handler.GetTask(c, testUUID(tt.taskID), generated.TaskGetTaskParams{})
 			case "UpdateTask":
-				err = handler.UpdateTask(c, testUUID(tt.taskID))
+				err = handler.UpdateTask(c, testUUID(tt.taskID), generated.TaskUpdateTaskParams{})
 			case "DeleteTask":
-				err = handler.DeleteTask(c, testUUID(tt.taskID))
+				err = handler.DeleteTask(c, testUUID(tt.taskID), generated.TaskDeleteTaskParams{})
 			}
 
 			// Assert
@@ -817,7 +3662,7 @@ func TestTaskContextErrors(t *testing.T) {
 
 			switch tt.operation {
 			case "GetAllTasks":
-				err = handler.GetAllTasks(c)
+				err = handler.GetAllTasks(c, generated.TaskGetAllTasksParams{})
 			}
 
 			// Assert
@@ -842,9 +3687,9 @@ func TestTaskConcurrentOperations(t *testing.T) {
 	existingTask := task.NewTaskWithoutValidation(taskDomainID, "Original Task", userID)
 
 	// First call succeeds
-	mockRepo.EXPECT().FindById(gomock.Any(), userID, taskDomainID).Return(existingTask, nil).Times(1)
+	mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(existingTask, nil).Times(1)
 	// Second call fails due to concurrent modification
-	mockRepo.EXPECT().FindById(gomock.Any(), userID, taskDomainID).Return(nil, fmt.Errorf("record modified by another transaction")).Times(1)
+	mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).Return(nil, fmt.Errorf("record modified by another transaction")).Times(1)
 
 	e := echo.New()
 
@@ -859,8 +3704,8 @@ func TestTaskConcurrentOperations(t *testing.T) {
 	c2.Set("user_id", testUserID)
 
 	// Act
-	err1 := handler.GetTask(c1, testUUID(testTaskID))
-	err2 := handler.GetTask(c2, testUUID(testTaskID))
+	err1 := handler.GetTask(c1, testUUID(testTaskID), generated.TaskGetTaskParams{})
+	err2 := handler.GetTask(c2, testUUID(testTaskID), generated.TaskGetTaskParams{})
 
 	// Assert
 	assert.NoError(t, err1)
@@ -869,3 +3714,234 @@ func TestTaskConcurrentOperations(t *testing.T) {
 	assert.True(t, (rec1.Code == http.StatusOK && rec2.Code == http.StatusInternalServerError) ||
 		(rec1.Code == http.StatusInternalServerError && rec2.Code == http.StatusOK))
 }
+
+func TestTaskPatchTask(t *testing.T) {
+	t.Parallel()
+
+	dueAt := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		contentType    string
+		body           string
+		ifMatch        *string
+		knownTags      []string
+		expectUpdate   bool
+		expectedStatus int
+		verify         func(t *testing.T, updated generated.Task)
+	}{
+		{
+			name:           "merge patch renames and clears the due date",
+			contentType:    "application/merge-patch+json",
+			body:           `{"title": "Renamed", "dueAt": null}`,
+			ifMatch:        nil,
+			knownTags:      nil,
+			expectUpdate:   true,
+			expectedStatus: http.StatusOK,
+			verify: func(t *testing.T, updated generated.Task) {
+				t.Helper()
+				assert.Equal(t, "Renamed", updated.Title)
+				assert.Nil(t, updated.DueAt)
+			},
+		},
+		{
+			name:           "json patch completes the task",
+			contentType:    "application/json-patch+json; charset=utf-8",
+			body:           `[{"op": "test", "path": "/title", "value": "Task"}, {"op": "replace", "path": "/completed", "value": true}]`,
+			ifMatch:        nil,
+			knownTags:      nil,
+			expectUpdate:   true,
+			expectedStatus: http.StatusOK,
+			verify: func(t *testing.T, updated generated.Task) {
+				t.Helper()
+				assert.True(t, updated.Completed)
+				assert.NotNil(t, updated.CompletedAt)
+				assert.Equal(t, dueAt, *updated.DueAt)
+			},
+		},
+		{
+			name:           "json patch adds a tag",
+			contentType:    "application/json-patch+json",
+			body:           `[{"op": "add", "path": "/tags/-", "value": " home "}]`,
+			ifMatch:        nil,
+			knownTags:      []string{"home"},
+			expectUpdate:   true,
+			expectedStatus: http.StatusOK,
+			verify: func(t *testing.T, updated generated.Task) {
+				t.Helper()
+				assert.Equal(t, []string{"home"}, updated.Tags)
+			},
+		},
+		{
+			name:           "unknown tag is unprocessable",
+			contentType:    "application/merge-patch+json",
+			body:           `{"tags": ["missing"]}`,
+			ifMatch:        nil,
+			knownTags:      []string{},
+			expectUpdate:   false,
+			expectedStatus: http.StatusUnprocessableEntity,
+			verify:         nil,
+		},
+		{
+			name:           "tags that are not strings are unprocessable",
+			contentType:    "application/merge-patch+json",
+			body:           `{"tags": [1]}`,
+			ifMatch:        nil,
+			knownTags:      nil,
+			expectUpdate:   false,
+			expectedStatus: http.StatusUnprocessableEntity,
+			verify:         nil,
+		},
+		{
+			name:           "empty title is unprocessable",
+			contentType:    "application/merge-patch+json",
+			body:           `{"title": "   "}`,
+			ifMatch:        nil,
+			knownTags:      nil,
+			expectUpdate:   false,
+			expectedStatus: http.StatusUnprocessableEntity,
+			verify:         nil,
+		},
+		{
+			name:           "removing the title is unprocessable",
+			contentType:    "application/json-patch+json",
+			body:           `[{"op": "remove", "path": "/title"}]`,
+			ifMatch:        nil,
+			knownTags:      nil,
+			expectUpdate:   false,
+			expectedStatus: http.StatusUnprocessableEntity,
+			verify:         nil,
+		},
+		{
+			name:           "changing the id is unprocessable",
+			contentType:    "application/merge-patch+json",
+			body:           `{"id": "00000000-0000-0000-0000-000000000000"}`,
+			ifMatch:        nil,
+			knownTags:      nil,
+			expectUpdate:   false,
+			expectedStatus: http.StatusUnprocessableEntity,
+			verify:         nil,
+		},
+		{
+			name:           "failed test operation is a conflict",
+			contentType:    "application/json-patch+json",
+			body:           `[{"op": "test", "path": "/title", "value": "Other"}]`,
+			ifMatch:        nil,
+			knownTags:      nil,
+			expectUpdate:   false,
+			expectedStatus: http.StatusConflict,
+			verify:         nil,
+		},
+		{
+			name:           "malformed json patch",
+			contentType:    "application/json-patch+json",
+			body:           `{"op": "replace"}`,
+			ifMatch:        nil,
+			knownTags:      nil,
+			expectUpdate:   false,
+			expectedStatus: http.StatusBadRequest,
+			verify:         nil,
+		},
+		{
+			name:           "stale If-Match is a failed precondition",
+			contentType:    "application/merge-patch+json",
+			body:           `{"title": "Renamed"}`,
+			ifMatch:        stringPtr(`"2"`),
+			knownTags:      nil,
+			expectUpdate:   false,
+			expectedStatus: http.StatusPreconditionFailed,
+			verify:         nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo, mockTagRepo := setupTestServerWithTags(ctrl)
+
+			testUserID := uuid.New().String()
+			taskID := uuid.New().String()
+			userID := createUserID(testUserID)
+			taskDomainID := createTaskID(taskID)
+
+			schedule := task.NewScheduleWithoutValidation(nil, &dueAt, false)
+			existing := func() *task.Task {
+				return task.NewTaskWithoutValidation(taskDomainID, "Task", userID, task.WithSchedule(schedule), task.WithVersion(3))
+			}
+
+			// The controller reloads the task before applying the update.
+			mockRepo.EXPECT().FindAccessible(gomock.Any(), userID, taskDomainID).
+				DoAndReturn(func(context.Context, user.UserID, task.TaskID) (*task.Task, error) {
+					return existing(), nil
+				}).MinTimes(1).MaxTimes(2)
+
+			if tt.knownTags != nil {
+				known := make([]*tagDomain.Tag, 0, len(tt.knownTags))
+				for _, name := range tt.knownTags {
+					known = append(known, tagDomain.NewTagWithoutValidation(tagDomain.GenerateTagID(), name, userID))
+				}
+
+				mockTagRepo.EXPECT().FindByNames(gomock.Any(), userID, gomock.Any()).Return(known, nil)
+			}
+
+			if tt.expectUpdate {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ user.UserID, updated *task.Task) (*task.Task, error) {
+						return updated, nil
+					})
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/tasks/"+taskID, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, tt.contentType)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.PatchTask(c, testUUID(taskID), generated.TaskPatchTaskParams{IfMatch: tt.ifMatch})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.verify != nil {
+				var updated generated.Task
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+				tt.verify(t, updated)
+			}
+		})
+	}
+}
+
+func TestTaskPatchTaskUnsupportedMediaType(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, _ := setupTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	taskID := uuid.New().String()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/tasks/"+taskID, strings.NewReader(`{"title": "Renamed"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.PatchTask(c, testUUID(taskID), generated.TaskPatchTaskParams{IfMatch: nil})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+}
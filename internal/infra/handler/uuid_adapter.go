@@ -1,7 +1,13 @@
 package handler
 
 import (
+	attachmentDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	commentDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/comment"
+	projectDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	tagDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
 	taskDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	webhookDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
 	"github.com/oapi-codegen/runtime/types"
 )
 
@@ -17,3 +23,33 @@ func NewUUIDAdapter() *UUIDAdapter {
 func (a *UUIDAdapter) ToDomainTaskID(apiUUID types.UUID) (taskDomain.TaskID, error) {
 	return taskDomain.NewTaskID(apiUUID.String())
 }
+
+// ToDomainTagID converts openapi_types.UUID to domain TagID
+func (a *UUIDAdapter) ToDomainTagID(apiUUID types.UUID) (tagDomain.TagID, error) {
+	return tagDomain.NewTagID(apiUUID.String())
+}
+
+// ToDomainProjectID converts openapi_types.UUID to domain ProjectID
+func (a *UUIDAdapter) ToDomainProjectID(apiUUID types.UUID) (projectDomain.ProjectID, error) {
+	return projectDomain.NewProjectID(apiUUID.String())
+}
+
+// ToDomainCommentID converts openapi_types.UUID to domain CommentID
+func (a *UUIDAdapter) ToDomainCommentID(apiUUID types.UUID) (commentDomain.CommentID, error) {
+	return commentDomain.NewCommentID(apiUUID.String())
+}
+
+// ToDomainAttachmentID converts openapi_types.UUID to domain AttachmentID
+func (a *UUIDAdapter) ToDomainAttachmentID(apiUUID types.UUID) (attachmentDomain.AttachmentID, error) {
+	return attachmentDomain.NewAttachmentID(apiUUID.String())
+}
+
+// ToDomainWebhookID converts openapi_types.UUID to domain WebhookID
+func (a *UUIDAdapter) ToDomainWebhookID(apiUUID types.UUID) (webhookDomain.WebhookID, error) {
+	return webhookDomain.NewWebhookID(apiUUID.String())
+}
+
+// ToDomainUserID converts openapi_types.UUID to domain UserID
+func (a *UUIDAdapter) ToDomainUserID(apiUUID types.UUID) (user.UserID, error) {
+	return user.NewUserID(apiUUID.String())
+}
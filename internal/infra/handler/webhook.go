@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	webhookDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+)
+
+// WebhookHandler handles HTTP requests for webhook operations.
+type WebhookHandler struct {
+	controller  controller.Webhook
+	uuidAdapter *UUIDAdapter
+}
+
+// NewWebhookHandler creates a new WebhookHandler with the provided controller.
+func NewWebhookHandler(ctr controller.Webhook) *WebhookHandler {
+	return &WebhookHandler{
+		controller:  ctr,
+		uuidAdapter: NewUUIDAdapter(),
+	}
+}
+
+// isWebhookInputError checks if the error is caused by an invalid URL, secret or event type
+func isWebhookInputError(err error) bool {
+	return errors.Is(err, webhookDomain.ErrURLEmpty) ||
+		errors.Is(err, webhookDomain.ErrURLTooLong) ||
+		errors.Is(err, webhookDomain.ErrInvalidURL) ||
+		errors.Is(err, webhookDomain.ErrSecretTooShort) ||
+		errors.Is(err, webhookDomain.ErrSecretTooLong) ||
+		errors.Is(err, webhookDomain.ErrInvalidEventType)
+}
+
+// toWebhookResponse converts a domain webhook to its API representation.
+// The secret is left out.
+func toWebhookResponse(webhook *webhookDomain.Webhook) taskHandler.Webhook {
+	eventTypes := make([]string, 0, len(webhook.EventTypes()))
+	for _, eventType := range webhook.EventTypes() {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return taskHandler.Webhook{
+		Id:           webhook.ID().UUID(),
+		Url:          webhook.URL(),
+		EventTypes:   eventTypes,
+		Active:       webhook.IsActive(),
+		FailureCount: webhook.FailureCount(),
+		DisabledAt:   webhook.DisabledAt(),
+		CreatedAt:    webhook.CreatedAt(),
+	}
+}
+
+// toDomainEventTypes converts the event type names of a request to domain event types
+func toDomainEventTypes(names []string) []webhookDomain.EventType {
+	eventTypes := make([]webhookDomain.EventType, 0, len(names))
+	for _, name := range names {
+		eventTypes = append(eventTypes, webhookDomain.EventType(name))
+	}
+
+	return eventTypes
+}
+
+// extractUserID extracts user ID from JWT context
+func (h *WebhookHandler) extractUserID(c echo.Context) (string, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return "", errors.New("user ID not found in token")
+	}
+
+	return userID, nil
+}
+
+func (h *WebhookHandler) GetAllWebhooks(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	webhooks, err := h.controller.GetAllWebhooks(c.Request().Context(), domainUserID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Webhook, 0, len(webhooks))
+
+	for _, webhook := range webhooks {
+		res = append(res, toWebhookResponse(webhook))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.WebhookCreate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	input := controller.WebhookCreate{
+		URL:        req.Url,
+		Secret:     req.Secret,
+		EventTypes: nil,
+	}
+	if req.EventTypes != nil {
+		input.EventTypes = toDomainEventTypes(*req.EventTypes)
+	}
+
+	webhook, err := h.controller.CreateWebhook(c.Request().Context(), domainUserID, input)
+	if err != nil {
+		details := err.Error()
+		if isWebhookInputError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusCreated, toWebhookResponse(webhook))
+}
+
+func (h *WebhookHandler) GetWebhook(c echo.Context, webhookId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainWebhookID, err := h.uuidAdapter.ToDomainWebhookID(webhookId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid webhook ID format", &details))
+	}
+
+	webhook, err := h.controller.GetWebhookById(c.Request().Context(), domainUserID, domainWebhookID)
+	if err != nil {
+		if errors.Is(err, webhookDomain.ErrWebhookNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Webhook not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusOK, toWebhookResponse(webhook))
+}
+
+func (h *WebhookHandler) UpdateWebhook(c echo.Context, webhookId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.WebhookUpdate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainWebhookID, err := h.uuidAdapter.ToDomainWebhookID(webhookId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid webhook ID format", &details))
+	}
+
+	input := controller.WebhookUpdate{
+		URL:        req.Url,
+		Secret:     req.Secret,
+		EventTypes: nil,
+		Active:     req.Active,
+	}
+	if req.EventTypes != nil {
+		eventTypes := toDomainEventTypes(*req.EventTypes)
+		input.EventTypes = &eventTypes
+	}
+
+	webhook, err := h.controller.UpdateWebhook(c.Request().Context(), domainUserID, domainWebhookID, input)
+	if err != nil {
+		if errors.Is(err, webhookDomain.ErrWebhookNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Webhook not found"))
+		}
+
+		details := err.Error()
+		if isWebhookInputError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusOK, toWebhookResponse(webhook))
+}
+
+func (h *WebhookHandler) DeleteWebhook(c echo.Context, webhookId openapiTypes.UUID) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := h.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	domainWebhookID, err := h.uuidAdapter.ToDomainWebhookID(webhookId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid webhook ID format", &details))
+	}
+
+	err = h.controller.DeleteWebhook(c.Request().Context(), domainUserID, domainWebhookID)
+	if err != nil {
+		if errors.Is(err, webhookDomain.ErrWebhookNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Webhook not found"))
+		}
+
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
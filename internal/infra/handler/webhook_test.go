@@ -0,0 +1,395 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	webhookDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=../../domain/webhook/repository.go -destination=mocks/mock_webhook_repository.go -package=mocks
+
+const testWebhookSecret = "0123456789abcdef"
+
+func setupWebhookTestServer(ctrl *gomock.Controller) (*WebhookHandler, *mocks.MockWebhookRepository) {
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	webhookController := controller.NewWebhook(mockRepo)
+	handler := NewWebhookHandler(*webhookController)
+
+	return handler, mockRepo
+}
+
+func createWebhookID(s string) webhookDomain.WebhookID {
+	webhookID, err := webhookDomain.NewWebhookID(s)
+	if err != nil {
+		panic("failed to create webhook ID: " + err.Error())
+	}
+
+	return webhookID
+}
+
+func TestWebhookGetAllWebhooks(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupWebhookTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+
+	webhooks := []*webhookDomain.Webhook{
+		webhookDomain.NewWebhookWithoutValidation(webhookDomain.GenerateWebhookID(), userID, "https://example.com/hooks", testWebhookSecret,
+			[]webhookDomain.EventType{"task.created"}, time.Now()),
+	}
+	mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return(webhooks, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.GetAllWebhooks(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), testWebhookSecret)
+
+	var responseWebhooks []generated.Webhook
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseWebhooks)
+	require.NoError(t, err)
+	require.Len(t, responseWebhooks, 1)
+	assert.Equal(t, "https://example.com/hooks", responseWebhooks[0].Url)
+	assert.Equal(t, []string{"task.created"}, responseWebhooks[0].EventTypes)
+	assert.True(t, responseWebhooks[0].Active)
+}
+
+func TestWebhookCreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(repo *mocks.MockWebhookRepository)
+		expectedStatus int
+	}{
+		{
+			name: "webhook created",
+			body: `{"url": " https://example.com/hooks ", "secret": "` + testWebhookSecret + `", "eventTypes": ["task.deleted", "task.created"]}`,
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, created *webhookDomain.Webhook) (*webhookDomain.Webhook, error) {
+						return created, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "invalid URL",
+			body:           `{"url": "ftp://example.com", "secret": "` + testWebhookSecret + `"}`,
+			setupMock:      func(*mocks.MockWebhookRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "short secret",
+			body:           `{"url": "https://example.com/hooks", "secret": "secret"}`,
+			setupMock:      func(*mocks.MockWebhookRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown event type",
+			body:           `{"url": "https://example.com/hooks", "secret": "` + testWebhookSecret + `", "eventTypes": ["task.renamed"]}`,
+			setupMock:      func(*mocks.MockWebhookRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid JSON",
+			body:           `{"url": `,
+			setupMock:      func(*mocks.MockWebhookRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupWebhookTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", uuid.New().String())
+
+			// Act
+			err := handler.CreateWebhook(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				assert.NotContains(t, rec.Body.String(), testWebhookSecret)
+
+				var created generated.Webhook
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+				assert.Equal(t, "https://example.com/hooks", created.Url)
+				assert.Equal(t, []string{"task.created", "task.deleted"}, created.EventTypes)
+				assert.True(t, created.Active)
+				assert.Nil(t, created.DisabledAt)
+			}
+		})
+	}
+}
+
+func TestWebhookGetWebhook(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	webhookID := uuid.New().String()
+	userID := createUserID(testUserID)
+	webhookDomainID := createWebhookID(webhookID)
+
+	tests := []struct {
+		name           string
+		setupMock      func(repo *mocks.MockWebhookRepository)
+		expectedStatus int
+	}{
+		{
+			name: "webhook found",
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, webhookDomainID).
+					Return(webhookDomain.NewWebhookWithoutValidation(webhookDomainID, userID, "https://example.com/hooks", testWebhookSecret, nil, time.Now()), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "webhook not found",
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, webhookDomainID).Return(nil, webhookDomain.ErrWebhookNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "repository error",
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, webhookDomainID).Return(nil, fmt.Errorf("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupWebhookTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/webhooks/"+webhookID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.GetWebhook(c, testUUID(webhookID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestWebhookUpdateWebhook(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	webhookID := uuid.New().String()
+	userID := createUserID(testUserID)
+	webhookDomainID := createWebhookID(webhookID)
+	disabledAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	existing := func() *webhookDomain.Webhook {
+		return webhookDomain.NewWebhookWithoutValidation(webhookDomainID, userID, "https://example.com/hooks", testWebhookSecret, nil, time.Now(),
+			webhookDomain.WithFailureCount(20), webhookDomain.WithDisabledAt(&disabledAt))
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(repo *mocks.MockWebhookRepository)
+		expectedStatus int
+		expectedActive bool
+	}{
+		{
+			name: "webhook re-enabled with new event types",
+			body: `{"active": true, "eventTypes": ["task.completed"]}`,
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, webhookDomainID).Return(existing(), nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, updated *webhookDomain.Webhook) (*webhookDomain.Webhook, error) {
+						return updated, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+			expectedActive: true,
+		},
+		{
+			name: "URL changed on a disabled webhook",
+			body: `{"url": "https://example.org/hooks"}`,
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, webhookDomainID).Return(existing(), nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, updated *webhookDomain.Webhook) (*webhookDomain.Webhook, error) {
+						return updated, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+			expectedActive: false,
+		},
+		{
+			name: "webhook not found",
+			body: `{"active": true}`,
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, webhookDomainID).Return(nil, webhookDomain.ErrWebhookNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "invalid URL",
+			body: `{"url": "example.org"}`,
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindById(gomock.Any(), userID, webhookDomainID).Return(existing(), nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupWebhookTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/webhooks/"+webhookID, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.UpdateWebhook(c, testUUID(webhookID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var updated generated.Webhook
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+				assert.Equal(t, tt.expectedActive, updated.Active)
+
+				if tt.expectedActive {
+					assert.Zero(t, updated.FailureCount)
+					assert.Nil(t, updated.DisabledAt)
+				}
+			}
+		})
+	}
+}
+
+func TestWebhookDeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	testUserID := uuid.New().String()
+	webhookID := uuid.New().String()
+	userID := createUserID(testUserID)
+	webhookDomainID := createWebhookID(webhookID)
+
+	tests := []struct {
+		name           string
+		setupMock      func(repo *mocks.MockWebhookRepository)
+		expectedStatus int
+	}{
+		{
+			name: "webhook deleted",
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().Delete(gomock.Any(), userID, webhookDomainID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "webhook not found",
+			setupMock: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().Delete(gomock.Any(), userID, webhookDomainID).Return(webhookDomain.ErrWebhookNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupWebhookTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/webhooks/"+webhookID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", testUserID)
+
+			// Act
+			err := handler.DeleteWebhook(c, testUUID(webhookID))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
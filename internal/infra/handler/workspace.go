@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	openapiTypes "github.com/oapi-codegen/runtime/types"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	workspaceDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+	taskHandler "github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+)
+
+// WorkspaceHandler handles HTTP requests for workspace operations.
+// Requests for a single workspace rely on WorkspaceMiddleware to resolve the
+// membership of the user in it.
+type WorkspaceHandler struct {
+	controller  controller.Workspace
+	uuidAdapter *UUIDAdapter
+}
+
+// NewWorkspaceHandler creates a new WorkspaceHandler with the provided controller.
+func NewWorkspaceHandler(ctr controller.Workspace) *WorkspaceHandler {
+	return &WorkspaceHandler{
+		controller:  ctr,
+		uuidAdapter: NewUUIDAdapter(),
+	}
+}
+
+// isWorkspaceNameError checks if the error is caused by an invalid workspace name
+func isWorkspaceNameError(err error) bool {
+	return errors.Is(err, workspaceDomain.ErrNameEmpty) ||
+		errors.Is(err, workspaceDomain.ErrNameTooLong)
+}
+
+// toWorkspaceResponse converts a domain workspace to its API representation
+func toWorkspaceResponse(workspace *workspaceDomain.Workspace) taskHandler.Workspace {
+	return taskHandler.Workspace{
+		Id:      workspace.ID().UUID(),
+		Name:    workspace.Name(),
+		OwnerId: workspace.OwnerID().UUID(),
+	}
+}
+
+// toWorkspaceMemberResponse converts a domain member to its API representation
+func toWorkspaceMemberResponse(member workspaceDomain.Member) taskHandler.WorkspaceMember {
+	return taskHandler.WorkspaceMember{
+		UserId: member.UserID().UUID(),
+		Role:   taskHandler.WorkspaceRole(member.Role()),
+	}
+}
+
+// extractUserID extracts user ID from JWT context
+func (w *WorkspaceHandler) extractUserID(c echo.Context) (string, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return "", errors.New("user ID not found in token")
+	}
+
+	return userID, nil
+}
+
+// extractMember returns the membership of the user in the workspace with the
+// given ID, as resolved by WorkspaceMiddleware
+func (w *WorkspaceHandler) extractMember(c echo.Context, workspaceId openapiTypes.UUID) (workspaceDomain.Member, bool) {
+	member := workspaceMember(c)
+	if member == nil || member.WorkspaceID().UUID() != workspaceId {
+		return workspaceDomain.Member{}, false
+	}
+
+	return *member, true
+}
+
+func (w *WorkspaceHandler) GetAllWorkspaces(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := w.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	workspaces, err := w.controller.GetAllWorkspaces(c.Request().Context(), domainUserID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.Workspace, 0, len(workspaces))
+
+	for _, workspace := range workspaces {
+		res = append(res, toWorkspaceResponse(workspace))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (w *WorkspaceHandler) CreateWorkspace(c echo.Context) error {
+	// Extract userID from JWT sub claim (set by JWTMiddleware in jwt.go)
+	userID, err := w.extractUserID(c)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusUnauthorized, NewUnauthorizedError("Unauthorized", &details))
+	}
+
+	var req taskHandler.WorkspaceCreate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	domainUserID, err := user.NewUserID(userID)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid user ID format", &details))
+	}
+
+	workspace, err := w.controller.CreateWorkspace(c.Request().Context(), domainUserID, req.Name)
+	if err != nil {
+		details := err.Error()
+		if isWorkspaceNameError(err) {
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		}
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	return c.JSON(http.StatusCreated, toWorkspaceResponse(workspace))
+}
+
+func (w *WorkspaceHandler) GetMembers(c echo.Context, workspaceId openapiTypes.UUID) error {
+	actor, ok := w.extractMember(c, workspaceId)
+	if !ok {
+		return c.JSON(http.StatusNotFound, NewNotFoundError("Workspace not found"))
+	}
+
+	members, err := w.controller.GetMembers(c.Request().Context(), actor)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+	}
+
+	res := make([]taskHandler.WorkspaceMember, 0, len(members))
+
+	for _, member := range members {
+		res = append(res, toWorkspaceMemberResponse(member))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (w *WorkspaceHandler) SetMember(c echo.Context, workspaceId openapiTypes.UUID, userId openapiTypes.UUID) error {
+	actor, ok := w.extractMember(c, workspaceId)
+	if !ok {
+		return c.JSON(http.StatusNotFound, NewNotFoundError("Workspace not found"))
+	}
+
+	var req taskHandler.WorkspaceMemberUpdate
+
+	if err := c.Bind(&req); err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+	}
+
+	memberID, err := w.uuidAdapter.ToDomainUserID(userId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid member ID format", &details))
+	}
+
+	member, err := w.controller.SetMember(c.Request().Context(), actor, memberID, string(req.Role))
+	if err != nil {
+		details := err.Error()
+
+		switch {
+		case errors.Is(err, workspaceDomain.ErrInvalidRole),
+			errors.Is(err, workspaceDomain.ErrOwnerRoleAssignment),
+			errors.Is(err, workspaceDomain.ErrOwnerMembership):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, workspaceDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	return c.JSON(http.StatusOK, toWorkspaceMemberResponse(member))
+}
+
+func (w *WorkspaceHandler) RemoveMember(c echo.Context, workspaceId openapiTypes.UUID, userId openapiTypes.UUID) error {
+	actor, ok := w.extractMember(c, workspaceId)
+	if !ok {
+		return c.JSON(http.StatusNotFound, NewNotFoundError("Workspace not found"))
+	}
+
+	memberID, err := w.uuidAdapter.ToDomainUserID(userId)
+	if err != nil {
+		details := err.Error()
+
+		return c.JSON(http.StatusBadRequest, NewBadRequestError("Invalid member ID format", &details))
+	}
+
+	if err := w.controller.RemoveMember(c.Request().Context(), actor, memberID); err != nil {
+		if errors.Is(err, workspaceDomain.ErrMemberNotFound) {
+			return c.JSON(http.StatusNotFound, NewNotFoundError("Member not found"))
+		}
+
+		details := err.Error()
+
+		switch {
+		case errors.Is(err, workspaceDomain.ErrOwnerMembership):
+			return c.JSON(http.StatusBadRequest, NewBadRequestError("Bad request", &details))
+		case errors.Is(err, workspaceDomain.ErrForbidden):
+			return c.JSON(http.StatusForbidden, NewForbiddenError("Forbidden", &details))
+		default:
+			return c.JSON(http.StatusInternalServerError, NewInternalServerError("Internal server error", &details))
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,401 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	workspaceDomain "github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/mocks"
+)
+
+func setupWorkspaceTestServer(ctrl *gomock.Controller) (*WorkspaceHandler, *mocks.MockWorkspaceRepository) {
+	mockRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceController := controller.NewWorkspace(mockRepo)
+	handler := NewWorkspaceHandler(*workspaceController)
+
+	return handler, mockRepo
+}
+
+func createWorkspaceMember(workspaceID workspaceDomain.WorkspaceID, userID user.UserID, role workspaceDomain.Role) workspaceDomain.Member {
+	member, err := workspaceDomain.NewMember(workspaceID, userID, role)
+	if err != nil {
+		panic("failed to create workspace member: " + err.Error())
+	}
+
+	return member
+}
+
+func TestWorkspaceGetAllWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockRepo := setupWorkspaceTestServer(ctrl)
+
+	testUserID := uuid.New().String()
+	userID := createUserID(testUserID)
+
+	workspaces := []*workspaceDomain.Workspace{
+		workspaceDomain.NewWorkspaceWithoutValidation(workspaceDomain.GenerateWorkspaceID(), "Home", userID),
+		workspaceDomain.NewWorkspaceWithoutValidation(workspaceDomain.GenerateWorkspaceID(), "Team", user.GenerateUserID()),
+	}
+	mockRepo.EXPECT().FindAllByUserID(gomock.Any(), userID).Return(workspaces, nil)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/workspaces", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", testUserID)
+
+	// Act
+	err := handler.GetAllWorkspaces(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var responseWorkspaces []generated.Workspace
+
+	err = json.Unmarshal(rec.Body.Bytes(), &responseWorkspaces)
+	require.NoError(t, err)
+	require.Len(t, responseWorkspaces, 2)
+	assert.Equal(t, "Home", responseWorkspaces[0].Name)
+	assert.Equal(t, testUserID, responseWorkspaces[0].OwnerId.String())
+	assert.Equal(t, workspaces[1].ID().String(), responseWorkspaces[1].Id.String())
+}
+
+func TestWorkspaceCreateWorkspace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(repo *mocks.MockWorkspaceRepository)
+		expectedStatus int
+	}{
+		{
+			name: "workspace created",
+			body: `{"name": " Team "}`,
+			setupMock: func(repo *mocks.MockWorkspaceRepository) {
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, created *workspaceDomain.Workspace) (*workspaceDomain.Workspace, error) {
+						return created, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "empty name",
+			body:           `{"name": "  "}`,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid JSON",
+			body:           `{"name": `,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupWorkspaceTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/workspaces", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", uuid.New().String())
+
+			// Act
+			err := handler.CreateWorkspace(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var created generated.Workspace
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+				assert.Equal(t, "Team", created.Name)
+			}
+		})
+	}
+}
+
+func TestWorkspaceGetMembers(t *testing.T) {
+	t.Parallel()
+
+	workspaceID := workspaceDomain.GenerateWorkspaceID()
+	owner := createWorkspaceMember(workspaceID, user.GenerateUserID(), workspaceDomain.RoleOwner)
+	guest := createWorkspaceMember(workspaceID, user.GenerateUserID(), workspaceDomain.RoleGuest)
+
+	t.Run("members are listed for any member", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, mockRepo := setupWorkspaceTestServer(ctrl)
+		mockRepo.EXPECT().FindMembers(gomock.Any(), workspaceID).Return([]workspaceDomain.Member{owner, guest}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/workspaces/"+workspaceID.String()+"/members", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set(workspaceMemberKey, guest)
+
+		// Act
+		err := handler.GetMembers(c, workspaceID.UUID())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var members []generated.WorkspaceMember
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &members))
+		require.Len(t, members, 2)
+		assert.Equal(t, generated.Owner, members[0].Role)
+		assert.Equal(t, guest.UserID().String(), members[1].UserId.String())
+	})
+
+	t.Run("membership of another workspace", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler, _ := setupWorkspaceTestServer(ctrl)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/workspaces", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set(workspaceMemberKey, owner)
+
+		// Act
+		err := handler.GetMembers(c, uuid.New())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestWorkspaceSetMember(t *testing.T) {
+	t.Parallel()
+
+	workspaceID := workspaceDomain.GenerateWorkspaceID()
+	owner := createWorkspaceMember(workspaceID, user.GenerateUserID(), workspaceDomain.RoleOwner)
+	admin := createWorkspaceMember(workspaceID, user.GenerateUserID(), workspaceDomain.RoleAdmin)
+	newMemberID := user.GenerateUserID()
+
+	tests := []struct {
+		name           string
+		actor          workspaceDomain.Member
+		userID         uuid.UUID
+		body           string
+		setupMock      func(repo *mocks.MockWorkspaceRepository)
+		expectedStatus int
+	}{
+		{
+			name:   "owner adds a member",
+			actor:  owner,
+			userID: newMemberID.UUID(),
+			body:   `{"role": "admin"}`,
+			setupMock: func(repo *mocks.MockWorkspaceRepository) {
+				repo.EXPECT().SaveMember(gomock.Any(), createWorkspaceMember(workspaceID, newMemberID, workspaceDomain.RoleAdmin)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "admin may not manage members",
+			actor:          admin,
+			userID:         newMemberID.UUID(),
+			body:           `{"role": "member"}`,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "owner role cannot be given",
+			actor:          owner,
+			userID:         newMemberID.UUID(),
+			body:           `{"role": "owner"}`,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "owner cannot change their own role",
+			actor:          owner,
+			userID:         owner.UserID().UUID(),
+			body:           `{"role": "member"}`,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown role",
+			actor:          owner,
+			userID:         newMemberID.UUID(),
+			body:           `{"role": "superuser"}`,
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupWorkspaceTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/workspaces/"+workspaceID.String()+"/members/"+tt.userID.String(), strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set(workspaceMemberKey, tt.actor)
+
+			// Act
+			err := handler.SetMember(c, workspaceID.UUID(), tt.userID)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var member generated.WorkspaceMember
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &member))
+				assert.Equal(t, tt.userID, member.UserId)
+				assert.Equal(t, generated.Admin, member.Role)
+			}
+		})
+	}
+}
+
+func TestWorkspaceRemoveMember(t *testing.T) {
+	t.Parallel()
+
+	workspaceID := workspaceDomain.GenerateWorkspaceID()
+	owner := createWorkspaceMember(workspaceID, user.GenerateUserID(), workspaceDomain.RoleOwner)
+	member := createWorkspaceMember(workspaceID, user.GenerateUserID(), workspaceDomain.RoleMember)
+
+	tests := []struct {
+		name           string
+		actor          workspaceDomain.Member
+		userID         user.UserID
+		setupMock      func(repo *mocks.MockWorkspaceRepository)
+		expectedStatus int
+	}{
+		{
+			name:   "owner removes a member",
+			actor:  owner,
+			userID: member.UserID(),
+			setupMock: func(repo *mocks.MockWorkspaceRepository) {
+				repo.EXPECT().RemoveMember(gomock.Any(), workspaceID, member.UserID()).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "user is not a member",
+			actor:  owner,
+			userID: member.UserID(),
+			setupMock: func(repo *mocks.MockWorkspaceRepository) {
+				repo.EXPECT().RemoveMember(gomock.Any(), workspaceID, member.UserID()).Return(workspaceDomain.ErrMemberNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "member may not remove members",
+			actor:          member,
+			userID:         owner.UserID(),
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "owner cannot remove themselves",
+			actor:          owner,
+			userID:         owner.UserID(),
+			setupMock:      func(*mocks.MockWorkspaceRepository) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, mockRepo := setupWorkspaceTestServer(ctrl)
+			tt.setupMock(mockRepo)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/workspaces/"+workspaceID.String()+"/members/"+tt.userID.String(), nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set(workspaceMemberKey, tt.actor)
+
+			// Act
+			err := handler.RemoveMember(c, workspaceID.UUID(), tt.userID.UUID())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestWorkspaceAuthenticationRequired(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, _ := setupWorkspaceTestServer(ctrl)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/workspaces", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Act
+	err := handler.GetAllWorkspaces(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// AttachmentModel represents the database model for the metadata of files
+// attached to tasks. When the task is permanently removed TaskID is set to nil,
+// which marks the attachment as detached until its content is cleaned up.
+type AttachmentModel struct {
+	ID          string     `gorm:"primaryKey;type:varchar(36)"`
+	TaskID      *string    `gorm:"type:varchar(36);index"`
+	UploaderID  string     `gorm:"not null;type:varchar(255);index"`
+	FileName    string     `gorm:"not null;type:varchar(255)"`
+	ContentType string     `gorm:"not null;type:varchar(100)"`
+	Size        int64      `gorm:"not null"`
+	Checksum    string     `gorm:"not null;type:varchar(64)"`
+	CreatedAt   time.Time  `gorm:"not null;type:timestamptz"`
+	Task        *TaskModel `gorm:"foreignKey:TaskID;constraint:OnDelete:SET NULL"`
+}
+
+// TableName returns the database table name for AttachmentModel.
+func (AttachmentModel) TableName() string {
+	return "attachments"
+}
+
+// ToDomain converts an AttachmentModel to a domain Attachment entity.
+func (m AttachmentModel) ToDomain() (*attachment.Attachment, error) {
+	attachmentID, err := attachment.NewAttachmentID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var taskID task.TaskID
+
+	if m.TaskID != nil {
+		if taskID, err = task.NewTaskID(*m.TaskID); err != nil {
+			return nil, err
+		}
+	}
+
+	uploaderID, err := user.NewUserID(m.UploaderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment.NewAttachmentWithoutValidation(attachmentID, taskID, uploaderID,
+		m.FileName, m.ContentType, m.Size, m.Checksum, m.CreatedAt), nil
+}
+
+// newAttachmentModel converts a domain Attachment entity to an AttachmentModel.
+func newAttachmentModel(attachmentEntity *attachment.Attachment) *AttachmentModel {
+	taskID := attachmentEntity.TaskID().String()
+
+	return &AttachmentModel{ //nolint:exhaustruct
+		ID:          attachmentEntity.ID().String(),
+		TaskID:      &taskID,
+		UploaderID:  attachmentEntity.UploaderID().String(),
+		FileName:    attachmentEntity.FileName(),
+		ContentType: attachmentEntity.ContentType(),
+		Size:        attachmentEntity.Size(),
+		Checksum:    attachmentEntity.Checksum(),
+		CreatedAt:   attachmentEntity.CreatedAt(),
+	}
+}
+
+// lockUsageSQL serializes uploads by the same user for the rest of the
+// transaction, so that concurrent uploads cannot together exceed the quota.
+const lockUsageSQL = `SELECT pg_advisory_xact_lock(hashtext('attachment_usage:' || ?))`
+
+const usageSQL = `SELECT COALESCE(SUM(size), 0) AS used FROM attachments WHERE uploader_id = ?`
+
+// attachmentUsageRow is the storage used by a user, as read by usageSQL.
+type attachmentUsageRow struct {
+	Used int64
+}
+
+// AttachmentDB implements the AttachmentRepository interface using GORM for database operations.
+type AttachmentDB struct {
+	db *gorm.DB
+}
+
+// NewAttachmentDB creates a new AttachmentDB instance with the provided GORM database connection.
+func NewAttachmentDB(db *gorm.DB) *AttachmentDB {
+	return &AttachmentDB{db: db}
+}
+
+func (r *AttachmentDB) FindById(ctx context.Context, id attachment.AttachmentID) (*attachment.Attachment, error) {
+	if id.IsEmpty() {
+		return nil, attachment.ErrAttachmentIDEmpty
+	}
+
+	attachmentRecord, err := gorm.G[AttachmentModel](r.db).Where("id = ? AND task_id IS NOT NULL", id.String()).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, attachment.ErrAttachmentNotFound
+		}
+
+		return nil, err
+	}
+
+	return attachmentRecord.ToDomain()
+}
+
+func (r *AttachmentDB) FindAllByTaskID(ctx context.Context, taskID task.TaskID) ([]*attachment.Attachment, error) {
+	if taskID.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	attachmentRecords, err := gorm.G[AttachmentModel](r.db).
+		Where("task_id = ?", taskID.String()).
+		Order("created_at ASC, id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainAttachments(attachmentRecords)
+}
+
+func (r *AttachmentDB) FindDetached(ctx context.Context, limit int) ([]*attachment.Attachment, error) {
+	attachmentRecords, err := gorm.G[AttachmentModel](r.db).
+		Where("task_id IS NULL").
+		Order("created_at ASC").
+		Limit(limit).
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainAttachments(attachmentRecords)
+}
+
+func (r *AttachmentDB) UsageByUserID(ctx context.Context, userID user.UserID) (int64, error) {
+	if userID.IsEmpty() {
+		return 0, user.ErrUserIDEmpty
+	}
+
+	return usageOf(ctx, r.db, userID)
+}
+
+func (r *AttachmentDB) CreateWithinQuota(ctx context.Context, attachmentEntity *attachment.Attachment, quota int64) (*attachment.Attachment, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		uploaderID := attachmentEntity.UploaderID()
+
+		if err := gorm.G[AttachmentModel](tx).Exec(ctx, lockUsageSQL, uploaderID.String()); err != nil {
+			return err
+		}
+
+		used, err := usageOf(ctx, tx, uploaderID)
+		if err != nil {
+			return err
+		}
+
+		if used+attachmentEntity.Size() > quota {
+			return attachment.ErrQuotaExceeded
+		}
+
+		return gorm.G[AttachmentModel](tx).Omit(clause.Associations).Create(ctx, newAttachmentModel(attachmentEntity))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return attachmentEntity, nil
+}
+
+func (r *AttachmentDB) Delete(ctx context.Context, id attachment.AttachmentID) error {
+	if id.IsEmpty() {
+		return attachment.ErrAttachmentIDEmpty
+	}
+
+	rowsAffected, err := gorm.G[AttachmentModel](r.db).Where("id = ?", id.String()).Delete(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return attachment.ErrAttachmentNotFound
+	}
+
+	return nil
+}
+
+// usageOf sums the sizes of the files the user attached.
+func usageOf(ctx context.Context, db *gorm.DB, userID user.UserID) (int64, error) {
+	rows, err := gorm.G[attachmentUsageRow](db).Raw(usageSQL, userID.String()).Find(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	return rows[0].Used, nil
+}
+
+func toDomainAttachments(attachmentRecords []AttachmentModel) ([]*attachment.Attachment, error) {
+	attachments := make([]*attachment.Attachment, len(attachmentRecords))
+
+	for i, record := range attachmentRecords {
+		var err error
+		if attachments[i], err = record.ToDomain(); err != nil {
+			return nil, err
+		}
+	}
+
+	return attachments, nil
+}
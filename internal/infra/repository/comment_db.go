@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/comment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// CommentModel represents the database model for comments on tasks.
+// A deleted comment keeps its row with an empty body so that the discussion
+// keeps its shape. Rows are removed together with the task.
+type CommentModel struct {
+	ID        string     `gorm:"primaryKey;type:varchar(36)"`
+	TaskID    string     `gorm:"not null;type:varchar(36);index:idx_comments_task_id_created_at,priority:1"`
+	AuthorID  string     `gorm:"not null;type:varchar(255)"`
+	Body      string     `gorm:"not null;type:text"`
+	CreatedAt time.Time  `gorm:"not null;type:timestamptz;index:idx_comments_task_id_created_at,priority:2"`
+	EditedAt  *time.Time `gorm:"type:timestamptz"`
+	DeletedAt *time.Time `gorm:"type:timestamptz"`
+	Task      *TaskModel `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the database table name for CommentModel.
+func (CommentModel) TableName() string {
+	return "comments"
+}
+
+// ToDomain converts a CommentModel to a domain Comment entity.
+func (m CommentModel) ToDomain() (*comment.Comment, error) {
+	commentID, err := comment.NewCommentID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskID, err := task.NewTaskID(m.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	authorID, err := user.NewUserID(m.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return comment.NewCommentWithoutValidation(commentID, taskID, authorID, m.Body, m.CreatedAt,
+		comment.WithEditedAt(m.EditedAt),
+		comment.WithDeletedAt(m.DeletedAt),
+	), nil
+}
+
+// newCommentModel converts a domain Comment entity to a CommentModel.
+func newCommentModel(commentEntity *comment.Comment) *CommentModel {
+	return &CommentModel{ //nolint:exhaustruct
+		ID:        commentEntity.ID().String(),
+		TaskID:    commentEntity.TaskID().String(),
+		AuthorID:  commentEntity.AuthorID().String(),
+		Body:      commentEntity.Body(),
+		CreatedAt: commentEntity.CreatedAt(),
+		EditedAt:  commentEntity.EditedAt(),
+		DeletedAt: commentEntity.DeletedAt(),
+	}
+}
+
+// CommentDB implements the CommentRepository interface using GORM for database operations.
+type CommentDB struct {
+	db *gorm.DB
+}
+
+// NewCommentDB creates a new CommentDB instance with the provided GORM database connection.
+func NewCommentDB(db *gorm.DB) *CommentDB {
+	return &CommentDB{db: db}
+}
+
+func (r *CommentDB) FindById(ctx context.Context, id comment.CommentID) (*comment.Comment, error) {
+	if id.IsEmpty() {
+		return nil, comment.ErrCommentIDEmpty
+	}
+
+	commentRecord, err := gorm.G[CommentModel](r.db).Where("id = ?", id.String()).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, comment.ErrCommentNotFound
+		}
+
+		return nil, err
+	}
+
+	return commentRecord.ToDomain()
+}
+
+// FindPageByTaskID reads one row more than the page holds to learn whether
+// another page follows without a separate count.
+func (r *CommentDB) FindPageByTaskID(ctx context.Context, taskID task.TaskID, page comment.PageRequest) (comment.Page, error) {
+	if taskID.IsEmpty() {
+		return comment.Page{}, task.ErrTaskIDEmpty
+	}
+
+	query := gorm.G[CommentModel](r.db).Where("task_id = ?", taskID.String())
+
+	if page.Cursor() != "" {
+		position, err := decodeCommentCursor(page.Cursor())
+		if err != nil {
+			return comment.Page{}, err
+		}
+
+		query = query.Where("(created_at > ? OR (created_at = ? AND id > ?))", position.CreatedAt, position.CreatedAt, position.ID)
+	}
+
+	commentRecords, err := query.Order("created_at ASC, id ASC").Limit(page.Limit() + 1).Find(ctx)
+	if err != nil {
+		return comment.Page{}, err
+	}
+
+	nextCursor := ""
+	if len(commentRecords) > page.Limit() {
+		commentRecords = commentRecords[:page.Limit()]
+		last := commentRecords[len(commentRecords)-1]
+		nextCursor = encodeCommentCursor(commentCursorPosition{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	comments := make([]*comment.Comment, len(commentRecords))
+	for i, record := range commentRecords {
+		if comments[i], err = record.ToDomain(); err != nil {
+			return comment.Page{}, err
+		}
+	}
+
+	return comment.Page{Comments: comments, NextCursor: nextCursor}, nil
+}
+
+func (r *CommentDB) Create(ctx context.Context, commentEntity *comment.Comment) (*comment.Comment, error) {
+	if err := gorm.G[CommentModel](r.db).Omit(clause.Associations).Create(ctx, newCommentModel(commentEntity)); err != nil {
+		return nil, err
+	}
+
+	return commentEntity, nil
+}
+
+func (r *CommentDB) Update(ctx context.Context, commentEntity *comment.Comment) (*comment.Comment, error) {
+	commentModel := newCommentModel(commentEntity)
+
+	rowsAffected, err := gorm.G[CommentModel](r.db).
+		Where("id = ?", commentModel.ID).
+		Select("body", "edited_at", "deleted_at").
+		Updates(ctx, *commentModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, comment.ErrCommentNotFound
+	}
+
+	return commentEntity, nil
+}
+
+// commentCursorPosition identifies the last comment of a page.
+type commentCursorPosition struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// encodeCommentCursor serialises a position into an opaque, URL-safe token.
+func encodeCommentCursor(position commentCursorPosition) string {
+	raw, err := json.Marshal(position)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCommentCursor parses a token produced by encodeCommentCursor.
+// It returns comment.ErrInvalidCursor for any token it did not produce.
+func decodeCommentCursor(cursor string) (commentCursorPosition, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return commentCursorPosition{}, comment.ErrInvalidCursor
+	}
+
+	var position commentCursorPosition
+	if err := json.Unmarshal(raw, &position); err != nil {
+		return commentCursorPosition{}, comment.ErrInvalidCursor
+	}
+
+	if position.CreatedAt.IsZero() {
+		return commentCursorPosition{}, comment.ErrInvalidCursor
+	}
+
+	if _, err := uuid.Parse(position.ID); err != nil {
+		return commentCursorPosition{}, comment.ErrInvalidCursor
+	}
+
+	return position, nil
+}
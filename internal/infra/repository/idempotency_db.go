@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/idempotency"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// IdempotencyKeyModel represents the database model for idempotency records.
+// The response columns are NULL while the first request is still in progress.
+type IdempotencyKeyModel struct {
+	UserID       string    `gorm:"primaryKey;type:varchar(255)"`
+	Key          string    `gorm:"primaryKey;type:varchar(255)"`
+	Fingerprint  string    `gorm:"not null;type:varchar(64)"`
+	StatusCode   *int      `gorm:"type:integer"`
+	ContentType  *string   `gorm:"type:varchar(255)"`
+	ResponseBody []byte    `gorm:"type:bytea"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	ExpiresAt    time.Time `gorm:"not null;type:timestamptz;index"`
+}
+
+// TableName returns the database table name for IdempotencyKeyModel.
+func (IdempotencyKeyModel) TableName() string {
+	return "idempotency_keys"
+}
+
+// ToDomain converts an IdempotencyKeyModel to a domain Record.
+func (m IdempotencyKeyModel) ToDomain() (*idempotency.Record, error) {
+	userID, err := user.NewUserID(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := idempotency.NewKey(m.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &idempotency.Record{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: m.Fingerprint,
+		Response:    nil,
+		ExpiresAt:   m.ExpiresAt,
+	}
+
+	if m.StatusCode != nil {
+		contentType := ""
+		if m.ContentType != nil {
+			contentType = *m.ContentType
+		}
+
+		record.Response = &idempotency.Response{
+			StatusCode:  *m.StatusCode,
+			ContentType: contentType,
+			Body:        m.ResponseBody,
+		}
+	}
+
+	return record, nil
+}
+
+// IdempotencyDB implements the IdempotencyRepository interface using GORM for database operations.
+type IdempotencyDB struct {
+	db *gorm.DB
+}
+
+// NewIdempotencyDB creates a new IdempotencyDB instance with the provided GORM database connection.
+func NewIdempotencyDB(db *gorm.DB) *IdempotencyDB {
+	return &IdempotencyDB{db: db}
+}
+
+// reserveKeySQL inserts a pending record, or takes over an expired record with
+// the same key. An unexpired record is left alone, so no row is affected.
+const reserveKeySQL = `INSERT INTO idempotency_keys (user_id, key, fingerprint, created_at, expires_at)
+VALUES (@user_id, @key, @fingerprint, now(), @expires_at)
+ON CONFLICT (user_id, key) DO UPDATE
+SET fingerprint = EXCLUDED.fingerprint, status_code = NULL, content_type = NULL, response_body = NULL,
+	created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= now()`
+
+// reserveAttempts bounds how often Reserve retries when the conflicting record
+// disappears between the insert and the lookup.
+const reserveAttempts = 3
+
+func (r *IdempotencyDB) Reserve(ctx context.Context, record idempotency.Record) (*idempotency.Record, error) {
+	if record.UserID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if record.Key.IsEmpty() {
+		return nil, idempotency.ErrKeyEmpty
+	}
+
+	for range reserveAttempts {
+		result := gorm.WithResult()
+
+		err := gorm.G[IdempotencyKeyModel](r.db, result).Exec(ctx, reserveKeySQL, map[string]any{
+			"user_id":     record.UserID.String(),
+			"key":         record.Key.String(),
+			"fingerprint": record.Fingerprint,
+			"expires_at":  record.ExpiresAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if result.RowsAffected > 0 {
+			return nil, nil
+		}
+
+		existing, err := gorm.G[IdempotencyKeyModel](r.db).
+			Where("user_id = ? AND key = ?", record.UserID.String(), record.Key.String()).
+			First(ctx)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		return existing.ToDomain()
+	}
+
+	return nil, idempotency.ErrRequestInProgress
+}
+
+func (r *IdempotencyDB) Complete(ctx context.Context, userID user.UserID, key idempotency.Key, response idempotency.Response) error {
+	rowsAffected, err := gorm.G[IdempotencyKeyModel](r.db).
+		Where("user_id = ? AND key = ? AND status_code IS NULL", userID.String(), key.String()).
+		Select("status_code", "content_type", "response_body").
+		Updates(ctx, IdempotencyKeyModel{ //nolint:exhaustruct
+			StatusCode:   &response.StatusCode,
+			ContentType:  &response.ContentType,
+			ResponseBody: response.Body,
+		})
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return idempotency.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *IdempotencyDB) Release(ctx context.Context, userID user.UserID, key idempotency.Key) error {
+	_, err := gorm.G[IdempotencyKeyModel](r.db).
+		Where("user_id = ? AND key = ? AND status_code IS NULL", userID.String(), key.String()).
+		Delete(ctx)
+
+	return err
+}
+
+func (r *IdempotencyDB) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	rowsAffected, err := gorm.G[IdempotencyKeyModel](r.db).Where("expires_at <= ?", now).Delete(ctx)
+
+	return int64(rowsAffected), err
+}
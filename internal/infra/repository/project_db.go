@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// ProjectModel represents the database model for projects.
+// Tasks refer to their project through TaskModel.ProjectID.
+type ProjectModel struct {
+	ID        string    `gorm:"primaryKey;type:varchar(36)"`
+	UserID    string    `gorm:"not null;type:varchar(255);index"`
+	Name      string    `gorm:"not null;type:varchar(100)"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName returns the database table name for ProjectModel.
+func (ProjectModel) TableName() string {
+	return "projects"
+}
+
+// ToDomain converts a ProjectModel to a domain Project entity.
+func (m ProjectModel) ToDomain() (*project.Project, error) {
+	projectID, err := project.NewProjectID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := user.NewUserID(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return project.NewProjectWithoutValidation(projectID, m.Name, userID), nil
+}
+
+// newProjectModel converts a domain Project entity to a ProjectModel.
+func newProjectModel(projectEntity *project.Project) *ProjectModel {
+	return &ProjectModel{ //nolint:exhaustruct
+		ID:     projectEntity.ID().String(),
+		UserID: projectEntity.UserID().String(),
+		Name:   projectEntity.Name(),
+	}
+}
+
+// ProjectDB implements the ProjectRepository interface using GORM for database operations.
+type ProjectDB struct {
+	db *gorm.DB
+}
+
+// NewProjectDB creates a new ProjectDB instance with the provided GORM database connection.
+func NewProjectDB(db *gorm.DB) *ProjectDB {
+	return &ProjectDB{db: db}
+}
+
+func (r *ProjectDB) FindById(ctx context.Context, ownerID user.UserID, id project.ProjectID) (*project.Project, error) {
+	if ownerID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, project.ErrProjectIDEmpty
+	}
+
+	projectRecord, err := gorm.G[ProjectModel](r.db).Where("id = ? AND user_id = ?", id.String(), ownerID.String()).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, project.ErrProjectNotFound
+		}
+
+		return nil, err
+	}
+
+	return projectRecord.ToDomain()
+}
+
+func (r *ProjectDB) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*project.Project, error) {
+	if ownerID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	projectRecords, err := gorm.G[ProjectModel](r.db).Where("user_id = ?", ownerID.String()).Order("name ASC, id ASC").Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make([]*project.Project, len(projectRecords))
+	for i, record := range projectRecords {
+		domainProject, err := record.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		projects[i] = domainProject
+	}
+
+	return projects, nil
+}
+
+func (r *ProjectDB) Create(ctx context.Context, projectEntity *project.Project) (*project.Project, error) {
+	if err := gorm.G[ProjectModel](r.db).Create(ctx, newProjectModel(projectEntity)); err != nil {
+		return nil, err
+	}
+
+	return projectEntity, nil
+}
+
+func (r *ProjectDB) Update(ctx context.Context, projectEntity *project.Project) (*project.Project, error) {
+	projectModel := newProjectModel(projectEntity)
+
+	rowsAffected, err := gorm.G[ProjectModel](r.db).
+		Where("id = ? AND user_id = ?", projectModel.ID, projectModel.UserID).
+		Select("name").
+		Updates(ctx, *projectModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, project.ErrProjectNotFound
+	}
+
+	return projectEntity, nil
+}
+
+// moveProjectTasksToInboxSQL takes the tasks out of a project. Their version is
+// incremented so that ETags handed out before no longer match.
+const moveProjectTasksToInboxSQL = `UPDATE tasks SET project_id = NULL, version = version + 1, updated_at = now()
+WHERE project_id = @project_id AND creator_id = @creator_id AND deleted_at IS NULL`
+
+// trashProjectTasksSQL moves the tasks of a project and their subtasks at
+// every level to the trash, including subtasks that belong to another project.
+const trashProjectTasksSQL = `WITH RECURSIVE subtree (id, level) AS (
+	SELECT id, 1 FROM tasks
+	WHERE project_id = @project_id AND creator_id = @creator_id AND deleted_at IS NULL
+	UNION ALL
+	SELECT child.id, subtree.level + 1 FROM tasks AS child
+	JOIN subtree ON child.parent_id = subtree.id
+	WHERE child.deleted_at IS NULL AND subtree.level < @max_depth
+)
+UPDATE tasks SET deleted_at = now()
+WHERE id IN (SELECT id FROM subtree)`
+
+// Delete disposes of the tasks of the project and removes it. The foreign key
+// on tasks clears the project of tasks already in the trash, so that they are
+// restored to the inbox.
+func (r *ProjectDB) Delete(ctx context.Context, ownerID user.UserID, id project.ProjectID, disposal project.TaskDisposal) error {
+	if ownerID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return project.ErrProjectIDEmpty
+	}
+
+	disposeSQL := moveProjectTasksToInboxSQL
+	if disposal == project.DeleteTasks {
+		disposeSQL = trashProjectTasksSQL
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := gorm.G[TaskModel](tx).Exec(ctx, disposeSQL, map[string]any{
+			"project_id": id.String(),
+			"creator_id": ownerID.String(),
+			"max_depth":  task.MaxTreeDepth,
+		})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := gorm.G[ProjectModel](tx).Where("id = ? AND user_id = ?", id.String(), ownerID.String()).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return project.ErrProjectNotFound
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// TagModel represents the database model for tags.
+// Names are unique per user.
+type TagModel struct {
+	ID        string    `gorm:"primaryKey;type:varchar(36)"`
+	UserID    string    `gorm:"not null;type:varchar(255);uniqueIndex:idx_tags_user_id_name,priority:1"`
+	Name      string    `gorm:"not null;type:varchar(50);uniqueIndex:idx_tags_user_id_name,priority:2"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName returns the database table name for TagModel.
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// ToDomain converts a TagModel to a domain Tag entity.
+func (m TagModel) ToDomain() (*tag.Tag, error) {
+	tagID, err := tag.NewTagID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := user.NewUserID(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tag.NewTagWithoutValidation(tagID, m.Name, userID), nil
+}
+
+// TaskTagModel represents the join table between tasks and tags.
+// Rows are removed together with their task or tag.
+type TaskTagModel struct {
+	TaskID string     `gorm:"primaryKey;type:varchar(36)"`
+	TagID  string     `gorm:"primaryKey;type:varchar(36);index"`
+	Task   *TaskModel `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE"`
+	Tag    *TagModel  `gorm:"foreignKey:TagID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the database table name for TaskTagModel.
+func (TaskTagModel) TableName() string {
+	return "task_tags"
+}
+
+// TagDB implements the TagRepository interface using GORM for database operations.
+type TagDB struct {
+	db *gorm.DB
+}
+
+// NewTagDB creates a new TagDB instance with the provided GORM database connection.
+func NewTagDB(db *gorm.DB) *TagDB {
+	return &TagDB{db: db}
+}
+
+func (r *TagDB) FindById(ctx context.Context, ownerID user.UserID, id tag.TagID) (*tag.Tag, error) {
+	if ownerID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, tag.ErrTagIDEmpty
+	}
+
+	tagRecord, err := gorm.G[TagModel](r.db).Where("id = ? AND user_id = ?", id.String(), ownerID.String()).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, tag.ErrTagNotFound
+		}
+
+		return nil, err
+	}
+
+	return tagRecord.ToDomain()
+}
+
+func (r *TagDB) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*tag.Tag, error) {
+	if ownerID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	tagRecords, err := gorm.G[TagModel](r.db).Where("user_id = ?", ownerID.String()).Order("name ASC").Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTags(tagRecords)
+}
+
+func (r *TagDB) FindByNames(ctx context.Context, ownerID user.UserID, names []string) ([]*tag.Tag, error) {
+	if ownerID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if len(names) == 0 {
+		return []*tag.Tag{}, nil
+	}
+
+	tagRecords, err := gorm.G[TagModel](r.db).
+		Where("user_id = ? AND name IN ?", ownerID.String(), names).
+		Order("name ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTags(tagRecords)
+}
+
+// createTagSQL inserts a tag unless the user already has a tag with the same
+// name, in which case no row is affected.
+const createTagSQL = `INSERT INTO tags (id, user_id, name, created_at, updated_at)
+VALUES (@id, @user_id, @name, now(), now())
+ON CONFLICT (user_id, name) DO NOTHING`
+
+func (r *TagDB) Create(ctx context.Context, tagEntity *tag.Tag) (*tag.Tag, error) {
+	result := gorm.WithResult()
+
+	err := gorm.G[TagModel](r.db, result).Exec(ctx, createTagSQL, map[string]any{
+		"id":      tagEntity.ID().String(),
+		"user_id": tagEntity.UserID().String(),
+		"name":    tagEntity.Name(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, tag.ErrNameTaken
+	}
+
+	return tagEntity, nil
+}
+
+// renameTagSQL renames a tag unless the user has another tag with the new name.
+const renameTagSQL = `UPDATE tags SET name = @name, updated_at = now()
+WHERE id = @id AND user_id = @user_id
+AND NOT EXISTS (SELECT 1 FROM tags AS other WHERE other.user_id = @user_id AND other.name = @name AND other.id <> @id)`
+
+func (r *TagDB) Update(ctx context.Context, tagEntity *tag.Tag) (*tag.Tag, error) {
+	result := gorm.WithResult()
+
+	err := gorm.G[TagModel](r.db, result).Exec(ctx, renameTagSQL, map[string]any{
+		"id":      tagEntity.ID().String(),
+		"user_id": tagEntity.UserID().String(),
+		"name":    tagEntity.Name(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RowsAffected == 0 {
+		if _, err := r.FindById(ctx, tagEntity.UserID(), tagEntity.ID()); err != nil {
+			return nil, err
+		}
+
+		return nil, tag.ErrNameTaken
+	}
+
+	return tagEntity, nil
+}
+
+// Delete removes the tag; the foreign key on task_tags detaches it from tasks.
+func (r *TagDB) Delete(ctx context.Context, ownerID user.UserID, id tag.TagID) error {
+	if ownerID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return tag.ErrTagIDEmpty
+	}
+
+	rowsAffected, err := gorm.G[TagModel](r.db).Where("id = ? AND user_id = ?", id.String(), ownerID.String()).Delete(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return tag.ErrTagNotFound
+	}
+
+	return nil
+}
+
+func toDomainTags(tagRecords []TagModel) ([]*tag.Tag, error) {
+	tags := make([]*tag.Tag, len(tagRecords))
+	for i, record := range tagRecords {
+		domainTag, err := record.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		tags[i] = domainTag
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// TaskAssignmentModel represents a change of the assignee of a task.
+// AssigneeID is nil for a change that left the task unassigned.
+// Rows are removed together with the task.
+type TaskAssignmentModel struct {
+	ID         string     `gorm:"primaryKey;type:varchar(36)"`
+	TaskID     string     `gorm:"not null;type:varchar(36);index:idx_task_assignments_task_id_assigned_at,priority:1"`
+	AssigneeID *string    `gorm:"type:varchar(255)"`
+	AssignedBy string     `gorm:"not null;type:varchar(255)"`
+	AssignedAt time.Time  `gorm:"not null;type:timestamptz;index:idx_task_assignments_task_id_assigned_at,priority:2"`
+	Task       *TaskModel `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the database table name for TaskAssignmentModel.
+func (TaskAssignmentModel) TableName() string {
+	return "task_assignments"
+}
+
+// ToDomain converts a TaskAssignmentModel to a domain Assignment.
+func (m TaskAssignmentModel) ToDomain() (task.Assignment, error) {
+	taskID, err := task.NewTaskID(m.TaskID)
+	if err != nil {
+		return task.Assignment{}, err
+	}
+
+	var assigneeID *user.UserID
+
+	if m.AssigneeID != nil {
+		id, err := user.NewUserID(*m.AssigneeID)
+		if err != nil {
+			return task.Assignment{}, err
+		}
+
+		assigneeID = &id
+	}
+
+	assignedBy, err := user.NewUserID(m.AssignedBy)
+	if err != nil {
+		return task.Assignment{}, err
+	}
+
+	return task.NewAssignment(taskID, assigneeID, assignedBy, m.AssignedAt)
+}
+
+// newTaskAssignmentModel converts a domain Assignment to a TaskAssignmentModel
+// with a new ID.
+func newTaskAssignmentModel(assignment task.Assignment) *TaskAssignmentModel {
+	return &TaskAssignmentModel{ //nolint:exhaustruct
+		ID:         uuid.NewString(),
+		TaskID:     assignment.TaskID().String(),
+		AssigneeID: assigneeModelID(assignment.AssigneeID()),
+		AssignedBy: assignment.AssignedBy().String(),
+		AssignedAt: assignment.AssignedAt(),
+	}
+}
+
+// Assign writes the assignee of the task only if the stored row is still at
+// the version the task was read at, and records the assignment in the same
+// transaction so that the history never misses a change.
+func (t *TaskDB) Assign(ctx context.Context, taskEntity *task.Task, assignment task.Assignment) (*task.Task, error) {
+	taskModel := newTaskModel(taskEntity)
+	taskModel.Version = taskEntity.Version() + 1
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stored, err := lockStoredTask(ctx, tx, taskEntity)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return t.updateConflict(ctx, taskEntity)
+			}
+
+			return err
+		}
+
+		rowsAffected, err := gorm.G[TaskModel](tx).
+			Where("id = ? AND creator_id = ? AND version = ?", taskEntity.ID().String(), taskEntity.UserID().String(), taskEntity.Version()).
+			Select("assignee_id", "version", "updated_at").
+			Updates(ctx, *taskModel)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return t.updateConflict(ctx, taskEntity)
+		}
+
+		if err := gorm.G[TaskAssignmentModel](tx).Omit(clause.Associations).Create(ctx, newTaskAssignmentModel(assignment)); err != nil {
+			return err
+		}
+
+		return recordChanges(ctx, tx, task.Diff(stored, taskEntity, assignment.AssignedBy(), assignment.AssignedAt()))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return taskModel.ToDomain()
+}
+
+// FindAssignments returns the assignment history of the task, oldest first.
+func (t *TaskDB) FindAssignments(ctx context.Context, id task.TaskID) ([]task.Assignment, error) {
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	records, err := gorm.G[TaskAssignmentModel](t.db).
+		Where("task_id = ?", id.String()).
+		Order("assigned_at ASC, id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]task.Assignment, len(records))
+	for i, record := range records {
+		if assignments[i], err = record.ToDomain(); err != nil {
+			return nil, err
+		}
+	}
+
+	return assignments, nil
+}
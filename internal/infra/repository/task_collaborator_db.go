@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// TaskCollaboratorModel represents a user a task is shared with.
+// Rows are removed together with the task.
+type TaskCollaboratorModel struct {
+	TaskID    string     `gorm:"primaryKey;type:varchar(36)"`
+	UserID    string     `gorm:"primaryKey;type:varchar(255);index"`
+	Role      string     `gorm:"not null;type:varchar(16)"`
+	Task      *TaskModel `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName returns the database table name for TaskCollaboratorModel.
+func (TaskCollaboratorModel) TableName() string {
+	return "task_collaborators"
+}
+
+// ToDomain converts a TaskCollaboratorModel to a domain Collaborator.
+func (m TaskCollaboratorModel) ToDomain() (task.Collaborator, error) {
+	taskID, err := task.NewTaskID(m.TaskID)
+	if err != nil {
+		return task.Collaborator{}, err
+	}
+
+	userID, err := user.NewUserID(m.UserID)
+	if err != nil {
+		return task.Collaborator{}, err
+	}
+
+	return task.NewCollaborator(taskID, userID, task.Role(m.Role))
+}
+
+// accessibleTasksCondition matches the tasks a user created, is a
+// collaborator on or that are scoped to a workspace they are a member of.
+// It takes the user ID three times.
+const accessibleTasksCondition = `(creator_id = ? OR id IN (SELECT task_id FROM task_collaborators WHERE user_id = ?)
+OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE user_id = ?))`
+
+// FindAccessible returns the task if the user created it, it is shared with
+// them or it is scoped to a workspace they are a member of. For anyone but its
+// creator the task carries their roles.
+func (t *TaskDB) FindAccessible(ctx context.Context, userID user.UserID, id task.TaskID) (*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	taskRecord, err := gorm.G[TaskModel](t.db).
+		Where("id = ?", id.String()).
+		Where(accessibleTasksCondition, userID.String(), userID.String(), userID.String()).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, task.ErrTaskNotFound
+		}
+
+		return nil, err
+	}
+
+	taskRecords := []TaskModel{taskRecord}
+	if err := loadRoles(ctx, t.db, userID, taskRecords); err != nil {
+		return nil, err
+	}
+
+	tasks, err := t.toDomainTasks(ctx, taskRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks[0], nil
+}
+
+// loadRoles sets the roles of the user on the task records they did not
+// create: the role they are shared with and the role they have in the
+// workspace of the task, with one query each.
+func loadRoles(ctx context.Context, db *gorm.DB, userID user.UserID, taskRecords []TaskModel) error {
+	ids := make([]string, 0, len(taskRecords))
+	workspaceIDs := make([]string, 0, len(taskRecords))
+
+	for _, record := range taskRecords {
+		if record.CreatorID == userID.String() {
+			continue
+		}
+
+		ids = append(ids, record.ID)
+
+		if record.WorkspaceID != nil {
+			workspaceIDs = append(workspaceIDs, *record.WorkspaceID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := loadSharedRoles(ctx, db, userID, ids, taskRecords); err != nil {
+		return err
+	}
+
+	if len(workspaceIDs) == 0 {
+		return nil
+	}
+
+	return loadWorkspaceRoles(ctx, db, userID, workspaceIDs, taskRecords)
+}
+
+// loadSharedRoles sets the role of the user on the task records with the
+// given IDs that are shared with them.
+func loadSharedRoles(ctx context.Context, db *gorm.DB, userID user.UserID, ids []string, taskRecords []TaskModel) error {
+
+	collaborators, err := gorm.G[TaskCollaboratorModel](db).
+		Where("user_id = ? AND task_id IN ?", userID.String(), ids).
+		Find(ctx)
+	if err != nil {
+		return err
+	}
+
+	roles := make(map[string]string, len(collaborators))
+	for _, collaborator := range collaborators {
+		roles[collaborator.TaskID] = collaborator.Role
+	}
+
+	for i := range taskRecords {
+		if role, ok := roles[taskRecords[i].ID]; ok {
+			taskRecords[i].SharedRole = &role
+		}
+	}
+
+	return nil
+}
+
+// loadWorkspaceRoles sets the role of the user in the workspace of the task
+// records they did not create, if they are a member of it.
+func loadWorkspaceRoles(ctx context.Context, db *gorm.DB, userID user.UserID, workspaceIDs []string, taskRecords []TaskModel) error {
+	members, err := gorm.G[WorkspaceMemberModel](db).
+		Where("user_id = ? AND workspace_id IN ?", userID.String(), workspaceIDs).
+		Find(ctx)
+	if err != nil {
+		return err
+	}
+
+	roles := make(map[string]string, len(members))
+	for _, member := range members {
+		roles[member.WorkspaceID] = member.Role
+	}
+
+	for i := range taskRecords {
+		if taskRecords[i].WorkspaceID == nil || taskRecords[i].CreatorID == userID.String() {
+			continue
+		}
+
+		if role, ok := roles[*taskRecords[i].WorkspaceID]; ok {
+			taskRecords[i].WorkspaceRole = &role
+		}
+	}
+
+	return nil
+}
+
+// FindCollaborators returns the collaborators of the task in the order they
+// were added.
+func (t *TaskDB) FindCollaborators(ctx context.Context, id task.TaskID) ([]task.Collaborator, error) {
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	records, err := gorm.G[TaskCollaboratorModel](t.db).
+		Where("task_id = ?", id.String()).
+		Order("created_at ASC, user_id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]task.Collaborator, len(records))
+	for i, record := range records {
+		if collaborators[i], err = record.ToDomain(); err != nil {
+			return nil, err
+		}
+	}
+
+	return collaborators, nil
+}
+
+// addCollaboratorSQL inserts a collaborator, or changes the role of an
+// existing one.
+const addCollaboratorSQL = `INSERT INTO task_collaborators (task_id, user_id, role, created_at)
+VALUES (@task_id, @user_id, @role, now())
+ON CONFLICT (task_id, user_id) DO UPDATE SET role = EXCLUDED.role`
+
+// AddCollaborator shares the task with the collaborator, or changes the role
+// of a user it is already shared with.
+func (t *TaskDB) AddCollaborator(ctx context.Context, collaborator task.Collaborator) error {
+	return gorm.G[TaskCollaboratorModel](t.db).Exec(ctx, addCollaboratorSQL, map[string]any{
+		"task_id": collaborator.TaskID().String(),
+		"user_id": collaborator.UserID().String(),
+		"role":    string(collaborator.Role()),
+	})
+}
+
+// RemoveCollaborator stops sharing the task with the user.
+// It returns task.ErrCollaboratorNotFound if the task is not shared with them.
+func (t *TaskDB) RemoveCollaborator(ctx context.Context, id task.TaskID, userID user.UserID) error {
+	rowsAffected, err := gorm.G[TaskCollaboratorModel](t.db).
+		Where("task_id = ? AND user_id = ?", id.String(), userID.String()).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return task.ErrCollaboratorNotFound
+	}
+
+	return nil
+}
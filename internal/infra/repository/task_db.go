@@ -2,23 +2,72 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
 )
 
 // TaskModel represents the database model for tasks.
 // It defines the structure for task data persistence in the database.
+// SearchVector is generated by PostgreSQL from the title and is never read or
+// written by GORM; it is declared so that migrations include it.
+// DeletedAt enables GORM soft deletion: deleting a task only sets it, and
+// queries skip deleted rows unless they are explicitly unscoped.
+// Tags holds the names of the task's tags, which are stored in task_tags.
+// ParentID refers to the parent of a subtask; it is cleared when the parent is
+// removed permanently.
+// Blocked is computed from task_dependencies when tasks are read.
+// SeriesID links the occurrences of a recurring task to their series, which
+// is read into Series together with the tasks.
+// ProjectID refers to the project of the task; it is cleared when the project
+// is deleted, which moves the task to the inbox.
+// Rank is compared byte by byte, which is the order of task.Rank.
+// SharedRole is the role of the user the task is read for, if the task is
+// shared with them; it is loaded from task_collaborators.
+// WorkspaceID refers to the workspace the task is scoped to; it is cleared
+// when the workspace is deleted, which leaves the task to its creator.
+// WorkspaceRole is the role the user the task is read for has in that
+// workspace, if they are a member; it is loaded from workspace_members.
+// AssigneeID is the user responsible for the task; the changes of it are
+// recorded in task_assignments.
 type TaskModel struct {
-	ID        string    `gorm:"primaryKey;type:varchar(36)"`
-	Title     string    `gorm:"not null;type:varchar(255)"`
-	CreatorID string    `gorm:"not null;type:varchar(255);index"`
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	ID            string           `gorm:"primaryKey;type:varchar(36);index:idx_tasks_creator_id_created_at_id,priority:3;index:idx_tasks_creator_id_rank_id,priority:3"`
+	Title         string           `gorm:"not null;type:varchar(255);index:idx_tasks_title_trgm,type:gin,expression:title gin_trgm_ops"`
+	CreatorID     string           `gorm:"not null;type:varchar(255);index;index:idx_tasks_creator_id_due_at,priority:1;index:idx_tasks_creator_id_created_at_id,priority:1;index:idx_tasks_creator_id_rank_id,priority:1"`
+	Completed     bool             `gorm:"not null;default:false"`
+	CompletedAt   *time.Time       `gorm:"type:timestamptz"`
+	StartAt       *time.Time       `gorm:"type:timestamptz"`
+	DueAt         *time.Time       `gorm:"type:timestamptz;index:idx_tasks_creator_id_due_at,priority:2"`
+	AllDay        bool             `gorm:"not null;default:false"`
+	CreatedAt     time.Time        `gorm:"autoCreateTime;index:idx_tasks_creator_id_created_at_id,priority:2"`
+	UpdatedAt     time.Time        `gorm:"autoUpdateTime"`
+	Version       int64            `gorm:"not null;default:1"`
+	DeletedAt     gorm.DeletedAt   `gorm:"index"`
+	ParentID      *string          `gorm:"type:varchar(36);index"`
+	Parent        *TaskModel       `gorm:"foreignKey:ParentID;constraint:OnDelete:SET NULL"`
+	Tags          []string         `gorm:"-"`
+	Blocked       bool             `gorm:"-"`
+	SeriesID      *string          `gorm:"type:varchar(36);index"`
+	Series        *TaskSeriesModel `gorm:"foreignKey:SeriesID;constraint:OnDelete:SET NULL"`
+	ProjectID     *string          `gorm:"type:varchar(36);index"`
+	Project       *ProjectModel    `gorm:"foreignKey:ProjectID;constraint:OnDelete:SET NULL"`
+	Rank          string           `gorm:"not null;type:varchar(255) COLLATE \"C\";index:idx_tasks_creator_id_rank_id,priority:2"`
+	SharedRole    *string          `gorm:"-"`
+	WorkspaceID   *string          `gorm:"type:varchar(36);index"`
+	Workspace     *WorkspaceModel  `gorm:"foreignKey:WorkspaceID;constraint:OnDelete:SET NULL"`
+	WorkspaceRole *string          `gorm:"-"`
+	AssigneeID    *string          `gorm:"type:varchar(255);index"`
+	SearchVector  string           `gorm:"->:false;<-:false;type:tsvector GENERATED ALWAYS AS (to_tsvector('simple', title)) STORED;index:idx_tasks_search_vector,type:gin"`
 }
 
 // TableName returns the database table name for TaskModel.
@@ -38,7 +87,212 @@ func (t TaskModel) ToDomain() (*task.Task, error) {
 		return nil, err
 	}
 
-	return task.NewTaskWithoutValidation(taskID, t.Title, creatorID), nil
+	var parentID *task.TaskID
+
+	if t.ParentID != nil {
+		id, err := task.NewTaskID(*t.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		parentID = &id
+	}
+
+	var projectID *project.ProjectID
+
+	if t.ProjectID != nil {
+		id, err := project.NewProjectID(*t.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		projectID = &id
+	}
+
+	var workspaceID *workspace.WorkspaceID
+
+	if t.WorkspaceID != nil {
+		id, err := workspace.NewWorkspaceID(*t.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		workspaceID = &id
+	}
+
+	var assigneeID *user.UserID
+
+	if t.AssigneeID != nil {
+		id, err := user.NewUserID(*t.AssigneeID)
+		if err != nil {
+			return nil, err
+		}
+
+		assigneeID = &id
+	}
+
+	var sharedRole *task.Role
+
+	if t.SharedRole != nil {
+		role, err := task.ParseRole(*t.SharedRole)
+		if err != nil {
+			return nil, err
+		}
+
+		sharedRole = &role
+	}
+
+	var workspaceRole *workspace.Role
+
+	if t.WorkspaceRole != nil {
+		role, err := workspace.ParseRole(*t.WorkspaceRole)
+		if err != nil {
+			return nil, err
+		}
+
+		workspaceRole = &role
+	}
+
+	var series *task.Series
+
+	if t.Series != nil {
+		series, err = t.Series.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return task.NewTaskWithoutValidation(
+		taskID,
+		t.Title,
+		creatorID,
+		task.WithCompletedAt(t.CompletedAt),
+		task.WithSchedule(task.NewScheduleWithoutValidation(t.StartAt, t.DueAt, t.AllDay)),
+		task.WithVersion(t.Version),
+		task.WithDeletedAt(deletedAt(t.DeletedAt)),
+		task.WithTags(t.Tags),
+		task.WithParentID(parentID),
+		task.WithBlocked(t.Blocked),
+		task.WithSeries(series),
+		task.WithProjectID(projectID),
+		task.WithRank(task.Rank(t.Rank)),
+		task.WithSharedRole(sharedRole),
+		task.WithWorkspaceID(workspaceID),
+		task.WithWorkspaceRole(workspaceRole),
+		task.WithAssigneeID(assigneeID),
+	), nil
+}
+
+// deletedAt converts a GORM soft delete timestamp to the domain representation.
+func deletedAt(value gorm.DeletedAt) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Time
+}
+
+// newTaskModel converts a domain Task entity to a TaskModel.
+func newTaskModel(taskEntity *task.Task) *TaskModel {
+	return &TaskModel{ //nolint:exhaustruct
+		ID:            taskEntity.ID().String(),
+		Title:         taskEntity.Title(),
+		CreatorID:     taskEntity.UserID().String(),
+		Completed:     taskEntity.IsCompleted(),
+		CompletedAt:   taskEntity.CompletedAt(),
+		StartAt:       taskEntity.Schedule().StartAt(),
+		DueAt:         taskEntity.Schedule().DueAt(),
+		AllDay:        taskEntity.Schedule().IsAllDay(),
+		Version:       taskEntity.Version(),
+		Tags:          taskEntity.Tags(),
+		ParentID:      parentModelID(taskEntity.ParentID()),
+		Blocked:       taskEntity.IsBlocked(),
+		SeriesID:      seriesModelID(taskEntity.Series()),
+		Series:        newTaskSeriesModel(taskEntity.Series()),
+		ProjectID:     projectModelID(taskEntity.ProjectID()),
+		Rank:          taskEntity.Rank().String(),
+		SharedRole:    sharedRoleName(taskEntity.SharedRole()),
+		WorkspaceID:   workspaceModelID(taskEntity.WorkspaceID()),
+		WorkspaceRole: workspaceRoleName(taskEntity.WorkspaceRole()),
+		AssigneeID:    assigneeModelID(taskEntity.AssigneeID()),
+	}
+}
+
+// parentModelID converts the parent of a domain task to its column value.
+func parentModelID(parentID *task.TaskID) *string {
+	if parentID == nil {
+		return nil
+	}
+
+	id := parentID.String()
+
+	return &id
+}
+
+// seriesModelID converts the series of a domain task to its column value.
+func seriesModelID(series *task.Series) *string {
+	if series == nil {
+		return nil
+	}
+
+	id := series.ID().String()
+
+	return &id
+}
+
+// projectModelID converts the project of a domain task to its column value.
+func projectModelID(projectID *project.ProjectID) *string {
+	if projectID == nil {
+		return nil
+	}
+
+	id := projectID.String()
+
+	return &id
+}
+
+// workspaceModelID converts the workspace of a domain task to its column value.
+func workspaceModelID(workspaceID *workspace.WorkspaceID) *string {
+	if workspaceID == nil {
+		return nil
+	}
+
+	id := workspaceID.String()
+
+	return &id
+}
+
+// assigneeModelID converts the assignee of a domain task to its column value.
+func assigneeModelID(assigneeID *user.UserID) *string {
+	if assigneeID == nil {
+		return nil
+	}
+
+	id := assigneeID.String()
+
+	return &id
+}
+
+// workspaceRoleName converts the workspace role of a domain task to its stored name.
+func workspaceRoleName(role *workspace.Role) *string {
+	if role == nil {
+		return nil
+	}
+
+	name := string(*role)
+
+	return &name
+}
+
+// sharedRoleName converts the shared role of a domain task to its stored name.
+func sharedRoleName(role *task.Role) *string {
+	if role == nil {
+		return nil
+	}
+
+	name := string(*role)
+
+	return &name
 }
 
 // TaskDB implements the TaskRepository interface using GORM for database operations.
@@ -69,15 +323,22 @@ func (t *TaskDB) FindById(ctx context.Context, creatorID user.UserID, id task.Ta
 		return nil, err
 	}
 
-	return taskRecord.ToDomain()
+	tasks, err := t.toDomainTasks(ctx, []TaskModel{taskRecord})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks[0], nil
 }
 
-func (t *TaskDB) FindAllByUserID(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+func (t *TaskDB) FindAllByUserID(ctx context.Context, creatorID user.UserID, filter task.Filter) ([]*task.Task, error) {
 	if creatorID.IsEmpty() {
 		return nil, user.ErrUserIDEmpty
 	}
 
-	taskRecords, err := gorm.G[TaskModel](t.db).Where("creator_id = ?", creatorID.String()).Find(ctx)
+	query := applyFilter(gorm.G[TaskModel](t.db).Where(accessibleTasksCondition, creatorID.String(), creatorID.String(), creatorID.String()), filter)
+
+	taskRecords, err := query.Order(orderClause(task.DefaultSort)).Find(ctx)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, task.ErrTaskNotFound
@@ -90,35 +351,149 @@ func (t *TaskDB) FindAllByUserID(ctx context.Context, creatorID user.UserID) ([]
 		return nil, task.ErrTaskNotFound
 	}
 
-	tasks := make([]*task.Task, len(taskRecords))
-	for i, record := range taskRecords {
-		domainTask, err := record.ToDomain()
+	if err := loadRoles(ctx, t.db, creatorID, taskRecords); err != nil {
+		return nil, err
+	}
+
+	return t.toDomainTasks(ctx, taskRecords)
+}
+
+// FindPageByUserID returns one page of tasks using keyset pagination on the
+// sort keys of the page request followed by id, so that a page is stable under
+// concurrent inserts and the cost of fetching it does not grow with its
+// position in the list.
+func (t *TaskDB) FindPageByUserID(ctx context.Context, creatorID user.UserID, filter task.Filter, page task.PageRequest) (*task.Page, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	sort := page.Sort()
+
+	query := applyFilter(gorm.G[TaskModel](t.db).Where(accessibleTasksCondition, creatorID.String(), creatorID.String(), creatorID.String()), filter)
+
+	if page.Cursor() != "" {
+		position, err := decodeCursor(page.Cursor(), sort)
 		if err != nil {
 			return nil, err
 		}
 
-		tasks[i] = domainTask
+		condition, args := keysetCondition(sort, position)
+		query = query.Where(condition, args...)
 	}
 
-	return tasks, nil
+	// Fetch one extra row to learn whether another page follows.
+	taskRecords, err := query.Order(orderClause(sort)).Limit(page.Limit() + 1).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &task.Page{
+		Tasks:      make([]*task.Task, 0, min(len(taskRecords), page.Limit())),
+		NextCursor: "",
+	}
+
+	if len(taskRecords) > page.Limit() {
+		taskRecords = taskRecords[:page.Limit()]
+		result.NextCursor = encodeCursor(newCursorPosition(taskRecords[len(taskRecords)-1], sort))
+	}
+
+	if err := loadRoles(ctx, t.db, creatorID, taskRecords); err != nil {
+		return nil, err
+	}
+
+	tasks, err := t.toDomainTasks(ctx, taskRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Tasks = append(result.Tasks, tasks...)
+
+	return result, nil
 }
 
-func (t *TaskDB) Create(ctx context.Context, taskEntity *task.Task) (*task.Task, error) {
-	taskModel := &TaskModel{ //nolint:exhaustruct
-		ID:        taskEntity.ID().String(),
-		Title:     taskEntity.Title(),
-		CreatorID: taskEntity.UserID().String(),
+// searchTasksSQL matches titles in two ways and ranks the union.
+// Full-text search on the 'simple' configuration finds whole words in any
+// language without stemming. Text without word boundaries, such as Japanese,
+// is stored as a single lexeme, so a substring match backed by the pg_trgm
+// index covers it; trigram similarity then ranks those matches.
+// The tasks are those of accessibleTasksCondition, written with named
+// parameters.
+const searchTasksSQL = `SELECT * FROM tasks
+WHERE (creator_id = @user_id OR id IN (SELECT task_id FROM task_collaborators WHERE user_id = @user_id)
+OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE user_id = @user_id))
+AND deleted_at IS NULL
+AND (search_vector @@ websearch_to_tsquery('simple', @text) OR title ILIKE @pattern ESCAPE '\')
+ORDER BY ts_rank(search_vector, websearch_to_tsquery('simple', @text)) + similarity(title, @text) DESC, created_at DESC, id ASC
+LIMIT @limit`
+
+// Search returns the tasks the user may read whose titles match the query,
+// best match first. It returns an empty slice if no tasks match.
+func (t *TaskDB) Search(ctx context.Context, userID user.UserID, query task.SearchQuery) ([]*task.Task, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
 	}
 
-	result := gorm.WithResult()
-	if err := gorm.G[TaskModel](t.db, result).Create(ctx, taskModel); err != nil {
+	taskRecords, err := gorm.G[TaskModel](t.db).Raw(searchTasksSQL, map[string]any{
+		"user_id": userID.String(),
+		"text":    query.Text(),
+		"pattern": "%" + likeEscaper.Replace(query.Text()) + "%",
+		"limit":   query.Limit(),
+	}).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := loadRoles(ctx, t.db, userID, taskRecords); err != nil {
+		return nil, err
+	}
+
+	return t.toDomainTasks(ctx, taskRecords)
+}
+
+func (t *TaskDB) Create(ctx context.Context, taskEntity *task.Task) (*task.Task, error) {
+	taskModel := newTaskModel(taskEntity)
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := createTask(ctx, tx, taskModel); err != nil {
+			return err
+		}
+
+		return recordChanges(ctx, tx, []task.Change{task.CreatedChange(taskEntity, taskEntity.UserID(), time.Now())})
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return taskModel.ToDomain()
 }
 
-func (t *TaskDB) Delete(ctx context.Context, creatorID user.UserID, id task.TaskID) error {
+// createTask inserts the task together with its series and its tags.
+// A task without a rank is appended to the end of the order of its creator.
+// Associations are omitted so that GORM does not write the series itself.
+func createTask(ctx context.Context, tx *gorm.DB, taskModel *TaskModel) error {
+	if err := createSeries(ctx, tx, taskModel); err != nil {
+		return err
+	}
+
+	if taskModel.Rank == "" {
+		rank, err := appendRank(ctx, tx, taskModel.CreatorID)
+		if err != nil {
+			return err
+		}
+
+		taskModel.Rank = rank.String()
+	}
+
+	if err := gorm.G[TaskModel](tx).Omit(clause.Associations).Create(ctx, taskModel); err != nil {
+		return err
+	}
+
+	return replaceTags(ctx, tx, taskModel)
+}
+
+// Delete moves the task to the trash unless it has subtasks outside the trash.
+// Trashed tasks are only removed permanently by EmptyTrash and PurgeDeletedBefore.
+func (t *TaskDB) Delete(ctx context.Context, creatorID user.UserID, id task.TaskID, expectedVersion *int64) error {
 	if creatorID.IsEmpty() {
 		return user.ErrUserIDEmpty
 	}
@@ -127,23 +502,714 @@ func (t *TaskDB) Delete(ctx context.Context, creatorID user.UserID, id task.Task
 		return task.ErrTaskIDEmpty
 	}
 
-	if _, err := gorm.G[TaskModel](t.db).Where("id = ? AND creator_id = ?", id.String(), creatorID.String()).Delete(ctx); err != nil {
+	deleted := false
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := gorm.G[TaskModel](tx).
+			Where("id = ? AND creator_id = ?", id.String(), creatorID.String()).
+			Where("NOT EXISTS (SELECT 1 FROM tasks AS child WHERE child.parent_id = tasks.id AND child.deleted_at IS NULL)")
+		if expectedVersion != nil {
+			query = query.Where("version = ?", *expectedVersion)
+		}
+
+		rowsAffected, err := query.Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return nil
+		}
+
+		deleted = true
+
+		return recordChanges(ctx, tx, []task.Change{task.DeletedChange(id, creatorID, time.Now())})
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		return nil
+	}
+
+	subtasks, err := gorm.G[TaskModel](t.db).Where("parent_id = ? AND creator_id = ?", id.String(), creatorID.String()).Count(ctx, "id")
+	if err != nil {
 		return err
 	}
 
+	if subtasks > 0 {
+		return task.ErrHasSubtasks
+	}
+
+	// A missing task only counts as a conflict when the client asked for a specific version.
+	if expectedVersion != nil {
+		return task.ErrVersionMismatch
+	}
+
 	return nil
 }
 
-func (t *TaskDB) Update(ctx context.Context, taskEntity *task.Task) (*task.Task, error) {
-	taskModel := &TaskModel{ //nolint:exhaustruct
-		ID:        taskEntity.ID().String(),
-		Title:     taskEntity.Title(),
-		CreatorID: taskEntity.UserID().String(),
+// deleteTreeSQL moves a task and its subtasks at every level to the trash.
+// The version check only applies to the task itself.
+const deleteTreeSQL = `WITH RECURSIVE subtree (id, level) AS (
+	SELECT id, 1 FROM tasks
+	WHERE id = @id AND creator_id = @creator_id AND deleted_at IS NULL
+	AND (CAST(@version AS bigint) IS NULL OR version = @version)
+	UNION ALL
+	SELECT child.id, subtree.level + 1 FROM tasks AS child
+	JOIN subtree ON child.parent_id = subtree.id
+	WHERE child.deleted_at IS NULL AND subtree.level < @max_depth
+)
+UPDATE tasks SET deleted_at = now()
+WHERE id IN (SELECT id FROM subtree)
+RETURNING id`
+
+// deletedTaskRow is the ID of a task moved to the trash, as returned by deleteTreeSQL.
+type deletedTaskRow struct {
+	ID string
+}
+
+// DeleteTree moves the task and all of its subtasks to the trash in a single statement.
+func (t *TaskDB) DeleteTree(ctx context.Context, creatorID user.UserID, id task.TaskID, expectedVersion *int64) error {
+	if creatorID.IsEmpty() {
+		return user.ErrUserIDEmpty
 	}
 
-	if _, err := gorm.G[TaskModel](t.db).Where("id = ? AND creator_id = ?", taskEntity.ID().String(), taskEntity.UserID().String()).Updates(ctx, *taskModel); err != nil {
+	if id.IsEmpty() {
+		return task.ErrTaskIDEmpty
+	}
+
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rows, err := gorm.G[deletedTaskRow](tx).Raw(deleteTreeSQL, map[string]any{
+			"id":         id.String(),
+			"creator_id": creatorID.String(),
+			"version":    expectedVersion,
+			"max_depth":  task.MaxTreeDepth,
+		}).Find(ctx)
+		if err != nil {
+			return err
+		}
+
+		// A missing task only counts as a conflict when the client asked for a specific version.
+		if len(rows) == 0 && expectedVersion != nil {
+			return task.ErrVersionMismatch
+		}
+
+		deletedAt := time.Now()
+		changes := make([]task.Change, len(rows))
+
+		for i, row := range rows {
+			deletedID, err := task.NewTaskID(row.ID)
+			if err != nil {
+				return err
+			}
+
+			changes[i] = task.DeletedChange(deletedID, creatorID, deletedAt)
+		}
+
+		return recordChanges(ctx, tx, changes)
+	})
+}
+
+// lineageSQL reads a task followed by its ancestors up to the root task.
+// The level bound stops the walk should the stored parents ever form a cycle.
+const lineageSQL = `WITH RECURSIVE lineage (id, parent_id, level) AS (
+	SELECT id, parent_id, 1 FROM tasks
+	WHERE id = @id AND creator_id = @creator_id AND deleted_at IS NULL
+	UNION ALL
+	SELECT parent.id, parent.parent_id, lineage.level + 1 FROM tasks AS parent
+	JOIN lineage ON parent.id = lineage.parent_id
+	WHERE parent.creator_id = @creator_id AND parent.deleted_at IS NULL AND lineage.level <= @max_depth
+)
+SELECT tasks.* FROM tasks
+JOIN lineage ON lineage.id = tasks.id
+ORDER BY lineage.level`
+
+// FindLineage returns the task followed by its ancestors up to the root task.
+// It returns task.ErrTaskNotFound if the task does not exist or is in the trash.
+func (t *TaskDB) FindLineage(ctx context.Context, creatorID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	taskRecords, err := gorm.G[TaskModel](t.db).Raw(lineageSQL, map[string]any{
+		"id":         id.String(),
+		"creator_id": creatorID.String(),
+		"max_depth":  task.MaxTreeDepth,
+	}).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(taskRecords) == 0 {
+		return nil, task.ErrTaskNotFound
+	}
+
+	return t.toDomainTasks(ctx, taskRecords)
+}
+
+// subtasksSQL reads the subtasks of a set of tasks at every level below them.
+// Subtasks in the trash are skipped together with their own subtasks.
+const subtasksSQL = `WITH RECURSIVE subtree (id, level) AS (
+	SELECT child.id, 1 FROM tasks AS child
+	WHERE child.parent_id IN @ids AND child.creator_id = @creator_id AND child.deleted_at IS NULL
+	UNION ALL
+	SELECT child.id, subtree.level + 1 FROM tasks AS child
+	JOIN subtree ON child.parent_id = subtree.id
+	WHERE child.deleted_at IS NULL AND subtree.level < @max_depth
+)
+SELECT * FROM tasks
+WHERE id IN (SELECT id FROM subtree)
+ORDER BY created_at ASC, id ASC`
+
+// FindSubtasks returns the subtasks of the given tasks at every level below
+// them, oldest first.
+func (t *TaskDB) FindSubtasks(ctx context.Context, creatorID user.UserID, ids []task.TaskID) ([]*task.Task, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if len(ids) == 0 {
+		return []*task.Task{}, nil
+	}
+
+	parentIDs := make([]string, len(ids))
+	for i, id := range ids {
+		parentIDs[i] = id.String()
+	}
+
+	taskRecords, err := gorm.G[TaskModel](t.db).Raw(subtasksSQL, map[string]any{
+		"ids":        parentIDs,
+		"creator_id": creatorID.String(),
+		"max_depth":  task.MaxTreeDepth,
+	}).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.toDomainTasks(ctx, taskRecords)
+}
+
+// FindTrashByUserID returns the tasks the user moved to the trash, most recently deleted first.
+func (t *TaskDB) FindTrashByUserID(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	taskRecords, err := gorm.G[TaskModel](t.db).
+		Scopes(unscoped).
+		Where("creator_id = ? AND deleted_at IS NOT NULL", creatorID.String()).
+		Order("deleted_at DESC, id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.toDomainTasks(ctx, taskRecords)
+}
+
+// restoreTaskSQL takes a task out of the trash. The version is incremented so
+// that ETags handed out before the task was deleted no longer match.
+// A subtask whose parent is still in the trash is restored as a root task.
+const restoreTaskSQL = `UPDATE tasks
+SET deleted_at = NULL, version = version + 1, updated_at = now(),
+parent_id = (SELECT parent.id FROM tasks AS parent WHERE parent.id = tasks.parent_id AND parent.deleted_at IS NULL)
+WHERE id = ? AND creator_id = ? AND deleted_at IS NOT NULL
+RETURNING id, title, creator_id, completed, completed_at, start_at, due_at, all_day, created_at, updated_at, version, deleted_at, parent_id, series_id, project_id, rank, workspace_id, assignee_id`
+
+// Restore moves a task out of the trash.
+// It returns task.ErrTaskNotFound if the task is not in the trash.
+func (t *TaskDB) Restore(ctx context.Context, creatorID user.UserID, id task.TaskID) (*task.Task, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	var taskRecords []TaskModel
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		taskRecords, err = gorm.G[TaskModel](tx).Raw(restoreTaskSQL, id.String(), creatorID.String()).Find(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(taskRecords) == 0 {
+			return task.ErrTaskNotFound
+		}
+
+		return recordChanges(ctx, tx, []task.Change{task.RestoredChange(id, creatorID, time.Now())})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := t.toDomainTasks(ctx, taskRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks[0], nil
+}
+
+// EmptyTrash permanently removes the tasks the user moved to the trash.
+func (t *TaskDB) EmptyTrash(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	return t.purge(ctx, "creator_id = ? AND deleted_at IS NOT NULL", creatorID.String())
+}
+
+// PurgeDeletedBefore permanently removes the tasks of all users that were moved
+// to the trash before cutoff.
+func (t *TaskDB) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	purged, err := t.purge(ctx, "deleted_at < ?", cutoff)
+
+	return int64(len(purged)), err
+}
+
+// purge permanently removes the trashed tasks that match the condition in one
+// transaction and returns them. The tasks are locked and announced to the
+// webhooks of their creators before they are removed, as the outbox looks up
+// the creators of the changed tasks. No history is recorded, since the history
+// of a task is removed together with it.
+func (t *TaskDB) purge(ctx context.Context, query string, args ...any) ([]*task.Task, error) {
+	var tasks []*task.Task
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		taskRecords, err := gorm.G[TaskModel](tx, clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Scopes(unscoped).
+			Where(query, args...).
+			Find(ctx)
+		if err != nil || len(taskRecords) == 0 {
+			return err
+		}
+
+		now := time.Now()
+		ids := make([]string, len(taskRecords))
+		changes := make([]task.Change, len(taskRecords))
+		tasks = make([]*task.Task, len(taskRecords))
+
+		for i, record := range taskRecords {
+			if tasks[i], err = record.ToDomain(); err != nil {
+				return err
+			}
+
+			ids[i] = record.ID
+			changes[i] = task.PurgedChange(tasks[i].ID(), tasks[i].UserID(), now)
+		}
+
+		if err := enqueueWebhookEvents(ctx, tx, changes); err != nil {
+			return err
+		}
+
+		_, err = gorm.G[TaskModel](tx).Scopes(unscoped).Where("id IN ?", ids).Delete(ctx)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// unscoped lifts the soft delete filter so that trashed tasks are included,
+// and makes Delete remove rows permanently.
+func unscoped(stmt *gorm.Statement) {
+	stmt.Unscoped = true
+}
+
+// Update writes the task only if the stored row is still at the version the
+// task was read at, and increments the version in the same statement so that
+// concurrent writers cannot both succeed.
+func (t *TaskDB) Update(ctx context.Context, actorID user.UserID, taskEntity *task.Task) (*task.Task, error) {
+	taskModel := newTaskModel(taskEntity)
+	taskModel.Version = taskEntity.Version() + 1
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return t.updateTask(ctx, tx, actorID, taskEntity, taskModel)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return taskModel.ToDomain()
 }
+
+// updateTask writes the model of the task if the stored row is still at the
+// version of the task, replaces its tags and records what changed compared to
+// the stored row as made by the user with actorID.
+func (t *TaskDB) updateTask(ctx context.Context, tx *gorm.DB, actorID user.UserID, taskEntity *task.Task, taskModel *TaskModel) error {
+	stored, err := lockStoredTask(ctx, tx, taskEntity)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return t.updateConflict(ctx, taskEntity)
+		}
+
+		return err
+	}
+
+	// Select the mutable columns explicitly so that zero values such as
+	// completed = false are written instead of being skipped.
+	rowsAffected, err := gorm.G[TaskModel](tx).
+		Where("id = ? AND creator_id = ? AND version = ?", taskEntity.ID().String(), taskEntity.UserID().String(), taskEntity.Version()).
+		Select("title", "completed", "completed_at", "start_at", "due_at", "all_day", "version", "parent_id", "project_id").
+		Updates(ctx, *taskModel)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return t.updateConflict(ctx, taskEntity)
+	}
+
+	if err := replaceTags(ctx, tx, taskModel); err != nil {
+		return err
+	}
+
+	return recordChanges(ctx, tx, task.Diff(stored, taskEntity, actorID, time.Now()))
+}
+
+// updateConflict explains why a conditional update matched no rows.
+func (t *TaskDB) updateConflict(ctx context.Context, taskEntity *task.Task) error {
+	count, err := gorm.G[TaskModel](t.db).
+		Where("id = ? AND creator_id = ?", taskEntity.ID().String(), taskEntity.UserID().String()).
+		Count(ctx, "id")
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return task.ErrTaskNotFound
+	}
+
+	return task.ErrVersionMismatch
+}
+
+// taskTagsSQL reads the tag names of a set of tasks.
+const taskTagsSQL = `SELECT task_tags.task_id, tags.name FROM task_tags
+JOIN tags ON tags.id = task_tags.tag_id
+WHERE task_tags.task_id IN ?
+ORDER BY tags.name`
+
+// taskTagRow is a tag name attached to a task, as read by taskTagsSQL.
+type taskTagRow struct {
+	TaskID string
+	Name   string
+}
+
+// toDomainTasks loads the tags, the blocked state and the series of the task
+// records with one query each and converts the records to domain tasks.
+func (t *TaskDB) toDomainTasks(ctx context.Context, taskRecords []TaskModel) ([]*task.Task, error) {
+	if len(taskRecords) > 0 {
+		ids := make([]string, len(taskRecords))
+		for i, record := range taskRecords {
+			ids[i] = record.ID
+		}
+
+		rows, err := gorm.G[taskTagRow](t.db).Raw(taskTagsSQL, ids).Find(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		tagsByTask := make(map[string][]string, len(taskRecords))
+		for _, row := range rows {
+			tagsByTask[row.TaskID] = append(tagsByTask[row.TaskID], row.Name)
+		}
+
+		blockedRows, err := gorm.G[blockedTaskRow](t.db).Raw(blockedTasksSQL, ids).Find(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		blocked := make(map[string]bool, len(blockedRows))
+		for _, row := range blockedRows {
+			blocked[row.BlockedID] = true
+		}
+
+		for i := range taskRecords {
+			taskRecords[i].Tags = tagsByTask[taskRecords[i].ID]
+			taskRecords[i].Blocked = blocked[taskRecords[i].ID]
+		}
+
+		if err := t.loadSeries(ctx, taskRecords); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks := make([]*task.Task, len(taskRecords))
+	for i, record := range taskRecords {
+		domainTask, err := record.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		tasks[i] = domainTask
+	}
+
+	return tasks, nil
+}
+
+// attachTagsSQL attaches the creator's tags with the given names to a task.
+// Names the creator has no tag for match no row and are skipped.
+const attachTagsSQL = `INSERT INTO task_tags (task_id, tag_id)
+SELECT ?, id FROM tags WHERE user_id = ? AND name IN ?`
+
+// replaceTags replaces the tags attached to the task with the tags named in the model.
+func replaceTags(ctx context.Context, tx *gorm.DB, taskModel *TaskModel) error {
+	if _, err := gorm.G[TaskTagModel](tx).Where("task_id = ?", taskModel.ID).Delete(ctx); err != nil {
+		return err
+	}
+
+	if len(taskModel.Tags) == 0 {
+		return nil
+	}
+
+	return gorm.G[TaskTagModel](tx).Exec(ctx, attachTagsSQL, taskModel.ID, taskModel.CreatorID, taskModel.Tags)
+}
+
+// sortColumns maps sort fields to the columns they order by.
+// Column names are only ever taken from this map, never from client input.
+var sortColumns = map[task.SortField]string{
+	task.SortByCreatedAt: "created_at",
+	task.SortByUpdatedAt: "updated_at",
+	task.SortByTitle:     "title",
+	task.SortByRank:      "rank",
+}
+
+// filterColumns maps filter fields to the columns they compare.
+var filterColumns = map[task.FilterField]string{
+	task.FilterByTitle:     "title",
+	task.FilterByCompleted: "completed",
+	task.FilterByCreatedAt: "created_at",
+	task.FilterByUpdatedAt: "updated_at",
+	task.FilterByStartAt:   "start_at",
+	task.FilterByDueAt:     "due_at",
+}
+
+// comparisonOperators maps filter operators to SQL comparison operators.
+var comparisonOperators = map[task.FilterOperator]string{
+	task.OpEqual:          "=",
+	task.OpGreater:        ">",
+	task.OpGreaterOrEqual: ">=",
+	task.OpLess:           "<",
+	task.OpLessOrEqual:    "<=",
+}
+
+// likeEscaper escapes the LIKE wildcards in a substring so that it matches literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// applyFilter narrows a task query by the conditions set in the filter.
+func applyFilter(query gorm.ChainInterface[TaskModel], filter task.Filter) gorm.ChainInterface[TaskModel] {
+	if filter.Completed != nil {
+		query = query.Where("completed = ?", *filter.Completed)
+	}
+
+	// The due range is half-open so that adjacent ranges never overlap.
+	if filter.DueAfter != nil {
+		query = query.Where("due_at >= ?", *filter.DueAfter)
+	}
+
+	if filter.DueBefore != nil {
+		query = query.Where("due_at < ?", *filter.DueBefore)
+	}
+
+	if filter.Tag != nil {
+		query = query.Where(`EXISTS (SELECT 1 FROM task_tags JOIN tags ON tags.id = task_tags.tag_id
+WHERE task_tags.task_id = tasks.id AND tags.name = ?)`, *filter.Tag)
+	}
+
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", filter.ProjectID.String())
+	}
+
+	if filter.WorkspaceID != nil {
+		query = query.Where("workspace_id = ?", filter.WorkspaceID.String())
+	}
+
+	if filter.AssigneeID != nil {
+		query = query.Where("assignee_id = ?", filter.AssigneeID.String())
+	}
+
+	for _, condition := range filter.Conditions {
+		query = applyCondition(query, condition)
+	}
+
+	return query
+}
+
+// applyCondition adds a single filter condition as a parameterized clause.
+// Conditions on unknown fields or operators are rejected by task.ParseCondition
+// and are ignored here.
+func applyCondition(query gorm.ChainInterface[TaskModel], condition task.Condition) gorm.ChainInterface[TaskModel] {
+	column, ok := filterColumns[condition.Field]
+	if !ok {
+		return query
+	}
+
+	if condition.Operator == task.OpContains {
+		value, _ := condition.Value.(string)
+
+		return query.Where(column+` ILIKE ? ESCAPE '\'`, "%"+likeEscaper.Replace(value)+"%")
+	}
+
+	operator, ok := comparisonOperators[condition.Operator]
+	if !ok {
+		return query
+	}
+
+	return query.Where(column+" "+operator+" ?", condition.Value)
+}
+
+// orderClause builds the ORDER BY clause for a sort, with id as the final tiebreaker.
+func orderClause(sort task.Sort) string {
+	parts := make([]string, 0, len(sort)+1)
+	for _, key := range sort {
+		parts = append(parts, sortColumns[key.Field]+" "+direction(key))
+	}
+
+	parts = append(parts, "id ASC")
+
+	return strings.Join(parts, ", ")
+}
+
+func direction(key task.SortKey) string {
+	if key.Descending {
+		return "DESC"
+	}
+
+	return "ASC"
+}
+
+// keysetCondition builds the condition selecting the rows after position in
+// the given sort. Because each key may have its own direction, the row value
+// comparison is expanded into
+// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... OR (k1 = v1 AND ... AND id > i),
+// with < in place of > for descending keys.
+func keysetCondition(sort task.Sort, position cursorPosition) (string, []any) {
+	columns := make([]string, 0, len(sort)+1)
+	comparators := make([]string, 0, len(sort)+1)
+
+	for _, key := range sort {
+		columns = append(columns, sortColumns[key.Field])
+
+		if key.Descending {
+			comparators = append(comparators, "<")
+		} else {
+			comparators = append(comparators, ">")
+		}
+	}
+
+	columns = append(columns, "id")
+	comparators = append(comparators, ">")
+	values := append(append(make([]any, 0, len(columns)), position.values...), position.ID)
+
+	var args []any
+
+	disjuncts := make([]string, len(columns))
+	for i := range columns {
+		terms := make([]string, 0, i+1)
+		for j := range i {
+			terms = append(terms, columns[j]+" = ?")
+			args = append(args, values[j])
+		}
+
+		terms = append(terms, columns[i]+" "+comparators[i]+" ?")
+		args = append(args, values[i])
+		disjuncts[i] = "(" + strings.Join(terms, " AND ") + ")"
+	}
+
+	return "(" + strings.Join(disjuncts, " OR ") + ")", args
+}
+
+// cursorPosition identifies the last task of a page in a sort.
+// Values holds the sort key values of the task in the order of the sort, as
+// RFC 3339 timestamps for time fields and verbatim for title and rank.
+type cursorPosition struct {
+	Sort   string   `json:"s"`
+	Values []string `json:"v"`
+	ID     string   `json:"i"`
+
+	values []any
+}
+
+// newCursorPosition captures the position of a record in a sort.
+func newCursorPosition(record TaskModel, sort task.Sort) cursorPosition {
+	values := make([]string, len(sort))
+	for i, key := range sort {
+		switch key.Field {
+		case task.SortByCreatedAt:
+			values[i] = record.CreatedAt.UTC().Format(time.RFC3339Nano)
+		case task.SortByUpdatedAt:
+			values[i] = record.UpdatedAt.UTC().Format(time.RFC3339Nano)
+		case task.SortByTitle:
+			values[i] = record.Title
+		case task.SortByRank:
+			values[i] = record.Rank
+		}
+	}
+
+	return cursorPosition{Sort: sort.String(), Values: values, ID: record.ID, values: nil}
+}
+
+// encodeCursor serialises a position into an opaque, URL-safe token.
+func encodeCursor(position cursorPosition) string {
+	raw, err := json.Marshal(position)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor parses a token produced by encodeCursor for the same sort.
+// It returns task.ErrInvalidCursor for any token it did not produce, including
+// tokens issued for a different sort.
+func decodeCursor(cursor string, sort task.Sort) (cursorPosition, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return cursorPosition{}, task.ErrInvalidCursor
+	}
+
+	var position cursorPosition
+	if err := json.Unmarshal(raw, &position); err != nil {
+		return cursorPosition{}, task.ErrInvalidCursor
+	}
+
+	if _, err := task.NewTaskID(position.ID); err != nil {
+		return cursorPosition{}, task.ErrInvalidCursor
+	}
+
+	if position.Sort != sort.String() || len(position.Values) != len(sort) {
+		return cursorPosition{}, task.ErrInvalidCursor
+	}
+
+	position.values = make([]any, len(sort))
+	for i, key := range sort {
+		if key.Field == task.SortByTitle || key.Field == task.SortByRank {
+			position.values[i] = position.Values[i]
+
+			continue
+		}
+
+		value, err := time.Parse(time.RFC3339Nano, position.Values[i])
+		if err != nil {
+			return cursorPosition{}, task.ErrInvalidCursor
+		}
+
+		position.values[i] = value
+	}
+
+	return position, nil
+}
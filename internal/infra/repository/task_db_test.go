@@ -8,6 +8,7 @@ import (
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
@@ -52,6 +53,7 @@ func TestTaskModel_ToDomain(t *testing.T) {
 	domainCreatorID1, _ := user.NewUserID(testCreatorID1)
 	domainTaskID2, _ := task.NewTaskID(testID2)
 	domainCreatorID2, _ := user.NewUserID(testCreatorID2)
+	completedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 
 	tests := []struct {
 		name        string
@@ -67,6 +69,7 @@ func TestTaskModel_ToDomain(t *testing.T) {
 				CreatorID: testCreatorID1,
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
+				Version:   task.InitialVersion,
 			},
 			expected:    task.NewTaskWithoutValidation(domainTaskID1, "Test Task", domainCreatorID1),
 			expectError: false,
@@ -79,6 +82,7 @@ func TestTaskModel_ToDomain(t *testing.T) {
 				CreatorID: testCreatorID1,
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
+				Version:   task.InitialVersion,
 			},
 			expected:    nil,
 			expectError: true,
@@ -91,6 +95,7 @@ func TestTaskModel_ToDomain(t *testing.T) {
 				CreatorID: "invalid-uuid",
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
+				Version:   task.InitialVersion,
 			},
 			expected:    nil,
 			expectError: true,
@@ -103,10 +108,55 @@ func TestTaskModel_ToDomain(t *testing.T) {
 				CreatorID: testCreatorID2,
 				CreatedAt: time.Now(),
 				UpdatedAt: time.Now(),
+				Version:   task.InitialVersion,
 			},
 			expected:    task.NewTaskWithoutValidation(domainTaskID2, "タスク with émojis 🚀", domainCreatorID2),
 			expectError: false,
 		},
+		{
+			name: "convert completed task",
+			taskModel: TaskModel{
+				ID:          testID1,
+				Title:       "Done Task",
+				CreatorID:   testCreatorID1,
+				Completed:   true,
+				CompletedAt: &completedAt,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+				Version:     task.InitialVersion,
+			},
+			expected:    task.NewTaskWithoutValidation(domainTaskID1, "Done Task", domainCreatorID1, task.WithCompletedAt(&completedAt)),
+			expectError: false,
+		},
+		{
+			name: "convert scheduled task",
+			taskModel: TaskModel{
+				ID:        testID1,
+				Title:     "Scheduled Task",
+				CreatorID: testCreatorID1,
+				DueAt:     &completedAt,
+				AllDay:    true,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+				Version:   task.InitialVersion,
+			},
+			expected: task.NewTaskWithoutValidation(domainTaskID1, "Scheduled Task", domainCreatorID1,
+				task.WithSchedule(task.NewScheduleWithoutValidation(nil, &completedAt, true))),
+			expectError: false,
+		},
+		{
+			name: "convert updated task",
+			taskModel: TaskModel{
+				ID:        testID1,
+				Title:     "Edited Task",
+				CreatorID: testCreatorID1,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+				Version:   4,
+			},
+			expected:    task.NewTaskWithoutValidation(domainTaskID1, "Edited Task", domainCreatorID1, task.WithVersion(4)),
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -126,7 +176,155 @@ func TestTaskModel_ToDomain(t *testing.T) {
 				assert.Equal(t, tt.expected.ID(), domainTask.ID())
 				assert.Equal(t, tt.expected.Title(), domainTask.Title())
 				assert.Equal(t, tt.expected.UserID(), domainTask.UserID())
+				assert.Equal(t, tt.expected.IsCompleted(), domainTask.IsCompleted())
+				assert.Equal(t, tt.expected.CompletedAt(), domainTask.CompletedAt())
+				assert.Equal(t, tt.expected.Schedule(), domainTask.Schedule())
+				assert.Equal(t, tt.expected.Version(), domainTask.Version())
 			}
 		})
 	}
 }
+
+func TestCursorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	sort := task.Sort{
+		{Field: task.SortByUpdatedAt, Descending: true},
+		{Field: task.SortByTitle, Descending: false},
+	}
+	record := TaskModel{ //nolint:exhaustruct
+		ID:        uuid.New().String(),
+		Title:     "Quarterly report",
+		UpdatedAt: time.Date(2024, 1, 15, 10, 30, 0, 123456000, time.UTC),
+	}
+
+	// Act
+	decoded, err := decodeCursor(encodeCursor(newCursorPosition(record, sort)), sort)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, record.ID, decoded.ID)
+	require.Len(t, decoded.values, 2)
+	assert.True(t, record.UpdatedAt.Equal(decoded.values[0].(time.Time)))
+	assert.Equal(t, record.Title, decoded.values[1])
+}
+
+func TestCursorRoundTrip_Rank(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	record := TaskModel{ //nolint:exhaustruct
+		ID:   uuid.New().String(),
+		Rank: "i00001",
+	}
+
+	// Act
+	decoded, err := decodeCursor(encodeCursor(newCursorPosition(record, task.DefaultSort)), task.DefaultSort)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, record.ID, decoded.ID)
+	assert.Equal(t, []any{record.Rank}, decoded.values)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	validID := uuid.New().String()
+	createdAtSort := task.Sort{{Field: task.SortByCreatedAt, Descending: false}}
+
+	tests := []struct {
+		name   string
+		cursor string
+		sort   task.Sort
+	}{
+		{
+			name:   "not base64",
+			cursor: "!!!",
+			sort:   task.DefaultSort,
+		},
+		{
+			name:   "not json",
+			cursor: "bm90LWpzb24",
+			sort:   task.DefaultSort,
+		},
+		{
+			name:   "invalid task ID",
+			cursor: encodeCursor(cursorPosition{Sort: "created_at", Values: []string{"2024-01-15T10:30:00Z"}, ID: "invalid-uuid"}), //nolint:exhaustruct
+			sort:   createdAtSort,
+		},
+		{
+			name:   "missing sort value",
+			cursor: encodeCursor(cursorPosition{Sort: "created_at", Values: nil, ID: validID}), //nolint:exhaustruct
+			sort:   createdAtSort,
+		},
+		{
+			name:   "invalid time value",
+			cursor: encodeCursor(cursorPosition{Sort: "created_at", Values: []string{"yesterday"}, ID: validID}), //nolint:exhaustruct
+			sort:   createdAtSort,
+		},
+		{
+			name:   "issued for a different sort",
+			cursor: encodeCursor(cursorPosition{Sort: "created_at", Values: []string{"2024-01-15T10:30:00Z"}, ID: validID}), //nolint:exhaustruct
+			sort:   task.Sort{{Field: task.SortByTitle, Descending: false}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			_, err := decodeCursor(tt.cursor, tt.sort)
+
+			// Assert
+			assert.ErrorIs(t, err, task.ErrInvalidCursor)
+		})
+	}
+}
+
+func TestOrderClause(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	sort := task.Sort{
+		{Field: task.SortByUpdatedAt, Descending: true},
+		{Field: task.SortByTitle, Descending: false},
+	}
+
+	// Act
+	clause := orderClause(sort)
+
+	// Assert
+	assert.Equal(t, "updated_at DESC, title ASC, id ASC", clause)
+}
+
+func TestKeysetCondition(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	updatedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	sort := task.Sort{
+		{Field: task.SortByUpdatedAt, Descending: true},
+		{Field: task.SortByTitle, Descending: false},
+	}
+	position := cursorPosition{ //nolint:exhaustruct
+		ID:     "68f8fade-87ee-11f0-9e3a-edcdb15c45d3",
+		values: []any{updatedAt, "Report"},
+	}
+
+	// Act
+	condition, args := keysetCondition(sort, position)
+
+	// Assert
+	assert.Equal(t,
+		"((updated_at < ?) OR (updated_at = ? AND title > ?) OR (updated_at = ? AND title = ? AND id > ?))",
+		condition,
+	)
+	assert.Equal(t, []any{
+		updatedAt,
+		updatedAt, "Report",
+		updatedAt, "Report", position.ID,
+	}, args)
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// TaskDependencyModel represents a "blocks" relation between two tasks of the
+// same user. Rows are removed together with either task.
+type TaskDependencyModel struct {
+	BlockedID string     `gorm:"primaryKey;type:varchar(36)"`
+	BlockerID string     `gorm:"primaryKey;type:varchar(36);index"`
+	Blocked   *TaskModel `gorm:"foreignKey:BlockedID;constraint:OnDelete:CASCADE"`
+	Blocker   *TaskModel `gorm:"foreignKey:BlockerID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName returns the database table name for TaskDependencyModel.
+func (TaskDependencyModel) TableName() string {
+	return "task_dependencies"
+}
+
+// ToDomain converts a TaskDependencyModel to a domain Dependency.
+func (m TaskDependencyModel) ToDomain() (task.Dependency, error) {
+	blockerID, err := task.NewTaskID(m.BlockerID)
+	if err != nil {
+		return task.Dependency{}, err
+	}
+
+	blockedID, err := task.NewTaskID(m.BlockedID)
+	if err != nil {
+		return task.Dependency{}, err
+	}
+
+	return task.NewDependency(blockerID, blockedID)
+}
+
+// blockersSQL reads the tasks that block a task, skipping those in the trash.
+const blockersSQL = `SELECT tasks.* FROM tasks
+JOIN task_dependencies ON task_dependencies.blocker_id = tasks.id
+WHERE task_dependencies.blocked_id = ? AND tasks.creator_id = ? AND tasks.deleted_at IS NULL
+ORDER BY tasks.created_at ASC, tasks.id ASC`
+
+func (t *TaskDB) FindBlockers(ctx context.Context, creatorID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	taskRecords, err := gorm.G[TaskModel](t.db).Raw(blockersSQL, id.String(), creatorID.String()).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.toDomainTasks(ctx, taskRecords)
+}
+
+// lockDependenciesSQL serialises dependency changes of a user until the
+// transaction ends, so that two concurrent inserts cannot each miss the
+// dependency added by the other.
+const lockDependenciesSQL = `SELECT pg_advisory_xact_lock(hashtext('task_dependencies:' || ?))`
+
+// reachableDependenciesSQL reads the dependencies that can be followed from a
+// task, which are the only ones a new dependency on that task can close a
+// cycle with. UNION stops the walk should the stored dependencies ever form a
+// cycle. Dependencies on trashed tasks are kept, as those tasks can be restored.
+const reachableDependenciesSQL = `WITH RECURSIVE reachable (blocker_id, blocked_id) AS (
+	SELECT blocker_id, blocked_id FROM task_dependencies WHERE blocker_id = @id
+	UNION
+	SELECT next.blocker_id, next.blocked_id FROM task_dependencies AS next
+	JOIN reachable ON next.blocker_id = reachable.blocked_id
+)
+SELECT blocker_id, blocked_id FROM reachable`
+
+// addDependencySQL inserts a dependency unless it exists already.
+const addDependencySQL = `INSERT INTO task_dependencies (blocked_id, blocker_id, created_at)
+VALUES (@blocked_id, @blocker_id, now())
+ON CONFLICT (blocked_id, blocker_id) DO NOTHING`
+
+// AddDependency checks the dependency against the dependencies reachable from
+// the blocked task and inserts it within one transaction.
+func (t *TaskDB) AddDependency(ctx context.Context, creatorID user.UserID, dependency task.Dependency) error {
+	if creatorID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	blockerID := dependency.BlockerID().String()
+	blockedID := dependency.BlockedID().String()
+
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := gorm.G[TaskDependencyModel](tx).Exec(ctx, lockDependenciesSQL, creatorID.String()); err != nil {
+			return err
+		}
+
+		found, err := gorm.G[TaskModel](tx).
+			Where("id IN ? AND creator_id = ?", []string{blockerID, blockedID}, creatorID.String()).
+			Count(ctx, "id")
+		if err != nil {
+			return err
+		}
+
+		if found != 2 {
+			return task.ErrTaskNotFound
+		}
+
+		records, err := gorm.G[TaskDependencyModel](tx).Raw(reachableDependenciesSQL, map[string]any{"id": blockedID}).Find(ctx)
+		if err != nil {
+			return err
+		}
+
+		dependencies := make([]task.Dependency, len(records))
+		for i, record := range records {
+			if dependencies[i], err = record.ToDomain(); err != nil {
+				return err
+			}
+		}
+
+		if err := task.NewDependencyGraph(dependencies).Add(dependency); err != nil {
+			return err
+		}
+
+		return gorm.G[TaskDependencyModel](tx).Exec(ctx, addDependencySQL, map[string]any{
+			"blocked_id": blockedID,
+			"blocker_id": blockerID,
+		})
+	})
+}
+
+func (t *TaskDB) RemoveDependency(ctx context.Context, creatorID user.UserID, dependency task.Dependency) error {
+	if creatorID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	rowsAffected, err := gorm.G[TaskDependencyModel](t.db).
+		Where("blocked_id = ? AND blocker_id = ?", dependency.BlockedID().String(), dependency.BlockerID().String()).
+		Where("blocked_id IN (SELECT id FROM tasks WHERE creator_id = ?)", creatorID.String()).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return task.ErrDependencyNotFound
+	}
+
+	return nil
+}
+
+// blockedTasksSQL reads which of a set of tasks are blocked by a task that is
+// neither completed nor in the trash.
+const blockedTasksSQL = `SELECT DISTINCT task_dependencies.blocked_id FROM task_dependencies
+JOIN tasks AS blocker ON blocker.id = task_dependencies.blocker_id
+WHERE task_dependencies.blocked_id IN ? AND blocker.completed = false AND blocker.deleted_at IS NULL`
+
+// blockedTaskRow is a blocked task, as read by blockedTasksSQL.
+type blockedTaskRow struct {
+	BlockedID string
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// TaskEventModel represents an entry in the history of a task. Seq orders the
+// entries of all tasks and is the position page cursors refer to.
+// Rows are only ever inserted, in the transaction of the write that made the
+// change, and are removed together with the task. Changes of the manual order
+// are not recorded.
+type TaskEventModel struct {
+	Seq        int64      `gorm:"primaryKey;autoIncrement;index:idx_task_events_task_id_seq,priority:2"`
+	TaskID     string     `gorm:"not null;type:varchar(36);index:idx_task_events_task_id_seq,priority:1"`
+	Type       string     `gorm:"not null;type:varchar(32)"`
+	ActorID    string     `gorm:"not null;type:varchar(255)"`
+	Before     *string    `gorm:"type:text"`
+	After      *string    `gorm:"type:text"`
+	OccurredAt time.Time  `gorm:"not null;type:timestamptz"`
+	Task       *TaskModel `gorm:"foreignKey:TaskID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the database table name for TaskEventModel.
+func (TaskEventModel) TableName() string {
+	return "task_events"
+}
+
+// ToDomain converts a TaskEventModel to a domain Change.
+func (m TaskEventModel) ToDomain() (task.Change, error) {
+	taskID, err := task.NewTaskID(m.TaskID)
+	if err != nil {
+		return task.Change{}, err
+	}
+
+	changeType, err := task.ParseChangeType(m.Type)
+	if err != nil {
+		return task.Change{}, err
+	}
+
+	actorID, err := user.NewUserID(m.ActorID)
+	if err != nil {
+		return task.Change{}, err
+	}
+
+	return task.NewChange(taskID, changeType, actorID, m.Before, m.After, m.OccurredAt)
+}
+
+// newTaskEventModel converts a domain Change to a TaskEventModel whose Seq is
+// assigned by the database.
+func newTaskEventModel(change task.Change) TaskEventModel {
+	return TaskEventModel{ //nolint:exhaustruct
+		TaskID:     change.TaskID().String(),
+		Type:       string(change.Type()),
+		ActorID:    change.ActorID().String(),
+		Before:     change.Before(),
+		After:      change.After(),
+		OccurredAt: change.OccurredAt(),
+	}
+}
+
+// recordChanges appends the changes to the history of their tasks and writes
+// them to the webhook outbox in the same transaction.
+func recordChanges(ctx context.Context, tx *gorm.DB, changes []task.Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	eventModels := make([]TaskEventModel, len(changes))
+	for i, change := range changes {
+		eventModels[i] = newTaskEventModel(change)
+	}
+
+	if err := gorm.G[TaskEventModel](tx).Omit(clause.Associations).CreateInBatches(ctx, &eventModels, len(eventModels)); err != nil {
+		return err
+	}
+
+	return enqueueWebhookEvents(ctx, tx, changes)
+}
+
+// lockStoredTask reads the stored state of the task together with its tags
+// and locks the row for the rest of the transaction. It returns
+// gorm.ErrRecordNotFound if the task is not at the version of taskEntity.
+func lockStoredTask(ctx context.Context, tx *gorm.DB, taskEntity *task.Task) (*task.Task, error) {
+	taskRecord, err := gorm.G[TaskModel](tx, clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where("id = ? AND creator_id = ? AND version = ?", taskEntity.ID().String(), taskEntity.UserID().String(), taskEntity.Version()).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gorm.G[taskTagRow](tx).Raw(taskTagsSQL, []string{taskRecord.ID}).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		taskRecord.Tags = append(taskRecord.Tags, row.Name)
+	}
+
+	return taskRecord.ToDomain()
+}
+
+// FindHistory reads one row more than the page holds to learn whether another
+// page follows without a separate count.
+func (t *TaskDB) FindHistory(ctx context.Context, id task.TaskID, page task.PageRequest) (task.HistoryPage, error) {
+	if id.IsEmpty() {
+		return task.HistoryPage{}, task.ErrTaskIDEmpty
+	}
+
+	query := gorm.G[TaskEventModel](t.db).Where("task_id = ?", id.String())
+
+	if page.Cursor() != "" {
+		seq, err := decodeHistoryCursor(page.Cursor())
+		if err != nil {
+			return task.HistoryPage{}, err
+		}
+
+		query = query.Where("seq > ?", seq)
+	}
+
+	eventRecords, err := query.Order("seq ASC").Limit(page.Limit() + 1).Find(ctx)
+	if err != nil {
+		return task.HistoryPage{}, err
+	}
+
+	nextCursor := ""
+	if len(eventRecords) > page.Limit() {
+		eventRecords = eventRecords[:page.Limit()]
+		nextCursor = encodeHistoryCursor(eventRecords[len(eventRecords)-1].Seq)
+	}
+
+	changes := make([]task.Change, len(eventRecords))
+	for i, record := range eventRecords {
+		if changes[i], err = record.ToDomain(); err != nil {
+			return task.HistoryPage{}, err
+		}
+	}
+
+	return task.HistoryPage{Changes: changes, NextCursor: nextCursor}, nil
+}
+
+// encodeHistoryCursor serialises the position of the last change of a page
+// into an opaque, URL-safe token.
+func encodeHistoryCursor(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(seq, 10)))
+}
+
+// decodeHistoryCursor parses a token produced by encodeHistoryCursor.
+// It returns task.ErrInvalidCursor for any token it did not produce.
+func decodeHistoryCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, task.ErrInvalidCursor
+	}
+
+	seq, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || seq <= 0 {
+		return 0, task.ErrInvalidCursor
+	}
+
+	return seq, nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryCursorRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	seq, err := decodeHistoryCursor(encodeHistoryCursor(42))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), seq)
+}
+
+func TestDecodeHistoryCursor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "!!!"},
+		{name: "not a number", cursor: "bm90LWEtbnVtYmVy"},
+		{name: "not positive", cursor: encodeHistoryCursor(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			_, err := decodeHistoryCursor(tt.cursor)
+
+			// Assert
+			assert.ErrorIs(t, err, task.ErrInvalidCursor)
+		})
+	}
+}
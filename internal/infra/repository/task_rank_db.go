@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// lockRanksSQL serialises rank changes of a user until the transaction ends,
+// so that two tasks appended or moved at the same time cannot be given the
+// same rank.
+const lockRanksSQL = `SELECT pg_advisory_xact_lock(hashtext('task_ranks:' || ?))`
+
+// moveTaskSQL gives a task a new rank. The version is incremented because the
+// rank is part of the representation of the task.
+const moveTaskSQL = `UPDATE tasks SET rank = @rank, version = version + 1, updated_at = now() WHERE id = @id`
+
+// rebalanceTaskSQL gives a task the rank it is spread to by a rebalance.
+// updated_at is left alone because the order of the tasks does not change.
+const rebalanceTaskSQL = `UPDATE tasks SET rank = @rank, version = version + 1 WHERE id = @id`
+
+// rebalanceCreatorsSQL reads the users that have a rank longer than the limit.
+const rebalanceCreatorsSQL = `SELECT DISTINCT creator_id FROM tasks WHERE length(rank) > ?`
+
+// rebalanceCreatorRow is a user whose ranks need a rebalance, as read by rebalanceCreatorsSQL.
+type rebalanceCreatorRow struct {
+	CreatorID string
+}
+
+// appendRank returns the rank after the last task of the user. Tasks in the
+// trash are included so that a restored task keeps a rank of its own.
+// It takes the rank lock of the user for the rest of the transaction.
+func appendRank(ctx context.Context, tx *gorm.DB, creatorID string) (task.Rank, error) {
+	if err := gorm.G[TaskModel](tx).Exec(ctx, lockRanksSQL, creatorID); err != nil {
+		return "", err
+	}
+
+	last, err := gorm.G[TaskModel](tx).
+		Scopes(unscoped).
+		Where("creator_id = ?", creatorID).
+		Order("rank DESC").
+		First(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
+	}
+
+	return task.RankBetween(task.Rank(last.Rank), "")
+}
+
+// Move gives the task a rank between its new neighbours and returns it with
+// an incremented version. A task placed after a neighbour only is put between
+// that neighbour and the task that followed it, and likewise for a task placed
+// before a neighbour only, so that only the moved task is written.
+func (t *TaskDB) Move(ctx context.Context, creatorID user.UserID, id task.TaskID, placement task.Placement) (*task.Task, error) {
+	if creatorID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, task.ErrTaskIDEmpty
+	}
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := gorm.G[TaskModel](tx).Exec(ctx, lockRanksSQL, creatorID.String()); err != nil {
+			return err
+		}
+
+		found, err := gorm.G[TaskModel](tx).
+			Where("id = ? AND creator_id = ?", id.String(), creatorID.String()).
+			Count(ctx, "id")
+		if err != nil {
+			return err
+		}
+
+		if found == 0 {
+			return task.ErrTaskNotFound
+		}
+
+		lower, upper, err := neighbourRanks(ctx, tx, creatorID, id, placement)
+		if err != nil {
+			return err
+		}
+
+		rank, err := task.RankBetween(lower, upper)
+		if err != nil {
+			return err
+		}
+
+		return gorm.G[TaskModel](tx).Exec(ctx, moveTaskSQL, map[string]any{
+			"rank": rank.String(),
+			"id":   id.String(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t.FindById(ctx, creatorID, id)
+}
+
+// neighbourRanks returns the ranks the moved task is placed between. A missing
+// neighbour is replaced by the closest task on that side of the given one, or
+// left open if there is none. The moved task itself is skipped.
+// It returns ErrNeighbourNotFound if a given neighbour does not exist or is in the trash.
+func neighbourRanks(ctx context.Context, tx *gorm.DB, creatorID user.UserID, id task.TaskID, placement task.Placement) (task.Rank, task.Rank, error) {
+	var lower, upper task.Rank
+
+	if placement.AfterID() != nil {
+		rank, err := neighbourRank(ctx, tx, creatorID, *placement.AfterID())
+		if err != nil {
+			return "", "", err
+		}
+
+		lower = rank
+	}
+
+	if placement.BeforeID() != nil {
+		rank, err := neighbourRank(ctx, tx, creatorID, *placement.BeforeID())
+		if err != nil {
+			return "", "", err
+		}
+
+		upper = rank
+	}
+
+	query := gorm.G[TaskModel](tx).
+		Scopes(unscoped).
+		Where("creator_id = ? AND id <> ?", creatorID.String(), id.String())
+
+	switch {
+	case placement.BeforeID() == nil:
+		next, err := query.Where("rank > ?", lower.String()).Order("rank ASC").First(ctx)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", "", err
+		}
+
+		upper = task.Rank(next.Rank)
+	case placement.AfterID() == nil:
+		previous, err := query.Where("rank < ?", upper.String()).Order("rank DESC").First(ctx)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", "", err
+		}
+
+		lower = task.Rank(previous.Rank)
+	}
+
+	return lower, upper, nil
+}
+
+// neighbourRank returns the rank of a neighbour of a moved task.
+func neighbourRank(ctx context.Context, tx *gorm.DB, creatorID user.UserID, id task.TaskID) (task.Rank, error) {
+	neighbour, err := gorm.G[TaskModel](tx).
+		Where("id = ? AND creator_id = ?", id.String(), creatorID.String()).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", task.ErrNeighbourNotFound
+		}
+
+		return "", err
+	}
+
+	return task.Rank(neighbour.Rank), nil
+}
+
+// RebalanceRanks spreads out the ranks of every user that has a task with a
+// rank longer than maxLength, keeping the order of their tasks, and returns
+// how many tasks were given a new rank. Each user is rebalanced in a
+// transaction of its own.
+func (t *TaskDB) RebalanceRanks(ctx context.Context, maxLength int) (int64, error) {
+	creators, err := gorm.G[rebalanceCreatorRow](t.db).Raw(rebalanceCreatorsSQL, maxLength).Find(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var rebalanced int64
+
+	for _, creator := range creators {
+		err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := gorm.G[TaskModel](tx).Exec(ctx, lockRanksSQL, creator.CreatorID); err != nil {
+				return err
+			}
+
+			taskRecords, err := gorm.G[TaskModel](tx).
+				Scopes(unscoped).
+				Select("id").
+				Where("creator_id = ?", creator.CreatorID).
+				Order("rank ASC, id ASC").
+				Find(ctx)
+			if err != nil {
+				return err
+			}
+
+			ranks := task.SpreadRanks(len(taskRecords))
+			for i, record := range taskRecords {
+				err := gorm.G[TaskModel](tx).Exec(ctx, rebalanceTaskSQL, map[string]any{
+					"rank": ranks[i].String(),
+					"id":   record.ID,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			rebalanced += int64(len(taskRecords))
+
+			return nil
+		})
+		if err != nil {
+			return rebalanced, err
+		}
+	}
+
+	return rebalanced, nil
+}
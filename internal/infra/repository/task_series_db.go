@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+)
+
+// TaskSeriesModel represents the database model for series of recurring tasks.
+// RecurrenceStart is the first occurrence the rule is counted from. Tasks
+// refer to their series through TaskModel.SeriesID.
+type TaskSeriesModel struct {
+	ID                 string    `gorm:"primaryKey;type:varchar(36)"`
+	CreatorID          string    `gorm:"not null;type:varchar(255);index"`
+	Title              string    `gorm:"not null;type:varchar(255)"`
+	RecurrenceRule     string    `gorm:"not null;type:varchar(500)"`
+	RecurrenceTimezone string    `gorm:"not null;type:varchar(64)"`
+	RecurrenceStart    time.Time `gorm:"not null;type:timestamptz"`
+	CreatedAt          time.Time `gorm:"autoCreateTime"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName returns the database table name for TaskSeriesModel.
+func (TaskSeriesModel) TableName() string {
+	return "task_series"
+}
+
+// ToDomain converts a TaskSeriesModel to a domain Series.
+func (m TaskSeriesModel) ToDomain() (*task.Series, error) {
+	seriesID, err := task.NewSeriesID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	creatorID, err := user.NewUserID(m.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrence, err := task.NewRecurrence(m.RecurrenceRule, m.RecurrenceTimezone, m.RecurrenceStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return task.NewSeriesWithoutValidation(seriesID, creatorID, m.Title, recurrence), nil
+}
+
+// newTaskSeriesModel converts a domain Series to a TaskSeriesModel.
+// It returns nil for a task that does not recur.
+func newTaskSeriesModel(series *task.Series) *TaskSeriesModel {
+	if series == nil {
+		return nil
+	}
+
+	return &TaskSeriesModel{ //nolint:exhaustruct
+		ID:                 series.ID().String(),
+		CreatorID:          series.UserID().String(),
+		Title:              series.Title(),
+		RecurrenceRule:     series.Recurrence().Rule(),
+		RecurrenceTimezone: series.Recurrence().Timezone(),
+		RecurrenceStart:    series.Recurrence().Start(),
+	}
+}
+
+// createSeriesSQL inserts a series unless it is already stored, as it is for
+// every occurrence after the first.
+const createSeriesSQL = `INSERT INTO task_series (id, creator_id, title, recurrence_rule, recurrence_timezone, recurrence_start, created_at, updated_at)
+VALUES (@id, @creator_id, @title, @rule, @timezone, @start, now(), now())
+ON CONFLICT (id) DO NOTHING`
+
+// createSeries stores the series of a task model if it has one.
+func createSeries(ctx context.Context, tx *gorm.DB, taskModel *TaskModel) error {
+	if taskModel.Series == nil {
+		return nil
+	}
+
+	return gorm.G[TaskSeriesModel](tx).Exec(ctx, createSeriesSQL, map[string]any{
+		"id":         taskModel.Series.ID,
+		"creator_id": taskModel.Series.CreatorID,
+		"title":      taskModel.Series.Title,
+		"rule":       taskModel.Series.RecurrenceRule,
+		"timezone":   taskModel.Series.RecurrenceTimezone,
+		"start":      taskModel.Series.RecurrenceStart,
+	})
+}
+
+// CompleteOccurrence writes the completed occurrence with the same version
+// check as Update and stores the next occurrence in the same transaction.
+func (t *TaskDB) CompleteOccurrence(ctx context.Context, actorID user.UserID, taskEntity *task.Task, next *task.Task) (*task.Task, error) {
+	taskModel := newTaskModel(taskEntity)
+	taskModel.Version = taskEntity.Version() + 1
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := t.updateTask(ctx, tx, actorID, taskEntity, taskModel); err != nil {
+			return err
+		}
+
+		if next == nil || taskModel.SeriesID == nil {
+			return nil
+		}
+
+		// Occurrences in the trash count as well, as they can be restored.
+		later, err := gorm.G[TaskModel](tx).
+			Scopes(unscoped).
+			Where("series_id = ? AND id <> ? AND COALESCE(due_at, start_at) > ?", *taskModel.SeriesID, taskModel.ID, taskEntity.Schedule().OccursAt()).
+			Count(ctx, "id")
+		if err != nil {
+			return err
+		}
+
+		if later > 0 {
+			return nil
+		}
+
+		if err := createTask(ctx, tx, newTaskModel(next)); err != nil {
+			return err
+		}
+
+		return recordChanges(ctx, tx, []task.Change{task.CreatedChange(next, actorID, time.Now())})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return taskModel.ToDomain()
+}
+
+// renameOccurrencesSQL gives the title of a series to its open occurrences
+// and returns the title each of them had before.
+// Their version is incremented so that ETags handed out before no longer match.
+const renameOccurrencesSQL = `UPDATE tasks SET title = @title, version = version + 1, updated_at = now()
+FROM (
+	SELECT id, title FROM tasks
+	WHERE series_id = @series_id AND creator_id = @creator_id
+	AND completed = false AND deleted_at IS NULL AND title <> @title
+	FOR UPDATE
+) AS previous
+WHERE tasks.id = previous.id
+RETURNING tasks.id, previous.title`
+
+// renamedOccurrenceRow is an occurrence renamed by renameOccurrencesSQL.
+type renamedOccurrenceRow struct {
+	ID    string
+	Title string
+}
+
+// UpdateSeries writes the series and renames its open occurrences in one transaction.
+func (t *TaskDB) UpdateSeries(ctx context.Context, series *task.Series) error {
+	seriesModel := newTaskSeriesModel(series)
+
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rowsAffected, err := gorm.G[TaskSeriesModel](tx).
+			Where("id = ? AND creator_id = ?", seriesModel.ID, seriesModel.CreatorID).
+			Select("title", "recurrence_rule", "recurrence_timezone", "recurrence_start").
+			Updates(ctx, *seriesModel)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return task.ErrNotRecurring
+		}
+
+		rows, err := gorm.G[renamedOccurrenceRow](tx).Raw(renameOccurrencesSQL, map[string]any{
+			"title":      seriesModel.Title,
+			"series_id":  seriesModel.ID,
+			"creator_id": seriesModel.CreatorID,
+		}).Find(ctx)
+		if err != nil {
+			return err
+		}
+
+		renamedAt := time.Now()
+		title := series.Title()
+		changes := make([]task.Change, len(rows))
+
+		for i, row := range rows {
+			taskID, err := task.NewTaskID(row.ID)
+			if err != nil {
+				return err
+			}
+
+			changes[i], err = task.NewChange(taskID, task.ChangeTitleChanged, series.UserID(), &row.Title, &title, renamedAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return recordChanges(ctx, tx, changes)
+	})
+}
+
+// loadSeries reads the series the task records are occurrences of with one
+// query and attaches them to the records.
+func (t *TaskDB) loadSeries(ctx context.Context, taskRecords []TaskModel) error {
+	ids := make([]string, 0, len(taskRecords))
+	for _, record := range taskRecords {
+		if record.SeriesID != nil {
+			ids = append(ids, *record.SeriesID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	seriesRecords, err := gorm.G[TaskSeriesModel](t.db).Where("id IN ?", ids).Find(ctx)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*TaskSeriesModel, len(seriesRecords))
+	for i := range seriesRecords {
+		byID[seriesRecords[i].ID] = &seriesRecords[i]
+	}
+
+	for i := range taskRecords {
+		if taskRecords[i].SeriesID != nil {
+			taskRecords[i].Series = byID[*taskRecords[i].SeriesID]
+		}
+	}
+
+	return nil
+}
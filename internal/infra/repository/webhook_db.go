@@ -0,0 +1,469 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+)
+
+// eventTypeSeparator joins the event types a webhook subscribes to in EventTypes.
+const eventTypeSeparator = ","
+
+// WebhookModel represents the database model for webhooks. EventTypes holds
+// the subscribed event types separated by commas, or an empty string for all.
+type WebhookModel struct {
+	ID           string     `gorm:"primaryKey;type:varchar(36)"`
+	UserID       string     `gorm:"not null;type:varchar(255);index"`
+	URL          string     `gorm:"not null;type:varchar(2048)"`
+	Secret       string     `gorm:"not null;type:varchar(256)"`
+	EventTypes   string     `gorm:"not null;type:text"`
+	FailureCount int        `gorm:"not null;default:0"`
+	DisabledAt   *time.Time `gorm:"type:timestamptz"`
+	CreatedAt    time.Time  `gorm:"not null;type:timestamptz"`
+	UpdatedAt    time.Time  `gorm:"autoUpdateTime"`
+}
+
+// TableName returns the database table name for WebhookModel.
+func (WebhookModel) TableName() string {
+	return "webhooks"
+}
+
+// ToDomain converts a WebhookModel to a domain Webhook entity.
+func (m WebhookModel) ToDomain() (*webhook.Webhook, error) {
+	webhookID, err := webhook.NewWebhookID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := user.NewUserID(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventTypes []webhook.EventType
+
+	if m.EventTypes != "" {
+		for name := range strings.SplitSeq(m.EventTypes, eventTypeSeparator) {
+			eventTypes = append(eventTypes, webhook.EventType(name))
+		}
+	}
+
+	return webhook.NewWebhookWithoutValidation(webhookID, ownerID, m.URL, m.Secret, eventTypes, m.CreatedAt,
+		webhook.WithFailureCount(m.FailureCount), webhook.WithDisabledAt(m.DisabledAt)), nil
+}
+
+// newWebhookModel converts a domain Webhook entity to a WebhookModel.
+func newWebhookModel(webhookEntity *webhook.Webhook) *WebhookModel {
+	names := make([]string, 0, len(webhookEntity.EventTypes()))
+	for _, eventType := range webhookEntity.EventTypes() {
+		names = append(names, string(eventType))
+	}
+
+	return &WebhookModel{ //nolint:exhaustruct
+		ID:           webhookEntity.ID().String(),
+		UserID:       webhookEntity.OwnerID().String(),
+		URL:          webhookEntity.URL(),
+		Secret:       webhookEntity.Secret(),
+		EventTypes:   strings.Join(names, eventTypeSeparator),
+		FailureCount: webhookEntity.FailureCount(),
+		DisabledAt:   webhookEntity.DisabledAt(),
+		CreatedAt:    webhookEntity.CreatedAt(),
+	}
+}
+
+// WebhookDeliveryModel represents an event on its way to one webhook.
+// Deliveries are removed together with their webhook.
+type WebhookDeliveryModel struct {
+	ID            string        `gorm:"primaryKey;type:varchar(36)"`
+	WebhookID     string        `gorm:"not null;type:varchar(36);index"`
+	EventID       string        `gorm:"not null;type:varchar(36)"`
+	EventType     string        `gorm:"not null;type:varchar(64)"`
+	Payload       string        `gorm:"not null;type:text"`
+	Status        string        `gorm:"not null;type:varchar(16);index:idx_webhook_deliveries_status_next_attempt_at,priority:1"`
+	Attempts      int           `gorm:"not null;default:0"`
+	NextAttemptAt time.Time     `gorm:"not null;type:timestamptz;index:idx_webhook_deliveries_status_next_attempt_at,priority:2"`
+	LastError     string        `gorm:"not null;type:text;default:''"`
+	CreatedAt     time.Time     `gorm:"not null;type:timestamptz"`
+	UpdatedAt     time.Time     `gorm:"autoUpdateTime"`
+	Webhook       *WebhookModel `gorm:"foreignKey:WebhookID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the database table name for WebhookDeliveryModel.
+func (WebhookDeliveryModel) TableName() string {
+	return "webhook_deliveries"
+}
+
+// ToDomain converts a WebhookDeliveryModel to a domain Delivery.
+func (m WebhookDeliveryModel) ToDomain() (*webhook.Delivery, error) {
+	deliveryID, err := webhook.NewDeliveryID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookID, err := webhook.NewWebhookID(m.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook.NewDeliveryWithoutValidation(deliveryID, webhookID, m.EventID, webhook.EventType(m.EventType), []byte(m.Payload), m.CreatedAt,
+		webhook.WithStatus(webhook.DeliveryStatus(m.Status)),
+		webhook.WithAttempts(m.Attempts),
+		webhook.WithNextAttemptAt(m.NextAttemptAt),
+		webhook.WithLastError(m.LastError)), nil
+}
+
+// newWebhookDeliveryModel converts a domain Delivery to a WebhookDeliveryModel.
+func newWebhookDeliveryModel(delivery *webhook.Delivery) WebhookDeliveryModel {
+	return WebhookDeliveryModel{ //nolint:exhaustruct
+		ID:            delivery.ID().String(),
+		WebhookID:     delivery.WebhookID().String(),
+		EventID:       delivery.EventID(),
+		EventType:     string(delivery.EventType()),
+		Payload:       string(delivery.Payload()),
+		Status:        string(delivery.Status()),
+		Attempts:      delivery.Attempts(),
+		NextAttemptAt: delivery.NextAttemptAt(),
+		LastError:     delivery.LastError(),
+		CreatedAt:     delivery.CreatedAt(),
+	}
+}
+
+// WebhookAttemptModel records one attempt of a delivery. StatusCode is nil
+// if no response was received and Error is nil if the attempt succeeded.
+type WebhookAttemptModel struct {
+	ID          int64                 `gorm:"primaryKey;autoIncrement"`
+	DeliveryID  string                `gorm:"not null;type:varchar(36);index"`
+	AttemptedAt time.Time             `gorm:"not null;type:timestamptz"`
+	DurationMs  int64                 `gorm:"not null"`
+	StatusCode  *int                  `gorm:""`
+	Error       *string               `gorm:"type:text"`
+	Delivery    *WebhookDeliveryModel `gorm:"foreignKey:DeliveryID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the database table name for WebhookAttemptModel.
+func (WebhookAttemptModel) TableName() string {
+	return "webhook_attempts"
+}
+
+// newWebhookAttemptModel converts a domain Attempt of the delivery to a WebhookAttemptModel.
+func newWebhookAttemptModel(deliveryID webhook.DeliveryID, attempt webhook.Attempt) *WebhookAttemptModel {
+	model := &WebhookAttemptModel{ //nolint:exhaustruct
+		DeliveryID:  deliveryID.String(),
+		AttemptedAt: attempt.AttemptedAt(),
+		DurationMs:  attempt.Duration().Milliseconds(),
+	}
+
+	if statusCode := attempt.StatusCode(); statusCode != 0 {
+		model.StatusCode = &statusCode
+	}
+
+	if message := attempt.Error(); message != "" {
+		model.Error = &message
+	}
+
+	return model
+}
+
+// WebhookDB implements the WebhookRepository interface using GORM for database operations.
+type WebhookDB struct {
+	db *gorm.DB
+}
+
+// NewWebhookDB creates a new WebhookDB instance with the provided GORM database connection.
+func NewWebhookDB(db *gorm.DB) *WebhookDB {
+	return &WebhookDB{db: db}
+}
+
+func (r *WebhookDB) FindById(ctx context.Context, ownerID user.UserID, id webhook.WebhookID) (*webhook.Webhook, error) {
+	if ownerID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return nil, webhook.ErrWebhookIDEmpty
+	}
+
+	webhookRecord, err := gorm.G[WebhookModel](r.db).Where("id = ? AND user_id = ?", id.String(), ownerID.String()).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, webhook.ErrWebhookNotFound
+		}
+
+		return nil, err
+	}
+
+	return webhookRecord.ToDomain()
+}
+
+func (r *WebhookDB) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*webhook.Webhook, error) {
+	if ownerID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	webhookRecords, err := gorm.G[WebhookModel](r.db).Where("user_id = ?", ownerID.String()).Order("created_at ASC, id ASC").Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainWebhooks(webhookRecords)
+}
+
+func (r *WebhookDB) Create(ctx context.Context, webhookEntity *webhook.Webhook) (*webhook.Webhook, error) {
+	if err := gorm.G[WebhookModel](r.db).Create(ctx, newWebhookModel(webhookEntity)); err != nil {
+		return nil, err
+	}
+
+	return webhookEntity, nil
+}
+
+func (r *WebhookDB) Update(ctx context.Context, webhookEntity *webhook.Webhook) (*webhook.Webhook, error) {
+	webhookModel := newWebhookModel(webhookEntity)
+
+	rowsAffected, err := gorm.G[WebhookModel](r.db).
+		Where("id = ? AND user_id = ?", webhookModel.ID, webhookModel.UserID).
+		Select("url", "secret", "event_types", "failure_count", "disabled_at").
+		Updates(ctx, *webhookModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, webhook.ErrWebhookNotFound
+	}
+
+	return webhookEntity, nil
+}
+
+func (r *WebhookDB) Delete(ctx context.Context, ownerID user.UserID, id webhook.WebhookID) error {
+	if ownerID.IsEmpty() {
+		return user.ErrUserIDEmpty
+	}
+
+	if id.IsEmpty() {
+		return webhook.ErrWebhookIDEmpty
+	}
+
+	rowsAffected, err := gorm.G[WebhookModel](r.db).Where("id = ? AND user_id = ?", id.String(), ownerID.String()).Delete(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return webhook.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// DispatchEvents locks the events it takes with SKIP LOCKED, so that several
+// workers can dispatch at once without creating a delivery twice.
+func (r *WebhookDB) DispatchEvents(ctx context.Context, limit int) (int, error) {
+	dispatched := 0
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		eventRecords, err := gorm.G[WebhookOutboxModel](tx, clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Order("seq ASC").
+			Limit(limit).
+			Find(ctx)
+		if err != nil || len(eventRecords) == 0 {
+			return err
+		}
+
+		ownerIDs := make([]string, 0, len(eventRecords))
+		seqs := make([]int64, len(eventRecords))
+
+		for i, record := range eventRecords {
+			ownerIDs = append(ownerIDs, record.UserID)
+			seqs[i] = record.Seq
+		}
+
+		webhookRecords, err := gorm.G[WebhookModel](tx).Where("user_id IN ? AND disabled_at IS NULL", ownerIDs).Find(ctx)
+		if err != nil {
+			return err
+		}
+
+		webhooksByOwner := make(map[string][]*webhook.Webhook)
+
+		for _, record := range webhookRecords {
+			webhookEntity, err := record.ToDomain()
+			if err != nil {
+				return err
+			}
+
+			webhooksByOwner[record.UserID] = append(webhooksByOwner[record.UserID], webhookEntity)
+		}
+
+		now := time.Now()
+
+		var deliveryModels []WebhookDeliveryModel
+
+		for _, record := range eventRecords {
+			eventType := webhook.EventType(record.Type)
+
+			for _, webhookEntity := range webhooksByOwner[record.UserID] {
+				if !webhookEntity.Accepts(eventType) {
+					continue
+				}
+
+				delivery := webhook.NewDelivery(webhook.GenerateDeliveryID(), webhookEntity.ID(), record.EventID, eventType, []byte(record.Payload), now)
+				deliveryModels = append(deliveryModels, newWebhookDeliveryModel(delivery))
+			}
+		}
+
+		if len(deliveryModels) > 0 {
+			if err := gorm.G[WebhookDeliveryModel](tx).Omit(clause.Associations).CreateInBatches(ctx, &deliveryModels, len(deliveryModels)); err != nil {
+				return err
+			}
+		}
+
+		if _, err := gorm.G[WebhookOutboxModel](tx).Where("seq IN ?", seqs).Delete(ctx); err != nil {
+			return err
+		}
+
+		dispatched = len(eventRecords)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return dispatched, nil
+}
+
+// claimDueDeliveriesSQL leases due deliveries of active webhooks by moving
+// their next attempt to the end of the lease. Rows locked by another worker
+// are skipped.
+const claimDueDeliveriesSQL = `UPDATE webhook_deliveries SET next_attempt_at = @lease_until, updated_at = now()
+WHERE id IN (
+	SELECT d.id FROM webhook_deliveries AS d
+	JOIN webhooks AS w ON w.id = d.webhook_id
+	WHERE d.status = @status AND d.next_attempt_at <= @now AND w.disabled_at IS NULL
+	ORDER BY d.next_attempt_at ASC, d.id ASC
+	LIMIT @limit
+	FOR UPDATE OF d SKIP LOCKED
+)
+RETURNING *`
+
+func (r *WebhookDB) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]webhook.PendingDelivery, error) {
+	deliveryRecords, err := gorm.G[WebhookDeliveryModel](r.db).Raw(claimDueDeliveriesSQL, map[string]any{
+		"lease_until": now.Add(lease),
+		"status":      string(webhook.DeliveryPending),
+		"now":         now,
+		"limit":       limit,
+	}).Find(ctx)
+	if err != nil || len(deliveryRecords) == 0 {
+		return nil, err
+	}
+
+	webhookIDs := make([]string, len(deliveryRecords))
+	for i, record := range deliveryRecords {
+		webhookIDs[i] = record.WebhookID
+	}
+
+	webhookRecords, err := gorm.G[WebhookModel](r.db).Where("id IN ?", webhookIDs).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make(map[string]*webhook.Webhook, len(webhookRecords))
+
+	for _, record := range webhookRecords {
+		if webhooks[record.ID], err = record.ToDomain(); err != nil {
+			return nil, err
+		}
+	}
+
+	pending := make([]webhook.PendingDelivery, 0, len(deliveryRecords))
+
+	for _, record := range deliveryRecords {
+		webhookEntity, ok := webhooks[record.WebhookID]
+		if !ok {
+			// The webhook was deleted together with the delivery meanwhile.
+			continue
+		}
+
+		delivery, err := record.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		pending = append(pending, webhook.PendingDelivery{Delivery: delivery, Webhook: webhookEntity})
+	}
+
+	return pending, nil
+}
+
+// RecordAttempt locks the webhook while counting the attempt against it, so
+// that concurrent attempts cannot lose a failure.
+func (r *WebhookDB) RecordAttempt(ctx context.Context, delivery *webhook.Delivery, attempt webhook.Attempt, policy webhook.RetryPolicy) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := gorm.G[WebhookAttemptModel](tx).Omit(clause.Associations).Create(ctx, newWebhookAttemptModel(delivery.ID(), attempt)); err != nil {
+			return err
+		}
+
+		deliveryModel := newWebhookDeliveryModel(delivery)
+
+		_, err := gorm.G[WebhookDeliveryModel](tx).
+			Where("id = ?", deliveryModel.ID).
+			Select("status", "attempts", "next_attempt_at", "last_error").
+			Updates(ctx, deliveryModel)
+		if err != nil {
+			return err
+		}
+
+		webhookRecord, err := gorm.G[WebhookModel](tx, clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Where("id = ?", delivery.WebhookID().String()).
+			First(ctx)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		webhookEntity, err := webhookRecord.ToDomain()
+		if err != nil {
+			return err
+		}
+
+		if attempt.Succeeded() {
+			webhookEntity.RecordSuccess()
+		} else {
+			webhookEntity.RecordFailure(attempt.AttemptedAt(), policy)
+		}
+
+		webhookModel := newWebhookModel(webhookEntity)
+
+		_, err = gorm.G[WebhookModel](tx).
+			Where("id = ?", webhookModel.ID).
+			Select("failure_count", "disabled_at").
+			Updates(ctx, *webhookModel)
+
+		return err
+	})
+}
+
+// toDomainWebhooks converts webhook records to domain webhooks.
+func toDomainWebhooks(webhookRecords []WebhookModel) ([]*webhook.Webhook, error) {
+	webhooks := make([]*webhook.Webhook, len(webhookRecords))
+
+	for i, record := range webhookRecords {
+		webhookEntity, err := record.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks[i] = webhookEntity
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+)
+
+// WebhookOutboxModel represents an event that webhooks of UserID are yet to
+// be notified of. Rows are inserted in the transaction of the task change
+// they describe and removed once deliveries have been created for them, so
+// that no change is announced that was not saved and no saved change is lost.
+type WebhookOutboxModel struct {
+	Seq        int64     `gorm:"primaryKey;autoIncrement"`
+	EventID    string    `gorm:"not null;type:varchar(36)"`
+	UserID     string    `gorm:"not null;type:varchar(255)"`
+	Type       string    `gorm:"not null;type:varchar(64)"`
+	Payload    string    `gorm:"not null;type:text"`
+	OccurredAt time.Time `gorm:"not null;type:timestamptz"`
+}
+
+// TableName returns the database table name for WebhookOutboxModel.
+func (WebhookOutboxModel) TableName() string {
+	return "webhook_outbox"
+}
+
+// subscribedCreatorsSQL returns the creators of the given tasks who have an
+// active webhook. Changes to the tasks of other users are not written to the
+// outbox at all.
+const subscribedCreatorsSQL = `SELECT id AS task_id, creator_id FROM tasks
+WHERE id IN ? AND creator_id IN (SELECT user_id FROM webhooks WHERE disabled_at IS NULL)`
+
+type subscribedCreatorRow struct {
+	TaskID    string
+	CreatorID string
+}
+
+// enqueueWebhookEvents writes the changes to the outbox as events for the
+// webhooks of the creators of the changed tasks.
+func enqueueWebhookEvents(ctx context.Context, tx *gorm.DB, changes []task.Change) error {
+	taskIDs := make([]string, len(changes))
+	for i, change := range changes {
+		taskIDs[i] = change.TaskID().String()
+	}
+
+	rows, err := gorm.G[subscribedCreatorRow](tx).Raw(subscribedCreatorsSQL, taskIDs).Find(ctx)
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+
+	creators := make(map[string]string, len(rows))
+	for _, row := range rows {
+		creators[row.TaskID] = row.CreatorID
+	}
+
+	outboxModels := make([]WebhookOutboxModel, 0, len(changes))
+
+	for _, change := range changes {
+		creatorID, ok := creators[change.TaskID().String()]
+		if !ok {
+			continue
+		}
+
+		eventID := uuid.New().String()
+
+		payload, err := webhook.EncodeEvent(eventID, change)
+		if err != nil {
+			return err
+		}
+
+		outboxModels = append(outboxModels, WebhookOutboxModel{ //nolint:exhaustruct
+			EventID:    eventID,
+			UserID:     creatorID,
+			Type:       string(webhook.EventTypeOf(change.Type())),
+			Payload:    string(payload),
+			OccurredAt: change.OccurredAt(),
+		})
+	}
+
+	return gorm.G[WebhookOutboxModel](tx).CreateInBatches(ctx, &outboxModels, len(outboxModels))
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+)
+
+// WorkspaceModel represents the database model for workspaces.
+// Tasks refer to their workspace through TaskModel.WorkspaceID.
+type WorkspaceModel struct {
+	ID        string    `gorm:"primaryKey;type:varchar(36)"`
+	OwnerID   string    `gorm:"not null;type:varchar(255);index"`
+	Name      string    `gorm:"not null;type:varchar(100)"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName returns the database table name for WorkspaceModel.
+func (WorkspaceModel) TableName() string {
+	return "workspaces"
+}
+
+// ToDomain converts a WorkspaceModel to a domain Workspace entity.
+func (m WorkspaceModel) ToDomain() (*workspace.Workspace, error) {
+	workspaceID, err := workspace.NewWorkspaceID(m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := user.NewUserID(m.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspace.NewWorkspaceWithoutValidation(workspaceID, m.Name, ownerID), nil
+}
+
+// WorkspaceMemberModel represents a user who belongs to a workspace.
+// The owner is stored as a member too. Rows are removed together with the workspace.
+type WorkspaceMemberModel struct {
+	WorkspaceID string          `gorm:"primaryKey;type:varchar(36)"`
+	UserID      string          `gorm:"primaryKey;type:varchar(255);index"`
+	Role        string          `gorm:"not null;type:varchar(16)"`
+	Workspace   *WorkspaceModel `gorm:"foreignKey:WorkspaceID;constraint:OnDelete:CASCADE"`
+	CreatedAt   time.Time       `gorm:"autoCreateTime"`
+}
+
+// TableName returns the database table name for WorkspaceMemberModel.
+func (WorkspaceMemberModel) TableName() string {
+	return "workspace_members"
+}
+
+// ToDomain converts a WorkspaceMemberModel to a domain Member.
+func (m WorkspaceMemberModel) ToDomain() (workspace.Member, error) {
+	workspaceID, err := workspace.NewWorkspaceID(m.WorkspaceID)
+	if err != nil {
+		return workspace.Member{}, err
+	}
+
+	userID, err := user.NewUserID(m.UserID)
+	if err != nil {
+		return workspace.Member{}, err
+	}
+
+	return workspace.NewMember(workspaceID, userID, workspace.Role(m.Role))
+}
+
+// WorkspaceDB implements the WorkspaceRepository interface using GORM for database operations.
+type WorkspaceDB struct {
+	db *gorm.DB
+}
+
+// NewWorkspaceDB creates a new WorkspaceDB instance with the provided GORM database connection.
+func NewWorkspaceDB(db *gorm.DB) *WorkspaceDB {
+	return &WorkspaceDB{db: db}
+}
+
+func (r *WorkspaceDB) FindAllByUserID(ctx context.Context, userID user.UserID) ([]*workspace.Workspace, error) {
+	if userID.IsEmpty() {
+		return nil, user.ErrUserIDEmpty
+	}
+
+	workspaceRecords, err := gorm.G[WorkspaceModel](r.db).
+		Where("id IN (SELECT workspace_id FROM workspace_members WHERE user_id = ?)", userID.String()).
+		Order("name ASC, id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]*workspace.Workspace, len(workspaceRecords))
+	for i, record := range workspaceRecords {
+		domainWorkspace, err := record.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+
+		workspaces[i] = domainWorkspace
+	}
+
+	return workspaces, nil
+}
+
+func (r *WorkspaceDB) Create(ctx context.Context, workspaceEntity *workspace.Workspace) (*workspace.Workspace, error) {
+	owner := workspaceEntity.Owner()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := gorm.G[WorkspaceModel](tx).Create(ctx, &WorkspaceModel{ //nolint:exhaustruct
+			ID:      workspaceEntity.ID().String(),
+			OwnerID: workspaceEntity.OwnerID().String(),
+			Name:    workspaceEntity.Name(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return gorm.G[WorkspaceMemberModel](tx).Create(ctx, &WorkspaceMemberModel{ //nolint:exhaustruct
+			WorkspaceID: owner.WorkspaceID().String(),
+			UserID:      owner.UserID().String(),
+			Role:        string(owner.Role()),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return workspaceEntity, nil
+}
+
+func (r *WorkspaceDB) FindMember(ctx context.Context, id workspace.WorkspaceID, userID user.UserID) (workspace.Member, error) {
+	if id.IsEmpty() {
+		return workspace.Member{}, workspace.ErrWorkspaceIDEmpty
+	}
+
+	if userID.IsEmpty() {
+		return workspace.Member{}, user.ErrUserIDEmpty
+	}
+
+	memberRecord, err := gorm.G[WorkspaceMemberModel](r.db).
+		Where("workspace_id = ? AND user_id = ?", id.String(), userID.String()).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return workspace.Member{}, workspace.ErrWorkspaceNotFound
+		}
+
+		return workspace.Member{}, err
+	}
+
+	return memberRecord.ToDomain()
+}
+
+func (r *WorkspaceDB) FindMembers(ctx context.Context, id workspace.WorkspaceID) ([]workspace.Member, error) {
+	if id.IsEmpty() {
+		return nil, workspace.ErrWorkspaceIDEmpty
+	}
+
+	records, err := gorm.G[WorkspaceMemberModel](r.db).
+		Where("workspace_id = ?", id.String()).
+		Order("created_at ASC, user_id ASC").
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]workspace.Member, len(records))
+	for i, record := range records {
+		if members[i], err = record.ToDomain(); err != nil {
+			return nil, err
+		}
+	}
+
+	return members, nil
+}
+
+// saveMemberSQL inserts a member, or changes the role of an existing one.
+// The owner is never changed, so that a workspace cannot lose its owner.
+const saveMemberSQL = `INSERT INTO workspace_members (workspace_id, user_id, role, created_at)
+VALUES (@workspace_id, @user_id, @role, now())
+ON CONFLICT (workspace_id, user_id) DO UPDATE SET role = EXCLUDED.role
+WHERE workspace_members.role <> 'owner'`
+
+func (r *WorkspaceDB) SaveMember(ctx context.Context, member workspace.Member) error {
+	return gorm.G[WorkspaceMemberModel](r.db).Exec(ctx, saveMemberSQL, map[string]any{
+		"workspace_id": member.WorkspaceID().String(),
+		"user_id":      member.UserID().String(),
+		"role":         string(member.Role()),
+	})
+}
+
+func (r *WorkspaceDB) RemoveMember(ctx context.Context, id workspace.WorkspaceID, userID user.UserID) error {
+	rowsAffected, err := gorm.G[WorkspaceMemberModel](r.db).
+		Where("workspace_id = ? AND user_id = ? AND role <> ?", id.String(), userID.String(), string(workspace.RoleOwner)).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return workspace.ErrMemberNotFound
+	}
+
+	return nil
+}
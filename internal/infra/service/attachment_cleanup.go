@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+)
+
+// attachmentCleanupBatchSize is the number of detached attachments removed per run.
+const attachmentCleanupBatchSize = 100
+
+// AttachmentCleanupService periodically removes attachments whose task has
+// been permanently deleted, together with their content in the blob store.
+type AttachmentCleanupService struct {
+	repo      attachment.AttachmentRepository
+	blobStore attachment.BlobStore
+	interval  time.Duration
+}
+
+func NewAttachmentCleanupService(repo attachment.AttachmentRepository, blobStore attachment.BlobStore, interval time.Duration) *AttachmentCleanupService {
+	return &AttachmentCleanupService{
+		repo:      repo,
+		blobStore: blobStore,
+		interval:  interval,
+	}
+}
+
+// Start runs the cleanup every interval in the background until ctx is done.
+func (s *AttachmentCleanupService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.cleanup(ctx)
+			}
+		}
+	}()
+}
+
+// cleanup removes one batch of detached attachments. The content goes before
+// the metadata, so that an attachment whose content could not be removed is
+// retried on the next run.
+func (s *AttachmentCleanupService) cleanup(ctx context.Context) {
+	attachments, err := s.repo.FindDetached(ctx, attachmentCleanupBatchSize)
+	if err != nil {
+		log.Println("Failed to find detached attachments:", err)
+
+		return
+	}
+
+	for _, attachmentEntity := range attachments {
+		if err := s.blobStore.Delete(ctx, attachmentEntity.Key()); err != nil {
+			log.Println("Failed to delete attachment content:", err)
+
+			continue
+		}
+
+		if err := s.repo.Delete(ctx, attachmentEntity.ID()); err != nil {
+			log.Println("Failed to delete detached attachment:", err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/idempotency"
+)
+
+// IdempotencyCleanupService periodically removes expired idempotency records.
+// Expired records are already ignored when keys are reserved; the cleanup only
+// keeps the table from growing without bound.
+type IdempotencyCleanupService struct {
+	repo     idempotency.IdempotencyRepository
+	interval time.Duration
+}
+
+func NewIdempotencyCleanupService(repo idempotency.IdempotencyRepository, interval time.Duration) *IdempotencyCleanupService {
+	return &IdempotencyCleanupService{
+		repo:     repo,
+		interval: interval,
+	}
+}
+
+// Start runs the cleanup every interval in the background until ctx is done.
+func (s *IdempotencyCleanupService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.repo.DeleteExpired(ctx, time.Now()); err != nil {
+					log.Println("Failed to delete expired idempotency keys:", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+)
+
+// RankRebalanceService periodically spreads out the ranks of users whose
+// ranks have grown longer than maxLength from repeated moves between the same
+// neighbours.
+type RankRebalanceService struct {
+	repo      task.TaskRepository
+	maxLength int
+	interval  time.Duration
+}
+
+func NewRankRebalanceService(repo task.TaskRepository, maxLength int, interval time.Duration) *RankRebalanceService {
+	return &RankRebalanceService{
+		repo:      repo,
+		maxLength: maxLength,
+		interval:  interval,
+	}
+}
+
+// Start runs the rebalance every interval in the background until ctx is done.
+func (s *RankRebalanceService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.repo.RebalanceRanks(ctx, s.maxLength); err != nil {
+					log.Println("Failed to rebalance task ranks:", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+)
+
+// TrashPurgeService periodically removes tasks that have been in the trash
+// for longer than the retention period.
+type TrashPurgeService struct {
+	repo      task.TaskRepository
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewTrashPurgeService(repo task.TaskRepository, retention, interval time.Duration) *TrashPurgeService {
+	return &TrashPurgeService{
+		repo:      repo,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Start runs the purge every interval in the background until ctx is done.
+func (s *TrashPurgeService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.repo.PurgeDeletedBefore(ctx, time.Now().Add(-s.retention)); err != nil {
+					log.Println("Failed to purge tasks from the trash:", err)
+				}
+			}
+		}
+	}()
+}
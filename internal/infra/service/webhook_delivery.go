@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+)
+
+const (
+	// webhookDispatchBatchSize is the number of outbox events turned into deliveries per query.
+	webhookDispatchBatchSize = 100
+	// webhookDeliveryBatchSize is the number of deliveries attempted at the same time per run.
+	webhookDeliveryBatchSize = 20
+	// webhookLeaseMargin is added to the send timeout to get how long claimed
+	// deliveries are hidden from other workers, so that they are not picked up
+	// again while their attempt is still being recorded.
+	webhookLeaseMargin = time.Minute
+)
+
+// WebhookDeliveryService periodically turns the events in the outbox into
+// deliveries and posts the deliveries that are due to their webhooks.
+// Several instances may run at the same time; each delivery is claimed by one
+// of them at a time.
+type WebhookDeliveryService struct {
+	repo     webhook.WebhookRepository
+	sender   webhook.Sender
+	policy   webhook.RetryPolicy
+	lease    time.Duration
+	interval time.Duration
+}
+
+func NewWebhookDeliveryService(repo webhook.WebhookRepository, sender webhook.Sender, policy webhook.RetryPolicy, timeout, interval time.Duration) *WebhookDeliveryService {
+	return &WebhookDeliveryService{
+		repo:     repo,
+		sender:   sender,
+		policy:   policy,
+		lease:    timeout + webhookLeaseMargin,
+		interval: interval,
+	}
+}
+
+// Start runs the delivery every interval in the background until ctx is done.
+func (s *WebhookDeliveryService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.dispatch(ctx)
+				s.deliver(ctx)
+			}
+		}
+	}()
+}
+
+// dispatch empties the outbox.
+func (s *WebhookDeliveryService) dispatch(ctx context.Context) {
+	for {
+		taken, err := s.repo.DispatchEvents(ctx, webhookDispatchBatchSize)
+		if err != nil {
+			log.Println("Failed to dispatch webhook events:", err)
+
+			return
+		}
+
+		if taken < webhookDispatchBatchSize {
+			return
+		}
+	}
+}
+
+// deliver attempts one batch of due deliveries concurrently and records the
+// outcome of every attempt.
+func (s *WebhookDeliveryService) deliver(ctx context.Context) {
+	pending, err := s.repo.ClaimDueDeliveries(ctx, time.Now(), s.lease, webhookDeliveryBatchSize)
+	if err != nil {
+		log.Println("Failed to claim webhook deliveries:", err)
+
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	for _, p := range pending {
+		wg.Go(func() {
+			s.attempt(ctx, p)
+		})
+	}
+
+	wg.Wait()
+}
+
+func (s *WebhookDeliveryService) attempt(ctx context.Context, p webhook.PendingDelivery) {
+	attemptedAt := time.Now()
+	statusCode, sendErr := s.sender.Send(ctx, p.Webhook, p.Delivery)
+	attempt := webhook.NewAttempt(attemptedAt, time.Since(attemptedAt), statusCode, sendErr)
+
+	if err := p.Delivery.RecordAttempt(attempt, s.policy); err != nil {
+		log.Println("Failed to record webhook delivery attempt:", err)
+
+		return
+	}
+
+	if err := s.repo.RecordAttempt(ctx, p.Delivery, attempt, s.policy); err != nil {
+		log.Println("Failed to record webhook delivery attempt:", err)
+	}
+}
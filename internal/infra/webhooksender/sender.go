@@ -0,0 +1,126 @@
+package webhooksender
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+)
+
+// Headers sent with every delivery. Receivers verify the signature over the
+// timestamp and the body with webhook.Verify and use the event ID to ignore
+// events they have already processed.
+const (
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderEventID   = "X-Webhook-Event-Id"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+const (
+	userAgent = "todo-server-webhook"
+	// maxResponseBodySize limits how much of a response is read, so that a
+	// slow or chatty endpoint cannot hold on to a worker.
+	maxResponseBodySize = 4096
+)
+
+// HTTPSender posts deliveries to the endpoints of webhooks over HTTP.
+// Redirects are not followed; an endpoint that redirects counts as failing.
+// Endpoints are only reached at public addresses, so that webhooks cannot be
+// pointed at services of the internal network.
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewHTTPSender creates an HTTPSender that gives up on an attempt after timeout.
+// An endpoint that resolves to a loopback, private, link-local, multicast or
+// unspecified address fails with webhook.ErrAddressNotAllowed unless the
+// address is in one of the allowed networks. The address is checked when the
+// connection is made, so a host name cannot be resolved to another address
+// after it was checked.
+func NewHTTPSender(timeout time.Duration, allowed []netip.Prefix) *HTTPSender {
+	dialer := &net.Dialer{Timeout: timeout, Control: guardAddress(allowed)}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be the address that is checked, not the endpoint
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPSender{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// guardAddress returns a dialer control function that refuses to connect to
+// addresses that are not public unless they are in one of the allowed networks.
+func guardAddress(allowed []netip.Prefix) func(network, address string, _ syscall.RawConn) error {
+	return func(_, address string, _ syscall.RawConn) error {
+		addrPort, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return fmt.Errorf("%w: %s", webhook.ErrAddressNotAllowed, address)
+		}
+
+		addr := addrPort.Addr().Unmap()
+
+		for _, prefix := range allowed {
+			if prefix.Contains(addr) {
+				return nil
+			}
+		}
+
+		if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+			addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+			return fmt.Errorf("%w: %s", webhook.ErrAddressNotAllowed, addr)
+		}
+
+		return nil
+	}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, target *webhook.Webhook, delivery *webhook.Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL(), bytes.NewReader(delivery.Payload()))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := s.now()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderDelivery, delivery.ID().String())
+	req.Header.Set(HeaderEvent, string(delivery.EventType()))
+	req.Header.Set(HeaderEventID, delivery.EventID())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderSignature, webhook.Sign(target.Secret(), timestamp, delivery.Payload()))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("%w: %d", webhook.ErrUnexpectedStatus, resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,177 @@
+package webhooksender
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+)
+
+const testSecret = "0123456789abcdef"
+
+// loopback allows the test servers, which listen on the loopback interface.
+var loopback = []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+
+// receiver is an endpoint that accepts a delivery only if it is signed with
+// testSecret and replies with status otherwise.
+func receiver(t *testing.T, status int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		unix, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		if err != nil || !webhook.Verify(testSecret, time.Unix(unix, 0), body, r.Header.Get(HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		if r.Header.Get("Content-Type") != "application/json" || r.Header.Get(HeaderEvent) != "task.created" ||
+			r.Header.Get(HeaderEventID) != "event-1" || r.Header.Get(HeaderDelivery) == "" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTarget(url, secret string) (*webhook.Webhook, *webhook.Delivery) {
+	target := webhook.NewWebhookWithoutValidation(webhook.GenerateWebhookID(), user.GenerateUserID(), url, secret, nil, time.Now())
+	delivery := webhook.NewDelivery(webhook.GenerateDeliveryID(), target.ID(), "event-1", "task.created", []byte(`{"id":"event-1"}`), time.Now())
+
+	return target, delivery
+}
+
+func TestHTTPSender_Send(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		status         int
+		secret         string
+		expectedStatus int
+		expectError    bool
+	}{
+		{name: "accepted", status: http.StatusNoContent, secret: testSecret, expectedStatus: http.StatusNoContent, expectError: false},
+		{name: "server error", status: http.StatusInternalServerError, secret: testSecret, expectedStatus: http.StatusInternalServerError, expectError: true},
+		{name: "redirect is not followed", status: http.StatusFound, secret: testSecret, expectedStatus: http.StatusFound, expectError: true},
+		{name: "wrong secret", status: http.StatusOK, secret: "fedcba9876543210", expectedStatus: http.StatusUnauthorized, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			server := receiver(t, tt.status)
+			target, delivery := newTarget(server.URL, tt.secret)
+			sender := NewHTTPSender(time.Second, loopback)
+
+			// Act
+			status, err := sender.Send(context.Background(), target, delivery)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, status)
+
+			if tt.expectError {
+				assert.ErrorIs(t, err, webhook.ErrUnexpectedStatus)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHTTPSender_SendTimeout(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	target, delivery := newTarget(server.URL, testSecret)
+	sender := NewHTTPSender(50*time.Millisecond, loopback)
+
+	// Act
+	status, err := sender.Send(context.Background(), target, delivery)
+
+	// Assert
+	require.Error(t, err)
+	assert.Zero(t, status)
+}
+
+func TestHTTPSender_SendRefusesInternalAddresses(t *testing.T) {
+	t.Parallel()
+
+	var reached atomic.Bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		reached.Store(true)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name    string
+		url     string
+		allowed []netip.Prefix
+	}{
+		{name: "loopback", url: server.URL, allowed: nil},
+		{name: "loopback outside the allowed networks", url: server.URL, allowed: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}},
+		{name: "private", url: "http://10.0.0.1/hook", allowed: nil},
+		{name: "link-local", url: "http://169.254.169.254/latest/meta-data", allowed: nil},
+		{name: "IPv6 loopback", url: "http://[::1]/hook", allowed: nil},
+		{name: "IPv4-mapped loopback", url: "http://[::ffff:127.0.0.1]/hook", allowed: nil},
+		{name: "unspecified", url: "http://0.0.0.0/hook", allowed: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			target, delivery := newTarget(tt.url, testSecret)
+			sender := NewHTTPSender(time.Second, tt.allowed)
+
+			// Act
+			status, err := sender.Send(context.Background(), target, delivery)
+
+			// Assert
+			require.ErrorIs(t, err, webhook.ErrAddressNotAllowed)
+			assert.Zero(t, status)
+		})
+	}
+
+	t.Cleanup(func() { assert.False(t, reached.Load(), "a refused endpoint was reached") })
+}
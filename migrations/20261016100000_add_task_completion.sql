@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "completed" boolean NOT NULL DEFAULT false, ADD COLUMN "completed_at" timestamptz NULL;
@@ -0,0 +1,4 @@
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "start_at" timestamptz NULL, ADD COLUMN "due_at" timestamptz NULL, ADD COLUMN "all_day" boolean NOT NULL DEFAULT false;
+-- Create index "idx_tasks_creator_id_due_at" to table: "tasks"
+CREATE INDEX "idx_tasks_creator_id_due_at" ON "tasks" ("creator_id", "due_at");
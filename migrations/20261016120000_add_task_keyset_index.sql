@@ -0,0 +1,2 @@
+-- Create index "idx_tasks_creator_id_created_at_id" to table: "tasks"
+CREATE INDEX "idx_tasks_creator_id_created_at_id" ON "tasks" ("creator_id", "created_at", "id");
@@ -0,0 +1,8 @@
+-- Add extension "pg_trgm"
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "search_vector" tsvector NULL GENERATED ALWAYS AS (to_tsvector('simple'::regconfig, (title)::text)) STORED;
+-- Create index "idx_tasks_search_vector" to table: "tasks"
+CREATE INDEX "idx_tasks_search_vector" ON "tasks" USING gin ("search_vector");
+-- Create index "idx_tasks_title_trgm" to table: "tasks"
+CREATE INDEX "idx_tasks_title_trgm" ON "tasks" USING gin ("title" gin_trgm_ops);
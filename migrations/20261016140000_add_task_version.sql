@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,14 @@
+-- Create "idempotency_keys" table
+CREATE TABLE "idempotency_keys" (
+  "user_id" character varying(255) NOT NULL,
+  "key" character varying(255) NOT NULL,
+  "fingerprint" character varying(64) NOT NULL,
+  "status_code" integer NULL,
+  "content_type" character varying(255) NULL,
+  "response_body" bytea NULL,
+  "created_at" timestamptz NULL,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("user_id", "key")
+);
+-- Create index "idx_idempotency_keys_expires_at" to table: "idempotency_keys"
+CREATE INDEX "idx_idempotency_keys_expires_at" ON "idempotency_keys" ("expires_at");
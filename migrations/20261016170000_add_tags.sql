@@ -0,0 +1,21 @@
+-- Create "tags" table
+CREATE TABLE "tags" (
+  "id" character varying(36) NOT NULL,
+  "user_id" character varying(255) NOT NULL,
+  "name" character varying(50) NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_tags_user_id_name" to table: "tags"
+CREATE UNIQUE INDEX "idx_tags_user_id_name" ON "tags" ("user_id", "name");
+-- Create "task_tags" table
+CREATE TABLE "task_tags" (
+  "task_id" character varying(36) NOT NULL,
+  "tag_id" character varying(36) NOT NULL,
+  PRIMARY KEY ("task_id", "tag_id"),
+  CONSTRAINT "fk_task_tags_tag" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_task_tags_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_task_tags_tag_id" to table: "task_tags"
+CREATE INDEX "idx_task_tags_tag_id" ON "task_tags" ("tag_id");
@@ -0,0 +1,4 @@
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "parent_id" character varying(36) NULL, ADD CONSTRAINT "fk_tasks_parent" FOREIGN KEY ("parent_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "idx_tasks_parent_id" to table: "tasks"
+CREATE INDEX "idx_tasks_parent_id" ON "tasks" ("parent_id");
@@ -0,0 +1,11 @@
+-- Create "task_dependencies" table
+CREATE TABLE "task_dependencies" (
+  "blocked_id" character varying(36) NOT NULL,
+  "blocker_id" character varying(36) NOT NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("blocked_id", "blocker_id"),
+  CONSTRAINT "fk_task_dependencies_blocked" FOREIGN KEY ("blocked_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_task_dependencies_blocker" FOREIGN KEY ("blocker_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_task_dependencies_blocker_id" to table: "task_dependencies"
+CREATE INDEX "idx_task_dependencies_blocker_id" ON "task_dependencies" ("blocker_id");
@@ -0,0 +1,18 @@
+-- Create "task_series" table
+CREATE TABLE "task_series" (
+  "id" character varying(36) NOT NULL,
+  "creator_id" character varying(255) NOT NULL,
+  "title" character varying(255) NOT NULL,
+  "recurrence_rule" character varying(500) NOT NULL,
+  "recurrence_timezone" character varying(64) NOT NULL,
+  "recurrence_start" timestamptz NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_task_series_creator_id" to table: "task_series"
+CREATE INDEX "idx_task_series_creator_id" ON "task_series" ("creator_id");
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "series_id" character varying(36) NULL, ADD CONSTRAINT "fk_tasks_series" FOREIGN KEY ("series_id") REFERENCES "task_series" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "idx_tasks_series_id" to table: "tasks"
+CREATE INDEX "idx_tasks_series_id" ON "tasks" ("series_id");
@@ -0,0 +1,15 @@
+-- Create "projects" table
+CREATE TABLE "projects" (
+  "id" character varying(36) NOT NULL,
+  "user_id" character varying(255) NOT NULL,
+  "name" character varying(100) NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_projects_user_id" to table: "projects"
+CREATE INDEX "idx_projects_user_id" ON "projects" ("user_id");
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "project_id" character varying(36) NULL, ADD CONSTRAINT "fk_tasks_project" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "idx_tasks_project_id" to table: "tasks"
+CREATE INDEX "idx_tasks_project_id" ON "tasks" ("project_id");
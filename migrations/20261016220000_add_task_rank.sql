@@ -0,0 +1,8 @@
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "rank" character varying(255) COLLATE "C" NULL;
+-- Backfill "rank" in the order the tasks were created; each rank ends in a non-zero digit
+UPDATE "tasks" SET "rank" = "ranked"."rank" FROM (SELECT "id", lpad(row_number() OVER (PARTITION BY "creator_id" ORDER BY "created_at", "id")::text, 10, '0') || 'i' AS "rank" FROM "tasks") AS "ranked" WHERE "tasks"."id" = "ranked"."id";
+-- Modify "tasks" table
+ALTER TABLE "tasks" ALTER COLUMN "rank" SET NOT NULL;
+-- Create index "idx_tasks_creator_id_rank_id" to table: "tasks"
+CREATE INDEX "idx_tasks_creator_id_rank_id" ON "tasks" ("creator_id", "rank", "id");
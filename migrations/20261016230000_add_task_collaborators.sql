@@ -0,0 +1,11 @@
+-- Create "task_collaborators" table
+CREATE TABLE "task_collaborators" (
+  "task_id" character varying(36) NOT NULL,
+  "user_id" character varying(255) NOT NULL,
+  "role" character varying(16) NOT NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("task_id", "user_id"),
+  CONSTRAINT "fk_task_collaborators_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_task_collaborators_user_id" to table: "task_collaborators"
+CREATE INDEX "idx_task_collaborators_user_id" ON "task_collaborators" ("user_id");
@@ -0,0 +1,26 @@
+-- Create "workspaces" table
+CREATE TABLE "workspaces" (
+  "id" character varying(36) NOT NULL,
+  "owner_id" character varying(255) NOT NULL,
+  "name" character varying(100) NOT NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_workspaces_owner_id" to table: "workspaces"
+CREATE INDEX "idx_workspaces_owner_id" ON "workspaces" ("owner_id");
+-- Create "workspace_members" table
+CREATE TABLE "workspace_members" (
+  "workspace_id" character varying(36) NOT NULL,
+  "user_id" character varying(255) NOT NULL,
+  "role" character varying(16) NOT NULL,
+  "created_at" timestamptz NULL,
+  PRIMARY KEY ("workspace_id", "user_id"),
+  CONSTRAINT "fk_workspace_members_workspace" FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_workspace_members_user_id" to table: "workspace_members"
+CREATE INDEX "idx_workspace_members_user_id" ON "workspace_members" ("user_id");
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "workspace_id" character varying(36) NULL, ADD CONSTRAINT "fk_tasks_workspace" FOREIGN KEY ("workspace_id") REFERENCES "workspaces" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "idx_tasks_workspace_id" to table: "tasks"
+CREATE INDEX "idx_tasks_workspace_id" ON "tasks" ("workspace_id");
@@ -0,0 +1,16 @@
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "assignee_id" character varying(255) NULL;
+-- Create index "idx_tasks_assignee_id" to table: "tasks"
+CREATE INDEX "idx_tasks_assignee_id" ON "tasks" ("assignee_id");
+-- Create "task_assignments" table
+CREATE TABLE "task_assignments" (
+  "id" character varying(36) NOT NULL,
+  "task_id" character varying(36) NOT NULL,
+  "assignee_id" character varying(255) NULL,
+  "assigned_by" character varying(255) NOT NULL,
+  "assigned_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_task_assignments_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_task_assignments_task_id_assigned_at" to table: "task_assignments"
+CREATE INDEX "idx_task_assignments_task_id_assigned_at" ON "task_assignments" ("task_id", "assigned_at");
@@ -0,0 +1,14 @@
+-- Create "comments" table
+CREATE TABLE "comments" (
+  "id" character varying(36) NOT NULL,
+  "task_id" character varying(36) NOT NULL,
+  "author_id" character varying(255) NOT NULL,
+  "body" text NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "edited_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_comments_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_comments_task_id_created_at" to table: "comments"
+CREATE INDEX "idx_comments_task_id_created_at" ON "comments" ("task_id", "created_at");
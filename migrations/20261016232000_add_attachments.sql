@@ -0,0 +1,17 @@
+-- Create "attachments" table
+CREATE TABLE "attachments" (
+  "id" character varying(36) NOT NULL,
+  "task_id" character varying(36) NULL,
+  "uploader_id" character varying(255) NOT NULL,
+  "file_name" character varying(255) NOT NULL,
+  "content_type" character varying(100) NOT NULL,
+  "size" bigint NOT NULL,
+  "checksum" character varying(64) NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_attachments_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "idx_attachments_task_id" to table: "attachments"
+CREATE INDEX "idx_attachments_task_id" ON "attachments" ("task_id");
+-- Create index "idx_attachments_uploader_id" to table: "attachments"
+CREATE INDEX "idx_attachments_uploader_id" ON "attachments" ("uploader_id");
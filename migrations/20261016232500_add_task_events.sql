@@ -0,0 +1,14 @@
+-- Create "task_events" table
+CREATE TABLE "task_events" (
+  "seq" bigserial NOT NULL,
+  "task_id" character varying(36) NOT NULL,
+  "type" character varying(32) NOT NULL,
+  "actor_id" character varying(255) NOT NULL,
+  "before" text NULL,
+  "after" text NULL,
+  "occurred_at" timestamptz NOT NULL,
+  PRIMARY KEY ("seq"),
+  CONSTRAINT "fk_task_events_task" FOREIGN KEY ("task_id") REFERENCES "tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_task_events_task_id_seq" to table: "task_events"
+CREATE INDEX "idx_task_events_task_id_seq" ON "task_events" ("task_id", "seq");
@@ -0,0 +1,58 @@
+-- Create "webhooks" table
+CREATE TABLE "webhooks" (
+  "id" character varying(36) NOT NULL,
+  "user_id" character varying(255) NOT NULL,
+  "url" character varying(2048) NOT NULL,
+  "secret" character varying(256) NOT NULL,
+  "event_types" text NOT NULL,
+  "failure_count" bigint NOT NULL DEFAULT 0,
+  "disabled_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_webhooks_user_id" to table: "webhooks"
+CREATE INDEX "idx_webhooks_user_id" ON "webhooks" ("user_id");
+-- Create "webhook_outbox" table
+CREATE TABLE "webhook_outbox" (
+  "seq" bigserial NOT NULL,
+  "event_id" character varying(36) NOT NULL,
+  "user_id" character varying(255) NOT NULL,
+  "type" character varying(64) NOT NULL,
+  "payload" text NOT NULL,
+  "occurred_at" timestamptz NOT NULL,
+  PRIMARY KEY ("seq")
+);
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" (
+  "id" character varying(36) NOT NULL,
+  "webhook_id" character varying(36) NOT NULL,
+  "event_id" character varying(36) NOT NULL,
+  "event_type" character varying(64) NOT NULL,
+  "payload" text NOT NULL,
+  "status" character varying(16) NOT NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL,
+  "last_error" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_webhook_deliveries_webhook" FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_webhook_deliveries_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "idx_webhook_deliveries_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
+-- Create index "idx_webhook_deliveries_webhook_id" to table: "webhook_deliveries"
+CREATE INDEX "idx_webhook_deliveries_webhook_id" ON "webhook_deliveries" ("webhook_id");
+-- Create "webhook_attempts" table
+CREATE TABLE "webhook_attempts" (
+  "id" bigserial NOT NULL,
+  "delivery_id" character varying(36) NOT NULL,
+  "attempted_at" timestamptz NOT NULL,
+  "duration_ms" bigint NOT NULL,
+  "status_code" bigint NULL,
+  "error" text NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_webhook_attempts_delivery" FOREIGN KEY ("delivery_id") REFERENCES "webhook_deliveries" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_webhook_attempts_delivery_id" to table: "webhook_attempts"
+CREATE INDEX "idx_webhook_attempts_delivery_id" ON "webhook_attempts" ("delivery_id");
@@ -1,23 +1,35 @@
 package e2e
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
 	infraAuth "github.com/KasumiMercury/todo-server-poc-go/internal/infra/auth"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/blobstore"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventbus"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/service"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/webhooksender"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -26,10 +38,20 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"golang.org/x/net/websocket"
 	gormPostgres "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+// e2eWebhookPolicy retries failed webhook deliveries within a fraction of a
+// second and disables a webhook after three failures in a row.
+var e2eWebhookPolicy = webhook.RetryPolicy{
+	MaxAttempts:  3,
+	BaseDelay:    100 * time.Millisecond,
+	MaxDelay:     time.Second,
+	DisableAfter: 3,
+}
+
 type TestServer struct {
 	server   *echo.Echo
 	baseURL  string
@@ -60,7 +82,30 @@ func setupTestServer(t *testing.T) *TestServer {
 	db, err := gorm.Open(gormPostgres.Open(connStr), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&repository.TaskModel{})
+	// The trigram index on tasks.title needs pg_trgm, which AutoMigrate cannot enable.
+	err = db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(
+		&repository.TaskModel{},
+		&repository.IdempotencyKeyModel{},
+		&repository.TagModel{},
+		&repository.TaskTagModel{},
+		&repository.TaskDependencyModel{},
+		&repository.TaskSeriesModel{},
+		&repository.ProjectModel{},
+		&repository.TaskCollaboratorModel{},
+		&repository.WorkspaceModel{},
+		&repository.WorkspaceMemberModel{},
+		&repository.TaskAssignmentModel{},
+		&repository.CommentModel{},
+		&repository.AttachmentModel{},
+		&repository.TaskEventModel{},
+		&repository.WebhookModel{},
+		&repository.WebhookOutboxModel{},
+		&repository.WebhookDeliveryModel{},
+		&repository.WebhookAttemptModel{},
+	)
 	require.NoError(t, err)
 
 	router := echo.New()
@@ -68,21 +113,57 @@ func setupTestServer(t *testing.T) *TestServer {
 		Auth: config.AuthConfig{
 			JWTSecret: "test-secret-key-for-e2e-testing",
 		},
+		Idempotency: config.IdempotencyConfig{
+			KeyTTL: 3600,
+		},
 		AllowOrigins: []string{"http://localhost:3000"},
 	}
 
 	router.Use(handler.CORSMiddleware(*cfg))
 
 	taskRepo := repository.NewTaskDB(db)
-	taskController := controller.NewTask(taskRepo)
+	tagRepo := repository.NewTagDB(db)
+	projectRepo := repository.NewProjectDB(db)
+	eventBus := eventbus.New()
+	taskController := controller.NewTask(taskRepo, tagRepo, projectRepo, eventBus)
+	tagController := controller.NewTag(tagRepo)
+	projectController := controller.NewProject(projectRepo, taskRepo)
+	workspaceController := controller.NewWorkspace(repository.NewWorkspaceDB(db))
+	commentController := controller.NewComment(repository.NewCommentDB(db), taskRepo)
+
+	blobStore, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = blobStore.Close() })
+
+	attachmentController := controller.NewAttachment(repository.NewAttachmentDB(db), taskRepo, blobStore, attachment.Limits{
+		MaxFileSize: 1 << 20,
+		UserQuota:   1 << 22,
+	})
+	webhookRepo := repository.NewWebhookDB(db)
+	webhookController := controller.NewWebhook(webhookRepo)
 	healthService := service.NewHealthService(db) // Use real implementation for E2E
-	apiServer := handler.NewAPIServer(*taskController, healthService)
+
+	// Send heartbeats often so that tests see them without waiting for long
+	taskEvents := eventstream.NewBroker(100, 16, 200*time.Millisecond)
+	eventbus.SubscribeAsync(eventBus, handler.NewTaskStreamPublisher(*taskController, taskEvents).Handle)
+
+	apiServer := handler.NewAPIServer(*taskController, *tagController, *projectController, *workspaceController, *commentController, *attachmentController, *webhookController, taskEvents, healthService)
+
+	// Deliver webhooks quickly so that tests do not wait for long, allowing the
+	// receivers of the tests, which listen on the loopback interface
+	deliveryCtx, stopDelivery := context.WithCancel(ctx)
+	webhookSender := webhooksender.NewHTTPSender(2*time.Second, []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")})
+	service.NewWebhookDeliveryService(webhookRepo, webhookSender, e2eWebhookPolicy, 2*time.Second, 100*time.Millisecond).
+		Start(deliveryCtx)
 
 	authService, err := infraAuth.NewAuthenticationService(*cfg)
 	require.NoError(t, err)
 
 	authMiddleware := handler.NewAuthenticationMiddleware(authService)
 	authMiddlewareFunc := authMiddleware.MiddlewareFunc()
+	workspaceMiddlewareFunc := handler.NewWorkspaceMiddleware(*workspaceController).MiddlewareFunc()
+
+	idempotencyMiddleware := handler.NewIdempotencyMiddleware(repository.NewIdempotencyDB(db), cfg.Idempotency)
 
 	wrapper := generated.ServerInterfaceWrapper{
 		Handler: apiServer,
@@ -91,13 +172,101 @@ func setupTestServer(t *testing.T) *TestServer {
 	router.GET("/health", wrapper.HealthGetHealth)
 
 	taskGroup := router.Group("/tasks")
-	taskGroup.Use(authMiddlewareFunc)
+	taskGroup.Use(authMiddlewareFunc, workspaceMiddlewareFunc)
 
 	taskGroup.GET("", wrapper.TaskGetAllTasks)
-	taskGroup.POST("", wrapper.TaskCreateTask)
+	taskGroup.POST("", wrapper.TaskCreateTask, idempotencyMiddleware.MiddlewareFunc())
+	taskGroup.GET("/events", wrapper.TaskStreamEvents)
+	taskGroup.GET("/search", wrapper.TaskSearchTasks)
+	taskGroup.GET("/trash", wrapper.TaskGetTrash)
+	taskGroup.DELETE("/trash", wrapper.TaskEmptyTrash)
 	taskGroup.GET("/:taskId", wrapper.TaskGetTask)
 	taskGroup.PUT("/:taskId", wrapper.TaskUpdateTask)
+	taskGroup.PATCH("/:taskId", wrapper.TaskPatchTask)
 	taskGroup.DELETE("/:taskId", wrapper.TaskDeleteTask)
+	taskGroup.POST("/:taskId/restore", wrapper.TaskRestoreTask)
+	taskGroup.GET("/:taskId/subtasks", wrapper.TaskGetSubtasks)
+	taskGroup.GET("/:taskId/collaborators", wrapper.TaskGetCollaborators)
+	taskGroup.PUT("/:taskId/collaborators/:userId", wrapper.TaskAddCollaborator)
+	taskGroup.DELETE("/:taskId/collaborators/:userId", wrapper.TaskRemoveCollaborator)
+	taskGroup.PUT("/:taskId/assignee", wrapper.TaskAssignTask)
+	taskGroup.GET("/:taskId/assignments", wrapper.TaskGetAssignments)
+	taskGroup.GET("/:taskId/history", wrapper.TaskGetHistory)
+	taskGroup.GET("/:taskId/comments", wrapper.CommentGetComments)
+	taskGroup.POST("/:taskId/comments", wrapper.CommentCreateComment)
+	taskGroup.GET("/:taskId/attachments", wrapper.AttachmentGetAttachments)
+	taskGroup.POST("/:taskId/attachments", wrapper.AttachmentUploadAttachment)
+	taskGroup.GET("/:taskId/dependencies", wrapper.TaskGetDependencies)
+	taskGroup.PUT("/:taskId/dependencies/:blockerId", wrapper.TaskAddDependency)
+	taskGroup.DELETE("/:taskId/dependencies/:blockerId", wrapper.TaskRemoveDependency)
+	taskGroup.GET("/:taskId/occurrences", wrapper.TaskGetOccurrences)
+	taskGroup.POST("/:taskId/move", wrapper.TaskMoveTask)
+	taskGroup.PUT("/:taskId/series", wrapper.TaskUpdateSeries)
+
+	commentGroup := router.Group("/comments")
+	commentGroup.Use(authMiddlewareFunc)
+
+	commentGroup.PATCH("/:commentId", wrapper.CommentUpdateComment)
+	commentGroup.DELETE("/:commentId", wrapper.CommentDeleteComment)
+
+	attachmentGroup := router.Group("/attachments")
+	attachmentGroup.Use(authMiddlewareFunc)
+
+	attachmentGroup.GET("/:attachmentId", wrapper.AttachmentDownloadAttachment)
+	attachmentGroup.DELETE("/:attachmentId", wrapper.AttachmentDeleteAttachment)
+
+	tagGroup := router.Group("/tags")
+	tagGroup.Use(authMiddlewareFunc)
+
+	tagGroup.GET("", wrapper.TagGetAllTags)
+	tagGroup.POST("", wrapper.TagCreateTag)
+	tagGroup.GET("/:tagId", wrapper.TagGetTag)
+	tagGroup.PUT("/:tagId", wrapper.TagUpdateTag)
+	tagGroup.DELETE("/:tagId", wrapper.TagDeleteTag)
+
+	// Create a group for protected project endpoints
+	projectGroup := router.Group("/projects")
+	projectGroup.Use(authMiddlewareFunc)
+
+	// Register project endpoints with authentication middleware
+	projectGroup.GET("", wrapper.ProjectGetAllProjects)
+	projectGroup.POST("", wrapper.ProjectCreateProject)
+	projectGroup.GET("/:projectId", wrapper.ProjectGetProject)
+	projectGroup.PUT("/:projectId", wrapper.ProjectUpdateProject)
+	projectGroup.DELETE("/:projectId", wrapper.ProjectDeleteProject)
+	projectGroup.GET("/:projectId/tasks", wrapper.ProjectGetProjectTasks)
+
+	// Create a group for protected webhook endpoints
+	webhookGroup := router.Group("/webhooks")
+	webhookGroup.Use(authMiddlewareFunc)
+
+	// Register webhook endpoints with authentication middleware
+	webhookGroup.GET("", wrapper.WebhookGetAllWebhooks)
+	webhookGroup.POST("", wrapper.WebhookCreateWebhook)
+	webhookGroup.GET("/:webhookId", wrapper.WebhookGetWebhook)
+	webhookGroup.PATCH("/:webhookId", wrapper.WebhookUpdateWebhook)
+	webhookGroup.DELETE("/:webhookId", wrapper.WebhookDeleteWebhook)
+
+	// Create a group for protected workspace endpoints
+	workspaceGroup := router.Group("/workspaces")
+	workspaceGroup.Use(authMiddlewareFunc)
+
+	// Register workspace endpoints with authentication middleware
+	workspaceGroup.GET("", wrapper.WorkspaceGetAllWorkspaces)
+	workspaceGroup.POST("", wrapper.WorkspaceCreateWorkspace)
+
+	// Endpoints of a single workspace also check the membership of the user
+	memberGroup := workspaceGroup.Group("/:workspaceId", workspaceMiddlewareFunc)
+	memberGroup.GET("/members", wrapper.WorkspaceGetMembers)
+	memberGroup.PUT("/members/:userId", wrapper.WorkspaceSetMember)
+	memberGroup.DELETE("/members/:userId", wrapper.WorkspaceRemoveMember)
+
+	taskSocketHandler := handler.NewTaskSocketHandler(*taskController, taskEvents, config.WebSocketConfig{
+		PingInterval:   30,
+		PongTimeout:    60,
+		MaxMessageSize: 65536,
+	})
+	router.GET("/ws", taskSocketHandler.Serve, authMiddlewareFunc)
 
 	userID := uuid.New().String()
 	jwtToken := generateTestJWTToken(userID, cfg.Auth.JWTSecret)
@@ -107,6 +276,7 @@ func setupTestServer(t *testing.T) *TestServer {
 		baseURL:  "",
 		jwtToken: jwtToken,
 		cleanup: func() {
+			stopDelivery()
 			postgresContainer.Terminate(ctx)
 		},
 	}
@@ -158,6 +328,39 @@ func (ts *TestServer) makeRequest(method, path string, body interface{}, headers
 	return rec, nil
 }
 
+// makeUploadRequest posts the content as the "file" part of a multipart form.
+func (ts *TestServer) makeUploadRequest(path, fileName string, content []byte, headers map[string]string) (*httptest.ResponseRecorder, error) {
+	var reqBody bytes.Buffer
+
+	writer := multipart.NewWriter(&reqBody)
+
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req := httptest.NewRequest(http.MethodPost, path, &reqBody)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+ts.jwtToken)
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	rec := httptest.NewRecorder()
+	ts.server.ServeHTTP(rec, req)
+
+	return rec, nil
+}
+
 func TestE2E_HealthEndpoint(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping E2E test in short mode")
@@ -713,3 +916,1582 @@ func TestE2E_MultipleTasksManagement(t *testing.T) {
 		assert.Len(t, tasks, len(taskTitles)-1) // One task was deleted
 	})
 }
+
+func TestE2E_IdempotentTaskCreation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	headers := map[string]string{"Idempotency-Key": uuid.New().String()}
+	createRequest := map[string]string{"title": "Idempotent Task"}
+
+	// Act
+	first, err := testServer.makeRequest("POST", "/tasks", createRequest, headers)
+	require.NoError(t, err)
+
+	retry, err := testServer.makeRequest("POST", "/tasks", createRequest, headers)
+	require.NoError(t, err)
+
+	reused, err := testServer.makeRequest("POST", "/tasks", map[string]string{"title": "Other Task"}, headers)
+	require.NoError(t, err)
+
+	list, err := testServer.makeRequest("GET", "/tasks", nil, nil)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get("Idempotent-Replayed"))
+
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get("Idempotent-Replayed"))
+	assert.JSONEq(t, first.Body.String(), retry.Body.String())
+
+	assert.Equal(t, http.StatusConflict, reused.Code)
+
+	var tasks []generated.Task
+
+	err = json.Unmarshal(list.Body.Bytes(), &tasks)
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+}
+
+func TestE2E_TrashAndRestore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	createTask := func(title string) generated.Task {
+		rec, err := testServer.makeRequest("POST", "/tasks", map[string]string{"title": title}, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		return created
+	}
+
+	restorable := createTask("Restorable Task")
+	discarded := createTask("Discarded Task")
+
+	for _, created := range []generated.Task{restorable, discarded} {
+		rec, err := testServer.makeRequest("DELETE", "/tasks/"+created.Id.String(), nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	}
+
+	// Act & Assert
+	rec, err := testServer.makeRequest("GET", "/tasks/"+restorable.Id.String(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/trash", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var trash []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &trash))
+	require.Len(t, trash, 2)
+	assert.NotNil(t, trash[0].DeletedAt)
+
+	rec, err = testServer.makeRequest("POST", "/tasks/"+restorable.Id.String()+"/restore", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("ETag"))
+
+	var restored generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &restored))
+	assert.Equal(t, restorable.Id, restored.Id)
+	assert.Nil(t, restored.DeletedAt)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+restorable.Id.String(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("DELETE", "/tasks/trash", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("POST", "/tasks/"+discarded.Id.String()+"/restore", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/trash", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[]`, rec.Body.String())
+}
+
+func TestE2E_TaskTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	createTag := func(name string) generated.Tag {
+		rec, err := testServer.makeRequest("POST", "/tags", map[string]string{"name": name}, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Tag
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		return created
+	}
+
+	work := createTag("work")
+	createTag("home")
+
+	// Act & Assert
+	rec, err := testServer.makeRequest("POST", "/tags", map[string]string{"name": " work "}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Tagged Task", "tags": []string{"work", "home"}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var tagged generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tagged))
+	assert.Equal(t, []string{"home", "work"}, tagged.Tags)
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Untagged Task"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Unknown Tag", "tags": []string{"missing"}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks?tag=work", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var filtered []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &filtered))
+	require.Len(t, filtered, 1)
+	assert.Equal(t, tagged.Id, filtered[0].Id)
+
+	rec, err = testServer.makeRequest("PUT", "/tags/"+work.Id.String(), map[string]string{"name": "office"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+tagged.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var renamed generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &renamed))
+	assert.Equal(t, []string{"home", "office"}, renamed.Tags)
+
+	rec, err = testServer.makeRequest("DELETE", "/tags/"+work.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+tagged.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var detached generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detached))
+	assert.Equal(t, []string{"home"}, detached.Tags)
+
+	rec, err = testServer.makeRequest("GET", "/tags", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var tags []generated.Tag
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tags))
+	require.Len(t, tags, 1)
+	assert.Equal(t, "home", tags[0].Name)
+}
+
+func TestE2E_Subtasks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	createTask := func(body map[string]any) generated.Task {
+		rec, err := testServer.makeRequest("POST", "/tasks", body, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		return created
+	}
+
+	root := createTask(map[string]any{"title": "Root"})
+	child := createTask(map[string]any{"title": "Child", "parentId": root.Id.String()})
+	grandchild := createTask(map[string]any{"title": "Grandchild", "parentId": child.Id.String()})
+
+	// Act & Assert
+	require.NotNil(t, grandchild.ParentId)
+	assert.Equal(t, child.Id, *grandchild.ParentId)
+
+	rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Orphan", "parentId": uuid.New().String()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+root.Id.String(), map[string]any{"parentId": grandchild.Id.String()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+root.Id.String()+"/subtasks", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var subtasks []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &subtasks))
+	require.Len(t, subtasks, 1)
+	assert.Equal(t, child.Id, subtasks[0].Id)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+root.Id.String()+"?include=children", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var expanded generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &expanded))
+	require.NotNil(t, expanded.Children)
+	require.Len(t, *expanded.Children, 1)
+	require.NotNil(t, (*expanded.Children)[0].Children)
+	require.Len(t, *(*expanded.Children)[0].Children, 1)
+	assert.Equal(t, grandchild.Id, (*(*expanded.Children)[0].Children)[0].Id)
+
+	rec, err = testServer.makeRequest("DELETE", "/tasks/"+root.Id.String(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec, err = testServer.makeRequest("DELETE", "/tasks/"+root.Id.String()+"?cascade=true", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/trash", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var trash []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &trash))
+	assert.Len(t, trash, 3)
+}
+
+func TestE2E_TaskDependencies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	createTask := func(title string) generated.Task {
+		rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": title}, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		return created
+	}
+
+	getTask := func(id string) generated.Task {
+		rec, err := testServer.makeRequest("GET", "/tasks/"+id, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var found generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &found))
+
+		return found
+	}
+
+	blocker := createTask("Blocker")
+	blocked := createTask("Blocked")
+
+	// Act & Assert
+	rec, err := testServer.makeRequest("PUT", "/tasks/"+blocked.Id.String()+"/dependencies/"+blocker.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	assert.True(t, getTask(blocked.Id.String()).Blocked)
+	assert.False(t, getTask(blocker.Id.String()).Blocked)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+blocker.Id.String()+"/dependencies/"+blocked.Id.String(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+blocker.Id.String()+"/dependencies/"+blocker.Id.String(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+blocked.Id.String()+"/dependencies", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var blockers []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &blockers))
+	require.Len(t, blockers, 1)
+	assert.Equal(t, blocker.Id, blockers[0].Id)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+blocker.Id.String(), map[string]any{"completed": true}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	assert.False(t, getTask(blocked.Id.String()).Blocked)
+
+	rec, err = testServer.makeRequest("DELETE", "/tasks/"+blocked.Id.String()+"/dependencies/"+blocker.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("DELETE", "/tasks/"+blocked.Id.String()+"/dependencies/"+blocker.Id.String(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestE2E_RecurringTasks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	listTasks := func() []generated.Task {
+		rec, err := testServer.makeRequest("GET", "/tasks", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var tasks []generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+
+		return tasks
+	}
+
+	rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{
+		"title": "Weekly report",
+		"dueAt": "2024-01-15T17:00:00Z",
+		"recurrence": map[string]any{
+			"rule":     "FREQ=WEEKLY;BYDAY=MO",
+			"timezone": "Europe/Berlin",
+		},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var created generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.NotNil(t, created.Recurrence)
+
+	// Act & Assert
+	rec, err = testServer.makeRequest("GET", "/tasks/"+created.Id.String()+"/occurrences?limit=2", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var occurrences []generated.Occurrence
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &occurrences))
+	require.Len(t, occurrences, 2)
+	assert.Equal(t, time.Date(2024, 1, 22, 17, 0, 0, 0, time.UTC), occurrences[0].DueAt.UTC())
+	assert.Equal(t, time.Date(2024, 1, 29, 17, 0, 0, 0, time.UTC), occurrences[1].DueAt.UTC())
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+created.Id.String(), map[string]any{"completed": true}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	tasks := listTasks()
+	require.Len(t, tasks, 2)
+
+	var next generated.Task
+
+	for _, found := range tasks {
+		if found.Id != created.Id {
+			next = found
+		}
+	}
+
+	assert.False(t, next.Completed)
+	require.NotNil(t, next.DueAt)
+	assert.Equal(t, time.Date(2024, 1, 22, 17, 0, 0, 0, time.UTC), next.DueAt.UTC())
+	require.NotNil(t, next.Recurrence)
+	assert.Equal(t, created.Recurrence.SeriesId, next.Recurrence.SeriesId)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+next.Id.String()+"/series", map[string]any{"title": "Team report"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	for _, found := range listTasks() {
+		if found.Completed {
+			assert.Equal(t, "Weekly report", found.Title)
+		} else {
+			assert.Equal(t, "Team report", found.Title)
+		}
+	}
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "One-off"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var oneOff generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &oneOff))
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+oneOff.Id.String()+"/occurrences", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestE2E_Projects(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	createProject := func(name string) generated.Project {
+		rec, err := testServer.makeRequest("POST", "/projects", map[string]any{"name": name}, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Project
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		return created
+	}
+
+	createTask := func(title string, projectID string) generated.Task {
+		rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": title, "projectId": projectID}, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		return created
+	}
+
+	listProjectTasks := func(projectID string) []generated.Task {
+		rec, err := testServer.makeRequest("GET", "/projects/"+projectID+"/tasks", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var tasks []generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+
+		return tasks
+	}
+
+	// Act & Assert
+	work := createProject("Work")
+	home := createProject("Home")
+
+	rec, err := testServer.makeRequest("GET", "/projects", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var projects []generated.Project
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &projects))
+	require.Len(t, projects, 2)
+	assert.Equal(t, "Home", projects[0].Name)
+
+	report := createTask("Report", work.Id.String())
+	createTask("Groceries", home.Id.String())
+
+	workTasks := listProjectTasks(work.Id.String())
+	require.Len(t, workTasks, 1)
+	assert.Equal(t, report.Id, workTasks[0].Id)
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Lost", "projectId": uuid.New().String()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, err = testServer.makeRequest("DELETE", "/projects/"+work.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+report.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var moved generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &moved))
+	assert.Nil(t, moved.ProjectId)
+
+	rec, err = testServer.makeRequest("DELETE", "/projects/"+home.Id.String()+"?tasks=delete", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/trash", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var trash []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &trash))
+	require.Len(t, trash, 1)
+	assert.Equal(t, "Groceries", trash[0].Title)
+
+	rec, err = testServer.makeRequest("GET", "/projects/"+home.Id.String()+"/tasks", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestE2E_MoveTask(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	createTask := func(title string) generated.Task {
+		rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": title}, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var created generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		return created
+	}
+
+	listTitles := func() []string {
+		rec, err := testServer.makeRequest("GET", "/tasks", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var tasks []generated.Task
+
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+
+		titles := make([]string, len(tasks))
+		for i, task := range tasks {
+			titles[i] = task.Title
+		}
+
+		return titles
+	}
+
+	first := createTask("First")
+	second := createTask("Second")
+	third := createTask("Third")
+
+	// Act & Assert
+	assert.Equal(t, []string{"First", "Second", "Third"}, listTitles())
+
+	rec, err := testServer.makeRequest("POST", "/tasks/"+third.Id.String()+"/move", map[string]any{"beforeId": first.Id.String()}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("ETag"))
+
+	var moved generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &moved))
+	assert.Less(t, moved.Rank, first.Rank)
+	assert.Equal(t, []string{"Third", "First", "Second"}, listTitles())
+
+	rec, err = testServer.makeRequest("POST", "/tasks/"+first.Id.String()+"/move", map[string]any{"afterId": second.Id.String()}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"Third", "Second", "First"}, listTitles())
+
+	rec, err = testServer.makeRequest("POST", "/tasks/"+first.Id.String()+"/move", map[string]any{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, err = testServer.makeRequest("POST", "/tasks/"+first.Id.String()+"/move", map[string]any{"afterId": first.Id.String(), "beforeId": third.Id.String()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, err = testServer.makeRequest("POST", "/tasks/"+first.Id.String()+"/move", map[string]any{"afterId": second.Id.String(), "beforeId": third.Id.String()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestE2E_SharedTasks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	collaboratorID := uuid.New().String()
+	asCollaborator := map[string]string{
+		"Authorization": "Bearer " + generateTestJWTToken(collaboratorID, "test-secret-key-for-e2e-testing"),
+	}
+
+	rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Shared Task"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var shared generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &shared))
+	assert.False(t, shared.Shared)
+
+	collaboratorPath := "/tasks/" + shared.Id.String() + "/collaborators/" + collaboratorID
+
+	// Act & Assert
+	rec, err = testServer.makeRequest("GET", "/tasks/"+shared.Id.String(), nil, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", collaboratorPath, map[string]any{"role": "viewer"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks", nil, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var tasks []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+	require.Len(t, tasks, 1)
+	assert.True(t, tasks[0].Shared)
+	require.NotNil(t, tasks[0].Role)
+	assert.Equal(t, generated.Viewer, *tasks[0].Role)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+shared.Id.String(), map[string]any{"title": "Renamed"}, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", collaboratorPath, map[string]any{"role": "editor"}, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", collaboratorPath, map[string]any{"role": "editor"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+shared.Id.String(), map[string]any{"title": "Renamed"}, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+shared.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var renamed generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &renamed))
+	assert.Equal(t, "Renamed", renamed.Title)
+	assert.False(t, renamed.Shared)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+shared.Id.String()+"/collaborators", nil, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var collaborators []generated.Collaborator
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &collaborators))
+	require.Len(t, collaborators, 1)
+	assert.Equal(t, collaboratorID, collaborators[0].UserId.String())
+
+	rec, err = testServer.makeRequest("DELETE", collaboratorPath, nil, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+shared.Id.String(), nil, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestE2E_Workspaces(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	memberID := uuid.New().String()
+	asMember := map[string]string{
+		"Authorization": "Bearer " + generateTestJWTToken(memberID, "test-secret-key-for-e2e-testing"),
+	}
+
+	rec, err := testServer.makeRequest("POST", "/workspaces", map[string]any{"name": "Team"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var team generated.Workspace
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &team))
+
+	workspacePath := "/workspaces/" + team.Id.String()
+	inTeam := map[string]string{"X-Workspace-ID": team.Id.String()}
+	memberInTeam := map[string]string{
+		"Authorization":  asMember["Authorization"],
+		"X-Workspace-ID": team.Id.String(),
+	}
+
+	// Act & Assert
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Team Task"}, inTeam)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var teamTask generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &teamTask))
+	require.NotNil(t, teamTask.WorkspaceId)
+	assert.Equal(t, team.Id, *teamTask.WorkspaceId)
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Second Team Task"}, inTeam)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Personal Task"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks", nil, inTeam)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var tasks []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+	assert.Len(t, tasks, 2)
+
+	rec, err = testServer.makeRequest("GET", "/tasks", nil, memberInTeam)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", workspacePath+"/members/"+memberID, map[string]any{"role": "guest"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", workspacePath+"/members", nil, asMember)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var members []generated.WorkspaceMember
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &members))
+	require.Len(t, members, 2)
+	assert.Equal(t, generated.Owner, members[0].Role)
+	assert.Equal(t, generated.Guest, members[1].Role)
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Guest Task"}, memberInTeam)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+teamTask.Id.String(), nil, memberInTeam)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+teamTask.Id.String(), map[string]any{"title": "Guest Edit"}, memberInTeam)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", workspacePath+"/members/"+memberID, map[string]any{"role": "admin"}, asMember)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", workspacePath+"/members/"+memberID, map[string]any{"role": "member"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Member Task"}, memberInTeam)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks", nil, memberInTeam)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+	assert.Len(t, tasks, 3)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+teamTask.Id.String(), map[string]any{"title": "Renamed Team Task"}, memberInTeam)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/workspaces", nil, asMember)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var workspaces []generated.Workspace
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &workspaces))
+	require.Len(t, workspaces, 1)
+	assert.Equal(t, team.Id, workspaces[0].Id)
+
+	rec, err = testServer.makeRequest("DELETE", workspacePath+"/members/"+memberID, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks", nil, memberInTeam)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestE2E_TaskAssignees(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	collaboratorID := uuid.New().String()
+	asCollaborator := map[string]string{
+		"Authorization": "Bearer " + generateTestJWTToken(collaboratorID, "test-secret-key-for-e2e-testing"),
+	}
+
+	rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Report"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var report generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Nil(t, report.AssigneeId)
+
+	assigneePath := "/tasks/" + report.Id.String() + "/assignee"
+
+	// Act & Assert
+	rec, err = testServer.makeRequest("PUT", assigneePath, map[string]any{"assigneeId": collaboratorID}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+report.Id.String()+"/collaborators/"+collaboratorID, map[string]any{"role": "editor"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", assigneePath, map[string]any{"assigneeId": collaboratorID}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var assigned generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &assigned))
+	require.NotNil(t, assigned.AssigneeId)
+	assert.Equal(t, collaboratorID, assigned.AssigneeId.String())
+
+	rec, err = testServer.makeRequest("GET", "/tasks?assigned_to=me", nil, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var tasks []generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+	require.Len(t, tasks, 1)
+	assert.Equal(t, report.Id, tasks[0].Id)
+
+	rec, err = testServer.makeRequest("GET", "/tasks?assigned_to=me", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tasks))
+	assert.Empty(t, tasks)
+
+	rec, err = testServer.makeRequest("PUT", assigneePath, map[string]any{"assigneeId": nil}, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", "/tasks/"+report.Id.String()+"/assignments", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var assignments []generated.TaskAssignment
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &assignments))
+	require.Len(t, assignments, 2)
+	require.NotNil(t, assignments[0].AssigneeId)
+	assert.Equal(t, collaboratorID, assignments[0].AssigneeId.String())
+	assert.Nil(t, assignments[1].AssigneeId)
+	assert.Equal(t, collaboratorID, assignments[1].AssignedBy.String())
+	assert.NotEqual(t, assignments[0].AssignedBy, assignments[1].AssignedBy)
+
+	rec, err = testServer.makeRequest("PATCH", "/tasks/"+report.Id.String(), map[string]any{"assigneeId": collaboratorID},
+		map[string]string{"Content-Type": "application/merge-patch+json"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestE2E_TaskComments(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	collaboratorID := uuid.New().String()
+	asCollaborator := map[string]string{
+		"Authorization": "Bearer " + generateTestJWTToken(collaboratorID, "test-secret-key-for-e2e-testing"),
+	}
+
+	rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Release"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var release generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &release))
+
+	commentsPath := "/tasks/" + release.Id.String() + "/comments"
+
+	// Act & Assert
+	rec, err = testServer.makeRequest("POST", commentsPath, map[string]any{"body": "Who takes this?"}, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+release.Id.String()+"/collaborators/"+collaboratorID, map[string]any{"role": "viewer"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("POST", commentsPath, map[string]any{"body": "  "}, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var question generated.Comment
+
+	rec, err = testServer.makeRequest("POST", commentsPath, map[string]any{"body": "Who takes this?"}, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &question))
+	assert.Equal(t, collaboratorID, question.AuthorId.String())
+
+	rec, err = testServer.makeRequest("POST", commentsPath, map[string]any{"body": "I do"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", commentsPath+"?limit=1", nil, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Link"), `rel="next"`)
+
+	var comments []generated.Comment
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &comments))
+	require.Len(t, comments, 1)
+	assert.Equal(t, question.Id, comments[0].Id)
+
+	commentPath := "/comments/" + question.Id.String()
+
+	rec, err = testServer.makeRequest("PATCH", commentPath, map[string]any{"body": "Edited"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec, err = testServer.makeRequest("PATCH", commentPath, map[string]any{"body": "Who takes this one?"}, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var edited generated.Comment
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &edited))
+	assert.Equal(t, "Who takes this one?", edited.Body)
+	assert.True(t, edited.Edited)
+
+	rec, err = testServer.makeRequest("DELETE", commentPath, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("DELETE", commentPath, nil, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", commentsPath, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Link"))
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &comments))
+	require.Len(t, comments, 2)
+	assert.True(t, comments[0].Deleted)
+	assert.Empty(t, comments[0].Body)
+	assert.Equal(t, "I do", comments[1].Body)
+}
+
+func TestE2E_TaskHistory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	collaboratorID := uuid.New().String()
+	asCollaborator := map[string]string{
+		"Authorization": "Bearer " + generateTestJWTToken(collaboratorID, "test-secret-key-for-e2e-testing"),
+	}
+
+	rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Draft"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var report generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+
+	historyPath := "/tasks/" + report.Id.String() + "/history"
+
+	// Act & Assert
+	rec, err = testServer.makeRequest("GET", historyPath, nil, asCollaborator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+report.Id.String()+"/collaborators/"+collaboratorID, map[string]any{"role": "editor"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+report.Id.String(), map[string]any{"title": "Report", "completed": true}, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", historyPath+"?limit=2", nil, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	link := rec.Header().Get("Link")
+	assert.Contains(t, link, `rel="next"`)
+
+	var firstPage []generated.TaskChange
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &firstPage))
+	require.Len(t, firstPage, 2)
+	assert.Equal(t, generated.Created, firstPage[0].Type)
+	assert.Equal(t, generated.TitleChanged, firstPage[1].Type)
+	assert.Equal(t, collaboratorID, firstPage[1].ActorId.String())
+	require.NotNil(t, firstPage[1].Before)
+	assert.Equal(t, "Draft", *firstPage[1].Before)
+	require.NotNil(t, firstPage[1].After)
+	assert.Equal(t, "Report", *firstPage[1].After)
+
+	nextPath := strings.TrimSuffix(strings.TrimPrefix(link, "<"), `>; rel="next"`)
+
+	rec, err = testServer.makeRequest("GET", nextPath, nil, asCollaborator)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Link"))
+
+	var secondPage []generated.TaskChange
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &secondPage))
+	require.Len(t, secondPage, 1)
+	assert.Equal(t, generated.Completed, secondPage[0].Type)
+
+	rec, err = testServer.makeRequest("GET", historyPath+"?cursor=invalid", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestE2E_TaskAttachments(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	viewerID := uuid.New().String()
+	asViewer := map[string]string{
+		"Authorization": "Bearer " + generateTestJWTToken(viewerID, "test-secret-key-for-e2e-testing"),
+	}
+
+	png := []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR\x00\x00\x00\x01\x00\x00\x00\x01")
+
+	rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Design"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var design generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &design))
+
+	attachmentsPath := "/tasks/" + design.Id.String() + "/attachments"
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+design.Id.String()+"/collaborators/"+viewerID, map[string]any{"role": "viewer"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Act & Assert
+	rec, err = testServer.makeUploadRequest(attachmentsPath, "page.html", []byte("<html><script>alert(1)</script></html>"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+
+	rec, err = testServer.makeUploadRequest(attachmentsPath, "diagram.png", png, asViewer)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec, err = testServer.makeUploadRequest(attachmentsPath, "../diagram.png", png, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var uploaded generated.Attachment
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &uploaded))
+	assert.Equal(t, "diagram.png", uploaded.FileName)
+	assert.Equal(t, "image/png", uploaded.ContentType)
+	assert.Equal(t, int64(len(png)), uploaded.Size)
+	assert.Len(t, uploaded.Sha256, 64)
+
+	rec, err = testServer.makeRequest("GET", attachmentsPath, nil, asViewer)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var attachments []generated.Attachment
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &attachments))
+	require.Len(t, attachments, 1)
+	assert.Equal(t, uploaded.Id, attachments[0].Id)
+
+	attachmentPath := "/attachments/" + uploaded.Id.String()
+
+	rec, err = testServer.makeRequest("GET", attachmentPath, nil, asViewer)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, png, rec.Body.Bytes())
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=diagram.png`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, `"`+uploaded.Sha256+`"`, rec.Header().Get("ETag"))
+
+	rec, err = testServer.makeRequest("DELETE", attachmentPath, nil, asViewer)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec, err = testServer.makeRequest("DELETE", attachmentPath, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", attachmentPath, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// webhookReceiver records the deliveries it is sent whose signature is valid
+// for secret and replies to every delivery with status.
+type webhookReceiver struct {
+	mu         sync.Mutex
+	deliveries []http.Header
+	payloads   []map[string]any
+}
+
+func newWebhookReceiver(t *testing.T, secret string, status int) (*webhookReceiver, *httptest.Server) {
+	t.Helper()
+
+	receiver := &webhookReceiver{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		unix, err := strconv.ParseInt(r.Header.Get(webhooksender.HeaderTimestamp), 10, 64)
+		if err != nil || !webhook.Verify(secret, time.Unix(unix, 0), body, r.Header.Get(webhooksender.HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		receiver.mu.Lock()
+		receiver.deliveries = append(receiver.deliveries, r.Header.Clone())
+		receiver.payloads = append(receiver.payloads, payload)
+		receiver.mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return receiver, server
+}
+
+func (r *webhookReceiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.deliveries)
+}
+
+func TestE2E_WebhookDelivery(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	const secret = "e2e-webhook-secret"
+
+	receiver, endpoint := newWebhookReceiver(t, secret, http.StatusNoContent)
+
+	rec, err := testServer.makeRequest("POST", "/webhooks", map[string]any{
+		"url":        endpoint.URL,
+		"secret":     secret,
+		"eventTypes": []string{"task.created", "task.completed"},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	assert.NotContains(t, rec.Body.String(), secret)
+
+	var created generated.Webhook
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.True(t, created.Active)
+
+	// Act
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Ship release"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var release generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &release))
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+release.Id.String(), map[string]any{"title": "Ship the release"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+release.Id.String(), map[string]any{"title": "Ship the release", "completed": true}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Assert
+	require.Eventually(t, func() bool {
+		return receiver.count() == 2
+	}, 10*time.Second, 100*time.Millisecond)
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	assert.Equal(t, "task.created", receiver.deliveries[0].Get(webhooksender.HeaderEvent))
+	assert.Equal(t, "task.completed", receiver.deliveries[1].Get(webhooksender.HeaderEvent))
+	assert.Equal(t, receiver.payloads[0]["id"], receiver.deliveries[0].Get(webhooksender.HeaderEventID))
+
+	data, ok := receiver.payloads[1]["data"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, release.Id.String(), data["taskId"])
+}
+
+func TestE2E_WebhookDisabledAfterFailures(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	const secret = "e2e-webhook-secret"
+
+	receiver, endpoint := newWebhookReceiver(t, secret, http.StatusInternalServerError)
+
+	rec, err := testServer.makeRequest("POST", "/webhooks", map[string]any{"url": endpoint.URL, "secret": secret}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var created generated.Webhook
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+	webhookPath := "/webhooks/" + created.Id.String()
+
+	// Act
+	rec, err = testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Unreachable"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	// Assert
+	var disabled generated.Webhook
+
+	require.Eventually(t, func() bool {
+		rec, err := testServer.makeRequest("GET", webhookPath, nil, nil)
+		if err != nil || rec.Code != http.StatusOK {
+			return false
+		}
+
+		return json.Unmarshal(rec.Body.Bytes(), &disabled) == nil && !disabled.Active
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assert.Equal(t, e2eWebhookPolicy.DisableAfter, disabled.FailureCount)
+	assert.NotNil(t, disabled.DisabledAt)
+	assert.Equal(t, e2eWebhookPolicy.MaxAttempts, receiver.count())
+
+	rec, err = testServer.makeRequest("PATCH", webhookPath, map[string]any{"active": true}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var enabled generated.Webhook
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &enabled))
+	assert.True(t, enabled.Active)
+	assert.Zero(t, enabled.FailureCount)
+
+	rec, err = testServer.makeRequest("DELETE", webhookPath, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = testServer.makeRequest("GET", webhookPath, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestE2E_TaskEventStream(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	httpServer := httptest.NewServer(testServer.server)
+	defer httpServer.Close()
+
+	req, err := http.NewRequest(http.MethodGet, httpServer.URL+"/tasks/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+testServer.jwtToken)
+
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+
+	// readEvent returns the type and data of the next event, skipping heartbeats
+	readEvent := func() (string, string) {
+		var eventType, data string
+
+		for {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+
+			line = strings.TrimSuffix(line, "\n")
+
+			switch {
+			case line == "" && eventType != "":
+				return eventType, data
+			case strings.HasPrefix(line, "event: "):
+				eventType = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}
+
+	// Act & Assert
+	// The events are streamed asynchronously, so each one is read before the
+	// next change is made to keep their order.
+	rec, err := testServer.makeRequest("POST", "/tasks", map[string]any{"title": "Stream Task"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var created generated.Task
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+	eventType, data := readEvent()
+	assert.Equal(t, "created", eventType)
+
+	var streamed generated.Task
+
+	require.NoError(t, json.Unmarshal([]byte(data), &streamed))
+	assert.Equal(t, created.Id, streamed.Id)
+	assert.Equal(t, "Stream Task", streamed.Title)
+
+	rec, err = testServer.makeRequest("PUT", "/tasks/"+created.Id.String(), map[string]any{"title": "Streamed Task"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	eventType, data = readEvent()
+	assert.Equal(t, "updated", eventType)
+	require.NoError(t, json.Unmarshal([]byte(data), &streamed))
+	assert.Equal(t, "Streamed Task", streamed.Title)
+
+	rec, err = testServer.makeRequest("DELETE", "/tasks/"+created.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	eventType, data = readEvent()
+	assert.Equal(t, "deleted", eventType)
+	assert.JSONEq(t, `{"id":"`+created.Id.String()+`"}`, data)
+}
+
+func TestE2E_TaskSocket(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping E2E test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	testServer := setupTestServer(t)
+	defer testServer.cleanup()
+
+	httpServer := httptest.NewServer(testServer.server)
+	defer httpServer.Close()
+
+	dial := func(token string) (*websocket.Conn, error) {
+		wsConfig, err := websocket.NewConfig("ws"+strings.TrimPrefix(httpServer.URL, "http")+"/ws", httpServer.URL)
+		require.NoError(t, err)
+		wsConfig.Header.Set("Authorization", "Bearer "+token)
+
+		return websocket.DialConfig(wsConfig)
+	}
+
+	// receive reads the next message of the socket
+	receive := func(conn *websocket.Conn) map[string]json.RawMessage {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+
+		var message map[string]json.RawMessage
+
+		require.NoError(t, websocket.JSON.Receive(conn, &message))
+
+		return message
+	}
+
+	_, err := dial("invalid-token")
+	require.Error(t, err, "socket opened without a valid token")
+
+	writer, err := dial(testServer.jwtToken)
+	require.NoError(t, err)
+
+	defer writer.Close()
+
+	listener, err := dial(testServer.jwtToken)
+	require.NoError(t, err)
+
+	defer listener.Close()
+
+	// Act
+	require.NoError(t, websocket.Message.Send(writer, `{"jsonrpc":"2.0","id":1,"method":"task.create","params":{"title":"Socket Task"}}`))
+
+	// Assert
+	var response map[string]json.RawMessage
+
+	// The writer is notified of its own change as well, in either order
+	for range 2 {
+		message := receive(writer)
+		if _, ok := message["result"]; ok {
+			response = message
+		}
+	}
+
+	require.NotNil(t, response, "no response to task.create")
+	assert.JSONEq(t, "1", string(response["id"]))
+
+	var created generated.Task
+
+	require.NoError(t, json.Unmarshal(response["result"], &created))
+	assert.Equal(t, "Socket Task", created.Title)
+
+	notification := receive(listener)
+	assert.JSONEq(t, `"task.created"`, string(notification["method"]))
+
+	var notified generated.Task
+
+	require.NoError(t, json.Unmarshal(notification["params"], &notified))
+	assert.Equal(t, created.Id, notified.Id)
+
+	// Changes made over REST reach the sockets too
+	rec, err := testServer.makeRequest("DELETE", "/tasks/"+created.Id.String(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	notification = receive(listener)
+	assert.JSONEq(t, `"task.deleted"`, string(notification["method"]))
+	assert.JSONEq(t, `{"id":"`+created.Id.String()+`"}`, string(notification["params"]))
+}
@@ -0,0 +1,137 @@
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentDB_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	attachmentRepo := repository.NewAttachmentDB(db)
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	createTask := func(creatorID user.UserID) *task.Task {
+		created, err := taskRepo.Create(ctx, task.NewTaskWithoutValidation(task.GenerateTaskID(), "Attached", creatorID))
+		require.NoError(t, err)
+
+		return created
+	}
+
+	newAttachment := func(taskID task.TaskID, uploaderID user.UserID, size int64, createdAt time.Time) *attachment.Attachment {
+		attachmentEntity, err := attachment.NewAttachment(attachment.GenerateAttachmentID(), taskID, uploaderID,
+			"diagram.png", "image/png", size, strings.Repeat("a", 64), createdAt)
+		require.NoError(t, err)
+
+		return attachmentEntity
+	}
+
+	t.Run("attachments are listed oldest first and counted towards usage", func(t *testing.T) {
+		uploaderID := user.GenerateUserID()
+		taskID := createTask(uploaderID).ID()
+		otherTaskID := createTask(uploaderID).ID()
+		start := time.Now().UTC().Truncate(time.Millisecond)
+
+		second, err := attachmentRepo.CreateWithinQuota(ctx, newAttachment(taskID, uploaderID, 200, start.Add(time.Second)), 1000)
+		require.NoError(t, err)
+
+		first, err := attachmentRepo.CreateWithinQuota(ctx, newAttachment(taskID, uploaderID, 100, start), 1000)
+		require.NoError(t, err)
+
+		_, err = attachmentRepo.CreateWithinQuota(ctx, newAttachment(otherTaskID, uploaderID, 300, start), 1000)
+		require.NoError(t, err)
+
+		attachments, err := attachmentRepo.FindAllByTaskID(ctx, taskID)
+		require.NoError(t, err)
+		require.Len(t, attachments, 2)
+		assert.Equal(t, first.ID(), attachments[0].ID())
+		assert.Equal(t, second.ID(), attachments[1].ID())
+		assert.Equal(t, "image/png", attachments[0].ContentType())
+		assert.Equal(t, int64(100), attachments[0].Size())
+
+		used, err := attachmentRepo.UsageByUserID(ctx, uploaderID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(600), used)
+	})
+
+	t.Run("attachment over the quota is not stored", func(t *testing.T) {
+		uploaderID := user.GenerateUserID()
+		taskID := createTask(uploaderID).ID()
+
+		_, err := attachmentRepo.CreateWithinQuota(ctx, newAttachment(taskID, uploaderID, 800, time.Now()), 1000)
+		require.NoError(t, err)
+
+		rejected := newAttachment(taskID, uploaderID, 201, time.Now())
+		_, err = attachmentRepo.CreateWithinQuota(ctx, rejected, 1000)
+		require.ErrorIs(t, err, attachment.ErrQuotaExceeded)
+
+		_, err = attachmentRepo.FindById(ctx, rejected.ID())
+		assert.ErrorIs(t, err, attachment.ErrAttachmentNotFound)
+
+		_, err = attachmentRepo.CreateWithinQuota(ctx, newAttachment(taskID, uploaderID, 200, time.Now()), 1000)
+		assert.NoError(t, err)
+	})
+
+	t.Run("deleted attachment frees the quota", func(t *testing.T) {
+		uploaderID := user.GenerateUserID()
+		taskID := createTask(uploaderID).ID()
+
+		created, err := attachmentRepo.CreateWithinQuota(ctx, newAttachment(taskID, uploaderID, 500, time.Now()), 1000)
+		require.NoError(t, err)
+
+		require.NoError(t, attachmentRepo.Delete(ctx, created.ID()))
+
+		used, err := attachmentRepo.UsageByUserID(ctx, uploaderID)
+		require.NoError(t, err)
+		assert.Zero(t, used)
+
+		assert.ErrorIs(t, attachmentRepo.Delete(ctx, created.ID()), attachment.ErrAttachmentNotFound)
+	})
+
+	t.Run("attachments of a purged task are detached", func(t *testing.T) {
+		uploaderID := user.GenerateUserID()
+		taskEntity := createTask(uploaderID)
+
+		created, err := attachmentRepo.CreateWithinQuota(ctx, newAttachment(taskEntity.ID(), uploaderID, 100, time.Now()), 1000)
+		require.NoError(t, err)
+
+		require.NoError(t, taskRepo.Delete(ctx, uploaderID, taskEntity.ID(), nil))
+
+		_, err = taskRepo.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+
+		_, err = attachmentRepo.FindById(ctx, created.ID())
+		require.ErrorIs(t, err, attachment.ErrAttachmentNotFound)
+
+		detached, err := attachmentRepo.FindDetached(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, detached, 1)
+		assert.Equal(t, created.ID(), detached[0].ID())
+		assert.True(t, detached[0].IsDetached())
+		assert.Equal(t, created.Key(), detached[0].Key())
+
+		require.NoError(t, attachmentRepo.Delete(ctx, created.ID()))
+
+		detached, err = attachmentRepo.FindDetached(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, detached)
+	})
+}
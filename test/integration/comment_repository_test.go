@@ -0,0 +1,141 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/comment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentDB_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	commentRepo := repository.NewCommentDB(db)
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	createTask := func(creatorID user.UserID) *task.Task {
+		created, err := taskRepo.Create(ctx, task.NewTaskWithoutValidation(task.GenerateTaskID(), "Discussed", creatorID))
+		require.NoError(t, err)
+
+		return created
+	}
+
+	createComment := func(taskID task.TaskID, authorID user.UserID, body string, createdAt time.Time) *comment.Comment {
+		commentEntity, err := comment.NewComment(comment.GenerateCommentID(), taskID, authorID, body, createdAt)
+		require.NoError(t, err)
+
+		created, err := commentRepo.Create(ctx, commentEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	t.Run("comments are paged oldest first", func(t *testing.T) {
+		authorID := user.GenerateUserID()
+		taskID := createTask(authorID).ID()
+		otherTaskID := createTask(authorID).ID()
+		start := time.Now().UTC().Truncate(time.Millisecond)
+
+		first := createComment(taskID, authorID, "First", start)
+		second := createComment(taskID, authorID, "Second", start.Add(time.Second))
+		third := createComment(taskID, authorID, "Third", start.Add(2*time.Second))
+		createComment(otherTaskID, authorID, "Elsewhere", start)
+
+		pageRequest, err := comment.NewPageRequest(2, "")
+		require.NoError(t, err)
+
+		page, err := commentRepo.FindPageByTaskID(ctx, taskID, pageRequest)
+		require.NoError(t, err)
+		require.Len(t, page.Comments, 2)
+		assert.Equal(t, first.ID(), page.Comments[0].ID())
+		assert.Equal(t, second.ID(), page.Comments[1].ID())
+		require.True(t, page.HasNext())
+
+		pageRequest, err = comment.NewPageRequest(2, page.NextCursor)
+		require.NoError(t, err)
+
+		page, err = commentRepo.FindPageByTaskID(ctx, taskID, pageRequest)
+		require.NoError(t, err)
+		require.Len(t, page.Comments, 1)
+		assert.Equal(t, third.ID(), page.Comments[0].ID())
+		assert.Equal(t, "Third", page.Comments[0].Body())
+		assert.False(t, page.HasNext())
+	})
+
+	t.Run("malformed cursor is rejected", func(t *testing.T) {
+		pageRequest, err := comment.NewPageRequest(10, "not-a-cursor")
+		require.NoError(t, err)
+
+		_, err = commentRepo.FindPageByTaskID(ctx, task.GenerateTaskID(), pageRequest)
+		assert.ErrorIs(t, err, comment.ErrInvalidCursor)
+	})
+
+	t.Run("edited and deleted comments are stored", func(t *testing.T) {
+		authorID := user.GenerateUserID()
+		taskID := createTask(authorID).ID()
+		edited := createComment(taskID, authorID, "Draft", time.Now())
+		deleted := createComment(taskID, authorID, "Oops", time.Now())
+
+		require.NoError(t, edited.Edit("Final", time.Now()))
+		_, err := commentRepo.Update(ctx, edited)
+		require.NoError(t, err)
+
+		require.NoError(t, deleted.Delete(time.Now()))
+		_, err = commentRepo.Update(ctx, deleted)
+		require.NoError(t, err)
+
+		found, err := commentRepo.FindById(ctx, edited.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Final", found.Body())
+		assert.True(t, found.IsEdited())
+		assert.False(t, found.IsDeleted())
+
+		found, err = commentRepo.FindById(ctx, deleted.ID())
+		require.NoError(t, err)
+		assert.Empty(t, found.Body())
+		assert.True(t, found.IsDeleted())
+	})
+
+	t.Run("updating a missing comment fails", func(t *testing.T) {
+		missing, err := comment.NewComment(comment.GenerateCommentID(), task.GenerateTaskID(), user.GenerateUserID(), "Ghost", time.Now())
+		require.NoError(t, err)
+
+		_, err = commentRepo.Update(ctx, missing)
+		assert.ErrorIs(t, err, comment.ErrCommentNotFound)
+
+		_, err = commentRepo.FindById(ctx, missing.ID())
+		assert.ErrorIs(t, err, comment.ErrCommentNotFound)
+	})
+
+	t.Run("comments are removed when their task is purged", func(t *testing.T) {
+		authorID := user.GenerateUserID()
+		taskEntity := createTask(authorID)
+		commentEntity := createComment(taskEntity.ID(), authorID, "Gone soon", time.Now())
+
+		require.NoError(t, taskRepo.Delete(ctx, authorID, taskEntity.ID(), nil))
+
+		_, err := commentRepo.FindById(ctx, commentEntity.ID())
+		require.NoError(t, err)
+
+		_, err = taskRepo.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+
+		_, err = commentRepo.FindById(ctx, commentEntity.ID())
+		assert.ErrorIs(t, err, comment.ErrCommentNotFound)
+	})
+}
@@ -0,0 +1,112 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/idempotency"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyDB_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := repository.NewIdempotencyDB(db)
+	ctx := context.Background()
+
+	newRecord := func(userID user.UserID, key string, fingerprint string, expiresAt time.Time) idempotency.Record {
+		idempotencyKey, err := idempotency.NewKey(key)
+		require.NoError(t, err)
+
+		return idempotency.Record{
+			UserID:      userID,
+			Key:         idempotencyKey,
+			Fingerprint: fingerprint,
+			Response:    nil,
+			ExpiresAt:   expiresAt,
+		}
+	}
+
+	t.Run("reserve, complete and replay", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		record := newRecord(userID, "create-1", "fingerprint", time.Now().Add(time.Hour))
+
+		existing, err := repo.Reserve(ctx, record)
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+
+		existing, err = repo.Reserve(ctx, record)
+		require.NoError(t, err)
+		require.NotNil(t, existing)
+		assert.False(t, existing.IsCompleted())
+
+		response := idempotency.Response{StatusCode: 201, ContentType: "application/json", Body: []byte(`{"id":"1"}`)}
+		require.NoError(t, repo.Complete(ctx, userID, record.Key, response))
+
+		existing, err = repo.Reserve(ctx, record)
+		require.NoError(t, err)
+		require.NotNil(t, existing)
+		assert.True(t, existing.Matches("fingerprint"))
+		assert.Equal(t, &response, existing.Response)
+
+		assert.ErrorIs(t, repo.Complete(ctx, userID, record.Key, response), idempotency.ErrRecordNotFound)
+	})
+
+	t.Run("keys are scoped per user", func(t *testing.T) {
+		first := newRecord(user.GenerateUserID(), "shared", "a", time.Now().Add(time.Hour))
+		second := newRecord(user.GenerateUserID(), "shared", "b", time.Now().Add(time.Hour))
+
+		existing, err := repo.Reserve(ctx, first)
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+
+		existing, err = repo.Reserve(ctx, second)
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+	})
+
+	t.Run("release allows the key to be reserved again", func(t *testing.T) {
+		record := newRecord(user.GenerateUserID(), "retry", "fingerprint", time.Now().Add(time.Hour))
+
+		_, err := repo.Reserve(ctx, record)
+		require.NoError(t, err)
+		require.NoError(t, repo.Release(ctx, record.UserID, record.Key))
+
+		existing, err := repo.Reserve(ctx, record)
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+	})
+
+	t.Run("expired record is replaced and purged", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		expired := newRecord(userID, "old", "before", time.Now().Add(-time.Minute))
+
+		_, err := repo.Reserve(ctx, expired)
+		require.NoError(t, err)
+
+		replacement := newRecord(userID, "old", "after", time.Now().Add(time.Hour))
+		existing, err := repo.Reserve(ctx, replacement)
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+
+		stale := newRecord(userID, "stale", "fingerprint", time.Now().Add(-time.Minute))
+		_, err = repo.Reserve(ctx, stale)
+		require.NoError(t, err)
+
+		deleted, err := repo.DeleteExpired(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+	})
+}
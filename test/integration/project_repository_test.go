@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectDB_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	projectRepo := repository.NewProjectDB(db)
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	createProject := func(userID user.UserID, name string) *project.Project {
+		projectEntity, err := project.NewProject(project.GenerateProjectID(), name, userID)
+		require.NoError(t, err)
+
+		created, err := projectRepo.Create(ctx, projectEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	createTask := func(userID user.UserID, title string, projectID *project.ProjectID, parentID *task.TaskID) *task.Task {
+		taskEntity := task.NewTaskWithoutValidation(task.GenerateTaskID(), title, userID,
+			task.WithProjectID(projectID), task.WithParentID(parentID))
+
+		created, err := taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	t.Run("projects are listed by name and scoped to their owner", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		work := createProject(userID, "Work")
+		home := createProject(userID, "Home")
+		createProject(user.GenerateUserID(), "Other")
+
+		projects, err := projectRepo.FindAllByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, projects, 2)
+		assert.Equal(t, home.ID(), projects[0].ID())
+		assert.Equal(t, work.ID(), projects[1].ID())
+
+		_, err = projectRepo.FindById(ctx, user.GenerateUserID(), work.ID())
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+	})
+
+	t.Run("project is renamed", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		projectEntity := createProject(userID, "Work")
+		require.NoError(t, projectEntity.Rename("Office"))
+
+		_, err := projectRepo.Update(ctx, projectEntity)
+		require.NoError(t, err)
+
+		found, err := projectRepo.FindById(ctx, userID, projectEntity.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "Office", found.Name())
+	})
+
+	t.Run("tasks are filtered by project", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		projectID := createProject(userID, "Work").ID()
+		inProject := createTask(userID, "In project", &projectID, nil)
+		createTask(userID, "In inbox", nil, nil)
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{ProjectID: &projectID})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, inProject.ID(), tasks[0].ID())
+		require.NotNil(t, tasks[0].ProjectID())
+		assert.Equal(t, projectID, *tasks[0].ProjectID())
+	})
+
+	t.Run("deleting a project moves its tasks to the inbox", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		projectID := createProject(userID, "Work").ID()
+		projectTask := createTask(userID, "Task", &projectID, nil)
+
+		require.NoError(t, projectRepo.Delete(ctx, userID, projectID, project.MoveTasksToInbox))
+
+		found, err := taskRepo.FindById(ctx, userID, projectTask.ID())
+		require.NoError(t, err)
+		assert.Nil(t, found.ProjectID())
+		assert.Greater(t, found.Version(), projectTask.Version())
+
+		_, err = projectRepo.FindById(ctx, userID, projectID)
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+	})
+
+	t.Run("deleting a project moves its tasks and their subtasks to the trash", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		projectID := createProject(userID, "Work").ID()
+		projectTask := createTask(userID, "Task", &projectID, nil)
+		parentID := projectTask.ID()
+		subtask := createTask(userID, "Subtask", nil, &parentID)
+		inbox := createTask(userID, "Inbox", nil, nil)
+
+		require.NoError(t, projectRepo.Delete(ctx, userID, projectID, project.DeleteTasks))
+
+		_, err := taskRepo.FindById(ctx, userID, projectTask.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+
+		_, err = taskRepo.FindById(ctx, userID, subtask.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+
+		_, err = taskRepo.FindById(ctx, userID, inbox.ID())
+		assert.NoError(t, err)
+
+		restored, err := taskRepo.Restore(ctx, userID, projectTask.ID())
+		require.NoError(t, err)
+		assert.Nil(t, restored.ProjectID())
+	})
+
+	t.Run("a project of another user is not deleted", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		projectID := createProject(userID, "Work").ID()
+		projectTask := createTask(userID, "Task", &projectID, nil)
+
+		err := projectRepo.Delete(ctx, user.GenerateUserID(), projectID, project.DeleteTasks)
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+
+		found, err := taskRepo.FindById(ctx, userID, projectTask.ID())
+		require.NoError(t, err)
+		require.NotNil(t, found.ProjectID())
+	})
+}
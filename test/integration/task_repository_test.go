@@ -2,10 +2,13 @@ package integration
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
@@ -42,7 +45,30 @@ func setupTestDB(t *testing.T) (*gorm.DB, func()) {
 	db, err := gorm.Open(gormPostgres.Open(connStr), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&repository.TaskModel{})
+	// The trigram index on tasks.title needs pg_trgm, which AutoMigrate cannot enable.
+	err = db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(
+		&repository.TaskModel{},
+		&repository.IdempotencyKeyModel{},
+		&repository.TagModel{},
+		&repository.TaskTagModel{},
+		&repository.TaskDependencyModel{},
+		&repository.TaskSeriesModel{},
+		&repository.ProjectModel{},
+		&repository.TaskCollaboratorModel{},
+		&repository.WorkspaceModel{},
+		&repository.WorkspaceMemberModel{},
+		&repository.TaskAssignmentModel{},
+		&repository.CommentModel{},
+		&repository.AttachmentModel{},
+		&repository.TaskEventModel{},
+		&repository.WebhookModel{},
+		&repository.WebhookOutboxModel{},
+		&repository.WebhookDeliveryModel{},
+		&repository.WebhookAttemptModel{},
+	)
 	require.NoError(t, err)
 
 	return db, func() {
@@ -284,7 +310,7 @@ func TestTaskDB_Integration_FindAllByUserID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Act
-			tasks, err := taskRepo.FindAllByUserID(ctx, tt.creatorID)
+			tasks, err := taskRepo.FindAllByUserID(ctx, tt.creatorID, task.Filter{})
 
 			// Assert
 			if tt.expectedError != nil {
@@ -305,7 +331,7 @@ func TestTaskDB_Integration_FindAllByUserID(t *testing.T) {
 
 	// Verify specific task content for user1
 	t.Run("verify user1 task content", func(t *testing.T) {
-		tasks, err := taskRepo.FindAllByUserID(ctx, user1ID)
+		tasks, err := taskRepo.FindAllByUserID(ctx, user1ID, task.Filter{})
 		require.NoError(t, err)
 		require.Len(t, tasks, 2)
 
@@ -317,7 +343,7 @@ func TestTaskDB_Integration_FindAllByUserID(t *testing.T) {
 
 	// Verify specific task content for user2
 	t.Run("verify user2 task content", func(t *testing.T) {
-		tasks, err := taskRepo.FindAllByUserID(ctx, user2ID)
+		tasks, err := taskRepo.FindAllByUserID(ctx, user2ID, task.Filter{})
 		require.NoError(t, err)
 		require.Len(t, tasks, 1)
 
@@ -326,7 +352,7 @@ func TestTaskDB_Integration_FindAllByUserID(t *testing.T) {
 	})
 }
 
-func TestTaskDB_Integration_MultiByteTitles(t *testing.T) {
+func TestTaskDB_Integration_Completion(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
 	}
@@ -337,65 +363,141 @@ func TestTaskDB_Integration_MultiByteTitles(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
 
+	taskRepo := repository.NewTaskDB(db)
 	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	openEntity, err := task.NewTask(task.GenerateTaskID(), "Open Task", userID)
+	require.NoError(t, err)
+	_, err = taskRepo.Create(ctx, openEntity)
+	require.NoError(t, err)
+
+	doneEntity, err := task.NewTask(task.GenerateTaskID(), "Done Task", userID)
+	require.NoError(t, err)
+	_, err = taskRepo.Create(ctx, doneEntity)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, doneEntity.Complete(time.Now()))
+	_, err = taskRepo.Update(ctx, doneEntity.UserID(), doneEntity)
+	require.NoError(t, err)
+
+	// Assert
+	completed := true
+	notCompleted := false
+
+	t.Run("filter completed tasks", func(t *testing.T) {
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{Completed: &completed})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+
+		assert.Equal(t, doneEntity.ID(), tasks[0].ID())
+		assert.True(t, tasks[0].IsCompleted())
+		assert.NotNil(t, tasks[0].CompletedAt())
+	})
+
+	t.Run("filter open tasks", func(t *testing.T) {
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{Completed: &notCompleted})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+
+		assert.Equal(t, openEntity.ID(), tasks[0].ID())
+		assert.False(t, tasks[0].IsCompleted())
+	})
+
+	t.Run("reopen persists cleared completion", func(t *testing.T) {
+		require.NoError(t, doneEntity.Reopen())
+		_, err := taskRepo.Update(ctx, doneEntity.UserID(), doneEntity)
+		require.NoError(t, err)
+
+		found, err := taskRepo.FindById(ctx, userID, doneEntity.ID())
+		require.NoError(t, err)
+		assert.False(t, found.IsCompleted())
+		assert.Nil(t, found.CompletedAt())
+	})
+}
+
+func TestTaskDB_Integration_DueDateRange(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
 	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
 
-	creatorID, err := user.NewUserID(uuid.New().String())
+	userID, err := user.NewUserID(uuid.New().String())
 	require.NoError(t, err)
 
+	base := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	dueDates := map[string]*time.Time{
+		"No Due Date":  nil,
+		"Due Jan 14":   ptrTime(base.AddDate(0, 0, -1)),
+		"Due Jan 15":   ptrTime(base),
+		"Due Jan 16":   ptrTime(base.AddDate(0, 0, 1)),
+		"Due Next Feb": ptrTime(base.AddDate(0, 1, 0)),
+	}
+
+	for title, dueAt := range dueDates {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+		require.NoError(t, err)
+		require.NoError(t, taskEntity.Reschedule(nil, dueAt, false))
+		_, err = taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+	}
+
 	tests := []struct {
-		name  string
-		title string
+		name           string
+		filter         task.Filter
+		expectedTitles []string
 	}{
 		{
-			name:  "chinese greeting exactly 255 characters",
-			title: strings.Repeat("你", 255),
-		},
-		{
-			name:  "korean greeting exactly 255 characters",
-			title: strings.Repeat("안", 255),
-		},
-		{
-			name:  "arabic greeting exactly 255 characters",
-			title: strings.Repeat("م", 255),
+			name:           "due after is inclusive",
+			filter:         task.Filter{DueAfter: ptrTime(base)},
+			expectedTitles: []string{"Due Jan 15", "Due Jan 16", "Due Next Feb"},
 		},
 		{
-			name:  "mixed multibyte characters",
-			title: "你好世界 안녕하세요 مرحبا بالعالم नमस्कार संसार สวัสดีชาวโลก שלום עולם Привет мир",
+			name:           "due before is exclusive",
+			filter:         task.Filter{DueBefore: ptrTime(base)},
+			expectedTitles: []string{"Due Jan 14"},
 		},
 		{
-			name:  "japanese with various scripts",
-			title: "日本語ひらがなカタカナ漢字テスト",
+			name:           "closed range",
+			filter:         task.Filter{DueAfter: ptrTime(base), DueBefore: ptrTime(base.AddDate(0, 0, 2))},
+			expectedTitles: []string{"Due Jan 15", "Due Jan 16"},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Create task entity with multibyte title
-			taskEntity, err := task.NewTask(task.GenerateTaskID(), tt.title, creatorID)
-			require.NoError(t, err)
-
-			// Act - Create task in database
-			createdTask, err := taskRepo.Create(ctx, taskEntity)
+			// Act
+			tasks, err := taskRepo.FindAllByUserID(ctx, userID, tt.filter)
 
 			// Assert
 			require.NoError(t, err)
-			assert.Equal(t, tt.title, createdTask.Title())
-			assert.Equal(t, creatorID, createdTask.UserID())
 
-			// Act - Retrieve task from database
-			retrievedTask, err := taskRepo.FindById(ctx, creatorID, createdTask.ID())
+			titles := make([]string, 0, len(tasks))
+			for _, item := range tasks {
+				titles = append(titles, item.Title())
+			}
 
-			// Assert
-			require.NoError(t, err)
-			assert.Equal(t, tt.title, retrievedTask.Title())
-			assert.Equal(t, createdTask.ID(), retrievedTask.ID())
-			assert.Equal(t, creatorID, retrievedTask.UserID())
+			assert.ElementsMatch(t, tt.expectedTitles, titles)
 		})
 	}
 }
 
-func TestTaskDB_Integration_ZeroWidthJoinerTitles(t *testing.T) {
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
+func TestTaskDB_Integration_FindPageByUserID(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
 	}
@@ -406,65 +508,66 @@ func TestTaskDB_Integration_ZeroWidthJoinerTitles(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
 
-	ctx := context.Background()
 	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
 
-	creatorID, err := user.NewUserID(uuid.New().String())
+	userID, err := user.NewUserID(uuid.New().String())
 	require.NoError(t, err)
 
-	tests := []struct {
-		name  string
-		title string
-	}{
-		{
-			name:  "profession emoji within boundary",
-			title: strings.Repeat("👨‍💻", 85), // 85 * 3 = 255 runes
-		},
-		{
-			name:  "family emoji within boundary",
-			title: strings.Repeat("👨‍👩‍👧‍👦", 36), // 36 * 7 = 252 runes
-		},
-		{
-			name:  "skin tone emoji within boundary",
-			title: strings.Repeat("👋🏽", 127), // 127 * 2 = 254 runes
-		},
-		{
-			name:  "mixed complex emojis",
-			title: "👨‍💻👩‍🔬👨‍🍳🧑‍🦰👋🏽👨‍👩‍👧‍👦",
-		},
-		{
-			name:  "gendered activity emojis",
-			title: "🏃‍♂️🏃‍♀️🚴‍♂️🚴‍♀️🏊‍♂️🏊‍♀️",
-		},
+	const total = 7
+
+	created := make([]task.TaskID, 0, total)
+
+	for i := range total {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), fmt.Sprintf("Task %d", i), userID)
+		require.NoError(t, err)
+		_, err = taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		created = append(created, taskEntity.ID())
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Create task entity with ZWJ emoji title
-			taskEntity, err := task.NewTask(task.GenerateTaskID(), tt.title, creatorID)
-			require.NoError(t, err)
+	// Act: walk all pages
+	var (
+		seen   []task.TaskID
+		cursor string
+		pages  int
+	)
 
-			// Act - Create task in database
-			createdTask, err := taskRepo.Create(ctx, taskEntity)
+	for {
+		pageRequest, err := task.NewPageRequest(3, cursor, nil)
+		require.NoError(t, err)
 
-			// Assert
-			require.NoError(t, err)
-			assert.Equal(t, tt.title, createdTask.Title())
-			assert.Equal(t, creatorID, createdTask.UserID())
+		page, err := taskRepo.FindPageByUserID(ctx, userID, task.Filter{}, pageRequest)
+		require.NoError(t, err)
 
-			// Act - Retrieve task from database
-			retrievedTask, err := taskRepo.FindById(ctx, creatorID, createdTask.ID())
+		pages++
 
-			// Assert
-			require.NoError(t, err)
-			assert.Equal(t, tt.title, retrievedTask.Title())
-			assert.Equal(t, createdTask.ID(), retrievedTask.ID())
-			assert.Equal(t, creatorID, retrievedTask.UserID())
-		})
+		for _, item := range page.Tasks {
+			seen = append(seen, item.ID())
+		}
+
+		if !page.HasNext() {
+			break
+		}
+
+		cursor = page.NextCursor
 	}
+
+	// Assert
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, created, seen)
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		pageRequest, err := task.NewPageRequest(3, "not-a-cursor", nil)
+		require.NoError(t, err)
+
+		_, err = taskRepo.FindPageByUserID(ctx, userID, task.Filter{}, pageRequest)
+		assert.ErrorIs(t, err, task.ErrInvalidCursor)
+	})
 }
 
-func TestTaskDB_Integration_BoundaryTitles(t *testing.T) {
+func TestTaskDB_Integration_SortAndFilter(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
 	}
@@ -475,81 +578,1601 @@ func TestTaskDB_Integration_BoundaryTitles(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
 
+	taskRepo := repository.NewTaskDB(db)
 	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	for _, title := range []string{"Beta report", "Alpha REPORT", "Gamma notes", "Delta report", "100% report", "Epsilon report"} {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+		require.NoError(t, err)
+		_, err = taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+	}
+
+	sort, err := task.ParseSort("-title")
+	require.NoError(t, err)
+
+	contains, err := task.ParseCondition("title:contains:report")
+	require.NoError(t, err)
+
+	filter := task.Filter{Conditions: []task.Condition{contains}} //nolint:exhaustruct
+
+	// Act: walk all pages in descending title order
+	var (
+		titles []string
+		cursor string
+	)
+
+	for {
+		pageRequest, err := task.NewPageRequest(2, cursor, sort)
+		require.NoError(t, err)
+
+		page, err := taskRepo.FindPageByUserID(ctx, userID, filter, pageRequest)
+		require.NoError(t, err)
+
+		for _, item := range page.Tasks {
+			titles = append(titles, item.Title())
+		}
+
+		if !page.HasNext() {
+			break
+		}
+
+		cursor = page.NextCursor
+	}
+
+	// Assert
+	require.Len(t, titles, 5)
+	assert.NotContains(t, titles, "Gamma notes")
+
+	for i := 1; i < len(titles); i++ {
+		assert.GreaterOrEqual(t, titles[i-1], titles[i], "titles must be in descending order")
+	}
+
+	t.Run("like wildcards match literally", func(t *testing.T) {
+		percent, err := task.ParseCondition("title:contains:%")
+		require.NoError(t, err)
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{Conditions: []task.Condition{percent}}) //nolint:exhaustruct
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, "100% report", tasks[0].Title())
+	})
+
+	t.Run("cursor from another sort is rejected", func(t *testing.T) {
+		first, err := task.NewPageRequest(2, "", sort)
+		require.NoError(t, err)
+
+		page, err := taskRepo.FindPageByUserID(ctx, userID, filter, first)
+		require.NoError(t, err)
+		require.True(t, page.HasNext())
+
+		next, err := task.NewPageRequest(2, page.NextCursor, task.DefaultSort)
+		require.NoError(t, err)
+
+		_, err = taskRepo.FindPageByUserID(ctx, userID, filter, next)
+		assert.ErrorIs(t, err, task.ErrInvalidCursor)
+	})
+}
+
+func TestTaskDB_Integration_Search(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
 	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
 
-	creatorID, err := user.NewUserID(uuid.New().String())
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	otherUserID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	for _, title := range []string{"Write weekly report", "Report", "Buy groceries", "週次レポートを書く", "買い物リスト"} {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+		require.NoError(t, err)
+		_, err = taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+	}
+
+	otherTask, err := task.NewTask(task.GenerateTaskID(), "Someone else's report", otherUserID)
+	require.NoError(t, err)
+	_, err = taskRepo.Create(ctx, otherTask)
 	require.NoError(t, err)
 
 	tests := []struct {
-		name          string
-		title         string
-		shouldSucceed bool
-		expectedError error
+		name           string
+		text           string
+		expectedTitles []string
 	}{
 		{
-			name:          "title exactly 255 ASCII characters",
-			title:         strings.Repeat("a", 255),
-			shouldSucceed: true,
-		},
-		{
-			name:          "title exactly 255 Chinese characters",
-			title:         strings.Repeat("你", 255),
-			shouldSucceed: true,
-		},
-		{
-			name:          "title exactly 255 ZWJ emoji runes",
-			title:         strings.Repeat("👨‍💻", 85), // 85 * 3 = 255 runes
-			shouldSucceed: true,
+			name:           "full-text match ranks the closest title first",
+			text:           "report",
+			expectedTitles: []string{"Report", "Write weekly report"},
 		},
 		{
-			name:          "title 256 ASCII characters (should fail)",
-			title:         strings.Repeat("a", 256),
-			shouldSucceed: false,
-			expectedError: task.ErrTitleTooLong,
+			name:           "japanese substring",
+			text:           "レポート",
+			expectedTitles: []string{"週次レポートを書く"},
 		},
 		{
-			name:          "title 256 Chinese characters (should fail)",
-			title:         strings.Repeat("你", 256),
-			shouldSucceed: false,
-			expectedError: task.ErrTitleTooLong,
+			name:           "short japanese substring",
+			text:           "買い",
+			expectedTitles: []string{"買い物リスト"},
 		},
 		{
-			name:          "title 258 ZWJ emoji runes (should fail)",
-			title:         strings.Repeat("👨‍💻", 86), // 86 * 3 = 258 runes
-			shouldSucceed: false,
-			expectedError: task.ErrTitleTooLong,
+			name:           "no match",
+			text:           "nothing",
+			expectedTitles: []string{},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Act - Create task entity with boundary title
-			taskEntity, err := task.NewTask(task.GenerateTaskID(), tt.title, creatorID)
+			query, err := task.NewSearchQuery(tt.text, 0)
+			require.NoError(t, err)
 
-			if tt.shouldSucceed {
-				// Assert successful creation
-				require.NoError(t, err)
+			// Act
+			tasks, err := taskRepo.Search(ctx, userID, query)
 
-				// Act - Create task in database
-				createdTask, err := taskRepo.Create(ctx, taskEntity)
+			// Assert
+			require.NoError(t, err)
 
-				// Assert
-				require.NoError(t, err)
-				assert.Equal(t, tt.title, createdTask.Title())
+			titles := make([]string, 0, len(tasks))
+			for _, item := range tasks {
+				titles = append(titles, item.Title())
+			}
 
-				// Act - Retrieve task from database to ensure roundtrip works
-				retrievedTask, err := taskRepo.FindById(ctx, creatorID, createdTask.ID())
+			assert.Equal(t, tt.expectedTitles, titles)
+		})
+	}
+}
 
-				// Assert
-				require.NoError(t, err)
-				assert.Equal(t, tt.title, retrievedTask.Title())
-			} else {
-				// Assert validation failure
-				require.Error(t, err)
-				assert.ErrorIs(t, err, tt.expectedError)
-				assert.Nil(t, taskEntity)
-			}
+func TestTaskDB_Integration_OptimisticLocking(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	taskEntity, err := task.NewTask(task.GenerateTaskID(), "Shared Task", userID)
+	require.NoError(t, err)
+
+	created, err := taskRepo.Create(ctx, taskEntity)
+	require.NoError(t, err)
+	assert.Equal(t, task.InitialVersion, created.Version())
+
+	// Two clients read the same version
+	first, err := taskRepo.FindById(ctx, userID, created.ID())
+	require.NoError(t, err)
+
+	second, err := taskRepo.FindById(ctx, userID, created.ID())
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, first.UpdateTitle("First Edit"))
+	updated, firstErr := taskRepo.Update(ctx, first.UserID(), first)
+
+	require.NoError(t, second.UpdateTitle("Second Edit"))
+	_, secondErr := taskRepo.Update(ctx, second.UserID(), second)
+
+	// Assert
+	require.NoError(t, firstErr)
+	assert.Equal(t, task.InitialVersion+1, updated.Version())
+	assert.ErrorIs(t, secondErr, task.ErrVersionMismatch)
+
+	stored, err := taskRepo.FindById(ctx, userID, created.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "First Edit", stored.Title())
+	assert.Equal(t, task.InitialVersion+1, stored.Version())
+
+	t.Run("delete with stale version", func(t *testing.T) {
+		staleVersion := task.InitialVersion
+
+		err := taskRepo.Delete(ctx, userID, created.ID(), &staleVersion)
+		assert.ErrorIs(t, err, task.ErrVersionMismatch)
+
+		currentVersion := stored.Version()
+
+		err = taskRepo.Delete(ctx, userID, created.ID(), &currentVersion)
+		assert.NoError(t, err)
+
+		_, err = taskRepo.FindById(ctx, userID, created.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+	})
+}
+
+func TestTaskDB_Integration_Trash(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+	otherUserID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	createTask := func(title string, creatorID user.UserID) *task.Task {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, creatorID)
+		require.NoError(t, err)
+
+		created, err := taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	kept := createTask("Kept report", userID)
+	first := createTask("First deleted report", userID)
+	second := createTask("Second deleted report", userID)
+	otherUsers := createTask("Other user's report", otherUserID)
+
+	// Act
+	require.NoError(t, taskRepo.Delete(ctx, userID, first.ID(), nil))
+	require.NoError(t, taskRepo.Delete(ctx, userID, second.ID(), nil))
+	require.NoError(t, taskRepo.Delete(ctx, otherUserID, otherUsers.ID(), nil))
+
+	// Assert
+	t.Run("deleted tasks are hidden", func(t *testing.T) {
+		_, err := taskRepo.FindById(ctx, userID, first.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, kept.ID(), tasks[0].ID())
+
+		query, err := task.NewSearchQuery("report", 0)
+		require.NoError(t, err)
+
+		found, err := taskRepo.Search(ctx, userID, query)
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, kept.ID(), found[0].ID())
+	})
+
+	t.Run("trash lists the most recently deleted first", func(t *testing.T) {
+		trash, err := taskRepo.FindTrashByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, trash, 2)
+		assert.Equal(t, second.ID(), trash[0].ID())
+		assert.Equal(t, first.ID(), trash[1].ID())
+		assert.True(t, trash[0].IsDeleted())
+	})
+
+	t.Run("restore brings a task back", func(t *testing.T) {
+		restored, err := taskRepo.Restore(ctx, userID, first.ID())
+		require.NoError(t, err)
+		assert.False(t, restored.IsDeleted())
+		assert.Equal(t, first.Version()+1, restored.Version())
+
+		found, err := taskRepo.FindById(ctx, userID, first.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "First deleted report", found.Title())
+
+		_, err = taskRepo.Restore(ctx, userID, first.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+
+		_, err = taskRepo.Restore(ctx, userID, otherUsers.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+	})
+
+	t.Run("empty trash removes only the user's trashed tasks", func(t *testing.T) {
+		removed, err := taskRepo.EmptyTrash(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, removed, 1)
+		assert.Equal(t, second.ID(), removed[0].ID())
+
+		trash, err := taskRepo.FindTrashByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, trash)
+
+		_, err = taskRepo.Restore(ctx, userID, second.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+
+		otherTrash, err := taskRepo.FindTrashByUserID(ctx, otherUserID)
+		require.NoError(t, err)
+		assert.Len(t, otherTrash, 1)
+	})
+
+	t.Run("purge removes tasks deleted before the cutoff", func(t *testing.T) {
+		removed, err := taskRepo.PurgeDeletedBefore(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), removed)
+
+		removed, err = taskRepo.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), removed)
+
+		otherTrash, err := taskRepo.FindTrashByUserID(ctx, otherUserID)
+		require.NoError(t, err)
+		assert.Empty(t, otherTrash)
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, tasks, 2)
+	})
+}
+
+func TestTaskDB_Integration_Tags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	tagRepo := repository.NewTagDB(db)
+	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+	otherUserID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	createTag := func(name string, ownerID user.UserID) *tag.Tag {
+		tagEntity, err := tag.NewTag(tag.GenerateTagID(), name, ownerID)
+		require.NoError(t, err)
+
+		created, err := tagRepo.Create(ctx, tagEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	work := createTag("work", userID)
+	createTag("home", userID)
+	createTag("work", otherUserID)
+
+	taskEntity, err := task.NewTask(task.GenerateTaskID(), "Tagged task", userID)
+	require.NoError(t, err)
+	require.NoError(t, taskEntity.SetTags([]string{"work", "home"}))
+
+	tagged, err := taskRepo.Create(ctx, taskEntity)
+	require.NoError(t, err)
+
+	untaggedEntity, err := task.NewTask(task.GenerateTaskID(), "Untagged task", userID)
+	require.NoError(t, err)
+
+	_, err = taskRepo.Create(ctx, untaggedEntity)
+	require.NoError(t, err)
+
+	// Act & Assert
+	t.Run("tags are loaded with the task", func(t *testing.T) {
+		found, err := taskRepo.FindById(ctx, userID, tagged.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"home", "work"}, found.Tags())
+	})
+
+	t.Run("duplicate names are rejected per user", func(t *testing.T) {
+		duplicate, err := tag.NewTag(tag.GenerateTagID(), "work", userID)
+		require.NoError(t, err)
+
+		_, err = tagRepo.Create(ctx, duplicate)
+		assert.ErrorIs(t, err, tag.ErrNameTaken)
+	})
+
+	t.Run("tasks are filtered by tag", func(t *testing.T) {
+		name := "work"
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{Tag: &name})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, tagged.ID(), tasks[0].ID())
+	})
+
+	t.Run("renamed tags are renamed on tasks", func(t *testing.T) {
+		require.NoError(t, work.Rename("office"))
+
+		_, err := tagRepo.Update(ctx, work)
+		require.NoError(t, err)
+
+		found, err := taskRepo.FindById(ctx, userID, tagged.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"home", "office"}, found.Tags())
+
+		require.NoError(t, work.Rename("home"))
+
+		_, err = tagRepo.Update(ctx, work)
+		assert.ErrorIs(t, err, tag.ErrNameTaken)
+	})
+
+	t.Run("updating the task replaces its tags", func(t *testing.T) {
+		found, err := taskRepo.FindById(ctx, userID, tagged.ID())
+		require.NoError(t, err)
+		require.NoError(t, found.SetTags([]string{"office"}))
+
+		updated, err := taskRepo.Update(ctx, found.UserID(), found)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"office"}, updated.Tags())
+
+		reloaded, err := taskRepo.FindById(ctx, userID, tagged.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"office"}, reloaded.Tags())
+	})
+
+	t.Run("deleted tags are detached from tasks", func(t *testing.T) {
+		require.NoError(t, tagRepo.Delete(ctx, userID, work.ID()))
+
+		found, err := taskRepo.FindById(ctx, userID, tagged.ID())
+		require.NoError(t, err)
+		assert.Empty(t, found.Tags())
+
+		err = tagRepo.Delete(ctx, userID, work.ID())
+		assert.ErrorIs(t, err, tag.ErrTagNotFound)
+	})
+
+	t.Run("tags of other users are not visible", func(t *testing.T) {
+		tags, err := tagRepo.FindAllByUserID(ctx, otherUserID)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		assert.Equal(t, "work", tags[0].Name())
+	})
+}
+
+func TestTaskDB_Integration_MultiByteTitles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	taskRepo := repository.NewTaskDB(db)
+
+	creatorID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		title string
+	}{
+		{
+			name:  "chinese greeting exactly 255 characters",
+			title: strings.Repeat("你", 255),
+		},
+		{
+			name:  "korean greeting exactly 255 characters",
+			title: strings.Repeat("안", 255),
+		},
+		{
+			name:  "arabic greeting exactly 255 characters",
+			title: strings.Repeat("م", 255),
+		},
+		{
+			name:  "mixed multibyte characters",
+			title: "你好世界 안녕하세요 مرحبا بالعالم नमस्कार संसार สวัสดีชาวโลก שלום עולם Привет мир",
+		},
+		{
+			name:  "japanese with various scripts",
+			title: "日本語ひらがなカタカナ漢字テスト",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create task entity with multibyte title
+			taskEntity, err := task.NewTask(task.GenerateTaskID(), tt.title, creatorID)
+			require.NoError(t, err)
+
+			// Act - Create task in database
+			createdTask, err := taskRepo.Create(ctx, taskEntity)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, createdTask.Title())
+			assert.Equal(t, creatorID, createdTask.UserID())
+
+			// Act - Retrieve task from database
+			retrievedTask, err := taskRepo.FindById(ctx, creatorID, createdTask.ID())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, retrievedTask.Title())
+			assert.Equal(t, createdTask.ID(), retrievedTask.ID())
+			assert.Equal(t, creatorID, retrievedTask.UserID())
 		})
 	}
 }
+
+func TestTaskDB_Integration_ZeroWidthJoinerTitles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	taskRepo := repository.NewTaskDB(db)
+
+	creatorID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		title string
+	}{
+		{
+			name:  "profession emoji within boundary",
+			title: strings.Repeat("👨‍💻", 85), // 85 * 3 = 255 runes
+		},
+		{
+			name:  "family emoji within boundary",
+			title: strings.Repeat("👨‍👩‍👧‍👦", 36), // 36 * 7 = 252 runes
+		},
+		{
+			name:  "skin tone emoji within boundary",
+			title: strings.Repeat("👋🏽", 127), // 127 * 2 = 254 runes
+		},
+		{
+			name:  "mixed complex emojis",
+			title: "👨‍💻👩‍🔬👨‍🍳🧑‍🦰👋🏽👨‍👩‍👧‍👦",
+		},
+		{
+			name:  "gendered activity emojis",
+			title: "🏃‍♂️🏃‍♀️🚴‍♂️🚴‍♀️🏊‍♂️🏊‍♀️",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create task entity with ZWJ emoji title
+			taskEntity, err := task.NewTask(task.GenerateTaskID(), tt.title, creatorID)
+			require.NoError(t, err)
+
+			// Act - Create task in database
+			createdTask, err := taskRepo.Create(ctx, taskEntity)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, createdTask.Title())
+			assert.Equal(t, creatorID, createdTask.UserID())
+
+			// Act - Retrieve task from database
+			retrievedTask, err := taskRepo.FindById(ctx, creatorID, createdTask.ID())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, retrievedTask.Title())
+			assert.Equal(t, createdTask.ID(), retrievedTask.ID())
+			assert.Equal(t, creatorID, retrievedTask.UserID())
+		})
+	}
+}
+
+func TestTaskDB_Integration_BoundaryTitles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	taskRepo := repository.NewTaskDB(db)
+
+	creatorID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		title         string
+		shouldSucceed bool
+		expectedError error
+	}{
+		{
+			name:          "title exactly 255 ASCII characters",
+			title:         strings.Repeat("a", 255),
+			shouldSucceed: true,
+		},
+		{
+			name:          "title exactly 255 Chinese characters",
+			title:         strings.Repeat("你", 255),
+			shouldSucceed: true,
+		},
+		{
+			name:          "title exactly 255 ZWJ emoji runes",
+			title:         strings.Repeat("👨‍💻", 85), // 85 * 3 = 255 runes
+			shouldSucceed: true,
+		},
+		{
+			name:          "title 256 ASCII characters (should fail)",
+			title:         strings.Repeat("a", 256),
+			shouldSucceed: false,
+			expectedError: task.ErrTitleTooLong,
+		},
+		{
+			name:          "title 256 Chinese characters (should fail)",
+			title:         strings.Repeat("你", 256),
+			shouldSucceed: false,
+			expectedError: task.ErrTitleTooLong,
+		},
+		{
+			name:          "title 258 ZWJ emoji runes (should fail)",
+			title:         strings.Repeat("👨‍💻", 86), // 86 * 3 = 258 runes
+			shouldSucceed: false,
+			expectedError: task.ErrTitleTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act - Create task entity with boundary title
+			taskEntity, err := task.NewTask(task.GenerateTaskID(), tt.title, creatorID)
+
+			if tt.shouldSucceed {
+				// Assert successful creation
+				require.NoError(t, err)
+
+				// Act - Create task in database
+				createdTask, err := taskRepo.Create(ctx, taskEntity)
+
+				// Assert
+				require.NoError(t, err)
+				assert.Equal(t, tt.title, createdTask.Title())
+
+				// Act - Retrieve task from database to ensure roundtrip works
+				retrievedTask, err := taskRepo.FindById(ctx, creatorID, createdTask.ID())
+
+				// Assert
+				require.NoError(t, err)
+				assert.Equal(t, tt.title, retrievedTask.Title())
+			} else {
+				// Assert validation failure
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, taskEntity)
+			}
+		})
+	}
+}
+
+func TestTaskDB_Integration_Subtasks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	createTask := func(title string, parentID *task.TaskID) *task.Task {
+		taskEntity := task.NewTaskWithoutValidation(task.GenerateTaskID(), title, userID, task.WithParentID(parentID))
+
+		created, err := taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	root := createTask("Root", nil)
+	rootID := root.ID()
+	child := createTask("Child", &rootID)
+	childID := child.ID()
+	grandchild := createTask("Grandchild", &childID)
+	sibling := createTask("Sibling", &rootID)
+
+	// Act & Assert
+	t.Run("parent is loaded with the task", func(t *testing.T) {
+		found, err := taskRepo.FindById(ctx, userID, grandchild.ID())
+		require.NoError(t, err)
+		require.NotNil(t, found.ParentID())
+		assert.Equal(t, childID, *found.ParentID())
+	})
+
+	t.Run("lineage lists the ancestors up to the root", func(t *testing.T) {
+		lineage, err := taskRepo.FindLineage(ctx, userID, grandchild.ID())
+		require.NoError(t, err)
+		require.Len(t, lineage, 3)
+		assert.Equal(t, grandchild.ID(), lineage[0].ID())
+		assert.Equal(t, childID, lineage[1].ID())
+		assert.Equal(t, rootID, lineage[2].ID())
+
+		otherUserID, err := user.NewUserID(uuid.New().String())
+		require.NoError(t, err)
+
+		_, err = taskRepo.FindLineage(ctx, otherUserID, grandchild.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+	})
+
+	t.Run("subtasks are loaded at every level", func(t *testing.T) {
+		subtasks, err := taskRepo.FindSubtasks(ctx, userID, []task.TaskID{rootID})
+		require.NoError(t, err)
+
+		tree := task.NewTree(subtasks)
+		require.Len(t, tree.Children(rootID), 2)
+		assert.Equal(t, childID, tree.Children(rootID)[0].ID())
+		assert.Equal(t, sibling.ID(), tree.Children(rootID)[1].ID())
+		require.Len(t, tree.Children(childID), 1)
+		assert.Equal(t, grandchild.ID(), tree.Children(childID)[0].ID())
+		assert.Equal(t, 3, tree.Height(rootID))
+	})
+
+	t.Run("a task with subtasks is not deleted", func(t *testing.T) {
+		err := taskRepo.Delete(ctx, userID, childID, nil)
+		assert.ErrorIs(t, err, task.ErrHasSubtasks)
+
+		_, err = taskRepo.FindById(ctx, userID, childID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("cascade delete moves the subtree to the trash", func(t *testing.T) {
+		require.NoError(t, taskRepo.DeleteTree(ctx, userID, childID, nil))
+
+		_, err := taskRepo.FindById(ctx, userID, grandchild.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+
+		subtasks, err := taskRepo.FindSubtasks(ctx, userID, []task.TaskID{rootID})
+		require.NoError(t, err)
+		require.Len(t, subtasks, 1)
+		assert.Equal(t, sibling.ID(), subtasks[0].ID())
+	})
+
+	t.Run("a subtask whose parent is in the trash is restored as a root task", func(t *testing.T) {
+		restored, err := taskRepo.Restore(ctx, userID, grandchild.ID())
+		require.NoError(t, err)
+		assert.Nil(t, restored.ParentID())
+
+		restoredChild, err := taskRepo.Restore(ctx, userID, childID)
+		require.NoError(t, err)
+		require.NotNil(t, restoredChild.ParentID())
+		assert.Equal(t, rootID, *restoredChild.ParentID())
+	})
+}
+
+func TestTaskDB_Integration_Dependencies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	createTask := func(title string) *task.Task {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+		require.NoError(t, err)
+
+		created, err := taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	dependency := func(blocker, blocked *task.Task) task.Dependency {
+		dependency, err := task.NewDependency(blocker.ID(), blocked.ID())
+		require.NoError(t, err)
+
+		return dependency
+	}
+
+	design := createTask("Design")
+	build := createTask("Build")
+	release := createTask("Release")
+
+	require.NoError(t, taskRepo.AddDependency(ctx, userID, dependency(design, build)))
+	require.NoError(t, taskRepo.AddDependency(ctx, userID, dependency(build, release)))
+
+	// Act & Assert
+	t.Run("blocked tasks are flagged", func(t *testing.T) {
+		found, err := taskRepo.FindById(ctx, userID, build.ID())
+		require.NoError(t, err)
+		assert.True(t, found.IsBlocked())
+
+		found, err = taskRepo.FindById(ctx, userID, design.ID())
+		require.NoError(t, err)
+		assert.False(t, found.IsBlocked())
+	})
+
+	t.Run("blockers are listed", func(t *testing.T) {
+		blockers, err := taskRepo.FindBlockers(ctx, userID, release.ID())
+		require.NoError(t, err)
+		require.Len(t, blockers, 1)
+		assert.Equal(t, build.ID(), blockers[0].ID())
+	})
+
+	t.Run("adding an existing dependency changes nothing", func(t *testing.T) {
+		assert.NoError(t, taskRepo.AddDependency(ctx, userID, dependency(design, build)))
+	})
+
+	t.Run("cycles are rejected", func(t *testing.T) {
+		err := taskRepo.AddDependency(ctx, userID, dependency(release, design))
+		assert.ErrorIs(t, err, task.ErrDependencyCycle)
+	})
+
+	t.Run("tasks of other users cannot be linked", func(t *testing.T) {
+		otherUserID, err := user.NewUserID(uuid.New().String())
+		require.NoError(t, err)
+
+		otherEntity, err := task.NewTask(task.GenerateTaskID(), "Other", otherUserID)
+		require.NoError(t, err)
+
+		other, err := taskRepo.Create(ctx, otherEntity)
+		require.NoError(t, err)
+
+		err = taskRepo.AddDependency(ctx, userID, dependency(other, design))
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+	})
+
+	t.Run("concurrent inserts cannot create a cycle", func(t *testing.T) {
+		first := createTask("First")
+		second := createTask("Second")
+
+		var wg sync.WaitGroup
+
+		errs := make([]error, 2)
+
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			errs[0] = taskRepo.AddDependency(ctx, userID, dependency(first, second))
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			errs[1] = taskRepo.AddDependency(ctx, userID, dependency(second, first))
+		}()
+
+		wg.Wait()
+
+		failed := 0
+
+		for _, err := range errs {
+			if err != nil {
+				assert.ErrorIs(t, err, task.ErrDependencyCycle)
+
+				failed++
+			}
+		}
+
+		assert.Equal(t, 1, failed)
+	})
+
+	t.Run("completing the blocker unblocks the task", func(t *testing.T) {
+		found, err := taskRepo.FindById(ctx, userID, design.ID())
+		require.NoError(t, err)
+		require.NoError(t, found.Complete(time.Now()))
+
+		_, err = taskRepo.Update(ctx, found.UserID(), found)
+		require.NoError(t, err)
+
+		unblocked, err := taskRepo.FindById(ctx, userID, build.ID())
+		require.NoError(t, err)
+		assert.False(t, unblocked.IsBlocked())
+	})
+
+	t.Run("removed dependencies no longer block", func(t *testing.T) {
+		require.NoError(t, taskRepo.RemoveDependency(ctx, userID, dependency(build, release)))
+
+		found, err := taskRepo.FindById(ctx, userID, release.ID())
+		require.NoError(t, err)
+		assert.False(t, found.IsBlocked())
+
+		err = taskRepo.RemoveDependency(ctx, userID, dependency(build, release))
+		assert.ErrorIs(t, err, task.ErrDependencyNotFound)
+	})
+}
+
+func TestTaskDB_Integration_Recurrence(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	due := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+
+	taskEntity, err := task.NewTask(task.GenerateTaskID(), "Weekly report", userID)
+	require.NoError(t, err)
+	require.NoError(t, taskEntity.Reschedule(nil, &due, false))
+	require.NoError(t, taskEntity.Repeat(task.GenerateSeriesID(), "FREQ=WEEKLY;COUNT=3", "Asia/Tokyo"))
+
+	first, err := taskRepo.Create(ctx, taskEntity)
+	require.NoError(t, err)
+
+	complete := func(current *task.Task) *task.Task {
+		require.NoError(t, current.Complete(time.Now()))
+
+		next := current.Series().NextOccurrence(current, task.GenerateTaskID())
+
+		completed, err := taskRepo.CompleteOccurrence(ctx, current.UserID(), current, next)
+		require.NoError(t, err)
+
+		return completed
+	}
+
+	occurrences := func() []*task.Task {
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{})
+		require.NoError(t, err)
+
+		return tasks
+	}
+
+	// Act & Assert
+	t.Run("series is stored with the task", func(t *testing.T) {
+		found, err := taskRepo.FindById(ctx, userID, first.ID())
+		require.NoError(t, err)
+		require.True(t, found.IsRecurring())
+		assert.Equal(t, taskEntity.Series().ID(), found.Series().ID())
+		assert.Equal(t, "FREQ=WEEKLY;COUNT=3", found.Series().Recurrence().Rule())
+		assert.Equal(t, "Asia/Tokyo", found.Series().Recurrence().Timezone())
+	})
+
+	t.Run("completing an occurrence creates the next one once", func(t *testing.T) {
+		found, err := taskRepo.FindById(ctx, userID, first.ID())
+		require.NoError(t, err)
+
+		completed := complete(found)
+		require.NoError(t, completed.Reopen())
+
+		reopened, err := taskRepo.Update(ctx, completed.UserID(), completed)
+		require.NoError(t, err)
+
+		complete(reopened)
+
+		tasks := occurrences()
+		require.Len(t, tasks, 2)
+
+		var next *task.Task
+
+		for _, occurrence := range tasks {
+			if occurrence.ID() != first.ID() {
+				next = occurrence
+			}
+		}
+
+		require.NotNil(t, next)
+		assert.False(t, next.IsCompleted())
+		assert.Equal(t, due.AddDate(0, 0, 7), next.Schedule().DueAt().UTC())
+		assert.Equal(t, first.Series().ID(), next.Series().ID())
+	})
+
+	t.Run("renaming the series renames its open occurrences", func(t *testing.T) {
+		found, err := taskRepo.FindById(ctx, userID, first.ID())
+		require.NoError(t, err)
+
+		series := found.Series()
+		require.NoError(t, series.Rename("Team report"))
+		require.NoError(t, taskRepo.UpdateSeries(ctx, series))
+
+		for _, occurrence := range occurrences() {
+			assert.Equal(t, "Team report", occurrence.Series().Title())
+
+			if occurrence.IsCompleted() {
+				assert.Equal(t, "Weekly report", occurrence.Title())
+			} else {
+				assert.Equal(t, "Team report", occurrence.Title())
+			}
+		}
+	})
+
+	t.Run("no occurrence follows the last one", func(t *testing.T) {
+		for open := true; open; {
+			open = false
+
+			for _, occurrence := range occurrences() {
+				if !occurrence.IsCompleted() {
+					complete(occurrence)
+
+					open = true
+				}
+			}
+		}
+
+		assert.Len(t, occurrences(), 3)
+	})
+}
+
+func TestTaskDB_Integration_Ranks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	userID, err := user.NewUserID(uuid.New().String())
+	require.NoError(t, err)
+
+	createTask := func(title string) *task.Task {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+		require.NoError(t, err)
+
+		created, err := taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	titles := func() []string {
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{})
+		require.NoError(t, err)
+
+		result := make([]string, len(tasks))
+		for i, taskEntity := range tasks {
+			result[i] = taskEntity.Title()
+		}
+
+		return result
+	}
+
+	move := func(moved *task.Task, after, before *task.Task) (*task.Task, error) {
+		var afterID, beforeID *task.TaskID
+
+		if after != nil {
+			id := after.ID()
+			afterID = &id
+		}
+
+		if before != nil {
+			id := before.ID()
+			beforeID = &id
+		}
+
+		placement, err := task.NewPlacement(moved.ID(), afterID, beforeID)
+		require.NoError(t, err)
+
+		return taskRepo.Move(ctx, userID, moved.ID(), placement)
+	}
+
+	first := createTask("First")
+	second := createTask("Second")
+	third := createTask("Third")
+
+	// Act & Assert
+	t.Run("new tasks are appended", func(t *testing.T) {
+		assert.Equal(t, []string{"First", "Second", "Third"}, titles())
+		assert.Less(t, first.Rank(), second.Rank())
+		assert.Less(t, second.Rank(), third.Rank())
+	})
+
+	t.Run("a task is moved before another", func(t *testing.T) {
+		moved, err := move(third, nil, first)
+		require.NoError(t, err)
+		assert.Greater(t, moved.Version(), third.Version())
+		assert.Equal(t, []string{"Third", "First", "Second"}, titles())
+	})
+
+	t.Run("a task is moved after another", func(t *testing.T) {
+		_, err := move(first, second, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Third", "Second", "First"}, titles())
+	})
+
+	t.Run("a task is moved between two others", func(t *testing.T) {
+		_, err := move(third, second, first)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Second", "Third", "First"}, titles())
+	})
+
+	t.Run("neighbours in the wrong order are rejected", func(t *testing.T) {
+		_, err := move(third, first, second)
+		assert.ErrorIs(t, err, task.ErrRankOrder)
+	})
+
+	t.Run("a neighbour of another user is not found", func(t *testing.T) {
+		otherUserID, err := user.NewUserID(uuid.New().String())
+		require.NoError(t, err)
+
+		otherEntity, err := task.NewTask(task.GenerateTaskID(), "Other", otherUserID)
+		require.NoError(t, err)
+
+		other, err := taskRepo.Create(ctx, otherEntity)
+		require.NoError(t, err)
+
+		_, err = move(third, other, nil)
+		assert.ErrorIs(t, err, task.ErrNeighbourNotFound)
+	})
+
+	t.Run("the default page order is the rank", func(t *testing.T) {
+		page, err := task.NewPageRequest(10, "", task.DefaultSort)
+		require.NoError(t, err)
+
+		result, err := taskRepo.FindPageByUserID(ctx, userID, task.Filter{}, page)
+		require.NoError(t, err)
+		require.Len(t, result.Tasks, 3)
+		assert.Equal(t, "Second", result.Tasks[0].Title())
+	})
+
+	t.Run("rebalance shortens long ranks and keeps the order", func(t *testing.T) {
+		// Moving tasks back and forth next to the same neighbour halves the gap
+		// after it each time, which grows the ranks by a digit every few moves.
+		for range 4 * task.RebalanceRankLength {
+			_, err := move(first, second, third)
+			require.NoError(t, err)
+
+			_, err = move(third, second, first)
+			require.NoError(t, err)
+		}
+
+		before := titles()
+
+		rebalanced, err := taskRepo.RebalanceRanks(ctx, task.RebalanceRankLength)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), rebalanced)
+		assert.Equal(t, before, titles())
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, userID, task.Filter{})
+		require.NoError(t, err)
+
+		for _, taskEntity := range tasks {
+			assert.LessOrEqual(t, len(taskEntity.Rank()), task.RebalanceRankLength)
+		}
+
+		rebalanced, err = taskRepo.RebalanceRanks(ctx, task.RebalanceRankLength)
+		require.NoError(t, err)
+		assert.Zero(t, rebalanced)
+	})
+}
+
+func TestTaskDB_Integration_Collaborators(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	createTask := func(userID user.UserID, title string) *task.Task {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+		require.NoError(t, err)
+
+		created, err := taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	share := func(taskEntity *task.Task, userID user.UserID, role task.Role) {
+		collaborator, err := taskEntity.ShareWith(userID, role)
+		require.NoError(t, err)
+		require.NoError(t, taskRepo.AddCollaborator(ctx, collaborator))
+	}
+
+	t.Run("shared tasks are listed with the role of the collaborator", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		collaboratorID := user.GenerateUserID()
+		shared := createTask(creatorID, "Shared")
+		createTask(creatorID, "Private")
+		own := createTask(collaboratorID, "Own")
+
+		share(shared, collaboratorID, task.RoleViewer)
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, collaboratorID, task.Filter{})
+		require.NoError(t, err)
+		require.Len(t, tasks, 2)
+
+		byID := make(map[task.TaskID]*task.Task, len(tasks))
+		for _, taskEntity := range tasks {
+			byID[taskEntity.ID()] = taskEntity
+		}
+
+		require.Contains(t, byID, shared.ID())
+		require.NotNil(t, byID[shared.ID()].SharedRole())
+		assert.Equal(t, task.RoleViewer, *byID[shared.ID()].SharedRole())
+		assert.Equal(t, creatorID, byID[shared.ID()].UserID())
+		require.Contains(t, byID, own.ID())
+		assert.False(t, byID[own.ID()].IsShared())
+
+		pageRequest, err := task.NewPageRequest(10, "", task.DefaultSort)
+		require.NoError(t, err)
+
+		page, err := taskRepo.FindPageByUserID(ctx, collaboratorID, task.Filter{}, pageRequest)
+		require.NoError(t, err)
+		assert.Len(t, page.Tasks, 2)
+
+		creatorTasks, err := taskRepo.FindAllByUserID(ctx, creatorID, task.Filter{})
+		require.NoError(t, err)
+		assert.Len(t, creatorTasks, 2)
+
+		for _, taskEntity := range creatorTasks {
+			assert.False(t, taskEntity.IsShared())
+		}
+	})
+
+	t.Run("sharing again changes the role", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		collaboratorID := user.GenerateUserID()
+		shared := createTask(creatorID, "Shared")
+
+		share(shared, collaboratorID, task.RoleViewer)
+		share(shared, collaboratorID, task.RoleEditor)
+
+		found, err := taskRepo.FindAccessible(ctx, collaboratorID, shared.ID())
+		require.NoError(t, err)
+		require.NotNil(t, found.SharedRole())
+		assert.Equal(t, task.RoleEditor, *found.SharedRole())
+
+		collaborators, err := taskRepo.FindCollaborators(ctx, shared.ID())
+		require.NoError(t, err)
+		require.Len(t, collaborators, 1)
+		assert.Equal(t, collaboratorID, collaborators[0].UserID())
+		assert.Equal(t, task.RoleEditor, collaborators[0].Role())
+	})
+
+	t.Run("removed collaborator loses access", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		collaboratorID := user.GenerateUserID()
+		shared := createTask(creatorID, "Shared")
+
+		share(shared, collaboratorID, task.RoleEditor)
+		require.NoError(t, taskRepo.RemoveCollaborator(ctx, shared.ID(), collaboratorID))
+
+		_, err := taskRepo.FindAccessible(ctx, collaboratorID, shared.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+
+		err = taskRepo.RemoveCollaborator(ctx, shared.ID(), collaboratorID)
+		assert.ErrorIs(t, err, task.ErrCollaboratorNotFound)
+	})
+
+	t.Run("shared tasks are found by search with the role of the collaborator", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		collaboratorID := user.GenerateUserID()
+		shared := createTask(creatorID, "Shared budget")
+		createTask(creatorID, "Private budget")
+
+		share(shared, collaboratorID, task.RoleEditor)
+
+		query, err := task.NewSearchQuery("budget", 0)
+		require.NoError(t, err)
+
+		found, err := taskRepo.Search(ctx, collaboratorID, query)
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, shared.ID(), found[0].ID())
+		require.NotNil(t, found[0].SharedRole())
+		assert.Equal(t, task.RoleEditor, *found[0].SharedRole())
+	})
+
+	t.Run("tasks of other users stay hidden", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		private := createTask(creatorID, "Private")
+
+		_, err := taskRepo.FindAccessible(ctx, user.GenerateUserID(), private.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+
+		found, err := taskRepo.FindAccessible(ctx, creatorID, private.ID())
+		require.NoError(t, err)
+		assert.False(t, found.IsShared())
+	})
+}
+
+func TestTaskDB_Integration_Assignees(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	createTask := func(userID user.UserID, title string) *task.Task {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+		require.NoError(t, err)
+
+		created, err := taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	assign := func(taskEntity *task.Task, assigneeID *user.UserID, assignedBy user.UserID, assignedAt time.Time) *task.Task {
+		collaborators, err := taskRepo.FindCollaborators(ctx, taskEntity.ID())
+		require.NoError(t, err)
+
+		assignment, err := taskEntity.AssignTo(assigneeID, collaborators, assignedBy, assignedAt)
+		require.NoError(t, err)
+
+		assigned, err := taskRepo.Assign(ctx, taskEntity, assignment)
+		require.NoError(t, err)
+
+		return assigned
+	}
+
+	t.Run("tasks are filtered by assignee", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		assigneeID := user.GenerateUserID()
+		assignedTask := createTask(creatorID, "Assigned")
+		createTask(creatorID, "Unassigned")
+
+		collaborator, err := assignedTask.ShareWith(assigneeID, task.RoleEditor)
+		require.NoError(t, err)
+		require.NoError(t, taskRepo.AddCollaborator(ctx, collaborator))
+
+		assigned := assign(assignedTask, &assigneeID, creatorID, time.Now())
+		assert.Equal(t, assignedTask.Version()+1, assigned.Version())
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, assigneeID, task.Filter{AssigneeID: &assigneeID})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, assignedTask.ID(), tasks[0].ID())
+		require.NotNil(t, tasks[0].AssigneeID())
+		assert.Equal(t, assigneeID, *tasks[0].AssigneeID())
+
+		_, err = taskRepo.FindAllByUserID(ctx, creatorID, task.Filter{AssigneeID: &creatorID})
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+	})
+
+	t.Run("every assignment change is recorded", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		assignedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+		taskEntity := createTask(creatorID, "Report")
+
+		assigned := assign(taskEntity, &creatorID, creatorID, assignedAt)
+		assign(assigned, nil, creatorID, assignedAt.Add(time.Hour))
+
+		found, err := taskRepo.FindById(ctx, creatorID, taskEntity.ID())
+		require.NoError(t, err)
+		assert.Nil(t, found.AssigneeID())
+
+		assignments, err := taskRepo.FindAssignments(ctx, taskEntity.ID())
+		require.NoError(t, err)
+		require.Len(t, assignments, 2)
+		require.NotNil(t, assignments[0].AssigneeID())
+		assert.Equal(t, creatorID, *assignments[0].AssigneeID())
+		assert.Equal(t, creatorID, assignments[0].AssignedBy())
+		assert.True(t, assignedAt.Equal(assignments[0].AssignedAt()))
+		assert.Nil(t, assignments[1].AssigneeID())
+	})
+
+	t.Run("stale task is not reassigned", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		taskEntity := createTask(creatorID, "Report")
+		assign(taskEntity, &creatorID, creatorID, time.Now())
+
+		assignment, err := taskEntity.AssignTo(nil, nil, creatorID, time.Now())
+		require.NoError(t, err)
+
+		_, err = taskRepo.Assign(ctx, taskEntity, assignment)
+		assert.ErrorIs(t, err, task.ErrVersionMismatch)
+
+		assignments, err := taskRepo.FindAssignments(ctx, taskEntity.ID())
+		require.NoError(t, err)
+		assert.Len(t, assignments, 1)
+	})
+}
+
+func TestTaskDB_Integration_History(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	createTask := func(userID user.UserID, title string) *task.Task {
+		taskEntity, err := task.NewTask(task.GenerateTaskID(), title, userID)
+		require.NoError(t, err)
+
+		created, err := taskRepo.Create(ctx, taskEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	history := func(id task.TaskID) []task.Change {
+		page, err := task.NewPageRequest(task.MaxPageLimit, "", nil)
+		require.NoError(t, err)
+
+		historyPage, err := taskRepo.FindHistory(ctx, id, page)
+		require.NoError(t, err)
+		assert.False(t, historyPage.HasNext())
+
+		return historyPage.Changes
+	}
+
+	changeTypes := func(changes []task.Change) []task.ChangeType {
+		types := make([]task.ChangeType, len(changes))
+		for i, change := range changes {
+			types[i] = change.Type()
+		}
+
+		return types
+	}
+
+	t.Run("updates are recorded with their actor and values", func(t *testing.T) {
+		creatorID := user.GenerateUserID()
+		editorID := user.GenerateUserID()
+		taskEntity := createTask(creatorID, "Draft")
+
+		collaborator, err := taskEntity.ShareWith(editorID, task.RoleEditor)
+		require.NoError(t, err)
+		require.NoError(t, taskRepo.AddCollaborator(ctx, collaborator))
+
+		require.NoError(t, taskEntity.UpdateTitle("Report"))
+		require.NoError(t, taskEntity.Complete(time.Now()))
+
+		_, err = taskRepo.Update(ctx, editorID, taskEntity)
+		require.NoError(t, err)
+
+		changes := history(taskEntity.ID())
+		require.Len(t, changes, 3)
+		assert.Equal(t, []task.ChangeType{task.ChangeCreated, task.ChangeTitleChanged, task.ChangeCompleted}, changeTypes(changes))
+		assert.Equal(t, creatorID, changes[0].ActorID())
+		assert.Equal(t, "Draft", *changes[0].After())
+		assert.Equal(t, editorID, changes[1].ActorID())
+		assert.Equal(t, "Draft", *changes[1].Before())
+		assert.Equal(t, "Report", *changes[1].After())
+		assert.Nil(t, changes[2].Before())
+		assert.NotNil(t, changes[2].After())
+	})
+
+	t.Run("stale update records nothing", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		taskEntity := createTask(userID, "Draft")
+
+		stale, err := taskRepo.FindById(ctx, userID, taskEntity.ID())
+		require.NoError(t, err)
+
+		require.NoError(t, taskEntity.UpdateTitle("Report"))
+		_, err = taskRepo.Update(ctx, userID, taskEntity)
+		require.NoError(t, err)
+
+		require.NoError(t, stale.UpdateTitle("Summary"))
+		_, err = taskRepo.Update(ctx, userID, stale)
+		require.ErrorIs(t, err, task.ErrVersionMismatch)
+
+		assert.Equal(t, []task.ChangeType{task.ChangeCreated, task.ChangeTitleChanged}, changeTypes(history(taskEntity.ID())))
+	})
+
+	t.Run("deleting and restoring are recorded", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		parent := createTask(userID, "Parent")
+
+		child, err := task.NewTask(task.GenerateTaskID(), "Child", userID)
+		require.NoError(t, err)
+		require.NoError(t, child.MoveUnder([]*task.Task{parent}, 1))
+
+		child, err = taskRepo.Create(ctx, child)
+		require.NoError(t, err)
+
+		require.NoError(t, taskRepo.DeleteTree(ctx, userID, parent.ID(), nil))
+
+		_, err = taskRepo.Restore(ctx, userID, parent.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t, []task.ChangeType{task.ChangeCreated, task.ChangeDeleted, task.ChangeRestored}, changeTypes(history(parent.ID())))
+		assert.Equal(t, []task.ChangeType{task.ChangeCreated, task.ChangeDeleted}, changeTypes(history(child.ID())))
+	})
+
+	t.Run("history is read one page at a time", func(t *testing.T) {
+		userID := user.GenerateUserID()
+		taskEntity := createTask(userID, "Title 0")
+
+		for i := 1; i <= 4; i++ {
+			require.NoError(t, taskEntity.UpdateTitle(fmt.Sprintf("Title %d", i)))
+
+			updated, err := taskRepo.Update(ctx, userID, taskEntity)
+			require.NoError(t, err)
+
+			taskEntity = updated
+		}
+
+		var titles []string
+
+		cursor := ""
+
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 3)
+
+			page, err := task.NewPageRequest(2, cursor, nil)
+			require.NoError(t, err)
+
+			historyPage, err := taskRepo.FindHistory(ctx, taskEntity.ID(), page)
+			require.NoError(t, err)
+
+			for _, change := range historyPage.Changes {
+				titles = append(titles, *change.After())
+			}
+
+			if !historyPage.HasNext() {
+				break
+			}
+
+			cursor = historyPage.NextCursor
+		}
+
+		assert.Equal(t, []string{"Title 0", "Title 1", "Title 2", "Title 3", "Title 4"}, titles)
+
+		page, err := task.NewPageRequest(2, "invalid", nil)
+		require.NoError(t, err)
+
+		_, err = taskRepo.FindHistory(ctx, taskEntity.ID(), page)
+		assert.ErrorIs(t, err, task.ErrInvalidCursor)
+	})
+}
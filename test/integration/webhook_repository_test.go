@@ -0,0 +1,159 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDB_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	webhookRepo := repository.NewWebhookDB(db)
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+	policy := webhook.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Minute, MaxDelay: time.Hour, DisableAfter: 2}
+
+	createWebhook := func(ownerID user.UserID, eventTypes ...webhook.EventType) *webhook.Webhook {
+		webhookEntity, err := webhook.NewWebhook(webhook.GenerateWebhookID(), ownerID, "https://example.com/hooks", "0123456789abcdef", eventTypes, time.Now())
+		require.NoError(t, err)
+
+		created, err := webhookRepo.Create(ctx, webhookEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	createTask := func(creatorID user.UserID, title string) *task.Task {
+		created, err := taskRepo.Create(ctx, task.NewTaskWithoutValidation(task.GenerateTaskID(), title, creatorID))
+		require.NoError(t, err)
+
+		return created
+	}
+
+	// The subtests share the outbox, so they run one after another and
+	// dispatch everything before claiming.
+	claimAll := func(now time.Time) []webhook.PendingDelivery {
+		_, err := webhookRepo.DispatchEvents(ctx, 100)
+		require.NoError(t, err)
+
+		pending, err := webhookRepo.ClaimDueDeliveries(ctx, now, time.Minute, 100)
+		require.NoError(t, err)
+
+		return pending
+	}
+
+	t.Run("task changes are delivered to the webhooks of the creator", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		everything := createWebhook(ownerID)
+		createdOnly := createWebhook(ownerID, "task.created")
+		createWebhook(user.GenerateUserID())
+
+		created := createTask(ownerID, "Announced")
+		require.NoError(t, created.Complete(time.Now()))
+		_, err := taskRepo.Update(ctx, ownerID, created)
+		require.NoError(t, err)
+
+		pending := claimAll(time.Now().Add(time.Second))
+		require.Len(t, pending, 3)
+
+		received := map[webhook.WebhookID][]webhook.EventType{}
+		for _, p := range pending {
+			received[p.Webhook.ID()] = append(received[p.Webhook.ID()], p.Delivery.EventType())
+		}
+
+		assert.ElementsMatch(t, []webhook.EventType{"task.created", "task.completed"}, received[everything.ID()])
+		assert.Equal(t, []webhook.EventType{"task.created"}, received[createdOnly.ID()])
+
+		assert.Empty(t, claimAll(time.Now().Add(time.Second)), "claimed deliveries are leased")
+
+		for _, p := range pending {
+			attempt := webhook.NewAttempt(time.Now(), time.Millisecond, 204, nil)
+			require.NoError(t, p.Delivery.RecordAttempt(attempt, policy))
+			require.NoError(t, webhookRepo.RecordAttempt(ctx, p.Delivery, attempt, policy))
+		}
+
+		assert.Empty(t, claimAll(time.Now().Add(2*time.Minute)), "succeeded deliveries are not attempted again")
+	})
+
+	t.Run("failing webhooks are disabled", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		failing := createWebhook(ownerID)
+
+		createTask(ownerID, "Unreachable")
+
+		now := time.Now()
+
+		for range policy.DisableAfter {
+			pending := claimAll(now.Add(time.Second))
+			require.Len(t, pending, 1)
+
+			attempt := webhook.NewAttempt(now, time.Millisecond, 500, errors.New("unexpected status"))
+			require.NoError(t, pending[0].Delivery.RecordAttempt(attempt, policy))
+			require.NoError(t, webhookRepo.RecordAttempt(ctx, pending[0].Delivery, attempt, policy))
+
+			now = now.Add(policy.MaxDelay)
+		}
+
+		found, err := webhookRepo.FindById(ctx, ownerID, failing.ID())
+		require.NoError(t, err)
+		assert.False(t, found.IsActive())
+		assert.Equal(t, policy.DisableAfter, found.FailureCount())
+
+		createTask(ownerID, "Ignored")
+		assert.Empty(t, claimAll(now.Add(time.Second)))
+	})
+
+	t.Run("purged tasks are announced before they are removed", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		createWebhook(ownerID, "task.purged")
+
+		emptied := createTask(ownerID, "Emptied")
+		expired := createTask(ownerID, "Expired")
+		require.NoError(t, taskRepo.Delete(ctx, ownerID, emptied.ID(), nil))
+
+		removed, err := taskRepo.EmptyTrash(ctx, ownerID)
+		require.NoError(t, err)
+		require.Len(t, removed, 1)
+
+		require.NoError(t, taskRepo.Delete(ctx, ownerID, expired.ID(), nil))
+
+		purged, err := taskRepo.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+
+		pending := claimAll(time.Now().Add(time.Second))
+		require.Len(t, pending, 2)
+
+		for _, p := range pending {
+			assert.Equal(t, webhook.EventType("task.purged"), p.Delivery.EventType())
+		}
+	})
+
+	t.Run("deleting a webhook removes it", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		deleted := createWebhook(ownerID)
+
+		require.NoError(t, webhookRepo.Delete(ctx, ownerID, deleted.ID()))
+
+		_, err := webhookRepo.FindById(ctx, ownerID, deleted.ID())
+		require.ErrorIs(t, err, webhook.ErrWebhookNotFound)
+		assert.ErrorIs(t, webhookRepo.Delete(ctx, ownerID, deleted.ID()), webhook.ErrWebhookNotFound)
+	})
+}
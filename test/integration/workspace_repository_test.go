@@ -0,0 +1,182 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceDB_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	t.Parallel()
+
+	// Arrange
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	workspaceRepo := repository.NewWorkspaceDB(db)
+	taskRepo := repository.NewTaskDB(db)
+	ctx := context.Background()
+
+	createWorkspace := func(ownerID user.UserID, name string) *workspace.Workspace {
+		workspaceEntity, err := workspace.NewWorkspace(workspace.GenerateWorkspaceID(), name, ownerID)
+		require.NoError(t, err)
+
+		created, err := workspaceRepo.Create(ctx, workspaceEntity)
+		require.NoError(t, err)
+
+		return created
+	}
+
+	admit := func(workspaceEntity *workspace.Workspace, userID user.UserID, role workspace.Role) workspace.Member {
+		member, err := workspaceEntity.Owner().Admit(userID, role)
+		require.NoError(t, err)
+		require.NoError(t, workspaceRepo.SaveMember(ctx, member))
+
+		return member
+	}
+
+	t.Run("workspaces are listed by name for their members", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		memberID := user.GenerateUserID()
+		team := createWorkspace(ownerID, "Team")
+		home := createWorkspace(ownerID, "Home")
+		createWorkspace(user.GenerateUserID(), "Other")
+
+		admit(team, memberID, workspace.RoleMember)
+
+		workspaces, err := workspaceRepo.FindAllByUserID(ctx, ownerID)
+		require.NoError(t, err)
+		require.Len(t, workspaces, 2)
+		assert.Equal(t, home.ID(), workspaces[0].ID())
+		assert.Equal(t, team.ID(), workspaces[1].ID())
+
+		workspaces, err = workspaceRepo.FindAllByUserID(ctx, memberID)
+		require.NoError(t, err)
+		require.Len(t, workspaces, 1)
+		assert.Equal(t, team.ID(), workspaces[0].ID())
+		assert.Equal(t, ownerID, workspaces[0].OwnerID())
+	})
+
+	t.Run("owner becomes the first member", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		workspaceEntity := createWorkspace(ownerID, "Team")
+
+		owner, err := workspaceRepo.FindMember(ctx, workspaceEntity.ID(), ownerID)
+		require.NoError(t, err)
+		assert.Equal(t, workspace.RoleOwner, owner.Role())
+
+		_, err = workspaceRepo.FindMember(ctx, workspaceEntity.ID(), user.GenerateUserID())
+		assert.ErrorIs(t, err, workspace.ErrWorkspaceNotFound)
+	})
+
+	t.Run("saving a member again changes the role", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		memberID := user.GenerateUserID()
+		workspaceEntity := createWorkspace(ownerID, "Team")
+
+		admit(workspaceEntity, memberID, workspace.RoleGuest)
+		admit(workspaceEntity, memberID, workspace.RoleAdmin)
+
+		members, err := workspaceRepo.FindMembers(ctx, workspaceEntity.ID())
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		assert.Equal(t, ownerID, members[0].UserID())
+		assert.Equal(t, workspace.RoleOwner, members[0].Role())
+		assert.Equal(t, memberID, members[1].UserID())
+		assert.Equal(t, workspace.RoleAdmin, members[1].Role())
+	})
+
+	t.Run("owner membership is never changed or removed", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		workspaceEntity := createWorkspace(ownerID, "Team")
+
+		demoted, err := workspace.NewMember(workspaceEntity.ID(), ownerID, workspace.RoleGuest)
+		require.NoError(t, err)
+		require.NoError(t, workspaceRepo.SaveMember(ctx, demoted))
+
+		err = workspaceRepo.RemoveMember(ctx, workspaceEntity.ID(), ownerID)
+		assert.ErrorIs(t, err, workspace.ErrMemberNotFound)
+
+		owner, err := workspaceRepo.FindMember(ctx, workspaceEntity.ID(), ownerID)
+		require.NoError(t, err)
+		assert.Equal(t, workspace.RoleOwner, owner.Role())
+	})
+
+	t.Run("removed member loses access", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		memberID := user.GenerateUserID()
+		workspaceEntity := createWorkspace(ownerID, "Team")
+
+		admit(workspaceEntity, memberID, workspace.RoleMember)
+		require.NoError(t, workspaceRepo.RemoveMember(ctx, workspaceEntity.ID(), memberID))
+
+		_, err := workspaceRepo.FindMember(ctx, workspaceEntity.ID(), memberID)
+		assert.ErrorIs(t, err, workspace.ErrWorkspaceNotFound)
+
+		err = workspaceRepo.RemoveMember(ctx, workspaceEntity.ID(), memberID)
+		assert.ErrorIs(t, err, workspace.ErrMemberNotFound)
+	})
+
+	t.Run("tasks are filtered by workspace", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		workspaceID := createWorkspace(ownerID, "Team").ID()
+
+		inWorkspace, err := taskRepo.Create(ctx, task.NewTaskWithoutValidation(task.GenerateTaskID(), "In workspace", ownerID,
+			task.WithWorkspaceID(&workspaceID)))
+		require.NoError(t, err)
+
+		_, err = taskRepo.Create(ctx, task.NewTaskWithoutValidation(task.GenerateTaskID(), "Personal", ownerID))
+		require.NoError(t, err)
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, ownerID, task.Filter{WorkspaceID: &workspaceID})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, inWorkspace.ID(), tasks[0].ID())
+		require.NotNil(t, tasks[0].WorkspaceID())
+		assert.Equal(t, workspaceID, *tasks[0].WorkspaceID())
+	})
+
+	t.Run("members access the tasks of the workspace with their role", func(t *testing.T) {
+		ownerID := user.GenerateUserID()
+		memberID := user.GenerateUserID()
+		guestID := user.GenerateUserID()
+		team := createWorkspace(ownerID, "Team")
+		workspaceID := team.ID()
+
+		admit(team, memberID, workspace.RoleMember)
+		admit(team, guestID, workspace.RoleGuest)
+
+		created, err := taskRepo.Create(ctx, task.NewTaskWithoutValidation(task.GenerateTaskID(), "Shared work", ownerID,
+			task.WithWorkspaceID(&workspaceID)))
+		require.NoError(t, err)
+
+		found, err := taskRepo.FindAccessible(ctx, memberID, created.ID())
+		require.NoError(t, err)
+		require.NotNil(t, found.WorkspaceRole())
+		assert.Equal(t, workspace.RoleMember, *found.WorkspaceRole())
+		require.NoError(t, found.Authorize(memberID, task.PermissionEdit))
+
+		tasks, err := taskRepo.FindAllByUserID(ctx, memberID, task.Filter{WorkspaceID: &workspaceID})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, created.ID(), tasks[0].ID())
+
+		found, err = taskRepo.FindAccessible(ctx, guestID, created.ID())
+		require.NoError(t, err)
+		require.NoError(t, found.Authorize(guestID, task.PermissionView))
+		assert.ErrorIs(t, found.Authorize(guestID, task.PermissionEdit), task.ErrForbidden)
+
+		_, err = taskRepo.FindAccessible(ctx, user.GenerateUserID(), created.ID())
+		assert.ErrorIs(t, err, task.ErrTaskNotFound)
+	})
+}
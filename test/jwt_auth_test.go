@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,9 +13,17 @@ import (
 
 	"github.com/KasumiMercury/todo-server-poc-go/internal/config"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/controller"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/attachment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/comment"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/project"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/tag"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/task"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/user"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/webhook"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/domain/workspace"
 	infraAuth "github.com/KasumiMercury/todo-server-poc-go/internal/infra/auth"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventbus"
+	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/eventstream"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/handler/generated"
 	"github.com/KasumiMercury/todo-server-poc-go/internal/infra/service"
@@ -25,6 +34,27 @@ import (
 // MockTaskRepository implements repository.TaskRepository for testing
 type MockTaskRepository struct{}
 
+// MockTagRepository implements tag.TagRepository for testing
+type MockTagRepository struct{}
+
+// MockProjectRepository implements project.ProjectRepository for testing
+type MockProjectRepository struct{}
+
+// MockWorkspaceRepository implements workspace.WorkspaceRepository for testing
+type MockWorkspaceRepository struct{}
+
+// MockCommentRepository implements comment.CommentRepository for testing
+type MockCommentRepository struct{}
+
+// MockAttachmentRepository implements attachment.AttachmentRepository for testing
+type MockAttachmentRepository struct{}
+
+// MockBlobStore implements attachment.BlobStore for testing
+type MockBlobStore struct{}
+
+// MockWebhookRepository implements webhook.WebhookRepository for testing
+type MockWebhookRepository struct{}
+
 // MockHealthService implements service.HealthService for testing
 type MockHealthService struct{}
 
@@ -44,7 +74,7 @@ func (m *MockHealthService) CheckHealth(ctx context.Context) service.HealthStatu
 	}
 }
 
-func (m *MockTaskRepository) FindAllByUserID(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+func (m *MockTaskRepository) FindAllByUserID(ctx context.Context, creatorID user.UserID, filter task.Filter) ([]*task.Task, error) {
 	// Return mock tasks for the specific user
 	if creatorID.String() == "550e8400-e29b-41d4-a716-446655440000" { // test-user UUID
 		taskID1, _ := task.NewTaskID("3f6e5e6b-3d6f-4f5e-b5e6-3f6e5e6b3d6f")
@@ -65,6 +95,19 @@ func (m *MockTaskRepository) FindAllByUserID(ctx context.Context, creatorID user
 	return []*task.Task{}, nil
 }
 
+func (m *MockTaskRepository) FindPageByUserID(ctx context.Context, creatorID user.UserID, filter task.Filter, page task.PageRequest) (*task.Page, error) {
+	tasks, err := m.FindAllByUserID(ctx, creatorID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.Page{Tasks: tasks, NextCursor: ""}, nil
+}
+
+func (m *MockTaskRepository) Search(ctx context.Context, creatorID user.UserID, query task.SearchQuery) ([]*task.Task, error) {
+	return []*task.Task{}, nil
+}
+
 func (m *MockTaskRepository) FindById(ctx context.Context, creatorID user.UserID, id task.TaskID) (*task.Task, error) {
 	if creatorID.String() == "550e8400-e29b-41d4-a716-446655440000" && id.String() == "3f6e5e6b-3d6f-4f5e-b5e6-3f6e5e6b3d6f" {
 		return task.NewTaskWithoutValidation(id, "Test Task 1", creatorID), nil
@@ -77,11 +120,15 @@ func (m *MockTaskRepository) FindById(ctx context.Context, creatorID user.UserID
 	return nil, task.ErrTaskNotFound
 }
 
+func (m *MockTaskRepository) FindAccessible(ctx context.Context, userID user.UserID, id task.TaskID) (*task.Task, error) {
+	return m.FindById(ctx, userID, id)
+}
+
 func (m *MockTaskRepository) Create(ctx context.Context, taskEntity *task.Task) (*task.Task, error) {
 	return taskEntity, nil
 }
 
-func (m *MockTaskRepository) Update(ctx context.Context, taskEntity *task.Task) (*task.Task, error) {
+func (m *MockTaskRepository) Update(ctx context.Context, actorID user.UserID, taskEntity *task.Task) (*task.Task, error) {
 	if taskEntity.UserID().String() == "550e8400-e29b-41d4-a716-446655440000" && taskEntity.ID().String() == "3f6e5e6b-3d6f-4f5e-b5e6-3f6e5e6b3d6f" {
 		return taskEntity, nil
 	}
@@ -89,7 +136,241 @@ func (m *MockTaskRepository) Update(ctx context.Context, taskEntity *task.Task)
 	return nil, task.ErrTaskNotFound
 }
 
-func (m *MockTaskRepository) Delete(ctx context.Context, userID user.UserID, id task.TaskID) error {
+func (m *MockTaskRepository) CompleteOccurrence(ctx context.Context, actorID user.UserID, taskEntity *task.Task, next *task.Task) (*task.Task, error) {
+	return m.Update(ctx, actorID, taskEntity)
+}
+
+func (m *MockTaskRepository) UpdateSeries(ctx context.Context, series *task.Series) error {
+	return task.ErrNotRecurring
+}
+
+func (m *MockTaskRepository) Delete(ctx context.Context, userID user.UserID, id task.TaskID, expectedVersion *int64) error {
+	return nil
+}
+
+func (m *MockTaskRepository) DeleteTree(ctx context.Context, userID user.UserID, id task.TaskID, expectedVersion *int64) error {
+	return nil
+}
+
+func (m *MockTaskRepository) FindLineage(ctx context.Context, creatorID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	return nil, task.ErrTaskNotFound
+}
+
+func (m *MockTaskRepository) FindSubtasks(ctx context.Context, creatorID user.UserID, ids []task.TaskID) ([]*task.Task, error) {
+	return []*task.Task{}, nil
+}
+
+func (m *MockTaskRepository) FindBlockers(ctx context.Context, creatorID user.UserID, id task.TaskID) ([]*task.Task, error) {
+	return []*task.Task{}, nil
+}
+
+func (m *MockTaskRepository) AddDependency(ctx context.Context, creatorID user.UserID, dependency task.Dependency) error {
+	return nil
+}
+
+func (m *MockTaskRepository) RemoveDependency(ctx context.Context, creatorID user.UserID, dependency task.Dependency) error {
+	return nil
+}
+
+func (m *MockTaskRepository) FindTrashByUserID(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+	return []*task.Task{}, nil
+}
+
+func (m *MockTaskRepository) Restore(ctx context.Context, creatorID user.UserID, id task.TaskID) (*task.Task, error) {
+	return nil, task.ErrTaskNotFound
+}
+
+func (m *MockTaskRepository) EmptyTrash(ctx context.Context, creatorID user.UserID) ([]*task.Task, error) {
+	return []*task.Task{}, nil
+}
+
+func (m *MockTaskRepository) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockTaskRepository) Move(ctx context.Context, creatorID user.UserID, id task.TaskID, placement task.Placement) (*task.Task, error) {
+	return nil, task.ErrTaskNotFound
+}
+
+func (m *MockTaskRepository) RebalanceRanks(ctx context.Context, maxLength int) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockTaskRepository) FindCollaborators(ctx context.Context, id task.TaskID) ([]task.Collaborator, error) {
+	return []task.Collaborator{}, nil
+}
+
+func (m *MockTaskRepository) AddCollaborator(ctx context.Context, collaborator task.Collaborator) error {
+	return nil
+}
+
+func (m *MockTaskRepository) RemoveCollaborator(ctx context.Context, id task.TaskID, userID user.UserID) error {
+	return task.ErrCollaboratorNotFound
+}
+
+func (m *MockTaskRepository) Assign(ctx context.Context, taskEntity *task.Task, assignment task.Assignment) (*task.Task, error) {
+	return nil, task.ErrTaskNotFound
+}
+
+func (m *MockTaskRepository) FindAssignments(ctx context.Context, id task.TaskID) ([]task.Assignment, error) {
+	return []task.Assignment{}, nil
+}
+
+func (m *MockTaskRepository) FindHistory(ctx context.Context, id task.TaskID, page task.PageRequest) (task.HistoryPage, error) {
+	return task.HistoryPage{}, nil
+}
+
+func (m *MockTagRepository) FindById(ctx context.Context, ownerID user.UserID, id tag.TagID) (*tag.Tag, error) {
+	return nil, tag.ErrTagNotFound
+}
+
+func (m *MockTagRepository) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*tag.Tag, error) {
+	return []*tag.Tag{}, nil
+}
+
+func (m *MockTagRepository) FindByNames(ctx context.Context, ownerID user.UserID, names []string) ([]*tag.Tag, error) {
+	return []*tag.Tag{}, nil
+}
+
+func (m *MockTagRepository) Create(ctx context.Context, tagEntity *tag.Tag) (*tag.Tag, error) {
+	return tagEntity, nil
+}
+
+func (m *MockTagRepository) Update(ctx context.Context, tagEntity *tag.Tag) (*tag.Tag, error) {
+	return tagEntity, nil
+}
+
+func (m *MockTagRepository) Delete(ctx context.Context, ownerID user.UserID, id tag.TagID) error {
+	return tag.ErrTagNotFound
+}
+
+func (m *MockProjectRepository) FindById(ctx context.Context, ownerID user.UserID, id project.ProjectID) (*project.Project, error) {
+	return nil, project.ErrProjectNotFound
+}
+
+func (m *MockProjectRepository) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*project.Project, error) {
+	return []*project.Project{}, nil
+}
+
+func (m *MockProjectRepository) Create(ctx context.Context, projectEntity *project.Project) (*project.Project, error) {
+	return projectEntity, nil
+}
+
+func (m *MockProjectRepository) Update(ctx context.Context, projectEntity *project.Project) (*project.Project, error) {
+	return projectEntity, nil
+}
+
+func (m *MockProjectRepository) Delete(ctx context.Context, ownerID user.UserID, id project.ProjectID, disposal project.TaskDisposal) error {
+	return project.ErrProjectNotFound
+}
+
+func (m *MockWorkspaceRepository) FindAllByUserID(ctx context.Context, userID user.UserID) ([]*workspace.Workspace, error) {
+	return []*workspace.Workspace{}, nil
+}
+
+func (m *MockWorkspaceRepository) Create(ctx context.Context, workspaceEntity *workspace.Workspace) (*workspace.Workspace, error) {
+	return workspaceEntity, nil
+}
+
+func (m *MockWorkspaceRepository) FindMember(ctx context.Context, id workspace.WorkspaceID, userID user.UserID) (workspace.Member, error) {
+	return workspace.Member{}, workspace.ErrWorkspaceNotFound
+}
+
+func (m *MockWorkspaceRepository) FindMembers(ctx context.Context, id workspace.WorkspaceID) ([]workspace.Member, error) {
+	return []workspace.Member{}, nil
+}
+
+func (m *MockWorkspaceRepository) SaveMember(ctx context.Context, member workspace.Member) error {
+	return nil
+}
+
+func (m *MockWorkspaceRepository) RemoveMember(ctx context.Context, id workspace.WorkspaceID, userID user.UserID) error {
+	return workspace.ErrMemberNotFound
+}
+
+func (m *MockCommentRepository) FindById(ctx context.Context, id comment.CommentID) (*comment.Comment, error) {
+	return nil, comment.ErrCommentNotFound
+}
+
+func (m *MockCommentRepository) FindPageByTaskID(ctx context.Context, taskID task.TaskID, page comment.PageRequest) (comment.Page, error) {
+	return comment.Page{Comments: []*comment.Comment{}, NextCursor: ""}, nil
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, commentEntity *comment.Comment) (*comment.Comment, error) {
+	return commentEntity, nil
+}
+
+func (m *MockCommentRepository) Update(ctx context.Context, commentEntity *comment.Comment) (*comment.Comment, error) {
+	return commentEntity, nil
+}
+
+func (m *MockAttachmentRepository) FindById(ctx context.Context, id attachment.AttachmentID) (*attachment.Attachment, error) {
+	return nil, attachment.ErrAttachmentNotFound
+}
+
+func (m *MockAttachmentRepository) FindAllByTaskID(ctx context.Context, taskID task.TaskID) ([]*attachment.Attachment, error) {
+	return []*attachment.Attachment{}, nil
+}
+
+func (m *MockAttachmentRepository) FindDetached(ctx context.Context, limit int) ([]*attachment.Attachment, error) {
+	return []*attachment.Attachment{}, nil
+}
+
+func (m *MockAttachmentRepository) UsageByUserID(ctx context.Context, userID user.UserID) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockAttachmentRepository) CreateWithinQuota(ctx context.Context, attachmentEntity *attachment.Attachment, quota int64) (*attachment.Attachment, error) {
+	return attachmentEntity, nil
+}
+
+func (m *MockAttachmentRepository) Delete(ctx context.Context, id attachment.AttachmentID) error {
+	return attachment.ErrAttachmentNotFound
+}
+
+func (m *MockBlobStore) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	_, err := io.Copy(io.Discard, content)
+
+	return err
+}
+
+func (m *MockBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return nil, attachment.ErrBlobNotFound
+}
+
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	return nil
+}
+
+func (m *MockWebhookRepository) FindById(ctx context.Context, ownerID user.UserID, id webhook.WebhookID) (*webhook.Webhook, error) {
+	return nil, webhook.ErrWebhookNotFound
+}
+
+func (m *MockWebhookRepository) FindAllByUserID(ctx context.Context, ownerID user.UserID) ([]*webhook.Webhook, error) {
+	return []*webhook.Webhook{}, nil
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, webhookEntity *webhook.Webhook) (*webhook.Webhook, error) {
+	return webhookEntity, nil
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, webhookEntity *webhook.Webhook) (*webhook.Webhook, error) {
+	return webhookEntity, nil
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, ownerID user.UserID, id webhook.WebhookID) error {
+	return webhook.ErrWebhookNotFound
+}
+
+func (m *MockWebhookRepository) DispatchEvents(ctx context.Context, limit int) (int, error) {
+	return 0, nil
+}
+
+func (m *MockWebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]webhook.PendingDelivery, error) {
+	return []webhook.PendingDelivery{}, nil
+}
+
+func (m *MockWebhookRepository) RecordAttempt(ctx context.Context, delivery *webhook.Delivery, attempt webhook.Attempt, policy webhook.RetryPolicy) error {
 	return nil
 }
 
@@ -126,9 +407,18 @@ func setupTestRouter() *echo.Echo {
 	router.Use(handler.CORSMiddleware(*cfg))
 
 	mockRepo := &MockTaskRepository{}
-	taskController := controller.NewTask(mockRepo)
+	mockTagRepo := &MockTagRepository{}
+	mockProjectRepo := &MockProjectRepository{}
+	taskController := controller.NewTask(mockRepo, mockTagRepo, mockProjectRepo, eventbus.New())
+	tagController := controller.NewTag(mockTagRepo)
+	projectController := controller.NewProject(mockProjectRepo, mockRepo)
+	workspaceController := controller.NewWorkspace(&MockWorkspaceRepository{})
+	commentController := controller.NewComment(&MockCommentRepository{}, mockRepo)
+	attachmentController := controller.NewAttachment(&MockAttachmentRepository{}, mockRepo, &MockBlobStore{}, attachment.Limits{MaxFileSize: 1 << 20, UserQuota: 1 << 24})
+	webhookController := controller.NewWebhook(&MockWebhookRepository{})
 	mockHealthService := &MockHealthService{}
-	apiServer := handler.NewAPIServer(*taskController, mockHealthService)
+	taskEvents := eventstream.NewBroker(10, 10, time.Second)
+	apiServer := handler.NewAPIServer(*taskController, *tagController, *projectController, *workspaceController, *commentController, *attachmentController, *webhookController, taskEvents, mockHealthService)
 
 	// Setup authentication service and middleware
 	authService, err := infraAuth.NewAuthenticationService(*cfg)
@@ -138,6 +428,7 @@ func setupTestRouter() *echo.Echo {
 
 	authMiddleware := handler.NewAuthenticationMiddleware(authService)
 	authMiddlewareFunc := authMiddleware.MiddlewareFunc()
+	workspaceMiddlewareFunc := handler.NewWorkspaceMiddleware(*workspaceController).MiddlewareFunc()
 
 	// Create wrapper for generated handlers
 	wrapper := generated.ServerInterfaceWrapper{
@@ -149,14 +440,107 @@ func setupTestRouter() *echo.Echo {
 
 	// Create a group for protected task endpoints
 	taskGroup := router.Group("/tasks")
-	taskGroup.Use(authMiddlewareFunc)
+	taskGroup.Use(authMiddlewareFunc, workspaceMiddlewareFunc)
 
 	// Register task endpoints with authentication middleware
 	taskGroup.GET("", wrapper.TaskGetAllTasks)
 	taskGroup.POST("", wrapper.TaskCreateTask)
+	taskGroup.GET("/events", wrapper.TaskStreamEvents)
+	taskGroup.GET("/search", wrapper.TaskSearchTasks)
+	taskGroup.GET("/trash", wrapper.TaskGetTrash)
+	taskGroup.DELETE("/trash", wrapper.TaskEmptyTrash)
 	taskGroup.GET("/:taskId", wrapper.TaskGetTask)
 	taskGroup.PUT("/:taskId", wrapper.TaskUpdateTask)
+	taskGroup.PATCH("/:taskId", wrapper.TaskPatchTask)
 	taskGroup.DELETE("/:taskId", wrapper.TaskDeleteTask)
+	taskGroup.POST("/:taskId/restore", wrapper.TaskRestoreTask)
+	taskGroup.GET("/:taskId/subtasks", wrapper.TaskGetSubtasks)
+	taskGroup.GET("/:taskId/collaborators", wrapper.TaskGetCollaborators)
+	taskGroup.PUT("/:taskId/collaborators/:userId", wrapper.TaskAddCollaborator)
+	taskGroup.DELETE("/:taskId/collaborators/:userId", wrapper.TaskRemoveCollaborator)
+	taskGroup.PUT("/:taskId/assignee", wrapper.TaskAssignTask)
+	taskGroup.GET("/:taskId/assignments", wrapper.TaskGetAssignments)
+	taskGroup.GET("/:taskId/history", wrapper.TaskGetHistory)
+	taskGroup.GET("/:taskId/comments", wrapper.CommentGetComments)
+	taskGroup.POST("/:taskId/comments", wrapper.CommentCreateComment)
+	taskGroup.GET("/:taskId/attachments", wrapper.AttachmentGetAttachments)
+	taskGroup.POST("/:taskId/attachments", wrapper.AttachmentUploadAttachment)
+	taskGroup.GET("/:taskId/dependencies", wrapper.TaskGetDependencies)
+	taskGroup.PUT("/:taskId/dependencies/:blockerId", wrapper.TaskAddDependency)
+	taskGroup.DELETE("/:taskId/dependencies/:blockerId", wrapper.TaskRemoveDependency)
+	taskGroup.GET("/:taskId/occurrences", wrapper.TaskGetOccurrences)
+	taskGroup.POST("/:taskId/move", wrapper.TaskMoveTask)
+	taskGroup.PUT("/:taskId/series", wrapper.TaskUpdateSeries)
+
+	// Create a group for protected comment endpoints
+	commentGroup := router.Group("/comments")
+	commentGroup.Use(authMiddlewareFunc)
+
+	// Register comment endpoints with authentication middleware
+	commentGroup.PATCH("/:commentId", wrapper.CommentUpdateComment)
+	commentGroup.DELETE("/:commentId", wrapper.CommentDeleteComment)
+
+	// Create a group for protected attachment endpoints
+	attachmentGroup := router.Group("/attachments")
+	attachmentGroup.Use(authMiddlewareFunc)
+
+	// Register attachment endpoints with authentication middleware
+	attachmentGroup.GET("/:attachmentId", wrapper.AttachmentDownloadAttachment)
+	attachmentGroup.DELETE("/:attachmentId", wrapper.AttachmentDeleteAttachment)
+
+	// Create a group for protected tag endpoints
+	tagGroup := router.Group("/tags")
+	tagGroup.Use(authMiddlewareFunc)
+
+	// Register tag endpoints with authentication middleware
+	tagGroup.GET("", wrapper.TagGetAllTags)
+	tagGroup.POST("", wrapper.TagCreateTag)
+	tagGroup.GET("/:tagId", wrapper.TagGetTag)
+	tagGroup.PUT("/:tagId", wrapper.TagUpdateTag)
+	tagGroup.DELETE("/:tagId", wrapper.TagDeleteTag)
+
+	// Create a group for protected project endpoints
+	projectGroup := router.Group("/projects")
+	projectGroup.Use(authMiddlewareFunc)
+
+	// Register project endpoints with authentication middleware
+	projectGroup.GET("", wrapper.ProjectGetAllProjects)
+	projectGroup.POST("", wrapper.ProjectCreateProject)
+	projectGroup.GET("/:projectId", wrapper.ProjectGetProject)
+	projectGroup.PUT("/:projectId", wrapper.ProjectUpdateProject)
+	projectGroup.DELETE("/:projectId", wrapper.ProjectDeleteProject)
+	projectGroup.GET("/:projectId/tasks", wrapper.ProjectGetProjectTasks)
+
+	// Create a group for protected webhook endpoints
+	webhookGroup := router.Group("/webhooks")
+	webhookGroup.Use(authMiddlewareFunc)
+
+	// Register webhook endpoints with authentication middleware
+	webhookGroup.GET("", wrapper.WebhookGetAllWebhooks)
+	webhookGroup.POST("", wrapper.WebhookCreateWebhook)
+	webhookGroup.GET("/:webhookId", wrapper.WebhookGetWebhook)
+	webhookGroup.PATCH("/:webhookId", wrapper.WebhookUpdateWebhook)
+	webhookGroup.DELETE("/:webhookId", wrapper.WebhookDeleteWebhook)
+
+	// Create a group for protected workspace endpoints
+	workspaceGroup := router.Group("/workspaces")
+	workspaceGroup.Use(authMiddlewareFunc)
+
+	// Register workspace endpoints with authentication middleware
+	workspaceGroup.GET("", wrapper.WorkspaceGetAllWorkspaces)
+	workspaceGroup.POST("", wrapper.WorkspaceCreateWorkspace)
+
+	// Endpoints of a single workspace also check the membership of the user
+	memberGroup := workspaceGroup.Group("/:workspaceId", workspaceMiddlewareFunc)
+	memberGroup.GET("/members", wrapper.WorkspaceGetMembers)
+	memberGroup.PUT("/members/:userId", wrapper.WorkspaceSetMember)
+	memberGroup.DELETE("/members/:userId", wrapper.WorkspaceRemoveMember)
+	memberGroup.GET("/tasks", wrapper.TaskGetAllTasks)
+	memberGroup.POST("/tasks", wrapper.TaskCreateTask)
+
+	// Register the task socket with authentication middleware
+	taskSocketHandler := handler.NewTaskSocketHandler(*taskController, taskEvents, config.WebSocketConfig{PingInterval: 30, PongTimeout: 60, MaxMessageSize: 65536})
+	router.GET("/ws", taskSocketHandler.Serve, authMiddlewareFunc)
 
 	return router
 }
@@ -193,6 +577,52 @@ func TestJWTAuthenticationUnauthorized(t *testing.T) {
 			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, w.Code)
 		}
 	})
+
+	t.Run("GET /tasks/events without JWT token should return 401", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/tasks/events", nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+
+	t.Run("GET /ws with invalid JWT token should return 401", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/ws", nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		req.Header.Set("Authorization", "Bearer invalid-token")
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+
+	t.Run("GET /tags without JWT token should return 401", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/tags", nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
 }
 
 func TestJWTAuthenticationAuthorized(t *testing.T) {